@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/google/syzkaller/pkg/email"
 	"golang.org/x/net/context"
 	"google.golang.org/appengine"
 	"google.golang.org/appengine/datastore"
@@ -66,6 +67,38 @@ func accessLevel(c context.Context, r *http.Request) AccessLevel {
 	return AccessUser
 }
 
+// checkEmbargoAccess checks that the current user can see the bug if it is embargoed.
+func checkEmbargoAccess(c context.Context, r *http.Request, bug *Bug) error {
+	if !bug.embargoed(timeNow(c)) || embargoAccess(c, r, bug.Namespace) {
+		return nil
+	}
+	if u := user.Current(c); u != nil {
+		log.Errorf(c, "unauthorized access to embargoed bug: %q [%q]", u.Email, u.AuthDomain)
+	}
+	return ErrAccess
+}
+
+// checkCrashEmbargoAccess checks that the current user can see the crash if it is embargoed.
+func checkCrashEmbargoAccess(c context.Context, r *http.Request, ns string, crash *Crash) error {
+	if !crash.embargoed(ns, timeNow(c)) || embargoAccess(c, r, ns) {
+		return nil
+	}
+	if u := user.Current(c); u != nil {
+		log.Errorf(c, "unauthorized access to embargoed crash: %q [%q]", u.Email, u.AuthDomain)
+	}
+	return ErrAccess
+}
+
+// embargoAccess says if the current user can see embargoed bugs in the namespace:
+// only admins and users listed in the namespace EmbargoConfig can.
+func embargoAccess(c context.Context, r *http.Request, ns string) bool {
+	if accessLevel(c, r) == AccessAdmin {
+		return true
+	}
+	u := user.Current(c)
+	return u != nil && stringInList(config.Namespaces[ns].Embargo.Users, email.CanonicalEmail(u.Email))
+}
+
 func checkTextAccess(c context.Context, r *http.Request, tag string, id int64) (*Crash, error) {
 	switch tag {
 	default:
@@ -74,6 +107,8 @@ func checkTextAccess(c context.Context, r *http.Request, tag string, id int64) (
 		return nil, checkJobTextAccess(c, r, "Patch", id)
 	case textError:
 		return nil, checkJobTextAccess(c, r, "Error", id)
+	case textLog:
+		return nil, checkJobTextAccess(c, r, "Log", id)
 	case textKernelConfig:
 		// This is checked based on text namespace.
 		return nil, nil
@@ -90,6 +125,8 @@ func checkTextAccess(c context.Context, r *http.Request, tag string, id int64) (
 			return crash, err
 		}
 		return nil, checkJobTextAccess(c, r, "CrashReport", id)
+	case textReportJSON:
+		return checkCrashTextAccess(c, r, "ReportJSON", id)
 	case textReproSyz:
 		return checkCrashTextAccess(c, r, "ReproSyz", id)
 	case textReproC:
@@ -115,7 +152,41 @@ func checkCrashTextAccess(c context.Context, r *http.Request, field string, id i
 		return nil, fmt.Errorf("failed to get bug: %v", err)
 	}
 	bugLevel := bug.sanitizeAccess(accessLevel(c, r))
-	return crash, checkAccessLevel(c, r, bugLevel)
+	if err := checkAccessLevel(c, r, bugLevel); err != nil {
+		return nil, err
+	}
+	if err := checkEmbargoAccess(c, r, bug); err != nil {
+		return nil, err
+	}
+	return crash, checkCrashEmbargoAccess(c, r, bug.Namespace, crash)
+}
+
+// checkBlobAccess checks that the current user can see at least one of the crashes
+// that have the blob as an asset and returns namespace of the blob.
+func checkBlobAccess(c context.Context, r *http.Request, id string) (string, error) {
+	var crashes []*Crash
+	keys, err := datastore.NewQuery("Crash").
+		Filter("Assets.Blob=", id).
+		Limit(10).
+		GetAll(c, &crashes)
+	if err != nil {
+		return "", fmt.Errorf("failed to query crashes: %v", err)
+	}
+	if len(keys) == 0 {
+		return "", fmt.Errorf("checkBlobAccess: found no crashes for blob %v", id)
+	}
+	level := accessLevel(c, r)
+	for i, key := range keys {
+		bug := new(Bug)
+		if err := datastore.Get(c, key.Parent(), bug); err != nil {
+			return "", fmt.Errorf("failed to get bug: %v", err)
+		}
+		if level >= bug.sanitizeAccess(level) && checkEmbargoAccess(c, r, bug) == nil &&
+			checkCrashEmbargoAccess(c, r, bug.Namespace, crashes[i]) == nil {
+			return bug.Namespace, nil
+		}
+	}
+	return "", ErrAccess
 }
 
 func checkJobTextAccess(c context.Context, r *http.Request, field string, id int64) error {
@@ -135,7 +206,10 @@ func checkJobTextAccess(c context.Context, r *http.Request, field string, id int
 		return fmt.Errorf("failed to get bug: %v", err)
 	}
 	bugLevel := bug.sanitizeAccess(accessLevel(c, r))
-	return checkAccessLevel(c, r, bugLevel)
+	if err := checkAccessLevel(c, r, bugLevel); err != nil {
+		return err
+	}
+	return checkEmbargoAccess(c, r, bug)
 }
 
 func (bug *Bug) sanitizeAccess(currentLevel AccessLevel) AccessLevel {
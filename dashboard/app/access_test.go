@@ -73,7 +73,7 @@ func TestAccess(t *testing.T) {
 		if err != nil {
 			t.Fatal(err)
 		}
-		crash, _, err := findCrashForBug(c.ctx, bug)
+		crash, _, err := findCrashForBug(c.ctx, bug, false)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -40,8 +40,10 @@ func dropNamespace(c context.Context, w http.ResponseWriter, r *http.Request) er
 		{textKernelConfig, ""},
 		{"Job", ""},
 		{textError, ""},
+		{textLog, ""},
 		{textCrashLog, ""},
 		{textCrashReport, ""},
+		{textReportJSON, ""},
 		{"Build", ""},
 		{"Manager", "ManagerStats"},
 		{"Bug", "Crash"},
@@ -36,6 +36,8 @@ var apiHandlers = map[string]APIHandler{
 	"reporting_poll_bugs":   apiReportingPollBugs,
 	"reporting_poll_closed": apiReportingPollClosed,
 	"reporting_update":      apiReportingUpdate,
+	"add_fix_info":          apiAddFixInfo,
+	"ci_heartbeat":          apiCIHeartbeat,
 }
 
 var apiNamespaceHandlers = map[string]APINamespaceHandler{
@@ -46,6 +48,9 @@ var apiNamespaceHandlers = map[string]APINamespaceHandler{
 	"report_failed_repro": apiReportFailedRepro,
 	"need_repro":          apiNeedRepro,
 	"manager_stats":       apiManagerStats,
+	"need_blob":           apiNeedBlob,
+	"upload_blob_chunk":   apiUploadBlobChunk,
+	"commit_blob":         apiCommitBlob,
 }
 
 type JSONHandler func(c context.Context, r *http.Request) (interface{}, error)
@@ -55,6 +60,7 @@ type APINamespaceHandler func(c context.Context, ns string, r *http.Request, pay
 const (
 	maxReproPerBug   = 10
 	reproRetryPeriod = 24 * time.Hour // try 1 repro per day until we have at least syz repro
+	maxBlobChunks    = 20000          // 10GB, chunk hashes must fit into a single Blob entity
 )
 
 // Overridable for testing.
@@ -219,7 +225,7 @@ func apiJobPoll(c context.Context, r *http.Request, payload []byte) (interface{}
 	if len(req.Managers) == 0 {
 		return nil, fmt.Errorf("no managers")
 	}
-	return pollPendingJobs(c, req.Managers)
+	return pollPendingJobs(c, req)
 }
 
 func apiJobDone(c context.Context, r *http.Request, payload []byte) (interface{}, error) {
@@ -521,9 +527,10 @@ func reportCrash(c context.Context, ns string, req *dashapi.Crash) (*Bug, error)
 		// Such reports are usually unactionable and are discarded.
 		// Collect them into a single bin.
 		req.Title = corruptedReportTitle
+		req.AltTitles = nil
 	}
 
-	bug, bugKey, err := findBugForCrash(c, ns, req.Title)
+	bug, bugKey, err := findBugForCrash(c, ns, req.Title, req.AltTitles)
 	if err != nil {
 		return nil, err
 	}
@@ -565,7 +572,10 @@ func reportCrash(c context.Context, ns string, req *dashapi.Crash) (*Bug, error)
 			Time:        now,
 			Maintainers: req.Maintainers,
 			ReproOpts:   req.ReproOpts,
+			Fingerprint: req.Fingerprint,
 			ReportLen:   prio,
+			Assets:      req.Assets,
+			Embargoed:   req.Embargoed,
 		}
 		if crash.Log, err = putText(c, ns, textCrashLog, req.Log, false); err != nil {
 			return nil, err
@@ -573,6 +583,9 @@ func reportCrash(c context.Context, ns string, req *dashapi.Crash) (*Bug, error)
 		if crash.Report, err = putText(c, ns, textCrashReport, req.Report, false); err != nil {
 			return nil, err
 		}
+		if crash.ReportJSON, err = putText(c, ns, textReportJSON, req.ReportJSON, false); err != nil {
+			return nil, err
+		}
 		if crash.ReproSyz, err = putText(c, ns, textReproSyz, req.ReproSyz, false); err != nil {
 			return nil, err
 		}
@@ -602,14 +615,18 @@ func reportCrash(c context.Context, ns string, req *dashapi.Crash) (*Bug, error)
 			bug.NumRepro++
 			bug.LastReproTime = now
 		}
-		if bug.ReproLevel < reproLevel {
-			bug.ReproLevel = reproLevel
-		}
-		if len(req.Report) != 0 {
-			bug.HasReport = true
-		}
-		if !stringInList(bug.HappenedOn, build.Manager) {
-			bug.HappenedOn = append(bug.HappenedOn, build.Manager)
+		// An embargoed crash in a non-embargoed bug must not leak into the bug info,
+		// its repro and report are not used for reporting (see findCrashForBug).
+		if !req.Embargoed || bug.embargoed(now) {
+			if bug.ReproLevel < reproLevel {
+				bug.ReproLevel = reproLevel
+			}
+			if len(req.Report) != 0 {
+				bug.HasReport = true
+			}
+			if !stringInList(bug.HappenedOn, build.Manager) {
+				bug.HappenedOn = append(bug.HappenedOn, build.Manager)
+			}
 		}
 		if _, err = datastore.Put(c, bugKey, bug); err != nil {
 			return fmt.Errorf("failed to put bug: %v", err)
@@ -681,6 +698,9 @@ func purgeOldCrashes(c context.Context, bug *Bug, bugKey *datastore.Key) {
 		if crash.Report != 0 {
 			toDelete = append(toDelete, datastore.NewKey(c, textCrashReport, "", crash.Report, nil))
 		}
+		if crash.ReportJSON != 0 {
+			toDelete = append(toDelete, datastore.NewKey(c, textReportJSON, "", crash.ReportJSON, nil))
+		}
 	}
 	if err := datastore.DeleteMulti(c, toDelete); err != nil {
 		log.Errorf(c, "failed to delete old crashes: %v", err)
@@ -696,7 +716,7 @@ func apiReportFailedRepro(c context.Context, ns string, r *http.Request, payload
 	}
 	req.Title = limitLength(req.Title, maxTextLen)
 
-	bug, bugKey, err := findBugForCrash(c, ns, req.Title)
+	bug, bugKey, err := findBugForCrash(c, ns, req.Title, req.AltTitles)
 	if err != nil {
 		return nil, err
 	}
@@ -736,7 +756,7 @@ func apiNeedRepro(c context.Context, ns string, r *http.Request, payload []byte)
 	}
 	req.Title = limitLength(req.Title, maxTextLen)
 
-	bug, _, err := findBugForCrash(c, ns, req.Title)
+	bug, _, err := findBugForCrash(c, ns, req.Title, req.AltTitles)
 	if err != nil {
 		return nil, err
 	}
@@ -749,6 +769,31 @@ func apiNeedRepro(c context.Context, ns string, r *http.Request, payload []byte)
 	return resp, nil
 }
 
+func apiCIHeartbeat(c context.Context, r *http.Request, payload []byte) (interface{}, error) {
+	req := new(dashapi.CIHeartbeat)
+	if err := json.Unmarshal(payload, req); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal request: %v", err)
+	}
+	if req.Name == "" {
+		return nil, fmt.Errorf("empty syz-ci name")
+	}
+	for i := range req.Managers {
+		mgr := &req.Managers[i]
+		mgr.LastBuildError = limitLength(mgr.LastBuildError, maxTextLen)
+	}
+	ci := &CIInstance{
+		Name:            req.Name,
+		LastAlive:       timeNow(c),
+		SyzkallerCommit: req.SyzkallerCommit,
+		UpTime:          req.UpTime,
+		Managers:        req.Managers,
+	}
+	if _, err := datastore.Put(c, datastore.NewKey(c, "CIInstance", req.Name, 0, nil), ci); err != nil {
+		return nil, fmt.Errorf("failed to put CI instance: %v", err)
+	}
+	return nil, nil
+}
+
 func apiManagerStats(c context.Context, ns string, r *http.Request, payload []byte) (interface{}, error) {
 	req := new(dashapi.ManagerStatsReq)
 	if err := json.Unmarshal(payload, req); err != nil {
@@ -772,7 +817,31 @@ func apiManagerStats(c context.Context, ns string, r *http.Request, payload []by
 	return nil, err
 }
 
-func findBugForCrash(c context.Context, ns, title string) (*Bug, *datastore.Key, error) {
+// findBugForCrash returns the latest bug with the crash title.
+// If there is no active bug with the title, but there is an active bug with one of altTitles
+// (the crash was reported with a different title before title normalization was introduced),
+// that bug is returned instead, so that such bugs are not re-filed under the new title.
+func findBugForCrash(c context.Context, ns, title string, altTitles []string) (*Bug, *datastore.Key, error) {
+	bug, bugKey, err := findBugForTitle(c, ns, title)
+	if err != nil {
+		return nil, nil, err
+	}
+	if active, err := isActiveBug(c, bug); err != nil || active {
+		return bug, bugKey, err
+	}
+	for _, alt := range altTitles {
+		altBug, altKey, err := findBugForTitle(c, ns, limitLength(alt, maxTextLen))
+		if err != nil {
+			return nil, nil, err
+		}
+		if active, err := isActiveBug(c, altBug); err != nil || active {
+			return altBug, altKey, err
+		}
+	}
+	return bug, bugKey, nil
+}
+
+func findBugForTitle(c context.Context, ns, title string) (*Bug, *datastore.Key, error) {
 	var bugs []*Bug
 	keys, err := datastore.NewQuery("Bug").
 		Filter("Namespace=", ns).
@@ -814,6 +883,10 @@ func createBugForCrash(c context.Context, ns string, req *dashapi.Crash) (*Bug,
 					FirstTime:  now,
 					LastTime:   now,
 				}
+				if req.Embargoed {
+					bug.Embargoed = true
+					bug.Disclosure = now.Add(config.Namespaces[ns].Embargo.Duration)
+				}
 				for _, rep := range config.Namespaces[ns].Reporting {
 					bug.Reporting = append(bug.Reporting, BugReporting{
 						Name: rep.Name,
@@ -875,6 +948,70 @@ func needReproForBug(c context.Context, bug *Bug) bool {
 			bug.ReproLevel == ReproLevelNone && timeSince(c, bug.LastReproTime) > reproRetryPeriod)
 }
 
+func apiNeedBlob(c context.Context, ns string, r *http.Request, payload []byte) (interface{}, error) {
+	req := new(dashapi.NeedBlobReq)
+	if err := json.Unmarshal(payload, req); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal request: %v", err)
+	}
+	err := datastore.Get(c, blobKey(c, ns, req.ID), new(Blob))
+	if err == datastore.ErrNoSuchEntity {
+		return &dashapi.NeedBlobResp{Need: true}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get blob: %v", err)
+	}
+	return &dashapi.NeedBlobResp{Need: false}, nil
+}
+
+func apiUploadBlobChunk(c context.Context, ns string, r *http.Request, payload []byte) (interface{}, error) {
+	req := new(dashapi.BlobChunk)
+	if err := json.Unmarshal(payload, req); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal request: %v", err)
+	}
+	if len(req.Data) == 0 || len(req.Data) > dashapi.BlobChunkSize {
+		return nil, fmt.Errorf("bad blob chunk size %v", len(req.Data))
+	}
+	if hash := dashapi.BlobChunkHash(req.Data); hash != req.Hash {
+		return nil, fmt.Errorf("blob chunk hash mismatch: %v, want %v", hash, req.Hash)
+	}
+	chunk := &BlobChunk{
+		Namespace: ns,
+		Data:      req.Data,
+	}
+	if _, err := datastore.Put(c, blobChunkKey(c, ns, req.Hash), chunk); err != nil {
+		return nil, fmt.Errorf("failed to put blob chunk: %v", err)
+	}
+	return nil, nil
+}
+
+func apiCommitBlob(c context.Context, ns string, r *http.Request, payload []byte) (interface{}, error) {
+	req := new(dashapi.Blob)
+	if err := json.Unmarshal(payload, req); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal request: %v", err)
+	}
+	if id := dashapi.BlobID(req.Chunks); id != req.ID {
+		return nil, fmt.Errorf("blob id mismatch: %v, want %v", id, req.ID)
+	}
+	// All chunks except for the last one are full, this is required to serve byte ranges.
+	n := int64(len(req.Chunks))
+	if n > maxBlobChunks {
+		return nil, fmt.Errorf("blob is too large: %v chunks", n)
+	}
+	if n == 0 || req.Size <= (n-1)*dashapi.BlobChunkSize || req.Size > n*dashapi.BlobChunkSize {
+		return nil, fmt.Errorf("bad blob size %v for %v chunks", req.Size, n)
+	}
+	blob := &Blob{
+		Namespace: ns,
+		Created:   timeNow(c),
+		Size:      req.Size,
+		Chunks:    req.Chunks,
+	}
+	if _, err := datastore.Put(c, blobKey(c, ns, req.ID), blob); err != nil {
+		return nil, fmt.Errorf("failed to put blob: %v", err)
+	}
+	return nil, nil
+}
+
 func putText(c context.Context, ns, tag string, data []byte, dedup bool) (int64, error) {
 	if ns == "" {
 		return 0, fmt.Errorf("putting text outside of namespace")
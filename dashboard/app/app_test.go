@@ -271,3 +271,35 @@ func TestApp(t *testing.T) {
 		ReproLevel: dashapi.ReproLevelC,
 	})
 }
+
+// Crashes with normalized titles must be attributed to active bugs reported with the old titles.
+func TestAltTitles(t *testing.T) {
+	c := NewCtx(t)
+	defer c.Close()
+
+	build := testBuild(1)
+	c.client.UploadBuild(build)
+
+	crash1 := testCrash(build, 1)
+	crash1.Title = "WARNING in SyS_ioctl"
+	c.client.ReportCrash(crash1)
+	rep := c.client.pollBug()
+
+	crash2 := testCrash(build, 2)
+	crash2.Title = "WARNING in sys_ioctl"
+	crash2.AltTitles = []string{"WARNING in SyS_ioctl"}
+	c.client.ReportCrash(crash2)
+	c.client.pollBugs(0)
+	cid := testCrashID(crash2)
+	cid.AltTitles = crash2.AltTitles
+	c.client.ReportFailedRepro(cid)
+	bug, _, _ := c.loadBug(rep.ID)
+	c.expectEQ(bug.NumCrashes, int64(2))
+	c.expectEQ(bug.NumRepro, int64(1))
+
+	// Once the old bug is closed, the new title is used.
+	c.client.updateBug(rep.ID, dashapi.BugStatusInvalid, "")
+	c.client.ReportCrash(crash2)
+	rep2 := c.client.pollBug()
+	c.expectEQ(rep2.Title, "WARNING in sys_ioctl")
+}
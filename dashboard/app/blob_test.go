@@ -0,0 +1,82 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+// +build aetest
+
+package dash
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+
+	"github.com/google/syzkaller/dashboard/dashapi"
+)
+
+func TestCrashAssets(t *testing.T) {
+	c := NewCtx(t)
+	defer c.Close()
+
+	client := c.makeClient(client1, key1, true)
+	build := testBuild(1)
+	client.UploadBuild(build)
+
+	data := make([]byte, 2*dashapi.BlobChunkSize+100)
+	rand.New(rand.NewSource(0)).Read(data)
+	blob, err := dashapi.HashBlob(bytes.NewReader(data), int64(len(data)))
+	c.expectOK(err)
+	c.expectEQ(len(blob.Chunks), 3)
+	c.expectOK(client.UploadBlob(blob, bytes.NewReader(data)))
+	need := new(dashapi.NeedBlobResp)
+	c.expectOK(client.Query("need_blob", &dashapi.NeedBlobReq{ID: blob.ID}, need))
+	c.expectEQ(need.Need, false)
+
+	// Blobs are served only if they are referenced by an accessible crash.
+	blobURL := "/blob?id=" + blob.ID + "&name=vmlinux"
+	_, err = c.AuthGET(AccessAdmin, blobURL)
+	c.expectTrue(err != nil)
+
+	crash := testCrash(build, 1)
+	crash.Assets = []dashapi.CrashAsset{{
+		Type: dashapi.AssetVmlinux,
+		Name: "vmlinux",
+		Blob: blob.ID,
+		Size: blob.Size,
+	}}
+	client.ReportCrash(crash)
+	_, dbCrash, _ := c.loadBug(client.pollBug().ID)
+	c.expectEQ(dbCrash.Assets, crash.Assets)
+	c.checkURLContents(blobURL, data)
+
+	// Commit of a blob with inconsistent id is rejected.
+	bad := *blob
+	bad.Chunks = bad.Chunks[1:]
+	client1Lax := c.makeClient(client1, key1, false)
+	c.expectFail("blob id mismatch", client1Lax.Query("commit_blob", &bad, nil))
+}
+
+func TestParseRange(t *testing.T) {
+	tests := []struct {
+		val     string
+		start   int64
+		end     int64
+		partial bool
+		err     bool
+	}{
+		{"", 0, 100, false, false},
+		{"bytes=0-9", 0, 10, true, false},
+		{"bytes=90-", 90, 100, true, false},
+		{"bytes=90-1000", 90, 100, true, false},
+		{"bytes=100-", 0, 0, false, true},
+		{"bytes=-10", 0, 0, false, true},
+		{"bytes=0-1,5-6", 0, 0, false, true},
+		{"items=0-1", 0, 0, false, true},
+	}
+	for _, test := range tests {
+		start, end, partial, err := parseRange(test.val, 100)
+		if test.err != (err != nil) || start != test.start || end != test.end || partial != test.partial {
+			t.Errorf("range %q: got %v-%v partial=%v err=%v, want %v-%v partial=%v err=%v",
+				test.val, start, end, partial, err, test.start, test.end, test.partial, test.err)
+		}
+	}
+}
@@ -57,6 +57,8 @@ type Config struct {
 	WaitForRepro time.Duration
 	// Managers contains some special additional info about syz-manager instances.
 	Managers map[string]ConfigManager
+	// Handling of embargoed bugs.
+	Embargo EmbargoConfig
 	// Reporting config.
 	Reporting []Reporting
 }
@@ -75,6 +77,23 @@ type ConfigManager struct {
 	RestrictedTestingReason string
 }
 
+// EmbargoConfig describes handling of embargoed bugs (bugs under responsible disclosure).
+// A bug becomes embargoed when it is created by an embargoed crash (see dashapi.Crash.Embargoed).
+// Until the disclosure deadline embargoed bugs are visible only to admins and Users,
+// and are not reported in reportings with AccessPublic.
+// An embargoed crash that matches an existing non-embargoed bug is embargoed on its own
+// (see Crash.Embargoed): its log, report and repro are hidden the same way and are not used
+// for public reporting, it does not change repro level and managers of the bug.
+type EmbargoConfig struct {
+	// Emails of users who can see embargoed bugs in addition to admins.
+	Users []string
+	// Time from the bug (or embargoed crash) creation till the disclosure deadline
+	// (defaultEmbargoDuration if not set).
+	Duration time.Duration
+}
+
+const defaultEmbargoDuration = 90 * 24 * time.Hour
+
 // One reporting stage.
 type Reporting struct {
 	// See GlobalConfig.AccessLevel.
@@ -191,6 +210,15 @@ func installConfig(cfg *GlobalConfig) {
 		if len(cfg.Reporting) == 0 {
 			panic(fmt.Sprintf("no reporting in namespace %q", ns))
 		}
+		if cfg.Embargo.Duration < 0 {
+			panic(fmt.Sprintf("bad embargo duration %v in namespace %q", cfg.Embargo.Duration, ns))
+		}
+		if cfg.Embargo.Duration == 0 {
+			cfg.Embargo.Duration = defaultEmbargoDuration
+		}
+		for i := range cfg.Embargo.Users {
+			cfg.Embargo.Users[i] = email.CanonicalEmail(cfg.Embargo.Users[i])
+		}
 		checkConfigAccessLevel(&cfg.AccessLevel, cfg.AccessLevel, fmt.Sprintf("namespace %q", ns))
 		parentAccessLevel := cfg.AccessLevel
 		reportingNames := make(map[string]bool)
@@ -0,0 +1,124 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+// +build aetest
+
+package dash
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"testing"
+
+	"github.com/google/syzkaller/dashboard/dashapi"
+)
+
+func TestEmbargo(t *testing.T) {
+	c := NewCtx(t)
+	defer c.Close()
+
+	client := c.makeClient(clientPublic, keyPublic, true)
+	build := testBuild(1)
+	client.UploadBuild(build)
+
+	crash := testCrash(build, 1)
+	crash.Title = "embargoed title"
+	crash.Embargoed = true
+	client.ReportCrash(crash)
+
+	// Embargoed bug is reported in the non-public reporting.
+	rep := client.pollBug()
+	bug, _, _ := c.loadBug(rep.ID)
+	c.expectTrue(bug.Embargoed)
+	c.expectEQ(bug.Disclosure, c.mockedTime.Add(defaultEmbargoDuration))
+
+	// But not in the public one until the disclosure deadline.
+	client.updateBug(rep.ID, dashapi.BugStatusUpstream, "")
+	client.pollBugs(0)
+
+	// Only admins can see the bug.
+	bugURL := fmt.Sprintf("/bug?extid=%v", rep.ID)
+	_, err := c.AuthGET(AccessAdmin, bugURL)
+	c.expectOK(err)
+	_, err = c.AuthGET(AccessUser, bugURL)
+	c.expectForbidden(err)
+	page, err := c.AuthGET(AccessUser, "/")
+	c.expectOK(err)
+	c.expectTrue(!bytes.Contains(page, []byte(crash.Title)))
+
+	// Subsequent crashes don't change the embargo.
+	crash.Embargoed = false
+	client.ReportCrash(crash)
+	bug, _, _ = c.loadBug(rep.ID)
+	c.expectTrue(bug.Embargoed)
+
+	// After the deadline the bug is disclosed.
+	c.advanceTime(defaultEmbargoDuration + 1)
+	client.pollBug()
+	_, err = c.AuthGET(AccessUser, bugURL)
+	c.expectOK(err)
+	page, err = c.AuthGET(AccessUser, "/")
+	c.expectOK(err)
+	c.expectTrue(bytes.Contains(page, []byte(crash.Title)))
+}
+
+func TestEmbargoedCrashInPublicBug(t *testing.T) {
+	c := NewCtx(t)
+	defer c.Close()
+
+	client := c.makeClient(clientPublic, keyPublic, true)
+	build := testBuild(1)
+	client.UploadBuild(build)
+
+	crash := testCrash(build, 1)
+	client.ReportCrash(crash)
+	rep := client.pollBug()
+	client.updateBug(rep.ID, dashapi.BugStatusUpstream, "")
+	rep = client.pollBug()
+
+	// An embargoed crash lands on the existing public bug.
+	embargoed := testCrashWithRepro(build, 1)
+	embargoed.Log = []byte("embargoed log")
+	embargoed.Embargoed = true
+	client.ReportCrash(embargoed)
+	bug, crash1, _ := c.loadBug(rep.ID)
+	c.expectTrue(!bug.Embargoed)
+	c.expectTrue(crash1.Embargoed)
+	// It does not change the bug repro level, so the bug is not reported again.
+	c.expectEQ(bug.ReproLevel, ReproLevelNone)
+	client.pollBugs(0)
+	crash2, _, err := findCrashForBug(c.ctx, bug, true)
+	c.expectOK(err)
+	c.expectTrue(!crash2.Embargoed)
+
+	// Only admins can see the crash.
+	logLink := textLink(textCrashLog, crash1.Log)
+	reproLink := textLink(textReproC, crash1.ReproC)
+	bugURL := fmt.Sprintf("/bug?extid=%v", rep.ID)
+	for _, url := range []string{logLink, reproLink} {
+		_, err = c.AuthGET(AccessUser, url)
+		c.expectForbidden(err)
+		_, err = c.AuthGET(AccessAdmin, url)
+		c.expectOK(err)
+	}
+	// Links are HTML-escaped on the bug page.
+	hasLink := func(page []byte, link string) bool {
+		return bytes.Contains(page, []byte(html.EscapeString(link)))
+	}
+	page, err := c.AuthGET(AccessUser, bugURL)
+	c.expectOK(err)
+	c.expectTrue(!hasLink(page, logLink))
+	c.expectTrue(hasLink(page, textLink(textCrashLog, crash2.Log)))
+	page, err = c.AuthGET(AccessAdmin, bugURL)
+	c.expectOK(err)
+	c.expectTrue(hasLink(page, logLink))
+
+	// After the deadline the crash is disclosed.
+	c.advanceTime(defaultEmbargoDuration + 1)
+	_, err = c.AuthGET(AccessUser, logLink)
+	c.expectOK(err)
+	page, err = c.AuthGET(AccessUser, bugURL)
+	c.expectOK(err)
+	c.expectTrue(hasLink(page, logLink))
+}
@@ -34,6 +34,15 @@ type Manager struct {
 	CurrentUpTime  time.Duration
 }
 
+// CIInstance is the last reported status of a syz-ci instance. Keyed by Name.
+type CIInstance struct {
+	Name            string
+	LastAlive       time.Time
+	SyzkallerCommit string
+	UpTime          time.Duration
+	Managers        []dashapi.CIManagerStatus `datastore:",noindex"`
+}
+
 // ManagerStats holds per-day manager runtime stats.
 // Has Manager as parent entity. Keyed by Date.
 type ManagerStats struct {
@@ -83,6 +92,16 @@ type Bug struct {
 	Commits        []string
 	HappenedOn     []string `datastore:",noindex"` // list of managers
 	PatchedOn      []string `datastore:",noindex"` // list of managers
+	// Embargoed bugs have restricted visibility until the Disclosure deadline, see EmbargoConfig.
+	Embargoed  bool
+	Disclosure time.Time
+	// CVE identifiers and fix commits found in kernel trees, see dashapi.BugFixInfo.
+	CVEs          []string
+	UpstreamFixes []dashapi.UpstreamFix `datastore:",noindex"`
+	// Status of the bisection of the commit that introduced the bug (the job is Job with JobBisectCause type).
+	BisectCause BisectStatus
+	// Status of the minimization of the kernel config (the job is Job with JobMinimizeConfig type).
+	MinimizeConfig BisectStatus
 }
 
 type BugReporting struct {
@@ -105,13 +124,20 @@ type Crash struct {
 	Maintainers []string  `datastore:",noindex"`
 	Log         int64     // reference to CrashLog text entity
 	Report      int64     // reference to CrashReport text entity
+	ReportJSON  int64     // reference to CrashReportJSON text entity
 	ReproOpts   []byte    `datastore:",noindex"`
 	ReproSyz    int64     // reference to ReproSyz text entity
 	ReproC      int64     // reference to ReproC text entity
+	Fingerprint string    `datastore:",noindex"` // execution environment fingerprint, see dashapi.Crash
 	// Custom crash priority for reporting (greater values are higher priority).
 	// For example, a crash in mainline kernel has higher priority than a crash in a side branch.
 	// For historical reasons this is called ReportLen.
 	ReportLen int
+	// Large files with debugging context (references to Blob entities), indexed for blob access checks.
+	Assets []dashapi.CrashAsset
+	// Set for crashes from embargoed managers (see dashapi.Crash.Embargoed). Such crashes can end up
+	// in non-embargoed bugs (if the bug already exists), so they have own visibility restrictions.
+	Embargoed bool `datastore:",noindex"`
 }
 
 // ReportingState holds dynamic info associated with reporting.
@@ -127,14 +153,14 @@ type ReportingStateEntry struct {
 	Date int // YYYYMMDD
 }
 
-// Job represent a single patch testing job for syz-ci.
+// Job represent a single job for syz-ci: patch testing, crash cause bisection or config minimization.
 // Later we may want to extend this to other types of jobs (hense the generic name):
 //   - test of a committed fix
 //   - reproduce crash
 //   - test that crash still happens on HEAD
-//   - crash bisect
 // Job has Bug as parent entity.
 type Job struct {
+	Type      dashapi.JobType
 	Created   time.Time
 	User      string
 	CC        []string
@@ -161,24 +187,63 @@ type Job struct {
 	CrashReport int64  // reference to CrashReport text entity
 	BuildID     string
 	Error       int64 // reference to Error text entity, if set job failed
+	// Bisection results:
+	Log     int64    // reference to Log text entity with bisection trace
+	Commits []Commit `datastore:",noindex"` // commit that introduced the bug (if found)
+	// Config minimization results:
+	MinimizedConfig int64 // reference to KernelConfig text entity
 
 	Reported bool // have we reported result back to user?
 }
 
+// Commit is a kernel commit found by bisection.
+type Commit struct {
+	Hash   string
+	Title  string
+	Author string
+	CC     string // |-delimited list of emails
+	Date   time.Time
+}
+
 // Text holds text blobs (crash logs, reports, reproducers, etc).
 type Text struct {
 	Namespace string
 	Text      []byte `datastore:",noindex"` // gzip-compressed text
 }
 
+// BlobChunk holds a chunk of a crash asset blob (see dashapi.CrashAsset).
+// Keyed by namespace and chunk hash, so equal chunks are stored once per namespace.
+type BlobChunk struct {
+	Namespace string
+	Data      []byte `datastore:",noindex"`
+}
+
+// Blob is a crash asset blob uploaded by managers. Keyed by namespace and dashapi.Blob.ID.
+type Blob struct {
+	Namespace string
+	Created   time.Time
+	Size      int64
+	Chunks    []string `datastore:",noindex"` // hashes of BlobChunk entities
+}
+
+func blobKey(c context.Context, ns, id string) *datastore.Key {
+	return datastore.NewKey(c, "Blob", fmt.Sprintf("%v-%v", ns, id), 0, nil)
+}
+
+func blobChunkKey(c context.Context, ns, hash string) *datastore.Key {
+	return datastore.NewKey(c, "BlobChunk", fmt.Sprintf("%v-%v", ns, hash), 0, nil)
+}
+
 const (
 	textCrashLog     = "CrashLog"
 	textCrashReport  = "CrashReport"
+	textReportJSON   = "CrashReportJSON"
 	textReproSyz     = "ReproSyz"
 	textReproC       = "ReproC"
 	textKernelConfig = "KernelConfig"
 	textPatch        = "Patch"
 	textError        = "Error"
+	textLog          = "Log"
 )
 
 const (
@@ -197,6 +262,15 @@ const (
 	ReproLevelC    = dashapi.ReproLevelC
 )
 
+type BisectStatus int
+
+const (
+	BisectNot BisectStatus = iota
+	BisectPending
+	BisectError
+	BisectYes // bisection finished, the culprit may still be not found
+)
+
 type BuildType int
 
 const (
@@ -301,6 +375,17 @@ func lastManagerBuild(c context.Context, ns, manager string) (*Build, error) {
 	return builds[0], nil
 }
 
+// embargoed returns true if the bug is embargoed and the disclosure deadline has not passed yet.
+func (bug *Bug) embargoed(now time.Time) bool {
+	return bug.Embargoed && now.Before(bug.Disclosure)
+}
+
+// embargoed returns true if the crash is embargoed and the disclosure deadline
+// (the namespace embargo duration from the crash time) has not passed yet.
+func (crash *Crash) embargoed(ns string, now time.Time) bool {
+	return crash.Embargoed && now.Before(crash.Time.Add(config.Namespaces[ns].Embargo.Duration))
+}
+
 func (bug *Bug) displayTitle() string {
 	if bug.Seq == 0 {
 		return bug.Title
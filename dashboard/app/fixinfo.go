@@ -0,0 +1,141 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package dash
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/google/syzkaller/dashboard/dashapi"
+	"github.com/google/syzkaller/pkg/vcs"
+	"golang.org/x/net/context"
+	"google.golang.org/appengine/datastore"
+)
+
+// This file contains handling of CVE identifiers and fix commits found in kernel trees
+// (manually attached or imported by tools/syz-fixinfo from upstream/stable trees).
+
+type uiUpstreamFixesPage struct {
+	Header *uiHeader
+	Now    time.Time
+	Bugs   []*uiBug
+}
+
+func apiAddFixInfo(c context.Context, r *http.Request, payload []byte) (interface{}, error) {
+	req := new(dashapi.BugFixInfo)
+	if err := json.Unmarshal(payload, req); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal request: %v", err)
+	}
+	for _, cve := range req.CVEs {
+		if !vcs.CheckCVE(cve) {
+			return nil, fmt.Errorf("bad CVE identifier %q", cve)
+		}
+	}
+	for i := range req.Fixes {
+		fix := &req.Fixes[i]
+		if !vcs.CheckCommitHash(fix.Hash) {
+			return nil, fmt.Errorf("bad fix commit hash %q", fix.Hash)
+		}
+		fix.Title = limitLength(fix.Title, maxTextLen)
+	}
+	_, bugKey, err := findBugByReportingID(c, req.ID)
+	if err != nil {
+		return nil, err
+	}
+	tx := func(c context.Context) error {
+		bug := new(Bug)
+		if err := datastore.Get(c, bugKey, bug); err != nil {
+			return fmt.Errorf("failed to get bug: %v", err)
+		}
+		for _, cve := range req.CVEs {
+			if !stringInList(bug.CVEs, cve) {
+				bug.CVEs = append(bug.CVEs, cve)
+			}
+		}
+		for _, fix := range req.Fixes {
+			if !bug.hasUpstreamFix(fix) {
+				bug.UpstreamFixes = append(bug.UpstreamFixes, fix)
+			}
+		}
+		if _, err := datastore.Put(c, bugKey, bug); err != nil {
+			return fmt.Errorf("failed to put bug: %v", err)
+		}
+		return nil
+	}
+	return nil, datastore.RunInTransaction(c, tx, &datastore.TransactionOptions{Attempts: 30})
+}
+
+func (bug *Bug) hasUpstreamFix(fix dashapi.UpstreamFix) bool {
+	for _, fix1 := range bug.UpstreamFixes {
+		if fix1.Repo == fix.Repo && fix1.Branch == fix.Branch && fix1.Hash == fix.Hash {
+			return true
+		}
+	}
+	return false
+}
+
+// pendingUpstreamFix says if the bug is fixed in some other kernel tree,
+// but the fix has not reached the fuzzed trees yet (the bug does not have the fixing commit).
+func (bug *Bug) pendingUpstreamFix() bool {
+	if bug.Status != BugStatusOpen {
+		return false
+	}
+	for _, fix := range bug.UpstreamFixes {
+		if !stringInList(bug.Commits, fix.Title) {
+			return true
+		}
+	}
+	return false
+}
+
+// handleUpstreamFixes serves the list of open bugs that appear to have fixes
+// in other kernel trees that are not yet in the fuzzed trees.
+func handleUpstreamFixes(c context.Context, w http.ResponseWriter, r *http.Request) error {
+	state, err := loadReportingState(c)
+	if err != nil {
+		return err
+	}
+	accessLevel := accessLevel(c, r)
+	var res []*uiBug
+	for ns, cfg := range config.Namespaces {
+		if accessLevel < cfg.AccessLevel {
+			continue
+		}
+		var bugs []*Bug
+		if _, err := datastore.NewQuery("Bug").
+			Filter("Namespace=", ns).
+			Filter("Status=", BugStatusOpen).
+			GetAll(c, &bugs); err != nil {
+			return err
+		}
+		managers, err := managerList(c, ns)
+		if err != nil {
+			return err
+		}
+		showEmbargoed := embargoAccess(c, r, ns)
+		for _, bug := range bugs {
+			if !bug.pendingUpstreamFix() ||
+				accessLevel < bug.sanitizeAccess(accessLevel) ||
+				!showEmbargoed && bug.embargoed(timeNow(c)) {
+				continue
+			}
+			res = append(res, createUIBug(c, bug, state, managers))
+		}
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Namespace != res[j].Namespace {
+			return res[i].Namespace < res[j].Namespace
+		}
+		return res[i].Title < res[j].Title
+	})
+	data := &uiUpstreamFixesPage{
+		Header: commonHeader(c, r),
+		Now:    timeNow(c),
+		Bugs:   res,
+	}
+	return serveTemplate(w, "upstream_fixes.html", data)
+}
@@ -0,0 +1,71 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+// +build aetest
+
+package dash
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/google/syzkaller/dashboard/dashapi"
+)
+
+func TestFixInfo(t *testing.T) {
+	c := NewCtx(t)
+	defer c.Close()
+
+	build := testBuild(1)
+	c.client.UploadBuild(build)
+
+	crash := testCrash(build, 1)
+	c.client.ReportCrash(crash)
+	rep := c.client.pollBug()
+
+	fix := dashapi.UpstreamFix{
+		Repo:   "git://git.kernel.org/stable.git",
+		Branch: "linux-4.14.y",
+		Hash:   "1111111111111111111111111111111111111111",
+		Title:  "foo: fix the crash",
+	}
+	c.expectOK(c.client.AddBugFixInfo(&dashapi.BugFixInfo{
+		ID:    rep.ID,
+		CVEs:  []string{"CVE-2018-1000"},
+		Fixes: []dashapi.UpstreamFix{fix},
+	}))
+	// Repeated info is not duplicated.
+	c.expectOK(c.client.AddBugFixInfo(&dashapi.BugFixInfo{
+		ID:    rep.ID,
+		CVEs:  []string{"CVE-2018-1000", "CVE-2018-1001"},
+		Fixes: []dashapi.UpstreamFix{fix},
+	}))
+	bug, _, _ := c.loadBug(rep.ID)
+	c.expectEQ(bug.CVEs, []string{"CVE-2018-1000", "CVE-2018-1001"})
+	c.expectEQ(bug.UpstreamFixes, []dashapi.UpstreamFix{fix})
+
+	c.expectFail("bad CVE identifier", c.client.AddBugFixInfo(&dashapi.BugFixInfo{
+		ID:   rep.ID,
+		CVEs: []string{"CVE-18-1"},
+	}))
+	c.expectFail("bad fix commit hash", c.client.AddBugFixInfo(&dashapi.BugFixInfo{
+		ID:    rep.ID,
+		Fixes: []dashapi.UpstreamFix{{Hash: "foo"}},
+	}))
+
+	// The bug is listed as having a fix that is not in the fuzzed trees.
+	page, err := c.AuthGET(AccessAdmin, "/upstream_fixes")
+	c.expectOK(err)
+	c.expectTrue(bytes.Contains(page, []byte(crash.Title)))
+
+	// But not when the fix reached the fuzzed trees.
+	reply, _ := c.client.ReportingUpdate(&dashapi.BugUpdate{
+		ID:         rep.ID,
+		Status:     dashapi.BugStatusOpen,
+		FixCommits: []string{fix.Title},
+	})
+	c.expectEQ(reply.OK, true)
+	page, err = c.AuthGET(AccessAdmin, "/upstream_fixes")
+	c.expectOK(err)
+	c.expectTrue(!bytes.Contains(page, []byte(crash.Title)))
+}
@@ -0,0 +1,50 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+// +build aetest
+
+package dash
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/google/syzkaller/dashboard/dashapi"
+)
+
+func TestCIHeartbeat(t *testing.T) {
+	c := NewCtx(t)
+	defer c.Close()
+
+	req := &dashapi.CIHeartbeat{
+		Name:            "ci-upstream",
+		SyzkallerCommit: "syzkaller-commit-1",
+		UpTime:          time.Hour,
+		Managers: []dashapi.CIManagerStatus{
+			{
+				Name:            "ci-upstream-kasan",
+				KernelCommit:    "kernel-commit-1",
+				KernelBuildTime: c.mockedTime,
+				UpTime:          time.Hour,
+			},
+			{
+				Name:             "ci-upstream-kmsan",
+				LastBuildError:   "kernel build failed",
+				LastBuildFailure: c.mockedTime,
+			},
+		},
+	}
+	c.expectOK(c.client.UploadCIHeartbeat(req))
+	c.expectFail("empty syz-ci name", c.client.UploadCIHeartbeat(&dashapi.CIHeartbeat{}))
+
+	page, err := c.AuthGET(AccessAdmin, "/")
+	c.expectOK(err)
+	for _, want := range []string{"ci-upstream-kasan", "kernel-commit-1", "ci-upstream-kmsan", "syzkaller-commit-1"} {
+		c.expectTrue(bytes.Contains(page, []byte(want)))
+	}
+	// Instances are shown only to admins.
+	page, err = c.AuthGET(AccessUser, "/")
+	c.expectOK(err)
+	c.expectTrue(!bytes.Contains(page, []byte("ci-upstream-kasan")))
+}
@@ -76,7 +76,10 @@ func handleTestRequest(c context.Context, bugID, user, extID, link, patch, repo,
 
 func addTestJob(c context.Context, bug *Bug, bugKey *datastore.Key, bugReporting *BugReporting,
 	user, extID, link, patch, repo, branch string, jobCC []string) (string, error) {
-	crash, crashKey, err := findCrashForBug(c, bug)
+	// Test results are sent to the bug reporting, so embargoed crashes are not used for public ones.
+	reporting := config.Namespaces[bug.Namespace].ReportingByName(bugReporting.Name)
+	public := (reporting == nil || reporting.AccessLevel == AccessPublic) && !bug.embargoed(timeNow(c))
+	crash, crashKey, err := findCrashForBug(c, bug, public)
 	if err != nil {
 		return "", err
 	}
@@ -177,10 +180,18 @@ func addTestJob(c context.Context, bug *Bug, bugKey *datastore.Key, bugReporting
 	return "", nil
 }
 
-// pollPendingJobs returns the next job to execute for the provided list of managers.
-func pollPendingJobs(c context.Context, managers []string) (interface{}, error) {
+// pollPendingJobs returns the next job to execute for the managers in req.
+// If there are no pending jobs, it creates a new bisection job (if req.BisectCause is set)
+// or a new config minimization job (for req.MinimizeConfigManagers).
+func pollPendingJobs(c context.Context, req *dashapi.JobPollReq) (interface{}, error) {
 retry:
-	job, jobKey, err := loadPendingJob(c, managers)
+	job, jobKey, err := loadPendingJob(c, req)
+	if job == nil && err == nil && req.BisectCause {
+		job, jobKey, err = createBisectJob(c, req.Managers, dashapi.JobBisectCause)
+	}
+	if job == nil && err == nil && len(req.MinimizeConfigManagers) != 0 {
+		job, jobKey, err = createBisectJob(c, req.MinimizeConfigManagers, dashapi.JobMinimizeConfig)
+	}
 	if job == nil || err != nil {
 		return job, err
 	}
@@ -242,6 +253,7 @@ retry:
 	}
 	resp := &dashapi.JobPollResp{
 		ID:              jobID,
+		Type:            job.Type,
 		Manager:         job.Manager,
 		KernelRepo:      job.KernelRepo,
 		KernelBranch:    job.KernelBranch,
@@ -252,9 +264,103 @@ retry:
 		ReproSyz:        reproSyz,
 		ReproC:          reproC,
 	}
+	if job.Type == dashapi.JobBisectCause || job.Type == dashapi.JobMinimizeConfig {
+		resp.KernelCommit = build.KernelCommit
+	}
 	return resp, nil
 }
 
+// createBisectJob creates a cause bisection or a config minimization job (depending on typ)
+// for an open bug with a reproducer that happened on one of the managers.
+// Returns nil job if there are no such bugs.
+func createBisectJob(c context.Context, managers []string, typ dashapi.JobType) (*Job, *datastore.Key, error) {
+	_, statusField := bugBisectStatus(new(Bug), typ)
+	var bugs []*Bug
+	keys, err := datastore.NewQuery("Bug").
+		Filter("Status=", BugStatusOpen).
+		Filter(statusField+"=", BisectNot).
+		GetAll(c, &bugs)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to query bugs: %v", err)
+	}
+	mgrs := make(map[string]bool)
+	for _, mgr := range managers {
+		mgrs[mgr] = true
+	}
+	for i, bug := range bugs {
+		if bug.ReproLevel == ReproLevelNone {
+			continue
+		}
+		// Bisection results are reported for the bug, which may be public unless it's embargoed.
+		crash, crashKey, err := findCrashForBug(c, bug, !bug.embargoed(timeNow(c)))
+		if err != nil {
+			return nil, nil, err
+		}
+		if !mgrs[crash.Manager] || crash.ReproC == 0 && crash.ReproSyz == 0 {
+			continue
+		}
+		build, err := loadBuild(c, bug.Namespace, crash.BuildID)
+		if err != nil {
+			return nil, nil, err
+		}
+		job := &Job{
+			Type:         typ,
+			Created:      timeNow(c),
+			Namespace:    bug.Namespace,
+			Manager:      crash.Manager,
+			BugTitle:     bug.displayTitle(),
+			CrashID:      crashKey.IntID(),
+			KernelRepo:   build.KernelRepo,
+			KernelBranch: build.KernelBranch,
+		}
+		bugKey := keys[i]
+		var jobKey *datastore.Key
+		tx := func(c context.Context) error {
+			jobKey = nil
+			bug := new(Bug)
+			if err := datastore.Get(c, bugKey, bug); err != nil {
+				return fmt.Errorf("failed to get bug: %v", err)
+			}
+			status, _ := bugBisectStatus(bug, typ)
+			if *status != BisectNot {
+				// Somebody else created the job concurrently.
+				return nil
+			}
+			*status = BisectPending
+			if _, err := datastore.Put(c, bugKey, bug); err != nil {
+				return fmt.Errorf("failed to put bug: %v", err)
+			}
+			key, err := datastore.Put(c, datastore.NewIncompleteKey(c, "Job", bugKey), job)
+			if err != nil {
+				return fmt.Errorf("failed to put job: %v", err)
+			}
+			jobKey = key
+			return nil
+		}
+		if err := datastore.RunInTransaction(c, tx, &datastore.TransactionOptions{Attempts: 10}); err != nil {
+			return nil, nil, fmt.Errorf("bisect job tx failed: %v", err)
+		}
+		if jobKey == nil {
+			continue
+		}
+		log.Infof(c, "created bisection job (type %v) for bug %q", typ, bug.displayTitle())
+		return job, jobKey, nil
+	}
+	return nil, nil, nil
+}
+
+// bugBisectStatus returns the bug field (and its name) that holds status of the job of the given type.
+func bugBisectStatus(bug *Bug, typ dashapi.JobType) (*BisectStatus, string) {
+	switch typ {
+	case dashapi.JobBisectCause:
+		return &bug.BisectCause, "BisectCause"
+	case dashapi.JobMinimizeConfig:
+		return &bug.MinimizeConfig, "MinimizeConfig"
+	default:
+		panic(fmt.Sprintf("bad bisection job type %v", typ))
+	}
+}
+
 // doneJob is called by syz-ci to mark completion of a job.
 func doneJob(c context.Context, req *dashapi.JobDoneReq) error {
 	jobID := req.ID
@@ -289,6 +395,11 @@ func doneJob(c context.Context, req *dashapi.JobDoneReq) error {
 		job.BuildID = req.Build.ID
 		job.CrashTitle = req.CrashTitle
 		job.Finished = now
+		if job.Type == dashapi.JobBisectCause || job.Type == dashapi.JobMinimizeConfig {
+			if err := doneBisectJob(c, job, jobKey, req); err != nil {
+				return err
+			}
+		}
 		if _, err := datastore.Put(c, jobKey, job); err != nil {
 			return fmt.Errorf("failed to put job: %v", err)
 		}
@@ -297,6 +408,42 @@ func doneJob(c context.Context, req *dashapi.JobDoneReq) error {
 	return datastore.RunInTransaction(c, tx, &datastore.TransactionOptions{XG: true, Attempts: 30})
 }
 
+// doneBisectJob saves bisection (or config minimization) results into the job
+// and updates bisection status of the bug.
+// Bisection results are not reported to users via reportings, so the job is marked as reported.
+func doneBisectJob(c context.Context, job *Job, jobKey *datastore.Key, req *dashapi.JobDoneReq) error {
+	var err error
+	if job.Log, err = putText(c, job.Namespace, textLog, req.Log, false); err != nil {
+		return err
+	}
+	if job.MinimizedConfig, err = putText(c, job.Namespace, textKernelConfig, req.MinimizedConfig, true); err != nil {
+		return err
+	}
+	for _, com := range req.Commits {
+		job.Commits = append(job.Commits, Commit{
+			Hash:   com.Hash,
+			Title:  com.Title,
+			Author: com.Author,
+			CC:     strings.Join(com.CC, "|"),
+			Date:   com.Date,
+		})
+	}
+	job.Reported = true
+	bug := new(Bug)
+	if err := datastore.Get(c, jobKey.Parent(), bug); err != nil {
+		return fmt.Errorf("job %v: failed to get bug: %v", extJobID(jobKey), err)
+	}
+	status, _ := bugBisectStatus(bug, job.Type)
+	*status = BisectYes
+	if len(req.Error) != 0 {
+		*status = BisectError
+	}
+	if _, err := datastore.Put(c, jobKey.Parent(), bug); err != nil {
+		return fmt.Errorf("failed to put bug: %v", err)
+	}
+	return nil
+}
+
 func pollCompletedJobs(c context.Context, typ string) ([]*dashapi.BugReport, error) {
 	var jobs []*Job
 	keys, err := datastore.NewQuery("Job").
@@ -418,7 +565,7 @@ func jobReported(c context.Context, jobID string) error {
 	return datastore.RunInTransaction(c, tx, nil)
 }
 
-func loadPendingJob(c context.Context, managers []string) (*Job, *datastore.Key, error) {
+func loadPendingJob(c context.Context, req *dashapi.JobPollReq) (*Job, *datastore.Key, error) {
 	var jobs []*Job
 	keys, err := datastore.NewQuery("Job").
 		Filter("Finished=", time.Time{}).
@@ -429,11 +576,16 @@ func loadPendingJob(c context.Context, managers []string) (*Job, *datastore.Key,
 		return nil, nil, fmt.Errorf("failed to query jobs: %v", err)
 	}
 	mgrs := make(map[string]bool)
-	for _, mgr := range managers {
+	for _, mgr := range req.Managers {
 		mgrs[mgr] = true
 	}
+	minimizeMgrs := make(map[string]bool)
+	for _, mgr := range req.MinimizeConfigManagers {
+		minimizeMgrs[mgr] = true
+	}
 	for i, job := range jobs {
-		if !mgrs[job.Manager] {
+		if !mgrs[job.Manager] || job.Type == dashapi.JobBisectCause && !req.BisectCause ||
+			job.Type == dashapi.JobMinimizeConfig && !minimizeMgrs[job.Manager] {
 			continue
 		}
 		return job, keys[i], nil
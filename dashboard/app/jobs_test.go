@@ -82,7 +82,7 @@ func TestJob(t *testing.T) {
 		EmailOptFrom("\"foo\" <blAcklisteD@dOmain.COM>"))
 	c.expectOK(c.GET("/email_poll"))
 	c.expectEQ(len(c.emailSink), 0)
-	pollResp, _ := c.client2.JobPoll([]string{build.Manager})
+	pollResp, _ := c.client2.JobPoll(&dashapi.JobPollReq{Managers: []string{build.Manager}})
 	c.expectEQ(pollResp.ID, "")
 
 	c.incomingEmail(sender, "#syz test: git://git.git/git.git kernel-branch\n"+patch,
@@ -98,9 +98,9 @@ func TestJob(t *testing.T) {
 	c.expectOK(c.GET("/email_poll"))
 	c.expectEQ(len(c.emailSink), 0)
 
-	pollResp, _ = c.client2.JobPoll([]string{"foobar"})
+	pollResp, _ = c.client2.JobPoll(&dashapi.JobPollReq{Managers: []string{"foobar"}})
 	c.expectEQ(pollResp.ID, "")
-	pollResp, _ = c.client2.JobPoll([]string{build.Manager})
+	pollResp, _ = c.client2.JobPoll(&dashapi.JobPollReq{Managers: []string{build.Manager}})
 	c.expectEQ(pollResp.ID != "", true)
 	c.expectEQ(pollResp.Manager, build.Manager)
 	c.expectEQ(pollResp.KernelRepo, "git://git.git/git.git")
@@ -112,7 +112,7 @@ func TestJob(t *testing.T) {
 	c.expectEQ(pollResp.ReproSyz, []byte("repro syz"))
 	c.expectEQ(pollResp.ReproC, []byte("repro C"))
 
-	pollResp2, _ := c.client2.JobPoll([]string{build.Manager})
+	pollResp2, _ := c.client2.JobPoll(&dashapi.JobPollReq{Managers: []string{build.Manager}})
 	c.expectEQ(pollResp2, pollResp)
 
 	jobDoneReq := &dashapi.JobDoneReq{
@@ -163,7 +163,7 @@ patch:          %[1]v
 
 	// Testing fails with an error.
 	c.incomingEmail(sender, "#syz test: git://git.git/git.git kernel-branch\n"+patch, EmailOptMessageID(2))
-	pollResp, _ = c.client2.JobPoll([]string{build.Manager})
+	pollResp, _ = c.client2.JobPoll(&dashapi.JobPollReq{Managers: []string{build.Manager}})
 	jobDoneReq = &dashapi.JobDoneReq{
 		ID:    pollResp.ID,
 		Build: *build,
@@ -203,7 +203,7 @@ patch:          %[1]v
 
 	// Testing fails with a huge error that can't be inlined in email.
 	c.incomingEmail(sender, "#syz test: git://git.git/git.git kernel-branch\n"+patch, EmailOptMessageID(3))
-	pollResp, _ = c.client2.JobPoll([]string{build.Manager})
+	pollResp, _ = c.client2.JobPoll(&dashapi.JobPollReq{Managers: []string{build.Manager}})
 	jobDoneReq = &dashapi.JobDoneReq{
 		ID:    pollResp.ID,
 		Build: *build,
@@ -248,7 +248,7 @@ patch:          %[3]v
 	}
 
 	c.incomingEmail(sender, "#syz test: git://git.git/git.git kernel-branch\n"+patch, EmailOptMessageID(4))
-	pollResp, _ = c.client2.JobPoll([]string{build.Manager})
+	pollResp, _ = c.client2.JobPoll(&dashapi.JobPollReq{Managers: []string{build.Manager}})
 	jobDoneReq = &dashapi.JobDoneReq{
 		ID:    pollResp.ID,
 		Build: *build,
@@ -285,7 +285,7 @@ Note: testing is done by a robot and is best-effort only.
 		c.checkURLContents(kernelConfigLink, build.KernelConfig)
 	}
 
-	pollResp, _ = c.client2.JobPoll([]string{build.Manager})
+	pollResp, _ = c.client2.JobPoll(&dashapi.JobPollReq{Managers: []string{build.Manager}})
 	c.expectEQ(pollResp.ID, "")
 }
 
@@ -311,7 +311,7 @@ func TestJobWithoutPatch(t *testing.T) {
 	}
 
 	c.incomingEmail(sender, "#syz test: git://mygit.com/git.git 5e6a2eea\n", EmailOptMessageID(1))
-	pollResp, _ := c.client2.JobPoll([]string{build.Manager})
+	pollResp, _ := c.client2.JobPoll(&dashapi.JobPollReq{Managers: []string{build.Manager}})
 	testBuild := testBuild(2)
 	testBuild.KernelRepo = "git://mygit.com/git.git"
 	testBuild.KernelBranch = ""
@@ -349,7 +349,7 @@ Note: testing is done by a robot and is best-effort only.
 		c.checkURLContents(kernelConfigLink, testBuild.KernelConfig)
 	}
 
-	pollResp, _ = c.client2.JobPoll([]string{build.Manager})
+	pollResp, _ = c.client2.JobPoll(&dashapi.JobPollReq{Managers: []string{build.Manager}})
 	c.expectEQ(pollResp.ID, "")
 }
 
@@ -373,13 +373,153 @@ func TestJobRestrictedManager(t *testing.T) {
 	// Testing on a wrong repo must fail and no test jobs passed to manager.
 	c.incomingEmail(sender, "#syz test: git://mygit.com/git.git master\n", EmailOptMessageID(1))
 	c.expectEQ(strings.Contains((<-c.emailSink).Body, "you should test only on restricted.git"), true)
-	pollResp, _ := c.client2.JobPoll([]string{build.Manager})
+	pollResp, _ := c.client2.JobPoll(&dashapi.JobPollReq{Managers: []string{build.Manager}})
 	c.expectEQ(pollResp.ID, "")
 
 	// Testing on the right repo must succeed.
 	c.incomingEmail(sender, "#syz test: git://restricted.git/restricted.git master\n", EmailOptMessageID(2))
-	pollResp, _ = c.client2.JobPoll([]string{build.Manager})
+	pollResp, _ = c.client2.JobPoll(&dashapi.JobPollReq{Managers: []string{build.Manager}})
 	c.expectEQ(pollResp.ID != "", true)
 	c.expectEQ(pollResp.Manager, build.Manager)
 	c.expectEQ(pollResp.KernelRepo, "git://restricted.git/restricted.git")
 }
+
+func TestBisectCauseJob(t *testing.T) {
+	c := NewCtx(t)
+	defer c.Close()
+
+	build := testBuild(1)
+	c.client2.UploadBuild(build)
+	crash := testCrashWithRepro(build, 1)
+	c.client2.ReportCrash(crash)
+	c.expectOK(c.GET("/email_poll"))
+	c.expectEQ(len(c.emailSink), 1)
+	<-c.emailSink
+
+	// Bisection jobs are handed out only to the clients that asked for them.
+	pollResp, _ := c.client2.JobPoll(&dashapi.JobPollReq{Managers: []string{build.Manager}})
+	c.expectEQ(pollResp.ID, "")
+	pollResp, _ = c.client2.JobPoll(&dashapi.JobPollReq{
+		Managers:    []string{"foobar"},
+		BisectCause: true,
+	})
+	c.expectEQ(pollResp.ID, "")
+
+	pollResp, _ = c.client2.JobPoll(&dashapi.JobPollReq{
+		Managers:    []string{build.Manager},
+		BisectCause: true,
+	})
+	c.expectEQ(pollResp.ID != "", true)
+	c.expectEQ(pollResp.Type, dashapi.JobBisectCause)
+	c.expectEQ(pollResp.Manager, build.Manager)
+	c.expectEQ(pollResp.KernelRepo, build.KernelRepo)
+	c.expectEQ(pollResp.KernelBranch, build.KernelBranch)
+	c.expectEQ(pollResp.KernelCommit, build.KernelCommit)
+	c.expectEQ(pollResp.KernelConfig, build.KernelConfig)
+	c.expectEQ(pollResp.SyzkallerCommit, build.SyzkallerCommit)
+	c.expectEQ(pollResp.ReproOpts, crash.ReproOpts)
+	c.expectEQ(pollResp.ReproSyz, crash.ReproSyz)
+	c.expectEQ(pollResp.ReproC, crash.ReproC)
+
+	// The pending job is handed out again, no second job is created.
+	pollResp2, _ := c.client2.JobPoll(&dashapi.JobPollReq{
+		Managers:    []string{build.Manager},
+		BisectCause: true,
+	})
+	c.expectEQ(pollResp2, pollResp)
+
+	done := &dashapi.JobDoneReq{
+		ID:    pollResp.ID,
+		Build: *build,
+		Log:   []byte("bisect log"),
+		Commits: []dashapi.Commit{
+			{
+				Hash:   "36c4a2b3a5a9c0a6d2b4f9a4b1d0f3c8e7a6b5c4",
+				Title:  "kernel: add a bug",
+				Author: "author@kernel.org",
+				CC:     []string{"reviewer1@kernel.org", "reviewer2@kernel.org"},
+				Date:   buildCommitDate,
+			},
+		},
+	}
+	c.expectOK(c.client2.JobDone(done))
+
+	job, _ := c.loadJob(pollResp.ID)
+	c.expectEQ(job.Type, dashapi.JobBisectCause)
+	c.expectEQ(job.Reported, true)
+	c.expectEQ(job.Error, int64(0))
+	c.expectEQ(len(job.Commits), 1)
+	c.expectEQ(job.Commits[0].Hash, done.Commits[0].Hash)
+	c.expectEQ(job.Commits[0].Author, done.Commits[0].Author)
+	c.expectEQ(job.Commits[0].CC, "reviewer1@kernel.org|reviewer2@kernel.org")
+	c.checkURLContents(textLink(textLog, job.Log), done.Log)
+
+	// The bug is bisected only once.
+	pollResp, _ = c.client2.JobPoll(&dashapi.JobPollReq{
+		Managers:    []string{build.Manager},
+		BisectCause: true,
+	})
+	c.expectEQ(pollResp.ID, "")
+}
+
+func TestMinimizeConfigJob(t *testing.T) {
+	c := NewCtx(t)
+	defer c.Close()
+
+	build := testBuild(1)
+	c.client2.UploadBuild(build)
+	crash := testCrashWithRepro(build, 1)
+	c.client2.ReportCrash(crash)
+	c.expectOK(c.GET("/email_poll"))
+	c.expectEQ(len(c.emailSink), 1)
+	<-c.emailSink
+
+	// Minimization jobs are handed out only for managers that can do them.
+	pollResp, _ := c.client2.JobPoll(&dashapi.JobPollReq{Managers: []string{build.Manager}})
+	c.expectEQ(pollResp.ID, "")
+	pollResp, _ = c.client2.JobPoll(&dashapi.JobPollReq{
+		Managers:               []string{build.Manager, "foobar"},
+		MinimizeConfigManagers: []string{"foobar"},
+	})
+	c.expectEQ(pollResp.ID, "")
+
+	pollResp, _ = c.client2.JobPoll(&dashapi.JobPollReq{
+		Managers:               []string{build.Manager},
+		MinimizeConfigManagers: []string{build.Manager},
+	})
+	c.expectEQ(pollResp.ID != "", true)
+	c.expectEQ(pollResp.Type, dashapi.JobMinimizeConfig)
+	c.expectEQ(pollResp.Manager, build.Manager)
+	c.expectEQ(pollResp.KernelCommit, build.KernelCommit)
+	c.expectEQ(pollResp.KernelConfig, build.KernelConfig)
+	c.expectEQ(pollResp.ReproSyz, crash.ReproSyz)
+
+	// The pending minimization job is not handed out to a client that can't do it.
+	pollResp2, _ := c.client2.JobPoll(&dashapi.JobPollReq{
+		Managers:    []string{build.Manager},
+		BisectCause: true,
+	})
+	c.expectEQ(pollResp2.Type, dashapi.JobBisectCause)
+
+	done := &dashapi.JobDoneReq{
+		ID:              pollResp.ID,
+		Build:           *build,
+		Log:             []byte("minimization log"),
+		MinimizedConfig: []byte("CONFIG_KASAN=y\nCONFIG_USB=y\n"),
+	}
+	c.expectOK(c.client2.JobDone(done))
+
+	job, _ := c.loadJob(pollResp.ID)
+	c.expectEQ(job.Type, dashapi.JobMinimizeConfig)
+	c.expectEQ(job.Reported, true)
+	c.expectEQ(job.Error, int64(0))
+	c.checkURLContents(textLink(textLog, job.Log), done.Log)
+	c.checkURLContents(textLink(textKernelConfig, job.MinimizedConfig), done.MinimizedConfig)
+
+	// The config is minimized only once.
+	pollResp, _ = c.client2.JobPoll(&dashapi.JobPollReq{
+		Managers:               []string{build.Manager},
+		MinimizeConfigManagers: []string{build.Manager},
+	})
+	c.expectEQ(pollResp.ID, "")
+}
@@ -7,6 +7,8 @@ import (
 	"bytes"
 	"fmt"
 	"net/http"
+	"net/url"
+	"regexp"
 	"sort"
 	"strconv"
 	"strings"
@@ -14,6 +16,7 @@ import (
 
 	"github.com/google/syzkaller/dashboard/dashapi"
 	"github.com/google/syzkaller/pkg/email"
+	"github.com/google/syzkaller/pkg/hash"
 	"golang.org/x/net/context"
 	"google.golang.org/appengine/datastore"
 	"google.golang.org/appengine/log"
@@ -24,13 +27,16 @@ import (
 func initHTTPHandlers() {
 	http.Handle("/", handlerWrapper(handleMain))
 	http.Handle("/bug", handlerWrapper(handleBug))
+	http.Handle("/upstream_fixes", handlerWrapper(handleUpstreamFixes))
 	http.Handle("/text", handlerWrapper(handleText))
+	http.Handle("/blob", handlerWrapper(handleBlob))
 	http.Handle("/x/.config", handlerWrapper(handleTextX(textKernelConfig)))
 	http.Handle("/x/log.txt", handlerWrapper(handleTextX(textCrashLog)))
 	http.Handle("/x/repro.syz", handlerWrapper(handleTextX(textReproSyz)))
 	http.Handle("/x/repro.c", handlerWrapper(handleTextX(textReproC)))
 	http.Handle("/x/patch.diff", handlerWrapper(handleTextX(textPatch)))
 	http.Handle("/x/error.txt", handlerWrapper(handleTextX(textError)))
+	http.Handle("/x/bisect.txt", handlerWrapper(handleTextX(textLog)))
 }
 
 type uiMain struct {
@@ -38,10 +44,20 @@ type uiMain struct {
 	Now           time.Time
 	Log           []byte
 	Managers      []*uiManager
+	CIInstances   []*uiCIInstance
 	Jobs          []*uiJob
 	BugNamespaces []*uiBugNamespace
 }
 
+type uiCIInstance struct {
+	Name            string
+	LastAlive       time.Time
+	LastAliveBad    bool
+	SyzkallerCommit string
+	UpTime          time.Duration
+	Managers        []dashapi.CIManagerStatus
+}
+
 type uiManager struct {
 	Namespace          string
 	Name               string
@@ -67,14 +83,23 @@ type uiBuild struct {
 }
 
 type uiBugPage struct {
-	Header       *uiHeader
-	Now          time.Time
-	Bug          *uiBug
-	DupOf        *uiBugGroup
-	Dups         *uiBugGroup
-	Similar      *uiBugGroup
-	SampleReport []byte
-	Crashes      []*uiCrash
+	Header         *uiHeader
+	Now            time.Time
+	Bug            *uiBug
+	DupOf          *uiBugGroup
+	Dups           *uiBugGroup
+	Similar        *uiBugGroup
+	SampleReport   []byte
+	Crashes        []*uiCrash
+	BisectCause    *uiBisect
+	MinimizeConfig *uiBisect
+}
+
+type uiBisect struct {
+	Commit     *Commit // nil if the culprit is not found
+	ConfigLink string  // minimized kernel config (for config minimization)
+	LogLink    string
+	ErrorLink  string
 }
 
 type uiBugNamespace struct {
@@ -118,6 +143,9 @@ type uiBug struct {
 	PatchedOn      []string
 	MissingOn      []string
 	NumManagers    int
+	Disclosure     time.Time // disclosure deadline if the bug is embargoed
+	CVEs           []string
+	UpstreamFixes  []dashapi.UpstreamFix
 }
 
 type uiCrash struct {
@@ -126,11 +154,20 @@ type uiCrash struct {
 	Maintainers  string
 	LogLink      string
 	ReportLink   string
+	JSONLink     string
 	ReproSyzLink string
 	ReproCLink   string
+	Fingerprint  string
+	Assets       []*uiAsset
 	*uiBuild
 }
 
+type uiAsset struct {
+	Type string
+	Link string
+	Size int64
+}
+
 type uiJob struct {
 	Created         time.Time
 	BugLink         string
@@ -158,6 +195,7 @@ type uiJob struct {
 func handleMain(c context.Context, w http.ResponseWriter, r *http.Request) error {
 	var errorLog []byte
 	var managers []*uiManager
+	var ciInstances []*uiCIInstance
 	var jobs []*uiJob
 	if accessLevel(c, r) == AccessAdmin && r.FormValue("fixed") == "" {
 		var err error
@@ -169,6 +207,10 @@ func handleMain(c context.Context, w http.ResponseWriter, r *http.Request) error
 		if err != nil {
 			return err
 		}
+		ciInstances, err = loadCIInstances(c)
+		if err != nil {
+			return err
+		}
 		jobs, err = loadRecentJobs(c)
 		if err != nil {
 			return err
@@ -183,6 +225,7 @@ func handleMain(c context.Context, w http.ResponseWriter, r *http.Request) error
 		Now:           timeNow(c),
 		Log:           errorLog,
 		Managers:      managers,
+		CIInstances:   ciInstances,
 		Jobs:          jobs,
 		BugNamespaces: bugNamespaces,
 	}
@@ -192,14 +235,15 @@ func handleMain(c context.Context, w http.ResponseWriter, r *http.Request) error
 // handleBug serves page about a single bug (which is passed in id argument).
 func handleBug(c context.Context, w http.ResponseWriter, r *http.Request) error {
 	bug := new(Bug)
+	var bugKey *datastore.Key
 	if id := r.FormValue("id"); id != "" {
-		bugKey := datastore.NewKey(c, "Bug", id, 0, nil)
+		bugKey = datastore.NewKey(c, "Bug", id, 0, nil)
 		if err := datastore.Get(c, bugKey, bug); err != nil {
 			return err
 		}
 	} else if extID := r.FormValue("extid"); extID != "" {
 		var err error
-		bug, _, err = findBugByReportingID(c, extID)
+		bug, bugKey, err = findBugByReportingID(c, extID)
 		if err != nil {
 			return err
 		}
@@ -210,6 +254,9 @@ func handleBug(c context.Context, w http.ResponseWriter, r *http.Request) error
 	if err := checkAccessLevel(c, r, bug.sanitizeAccess(accessLevel)); err != nil {
 		return err
 	}
+	if err := checkEmbargoAccess(c, r, bug); err != nil {
+		return err
+	}
 	state, err := loadReportingState(c)
 	if err != nil {
 		return err
@@ -224,7 +271,7 @@ func handleBug(c context.Context, w http.ResponseWriter, r *http.Request) error
 		if err := datastore.Get(c, datastore.NewKey(c, "Bug", bug.DupOf, 0, nil), dup); err != nil {
 			return err
 		}
-		if accessLevel >= dup.sanitizeAccess(accessLevel) {
+		if accessLevel >= dup.sanitizeAccess(accessLevel) && checkEmbargoAccess(c, r, dup) == nil {
 			dupOf = &uiBugGroup{
 				Now:     timeNow(c),
 				Caption: "Duplicate of",
@@ -233,7 +280,7 @@ func handleBug(c context.Context, w http.ResponseWriter, r *http.Request) error
 		}
 	}
 	uiBug := createUIBug(c, bug, state, managers)
-	crashes, sampleReport, err := loadCrashesForBug(c, bug)
+	crashes, sampleReport, err := loadCrashesForBug(c, r, bug)
 	if err != nil {
 		return err
 	}
@@ -245,19 +292,60 @@ func handleBug(c context.Context, w http.ResponseWriter, r *http.Request) error
 	if err != nil {
 		return err
 	}
+	bisectCause, err := loadBisectResult(c, bug, bugKey, dashapi.JobBisectCause)
+	if err != nil {
+		return err
+	}
+	minimizeConfig, err := loadBisectResult(c, bug, bugKey, dashapi.JobMinimizeConfig)
+	if err != nil {
+		return err
+	}
 	data := &uiBugPage{
-		Header:       commonHeader(c, r),
-		Now:          timeNow(c),
-		Bug:          uiBug,
-		DupOf:        dupOf,
-		Dups:         dups,
-		Similar:      similar,
-		SampleReport: sampleReport,
-		Crashes:      crashes,
+		Header:         commonHeader(c, r),
+		Now:            timeNow(c),
+		Bug:            uiBug,
+		DupOf:          dupOf,
+		Dups:           dups,
+		Similar:        similar,
+		SampleReport:   sampleReport,
+		Crashes:        crashes,
+		BisectCause:    bisectCause,
+		MinimizeConfig: minimizeConfig,
 	}
 	return serveTemplate(w, "bug.html", data)
 }
 
+// loadBisectResult returns results of the finished cause bisection or config minimization job
+// (depending on typ) for the bug (if any).
+func loadBisectResult(c context.Context, bug *Bug, bugKey *datastore.Key, typ dashapi.JobType) (*uiBisect, error) {
+	if status, _ := bugBisectStatus(bug, typ); *status != BisectYes && *status != BisectError {
+		return nil, nil
+	}
+	var jobs []*Job
+	_, err := datastore.NewQuery("Job").
+		Ancestor(bugKey).
+		Filter("Type=", typ).
+		GetAll(c, &jobs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query bisection jobs: %v", err)
+	}
+	for _, job := range jobs {
+		if job.Finished.IsZero() {
+			continue
+		}
+		res := &uiBisect{
+			ConfigLink: textLink(textKernelConfig, job.MinimizedConfig),
+			LogLink:    textLink(textLog, job.Log),
+			ErrorLink:  textLink(textError, job.Error),
+		}
+		if len(job.Commits) != 0 {
+			res.Commit = &job.Commits[0]
+		}
+		return res, nil
+	}
+	return nil, nil
+}
+
 // handleText serves plain text blobs (crash logs, reports, reproducers, etc).
 func handleTextImpl(c context.Context, w http.ResponseWriter, r *http.Request, tag string) error {
 	var id int64
@@ -286,7 +374,11 @@ func handleTextImpl(c context.Context, w http.ResponseWriter, r *http.Request, t
 	if err := checkAccessLevel(c, r, config.Namespaces[ns].AccessLevel); err != nil {
 		return err
 	}
-	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	if tag == textReportJSON {
+		w.Header().Set("Content-Type", "application/json")
+	} else {
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	}
 	// Unfortunately filename does not work in chrome on linux due to:
 	// https://bugs.chromium.org/p/chromium/issues/detail?id=608342
 	w.Header().Set("Content-Disposition", "inline; filename="+textFilename(tag))
@@ -295,6 +387,9 @@ func handleTextImpl(c context.Context, w http.ResponseWriter, r *http.Request, t
 		w.Write([]byte(syzReproPrefix))
 		if crash != nil {
 			fmt.Fprintf(w, "#%s\n", crash.ReproOpts)
+			if crash.Fingerprint != "" {
+				fmt.Fprintf(w, "%v%v\n", fingerprintPrefix, crash.Fingerprint)
+			}
 		}
 	}
 	w.Write(data)
@@ -311,6 +406,106 @@ func handleTextX(tag string) contextHandler {
 	}
 }
 
+// Responses are limited to 32MB by App Engine, larger blobs need to be downloaded with range requests.
+const maxBlobResponse = 30 << 20
+
+// handleBlob serves crash asset blobs (see dashapi.CrashAsset).
+// Supports single range requests ("Range: bytes=start-end"), ranges longer than maxBlobResponse
+// are truncated and the actual range is returned in Content-Range.
+func handleBlob(c context.Context, w http.ResponseWriter, r *http.Request) error {
+	id := r.FormValue("id")
+	if _, err := hash.FromString(id); err != nil {
+		return ErrDontLog(err)
+	}
+	ns, err := checkBlobAccess(c, r, id)
+	if err != nil {
+		return err
+	}
+	if err := checkAccessLevel(c, r, config.Namespaces[ns].AccessLevel); err != nil {
+		return err
+	}
+	blob := new(Blob)
+	if err := datastore.Get(c, blobKey(c, ns, id), blob); err != nil {
+		return fmt.Errorf("failed to get blob %v (may be not uploaded yet): %v", id, err)
+	}
+	start, end, partial, err := parseRange(r.Header.Get("Range"), blob.Size)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusRequestedRangeNotSatisfiable)
+		return nil
+	}
+	if end-start > maxBlobResponse {
+		if !partial {
+			return ErrDontLog(fmt.Errorf("blob size is %v, use range requests of at most %v bytes",
+				blob.Size, maxBlobResponse))
+		}
+		end = start + maxBlobResponse
+	}
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Header().Set("Content-Disposition", "attachment; filename="+blobFilename(r.FormValue("name")))
+	w.Header().Set("Accept-Ranges", "bytes")
+	w.Header().Set("Content-Length", fmt.Sprint(end-start))
+	if partial {
+		w.Header().Set("Content-Range", fmt.Sprintf("bytes %v-%v/%v", start, end-1, blob.Size))
+		w.WriteHeader(http.StatusPartialContent)
+	}
+	for i := start / dashapi.BlobChunkSize; i*dashapi.BlobChunkSize < end; i++ {
+		chunk := new(BlobChunk)
+		if err := datastore.Get(c, blobChunkKey(c, ns, blob.Chunks[i]), chunk); err != nil {
+			return fmt.Errorf("failed to get blob chunk %v: %v", blob.Chunks[i], err)
+		}
+		off := i * dashapi.BlobChunkSize
+		data := chunk.Data
+		if end-off < int64(len(data)) {
+			data = data[:end-off]
+		}
+		if start > off {
+			data = data[start-off:]
+		}
+		w.Write(data)
+	}
+	return nil
+}
+
+// parseRange parses a single byte range from Range header value,
+// returns [start, end) range and whether the range was requested at all.
+func parseRange(val string, size int64) (int64, int64, bool, error) {
+	if val == "" {
+		return 0, size, false, nil
+	}
+	var start, end int64
+	rng := strings.TrimPrefix(val, "bytes=")
+	if rng == val || strings.Contains(rng, ",") {
+		return 0, 0, false, fmt.Errorf("unsupported range %q", val)
+	}
+	if n, _ := fmt.Sscanf(rng, "%d-%d", &start, &end); n == 2 {
+		end++
+	} else if n == 1 && strings.HasSuffix(rng, "-") {
+		end = size
+	} else {
+		return 0, 0, false, fmt.Errorf("unsupported range %q", val)
+	}
+	if end > size {
+		end = size
+	}
+	if start < 0 || start >= end {
+		return 0, 0, false, fmt.Errorf("bad range %q for size %v", val, size)
+	}
+	return start, end, true, nil
+}
+
+var blobFilenameRe = regexp.MustCompile(`[^a-zA-Z0-9._-]`)
+
+func blobFilename(name string) string {
+	if name = blobFilenameRe.ReplaceAllString(name, "_"); name == "" {
+		name = "blob"
+	}
+	return name
+}
+
+func blobLink(asset dashapi.CrashAsset) string {
+	return fmt.Sprintf("/blob?id=%v&name=%v", asset.Blob, url.QueryEscape(asset.Name))
+}
+
 func textFilename(tag string) string {
 	switch tag {
 	case textKernelConfig:
@@ -319,6 +514,8 @@ func textFilename(tag string) string {
 		return "log.txt"
 	case textCrashReport:
 		return "report.txt"
+	case textReportJSON:
+		return "report.json"
 	case textReproSyz:
 		return "repro.syz"
 	case textReproC:
@@ -327,6 +524,8 @@ func textFilename(tag string) string {
 		return "patch.diff"
 	case textError:
 		return "error.txt"
+	case textLog:
+		return "bisect.txt"
 	default:
 		return "text.txt"
 	}
@@ -347,7 +546,7 @@ func fetchBugs(c context.Context, r *http.Request) ([]*uiBugNamespace, error) {
 		if onlyFixed != "" && onlyFixed != ns {
 			continue
 		}
-		uiNamespace, err := fetchNamespaceBugs(c, accessLevel, ns, state, onlyFixed != "")
+		uiNamespace, err := fetchNamespaceBugs(c, accessLevel, embargoAccess(c, r, ns), ns, state, onlyFixed != "")
 		if err != nil {
 			return nil, err
 		}
@@ -357,7 +556,7 @@ func fetchBugs(c context.Context, r *http.Request) ([]*uiBugNamespace, error) {
 	return res, nil
 }
 
-func fetchNamespaceBugs(c context.Context, accessLevel AccessLevel, ns string,
+func fetchNamespaceBugs(c context.Context, accessLevel AccessLevel, showEmbargoed bool, ns string,
 	state *ReportingState, onlyFixed bool) (*uiBugNamespace, error) {
 	query := datastore.NewQuery("Bug").Filter("Namespace=", ns)
 	if onlyFixed {
@@ -386,6 +585,9 @@ func fetchNamespaceBugs(c context.Context, accessLevel AccessLevel, ns string,
 		if accessLevel < bug.sanitizeAccess(accessLevel) {
 			continue
 		}
+		if !showEmbargoed && bug.embargoed(timeNow(c)) {
+			continue
+		}
 		if bug.Status == BugStatusDup {
 			dups = append(dups, bug)
 			continue
@@ -467,7 +669,7 @@ func loadDupsForBug(c context.Context, r *http.Request, bug *Bug, state *Reporti
 	var results []*uiBug
 	accessLevel := accessLevel(c, r)
 	for _, dup := range dups {
-		if accessLevel < dup.sanitizeAccess(accessLevel) {
+		if accessLevel < dup.sanitizeAccess(accessLevel) || checkEmbargoAccess(c, r, dup) != nil {
 			continue
 		}
 		results = append(results, createUIBug(c, dup, state, managers))
@@ -494,7 +696,7 @@ func loadSimilarBugs(c context.Context, r *http.Request, bug *Bug, state *Report
 	var results []*uiBug
 	accessLevel := accessLevel(c, r)
 	for _, similar := range similar {
-		if accessLevel < similar.sanitizeAccess(accessLevel) {
+		if accessLevel < similar.sanitizeAccess(accessLevel) || checkEmbargoAccess(c, r, similar) != nil {
 			continue
 		}
 		if similar.Namespace == bug.Namespace && similar.Seq == bug.Seq {
@@ -579,6 +781,11 @@ func createUIBug(c context.Context, bug *Bug, state *ReportingState, managers []
 		ExternalLink:   link,
 		CreditEmail:    creditEmail,
 		NumManagers:    len(managers),
+		CVEs:           bug.CVEs,
+		UpstreamFixes:  bug.UpstreamFixes,
+	}
+	if bug.embargoed(timeNow(c)) {
+		uiBug.Disclosure = bug.Disclosure
 	}
 	updateBugBadness(c, uiBug)
 	if len(bug.Commits) != 0 {
@@ -621,7 +828,7 @@ func updateBugBadness(c context.Context, bug *uiBug) {
 	bug.NumCrashesBad = bug.NumCrashes >= 10000 && timeNow(c).Sub(bug.LastTime) < 24*time.Hour
 }
 
-func loadCrashesForBug(c context.Context, bug *Bug) ([]*uiCrash, []byte, error) {
+func loadCrashesForBug(c context.Context, r *http.Request, bug *Bug) ([]*uiCrash, []byte, error) {
 	bugHash := bugKeyHash(bug.Namespace, bug.Title, bug.Seq)
 	bugKey := datastore.NewKey(c, "Bug", bugHash, 0, nil)
 	// We can have more than maxCrashes crashes, if we have lots of reproducers.
@@ -629,9 +836,18 @@ func loadCrashesForBug(c context.Context, bug *Bug) ([]*uiCrash, []byte, error)
 	if err != nil || len(crashes) == 0 {
 		return nil, nil, err
 	}
+	showEmbargoed := embargoAccess(c, r, bug.Namespace)
+	now := timeNow(c)
 	builds := make(map[string]*Build)
 	var results []*uiCrash
+	var sampleCrash *Crash
 	for _, crash := range crashes {
+		if !showEmbargoed && crash.embargoed(bug.Namespace, now) {
+			continue
+		}
+		if sampleCrash == nil {
+			sampleCrash = crash
+		}
 		build := builds[crash.BuildID]
 		if build == nil {
 			build, err = loadBuild(c, bug.Namespace, crash.BuildID)
@@ -646,13 +862,25 @@ func loadCrashesForBug(c context.Context, bug *Bug) ([]*uiCrash, []byte, error)
 			Maintainers:  fmt.Sprintf("%q", crash.Maintainers),
 			LogLink:      textLink(textCrashLog, crash.Log),
 			ReportLink:   textLink(textCrashReport, crash.Report),
+			JSONLink:     textLink(textReportJSON, crash.ReportJSON),
 			ReproSyzLink: textLink(textReproSyz, crash.ReproSyz),
 			ReproCLink:   textLink(textReproC, crash.ReproC),
+			Fingerprint:  crash.Fingerprint,
 			uiBuild:      makeUIBuild(build),
 		}
+		for _, asset := range crash.Assets {
+			ui.Assets = append(ui.Assets, &uiAsset{
+				Type: asset.Type,
+				Link: blobLink(asset),
+				Size: asset.Size,
+			})
+		}
 		results = append(results, ui)
 	}
-	sampleReport, _, err := getText(c, textCrashReport, crashes[0].Report)
+	if sampleCrash == nil {
+		return nil, nil, nil
+	}
+	sampleReport, _, err := getText(c, textCrashReport, sampleCrash.Report)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -731,6 +959,26 @@ func loadManagers(c context.Context) ([]*uiManager, error) {
 	return results, nil
 }
 
+func loadCIInstances(c context.Context) ([]*uiCIInstance, error) {
+	var instances []*CIInstance
+	if _, err := datastore.NewQuery("CIInstance").Order("Name").GetAll(c, &instances); err != nil {
+		return nil, err
+	}
+	now := timeNow(c)
+	var results []*uiCIInstance
+	for _, ci := range instances {
+		results = append(results, &uiCIInstance{
+			Name:            ci.Name,
+			LastAlive:       ci.LastAlive,
+			LastAliveBad:    now.Sub(ci.LastAlive) > time.Hour,
+			SyzkallerCommit: ci.SyzkallerCommit,
+			UpTime:          ci.UpTime,
+			Managers:        ci.Managers,
+		})
+	}
+	return results, nil
+}
+
 func loadRecentJobs(c context.Context) ([]*uiJob, error) {
 	var jobs []*Job
 	keys, err := datastore.NewQuery("Job").
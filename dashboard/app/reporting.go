@@ -31,6 +31,9 @@ const (
 	internalError    = "internal error"
 	// This is embedded as first line of syzkaller reproducer files.
 	syzReproPrefix = "# See https://goo.gl/kgGztJ for information about syzkaller reproducers.\n"
+	// Execution environment fingerprint line in syzkaller reproducers,
+	// must match host.FingerprintPrefix (syz-execprog checks it).
+	fingerprintPrefix = "# fingerprint: "
 )
 
 // reportingPoll is called by backends to get list of bugs that need to be reported.
@@ -119,7 +122,7 @@ func needReport(c context.Context, typ string, state *ReportingState, bug *Bug)
 		return
 	}
 
-	crash, crashKey, err = findCrashForBug(c, bug)
+	crash, crashKey, err = findCrashForBug(c, bug, reporting.AccessLevel == AccessPublic)
 	if err != nil {
 		status = fmt.Sprintf("%v: no crashes!", reporting.DisplayTitle)
 		reporting, bugReporting = nil, nil
@@ -164,6 +167,10 @@ func currentReporting(c context.Context, bug *Bug) (*Reporting, *BugReporting, i
 		if reporting == nil {
 			return nil, nil, 0, "", fmt.Errorf("%v: missing in config", bugReporting.Name)
 		}
+		if reporting.AccessLevel == AccessPublic && bug.embargoed(timeNow(c)) {
+			return nil, nil, 0, fmt.Sprintf("%v: embargoed until %v",
+				reporting.DisplayTitle, formatTime(bug.Disclosure)), nil
+		}
 		switch reporting.Filter(bug) {
 		case FilterSkip:
 			if bugReporting.Reported.IsZero() {
@@ -224,6 +231,9 @@ func createBugReport(c context.Context, bug *Bug, crash *Crash, crashKey *datast
 		if len(crash.ReproOpts) != 0 {
 			fmt.Fprintf(buf, "#%s\n", crash.ReproOpts)
 		}
+		if crash.Fingerprint != "" {
+			fmt.Fprintf(buf, "%v%v\n", fingerprintPrefix, crash.Fingerprint)
+		}
 		buf.Write(reproSyz)
 		reproSyz = buf.Bytes()
 	}
@@ -643,12 +653,30 @@ func queryCrashesForBug(c context.Context, bugKey *datastore.Key, limit int) (
 	return crashes, keys, nil
 }
 
-func findCrashForBug(c context.Context, bug *Bug) (*Crash, *datastore.Key, error) {
+// findCrashForBug returns the best crash of the bug for reporting.
+// If public is set, crashes that are embargoed (see Crash.Embargoed) are skipped.
+func findCrashForBug(c context.Context, bug *Bug, public bool) (*Crash, *datastore.Key, error) {
 	bugKey := datastore.NewKey(c, "Bug", bugKeyHash(bug.Namespace, bug.Title, bug.Seq), 0, nil)
 	crashes, keys, err := queryCrashesForBug(c, bugKey, 1)
 	if err != nil {
 		return nil, nil, err
 	}
+	now := timeNow(c)
+	if public && len(crashes) != 0 && crashes[0].embargoed(bug.Namespace, now) {
+		// The best crash is embargoed, look for the best non-embargoed one.
+		crashes, keys, err = queryCrashesForBug(c, bugKey, maxCrashes+200)
+		if err != nil {
+			return nil, nil, err
+		}
+		for len(crashes) != 0 && crashes[0].embargoed(bug.Namespace, now) {
+			crashes, keys = crashes[1:], keys[1:]
+		}
+		if len(crashes) != 0 {
+			// Repro and report of the bug may come from the embargoed crashes
+			// (if they were reported while the bug was embargoed), so the checks below don't apply.
+			return crashes[0], keys[0], nil
+		}
+	}
 	if len(crashes) < 1 {
 		return nil, nil, fmt.Errorf("no crashes")
 	}
@@ -194,7 +194,7 @@ func (c *Ctx) loadBug(extID string) (*Bug, *Crash, *Build) {
 	if err != nil {
 		c.t.Fatalf("failed to load bug: %v", err)
 	}
-	crash, _, err := findCrashForBug(c.ctx, bug)
+	crash, _, err := findCrashForBug(c.ctx, bug, false)
 	if err != nil {
 		c.t.Fatalf("failed to load crash: %v", err)
 	}
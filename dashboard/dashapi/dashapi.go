@@ -8,6 +8,8 @@ package dashapi
 import (
 	"bytes"
 	"compress/gzip"
+	"crypto/sha1"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -114,16 +116,34 @@ func (dash *Dashboard) BuilderPoll(manager string) (*BuilderPollResp, error) {
 //   - when syz-ci finishes the job, it sends JobDoneReq which contains
 //     job execution result (Build, Crash or Error details),
 //     ID must match JobPollResp.ID.
+// There are 3 types of jobs: testing of patches requested by users,
+// bisection of crashes with reproducers to find the commit that introduced the crash
+// and minimization of kernel configs of crashes with reproducers.
+// Bisection jobs are returned only if JobPollReq.BisectCause is set,
+// config minimization jobs only for managers in JobPollReq.MinimizeConfigManagers.
 
 type JobPollReq struct {
-	Managers []string
+	Managers    []string
+	BisectCause bool // syz-ci can do cause bisection
+	// Subset of Managers that can do kernel config minimization.
+	MinimizeConfigManagers []string
 }
 
+type JobType int
+
+const (
+	JobTestPatch JobType = iota
+	JobBisectCause
+	JobMinimizeConfig
+)
+
 type JobPollResp struct {
 	ID              string
+	Type            JobType
 	Manager         string
 	KernelRepo      string
 	KernelBranch    string
+	KernelCommit    string // commit where the crash happened (for bisection and minimization jobs)
 	KernelConfig    []byte
 	SyzkallerCommit string
 	Patch           []byte
@@ -139,10 +159,23 @@ type JobDoneReq struct {
 	CrashTitle  string
 	CrashLog    []byte
 	CrashReport []byte
+	// Bisection results: trace of the bisection process and the commit that introduced the crash.
+	// Commits is empty if the culprit is not found (e.g. the crash happens on the oldest release).
+	Log     []byte
+	Commits []Commit
+	// Config minimization result: a small kernel config that still reproduces the crash.
+	MinimizedConfig []byte
 }
 
-func (dash *Dashboard) JobPoll(managers []string) (*JobPollResp, error) {
-	req := &JobPollReq{Managers: managers}
+type Commit struct {
+	Hash   string
+	Title  string
+	Author string
+	CC     []string
+	Date   time.Time
+}
+
+func (dash *Dashboard) JobPoll(req *JobPollReq) (*JobPollResp, error) {
 	resp := new(JobPollResp)
 	err := dash.Query("job_poll", req, resp)
 	return resp, err
@@ -163,16 +196,128 @@ func (dash *Dashboard) ReportBuildError(req *BuildErrorReq) error {
 
 // Crash describes a single kernel crash (potentially with repro).
 type Crash struct {
-	BuildID     string // refers to Build.ID
-	Title       string
+	BuildID string // refers to Build.ID
+	Title   string
+	// Titles the crash had before title normalization (see report.DedupTitle),
+	// used to find bugs that were reported with these titles.
+	AltTitles   []string
 	Corrupted   bool // report is corrupted (corrupted title, no stacks, etc)
+	Embargoed   bool // crash is under responsible disclosure, new bugs are embargoed
 	Maintainers []string
 	Log         []byte
 	Report      []byte
+	// Serialized report.JSONReport, machine-readable version of Report.
+	ReportJSON []byte
+	// Serialized fingerprint of the execution environment (executor build, descriptions revision,
+	// enabled features, sandbox), included into syz reproducers to detect replay in a different environment.
+	Fingerprint string
 	// The following is optional and is filled only after repro.
 	ReproOpts []byte
 	ReproSyz  []byte
 	ReproC    []byte
+	// Large files with debugging context for the crash, see CrashAsset.
+	Assets []CrashAsset
+}
+
+// CrashAsset is a large file attached to a crash (full console log, kernel binary, disk image).
+// Contents of assets are uploaded separately as content-addressed blobs (see UploadBlob),
+// so that e.g. vmlinux of a build is uploaded once and shared by all crashes on that build.
+type CrashAsset struct {
+	Type string // one of Asset* constants
+	Name string // file name for downloads
+	Blob string // Blob.ID
+	Size int64
+}
+
+const (
+	AssetConsoleLog = "console_log"
+	AssetVmlinux    = "vmlinux"
+	AssetDiskImage  = "disk_image"
+)
+
+// Blobs are split into chunks of BlobChunkSize (except for the last one),
+// each chunk is identified by hash of its contents, blob ID is hash of the list of chunk hashes.
+const BlobChunkSize = 512 << 10
+
+type Blob struct {
+	ID     string
+	Size   int64
+	Chunks []string
+}
+
+type BlobChunk struct {
+	Hash string
+	Data []byte
+}
+
+type NeedBlobReq struct {
+	ID string
+}
+
+type NeedBlobResp struct {
+	Need bool
+}
+
+// HashBlob splits contents of r into chunks and calculates blob ID.
+func HashBlob(r io.ReaderAt, size int64) (*Blob, error) {
+	blob := &Blob{Size: size}
+	buf := make([]byte, BlobChunkSize)
+	for off := int64(0); off < size; off += BlobChunkSize {
+		n, err := readBlobChunk(r, size, off, buf)
+		if err != nil {
+			return nil, err
+		}
+		blob.Chunks = append(blob.Chunks, BlobChunkHash(buf[:n]))
+	}
+	blob.ID = BlobID(blob.Chunks)
+	return blob, nil
+}
+
+func BlobChunkHash(data []byte) string {
+	sum := sha1.Sum(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func BlobID(chunks []string) string {
+	sum := sha1.Sum([]byte(strings.Join(chunks, "\n")))
+	return hex.EncodeToString(sum[:])
+}
+
+func readBlobChunk(r io.ReaderAt, size, off int64, buf []byte) (int, error) {
+	n := int64(len(buf))
+	if size-off < n {
+		n = size - off
+	}
+	if _, err := r.ReadAt(buf[:n], off); err != nil {
+		return 0, fmt.Errorf("failed to read blob chunk at %v: %v", off, err)
+	}
+	return int(n), nil
+}
+
+// UploadBlob uploads contents of the blob (as returned by HashBlob for r),
+// if the dashboard does not have it yet.
+func (dash *Dashboard) UploadBlob(blob *Blob, r io.ReaderAt) error {
+	resp := new(NeedBlobResp)
+	if err := dash.Query("need_blob", &NeedBlobReq{ID: blob.ID}, resp); err != nil {
+		return err
+	}
+	if !resp.Need {
+		return nil
+	}
+	buf := make([]byte, BlobChunkSize)
+	for i, hash := range blob.Chunks {
+		n, err := readBlobChunk(r, blob.Size, int64(i)*BlobChunkSize, buf)
+		if err != nil {
+			return err
+		}
+		if BlobChunkHash(buf[:n]) != hash {
+			return fmt.Errorf("blob chunk %v has changed since hashing", i)
+		}
+		if err := dash.Query("upload_blob_chunk", &BlobChunk{Hash: hash, Data: buf[:n]}, nil); err != nil {
+			return err
+		}
+	}
+	return dash.Query("commit_blob", blob, nil)
 }
 
 type ReportCrashResp struct {
@@ -189,6 +334,7 @@ func (dash *Dashboard) ReportCrash(crash *Crash) (*ReportCrashResp, error) {
 type CrashID struct {
 	BuildID   string
 	Title     string
+	AltTitles []string // see Crash.AltTitles
 	Corrupted bool
 }
 
@@ -277,6 +423,25 @@ type BugUpdate struct {
 	CrashID    int64
 }
 
+// BugFixInfo attaches CVE identifiers and fix commits found in kernel trees
+// (e.g. upstream or stable) to a bug.
+type BugFixInfo struct {
+	ID    string // bug reporting ID (as in BugReport.ID and Reported-by tags)
+	CVEs  []string
+	Fixes []UpstreamFix
+}
+
+type UpstreamFix struct {
+	Repo   string
+	Branch string
+	Hash   string
+	Title  string
+}
+
+func (dash *Dashboard) AddBugFixInfo(info *BugFixInfo) error {
+	return dash.Query("add_fix_info", info, nil)
+}
+
 type BugUpdateReply struct {
 	// Bug update can fail for 2 reason:
 	//  - update does not pass logical validataion, in this case OK=false
@@ -351,6 +516,31 @@ func (dash *Dashboard) UploadManagerStats(req *ManagerStatsReq) error {
 	return dash.Query("manager_stats", req, nil)
 }
 
+// CIHeartbeat is periodically sent by syz-ci instances to report their own status.
+type CIHeartbeat struct {
+	Name                    string
+	SyzkallerCommit         string
+	UpTime                  time.Duration
+	LastSyzkallerPoll       time.Time // time of the last successful poll of syzkaller repo
+	LastSyzkallerBuildError string    // empty if the last syzkaller build succeeded
+	Managers                []CIManagerStatus
+}
+
+type CIManagerStatus struct {
+	Name             string
+	KernelCommit     string    // kernel commit of the current build
+	KernelBuildTime  time.Time // time of the current build
+	LastKernelPoll   time.Time // time of the last successful poll of the kernel repo
+	LastBuildError   string    // empty if the last kernel build succeeded
+	LastBuildFailure time.Time
+	UpTime           time.Duration // uptime of the syz-manager process, 0 if it is not running
+	Paused           bool          // the manager is paused via syz-ci control API
+}
+
+func (dash *Dashboard) UploadCIHeartbeat(req *CIHeartbeat) error {
+	return dash.Query("ci_heartbeat", req, nil)
+}
+
 type (
 	BugStatus  int
 	ReproLevel int
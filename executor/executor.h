@@ -63,6 +63,12 @@ bool flag_inject_fault;
 int flag_fault_call;
 int flag_fault_nth;
 
+// Execute the program with the credential context (uid/gid, capabilities to drop, no_new_privs).
+bool flag_creds;
+int flag_creds_uid;
+uint64 flag_creds_drop_caps;
+bool flag_creds_nnp;
+
 unsigned long long procid;
 
 int running;
@@ -99,6 +105,7 @@ struct thread_t {
 	long args[kMaxArgs];
 	long res;
 	uint32 reserrno;
+	uint32 duration; // in microseconds
 	uint32 cover_size;
 	bool fault_injected;
 	int cover_fd;
@@ -133,6 +140,9 @@ struct execute_req {
 	uint64 pid;
 	uint64 fault_call;
 	uint64 fault_nth;
+	uint64 creds_uid;
+	uint64 creds_drop_caps;
+	uint64 creds_nnp;
 	uint64 prog_size;
 };
 
@@ -148,6 +158,7 @@ struct call_reply {
 	uint32 call_num;
 	uint32 reserrno;
 	uint32 fault_injected;
+	uint32 duration;
 	uint32 signal_size;
 	uint32 cover_size;
 	uint32 comps_size;
@@ -279,12 +290,17 @@ void receive_execute()
 	flag_collide = req.exec_flags & (1 << 5);
 	flag_fault_call = req.fault_call;
 	flag_fault_nth = req.fault_nth;
+	flag_creds_uid = req.creds_uid;
+	flag_creds_drop_caps = req.creds_drop_caps;
+	flag_creds_nnp = req.creds_nnp;
+	flag_creds = flag_creds_uid || flag_creds_drop_caps || flag_creds_nnp;
 	if (!flag_threaded)
 		flag_collide = false;
-	debug("exec opts: pid=%llu threaded=%d collide=%d cover=%d comps=%d dedup=%d fault=%d/%d/%d prog=%llu\n",
+	debug("exec opts: pid=%llu threaded=%d collide=%d cover=%d comps=%d dedup=%d fault=%d/%d/%d"
+	      " creds=%d/0x%llx/%d prog=%llu\n",
 	      procid, flag_threaded, flag_collide, flag_collect_cover, flag_collect_comps,
 	      flag_dedup_cover, flag_inject_fault, flag_fault_call, flag_fault_nth,
-	      req.prog_size);
+	      flag_creds_uid, flag_creds_drop_caps, flag_creds_nnp, req.prog_size);
 	if (SYZ_EXECUTOR_USES_SHMEM) {
 		if (req.prog_size)
 			fail("need_prog: no program");
@@ -599,6 +615,7 @@ void handle_completion(thread_t* th)
 			write_output(th->call_num);
 			write_output(reserrno);
 			write_output(th->fault_injected);
+			write_output(th->duration);
 			uint32* signal_count_pos = write_output(0); // filled in later
 			uint32* cover_count_pos = write_output(0); // filled in later
 			uint32* comps_count_pos = write_output(0); // filled in later
@@ -641,6 +658,7 @@ void handle_completion(thread_t* th)
 			reply.call_num = th->call_num;
 			reply.reserrno = reserrno;
 			reply.fault_injected = th->fault_injected;
+			reply.duration = th->duration;
 			reply.signal_size = 0;
 			reply.cover_size = 0;
 			reply.comps_size = 0;
@@ -697,6 +715,9 @@ void execute_call(thread_t* th)
 		fail_fd = inject_fault(flag_fault_nth);
 	}
 
+	// The clock is read outside of the coverage window: without vDSO (and on some OSes)
+	// reading the clock is a real syscall, its coverage would pollute signal of the call.
+	uint64 start = current_time_us();
 	if (flag_cover)
 		cover_reset(th);
 	errno = 0;
@@ -704,10 +725,12 @@ void execute_call(thread_t* th)
 				  th->args[3], th->args[4], th->args[5],
 				  th->args[6], th->args[7], th->args[8]);
 	th->reserrno = errno;
-	if (th->res == -1 && th->reserrno == 0)
-		th->reserrno = EINVAL; // our syz syscalls may misbehave
 	if (flag_cover)
 		th->cover_size = cover_read_size(th);
+	uint64 duration = current_time_us() - start;
+	th->duration = duration < (1ull << 32) ? duration : (uint32)-1;
+	if (th->res == -1 && th->reserrno == 0)
+		th->reserrno = EINVAL; // our syz syscalls may misbehave
 	th->fault_injected = false;
 
 	if (flag_inject_fault && th->call_index == flag_fault_call) {
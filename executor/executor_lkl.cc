@@ -0,0 +1,222 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+// +build
+
+// Experimental executor that runs the Linux kernel in-process as LKL (Linux Kernel Library,
+// https://github.com/lkl/linux) and executes programs without forking a new process
+// for each program. This gives much higher throughput for arch-independent subsystems
+// (fs, net), but the kernel state is shared between programs.
+// See docs/linux/lkl.md for details.
+
+#include <fcntl.h>
+#include <limits.h>
+#include <pthread.h>
+#include <string.h>
+#include <sys/mman.h>
+#include <sys/prctl.h>
+#include <sys/syscall.h>
+#include <sys/time.h>
+#include <sys/types.h>
+#include <unistd.h>
+
+#define SYZ_EXECUTOR
+// Pseudo-syscalls are referenced by the syscall table, but are not executed (see execute_syscall).
+#pragma GCC diagnostic push
+#pragma GCC diagnostic ignored "-Wunused-function"
+#include "common_linux.h"
+#pragma GCC diagnostic pop
+
+#include "executor_linux.h"
+
+// LKL uses asm-generic syscall numbers and structs, which is what arm64 uses,
+// so we execute programs generated for linux/arm64 regardless of the host arch.
+#if defined(__x86_64__)
+#define SYZ_LKL_HOST_X86_64
+#undef __x86_64__
+#endif
+#if !defined(__aarch64__)
+#define SYZ_LKL_HOST_NOT_AARCH64
+#define __aarch64__ 1
+#endif
+#include "syscalls_linux.h"
+#if defined(SYZ_LKL_HOST_NOT_AARCH64)
+#undef __aarch64__
+#endif
+#if defined(SYZ_LKL_HOST_X86_64)
+#define __x86_64__ 1
+#endif
+
+#include "executor.h"
+
+#include <lkl.h>
+#include <lkl_host.h>
+
+const int kInFd = 3;
+const int kOutFd = 4;
+
+// Memory given to the LKL kernel.
+const char* kLKLMemory = "64M";
+
+uint32* output_data;
+uint32* output_pos;
+
+int main(int argc, char** argv)
+{
+	if (argc == 2 && strcmp(argv[1], "version") == 0) {
+		puts(GOOS " " GOARCH " " SYZ_REVISION " " GIT_REVISION);
+		return 0;
+	}
+
+	prctl(PR_SET_PDEATHSIG, SIGKILL, 0, 0, 0);
+	if (mmap(&input_data[0], kMaxInput, PROT_READ, MAP_PRIVATE | MAP_FIXED, kInFd, 0) != &input_data[0])
+		fail("mmap of input file failed");
+	// See executor_linux.cc for explanation of the output region address.
+	void* preferred = (void*)(0x1b2bc20000ull + (1 << 20) * (getpid() % 128));
+	output_data = (uint32*)mmap(preferred, kMaxOutput,
+				    PROT_READ | PROT_WRITE, MAP_SHARED | MAP_FIXED, kOutFd, 0);
+	if (output_data != preferred)
+		fail("mmap of output file failed");
+	// LKL kernel accesses "user" memory directly, so the data segment is just host memory.
+	if (mmap((void*)SYZ_DATA_OFFSET, SYZ_NUM_PAGES * SYZ_PAGE_SIZE, PROT_READ | PROT_WRITE,
+		 MAP_ANON | MAP_PRIVATE | MAP_FIXED, -1, 0) != (void*)SYZ_DATA_OFFSET)
+		fail("mmap of data segment failed");
+	close(kInFd);
+	close(kOutFd);
+	main_init();
+	install_segv_handler();
+	if (flag_sandbox != sandbox_none)
+		fail("lkl executor supports only sandbox none");
+	if (flag_enable_fault_injection)
+		fail("lkl executor does not support fault injection");
+	int err = lkl_start_kernel(&lkl_host_ops, "mem=%s", kLKLMemory);
+	if (err < 0)
+		fail("failed to start lkl kernel: %s", lkl_strerror(err));
+	reply_handshake();
+
+	// Unlike the linux executor, we don't fork for every program:
+	// the kernel lives in this process and is reused by subsequent programs,
+	// similar to persistent mode of libFuzzer.
+	for (;;) {
+		receive_execute();
+		if (flag_inject_fault)
+			fail("lkl executor does not support fault injection");
+		output_pos = output_data;
+		collide = false;
+		execute_one();
+		if (running != 0) {
+			// Some calls are still blocked in the kernel. We can't kill them,
+			// so ask ipc to restart the executor with a fresh kernel.
+			debug("%d calls are still running, restarting\n", running);
+			reply_execute(kRetryStatus);
+			doexit(kRetryStatus);
+		}
+		reply_execute(0);
+	}
+	// Unreachable.
+	return 1;
+}
+
+// LKL executes syscalls in the context of the calling host thread,
+// so coverage is collected into per-thread buffers.
+static __thread thread_t* current_thread;
+
+// LKL is built with -fsanitize-coverage=trace-pc, the callback has the same format as kcov:
+// the first word of the buffer is the number of PCs, followed by the PCs.
+extern "C" void __sanitizer_cov_trace_pc()
+{
+	thread_t* th = current_thread;
+	if (th == 0 || flag_collect_comps)
+		return;
+	uint64* data = (uint64*)th->cover_data;
+	uint64 n = data[0];
+	if (n + 1 >= kCoverSize)
+		return;
+	data[n + 1] = (uint64)__builtin_return_address(0);
+	data[0] = n + 1;
+}
+
+long execute_syscall(const call_t* c, long a0, long a1, long a2, long a3, long a4, long a5, long a6, long a7, long a8)
+{
+	if (c->call) {
+		// Pseudo-syscalls work with host files (/dev, /proc, etc), which LKL does not have.
+		errno = ENOSYS;
+		return -1;
+	}
+	long params[6] = {a0, a1, a2, a3, a4, a5};
+	long res = lkl_syscall(c->sys_nr, params);
+	if (res < 0) {
+		errno = -res;
+		return -1;
+	}
+	return res;
+}
+
+void cover_open()
+{
+	for (int i = 0; i < kMaxThreads; i++) {
+		thread_t* th = &threads[i];
+		size_t alloc_size = kCoverSize * sizeof(uint64);
+		th->cover_data = (char*)mmap(NULL, alloc_size,
+					     PROT_READ | PROT_WRITE, MAP_ANON | MAP_PRIVATE, -1, 0);
+		if (th->cover_data == MAP_FAILED)
+			fail("cover mmap failed");
+		th->cover_end = th->cover_data + alloc_size;
+	}
+}
+
+void cover_enable(thread_t* th)
+{
+	if (flag_collect_comps)
+		debug("#%d: comparisons are not supported by lkl executor\n", th->id);
+	current_thread = th;
+}
+
+void cover_reset(thread_t* th)
+{
+	if (th == 0)
+		th = current_thread;
+	*(uint64*)th->cover_data = 0;
+}
+
+uint32 cover_read_size(thread_t* th)
+{
+	uint32 n = *(uint64*)th->cover_data;
+	debug("#%d: read cover size = %u\n", th->id, n);
+	if (n >= kCoverSize)
+		fail("#%d: too much cover %u", th->id, n);
+	return n;
+}
+
+bool cover_check(uint32 pc)
+{
+	return true;
+}
+
+bool cover_check(uint64 pc)
+{
+	// LKL code is part of the executor binary, all PCs belong to the kernel.
+	return true;
+}
+
+uint32* write_output(uint32 v)
+{
+	if (collide)
+		return 0;
+	if (output_pos < output_data || (char*)output_pos >= (char*)output_data + kMaxOutput)
+		fail("output overflow: pos=%p region=[%p:%p]",
+		     output_pos, output_data, (char*)output_data + kMaxOutput);
+	*output_pos = v;
+	return output_pos++;
+}
+
+void write_completed(uint32 completed)
+{
+	__atomic_store_n(output_data, completed, __ATOMIC_RELEASE);
+}
+
+bool kcov_comparison_t::ignore() const
+{
+	// Comparisons are not collected (see cover_enable).
+	return true;
+}
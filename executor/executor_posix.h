@@ -80,3 +80,12 @@ bool event_timedwait(event_t* ev, uint64 timeout_ms)
 	pthread_mutex_unlock(&ev->mu);
 	return res;
 }
+
+// current_time_us returns monotonic time in microseconds, used to measure syscall latency.
+uint64 current_time_us()
+{
+	struct timespec ts;
+	if (clock_gettime(CLOCK_MONOTONIC, &ts))
+		fail("clock_gettime failed");
+	return (uint64)ts.tv_sec * 1000000 + (uint64)ts.tv_nsec / 1000;
+}
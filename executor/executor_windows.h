@@ -72,3 +72,12 @@ bool event_timedwait(event_t* ev, uint64 timeout_ms)
 	LeaveCriticalSection(&ev->cs);
 	return res;
 }
+
+// current_time_us returns monotonic time in microseconds, used to measure syscall latency.
+uint64 current_time_us()
+{
+	LARGE_INTEGER freq, now;
+	QueryPerformanceFrequency(&freq);
+	QueryPerformanceCounter(&now);
+	return (uint64)(now.QuadPart / freq.QuadPart * 1000000 + now.QuadPart % freq.QuadPart * 1000000 / freq.QuadPart);
+}
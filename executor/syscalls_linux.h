@@ -2,13 +2,13 @@
 
 #if defined(__i386__) || 0
 #define GOARCH "386"
-#define SYZ_REVISION "7c3b92654cfa280739a2cab1908dd7fbafba5a74"
+#define SYZ_REVISION "a38fbd9c57db6ddea7338f74b40b46b1730820e1"
 #define SYZ_EXECUTOR_USES_FORK_SERVER true
 #define SYZ_EXECUTOR_USES_SHMEM true
 #define SYZ_PAGE_SIZE 4096
 #define SYZ_NUM_PAGES 4096
 #define SYZ_DATA_OFFSET 536870912
-#define SYZ_SYSCALL_COUNT 2021
+#define SYZ_SYSCALL_COUNT 2065
 const call_t syscalls[] = {
     {"accept4", 364},
     {"accept4$alg", 364},
@@ -405,6 +405,17 @@ const call_t syscalls[] = {
     {"io_pgetevents", 385},
     {"io_setup", 245},
     {"io_submit", 248},
+    {"io_uring_enter", 426},
+    {"io_uring_register$IORING_REGISTER_BUFFERS", 427},
+    {"io_uring_register$IORING_REGISTER_EVENTFD", 427},
+    {"io_uring_register$IORING_REGISTER_FILES", 427},
+    {"io_uring_register$IORING_REGISTER_FILES_UPDATE", 427},
+    {"io_uring_register$IORING_REGISTER_PERSONALITY", 427},
+    {"io_uring_register$IORING_UNREGISTER_BUFFERS", 427},
+    {"io_uring_register$IORING_UNREGISTER_EVENTFD", 427},
+    {"io_uring_register$IORING_UNREGISTER_FILES", 427},
+    {"io_uring_register$IORING_UNREGISTER_PERSONALITY", 427},
+    {"io_uring_setup", 425},
     {"ioctl", 54},
     {"ioctl$ASHMEM_GET_NAME", 54},
     {"ioctl$ASHMEM_GET_PIN_STATUS", 54},
@@ -1249,6 +1260,10 @@ const call_t syscalls[] = {
     {"keyctl$setperm", 288},
     {"keyctl$unlink", 288},
     {"keyctl$update", 288},
+    {"landlock_add_rule$LANDLOCK_RULE_PATH_BENEATH", 445},
+    {"landlock_create_ruleset", 444},
+    {"landlock_create_ruleset$version", 444},
+    {"landlock_restrict_self", 446},
     {"lchown", 16},
     {"lgetxattr", 230},
     {"link", 9},
@@ -1305,12 +1320,25 @@ const call_t syscalls[] = {
     {"open$dir", 5},
     {"open_by_handle_at", 342},
     {"openat", 295},
+    {"openat$apparmor_load", 295},
+    {"openat$apparmor_profiles", 295},
+    {"openat$apparmor_remove", 295},
+    {"openat$apparmor_replace", 295},
+    {"openat$apparmor_revision", 295},
+    {"openat$apparmor_task_current", 295},
+    {"openat$apparmor_task_exec", 295},
+    {"openat$apparmor_thread_current", 295},
+    {"openat$apparmor_thread_exec", 295},
     {"openat$ashmem", 295},
     {"openat$audio", 295},
     {"openat$autofs", 295},
     {"openat$capi20", 295},
     {"openat$cgroup", 295},
+    {"openat$cgroup_devices", 295},
+    {"openat$cgroup_ifpriomap", 295},
     {"openat$cgroup_int", 295},
+    {"openat$cgroup_limit", 295},
+    {"openat$cgroup_partition", 295},
     {"openat$cgroup_procs", 295},
     {"openat$cgroup_ro", 295},
     {"openat$cgroup_root", 295},
@@ -1895,6 +1923,9 @@ const call_t syscalls[] = {
     {"syz_init_net_socket$llc", 0, (syscall_t)syz_init_net_socket},
     {"syz_init_net_socket$nfc_llcp", 0, (syscall_t)syz_init_net_socket},
     {"syz_init_net_socket$nfc_raw", 0, (syscall_t)syz_init_net_socket},
+    {"syz_io_uring_complete", 0, (syscall_t)syz_io_uring_complete},
+    {"syz_io_uring_setup", 0, (syscall_t)syz_io_uring_setup},
+    {"syz_io_uring_submit", 0, (syscall_t)syz_io_uring_submit},
     {"syz_kvm_setup_cpu$arm64", 0, (syscall_t)syz_kvm_setup_cpu},
     {"syz_kvm_setup_cpu$x86", 0, (syscall_t)syz_kvm_setup_cpu},
     {"syz_mount_image$bfs", 0, (syscall_t)syz_mount_image},
@@ -1958,6 +1989,11 @@ const call_t syscalls[] = {
     {"syz_open_procfs$namespace", 0, (syscall_t)syz_open_procfs},
     {"syz_open_pts", 0, (syscall_t)syz_open_pts},
     {"syz_read_part_table", 0, (syscall_t)syz_read_part_table},
+    {"syz_sysctl$bool", 0, (syscall_t)syz_sysctl},
+    {"syz_sysctl$int", 0, (syscall_t)syz_sysctl},
+    {"syz_sysctl$mode", 0, (syscall_t)syz_sysctl},
+    {"syz_sysctl$qdisc", 0, (syscall_t)syz_sysctl},
+    {"syz_sysctl$tcp_cong", 0, (syscall_t)syz_sysctl},
     {"tee", 315},
     {"tgkill", 270},
     {"time", 13},
@@ -2009,12 +2045,20 @@ const call_t syscalls[] = {
     {"write$RDMA_USER_CM_CMD_RESOLVE_IP", 4},
     {"write$RDMA_USER_CM_CMD_RESOLVE_ROUTE", 4},
     {"write$RDMA_USER_CM_CMD_SET_OPTION", 4},
+    {"write$apparmor_current", 4},
+    {"write$apparmor_exec", 4},
+    {"write$apparmor_policy", 4},
+    {"write$apparmor_remove", 4},
     {"write$binfmt_aout", 4},
     {"write$binfmt_elf32", 4},
     {"write$binfmt_elf64", 4},
     {"write$binfmt_misc", 4},
     {"write$binfmt_script", 4},
+    {"write$cgroup_devices", 4},
+    {"write$cgroup_ifpriomap", 4},
     {"write$cgroup_int", 4},
+    {"write$cgroup_limit", 4},
+    {"write$cgroup_partition", 4},
     {"write$cgroup_pid", 4},
     {"write$cgroup_subtree", 4},
     {"write$cgroup_type", 4},
@@ -2037,13 +2081,13 @@ const call_t syscalls[] = {
 
 #if defined(__x86_64__) || 0
 #define GOARCH "amd64"
-#define SYZ_REVISION "9b691ef1ad3871279dddf35bbadcb13736803e01"
+#define SYZ_REVISION "ec5e742b731ff1b6f7a46d5854cce8aba7032e9c"
 #define SYZ_EXECUTOR_USES_FORK_SERVER true
 #define SYZ_EXECUTOR_USES_SHMEM true
 #define SYZ_PAGE_SIZE 4096
 #define SYZ_NUM_PAGES 4096
 #define SYZ_DATA_OFFSET 536870912
-#define SYZ_SYSCALL_COUNT 2073
+#define SYZ_SYSCALL_COUNT 2117
 const call_t syscalls[] = {
     {"accept", 43},
     {"accept$alg", 43},
@@ -2450,6 +2494,17 @@ const call_t syscalls[] = {
     {"io_pgetevents", 333},
     {"io_setup", 206},
     {"io_submit", 209},
+    {"io_uring_enter", 426},
+    {"io_uring_register$IORING_REGISTER_BUFFERS", 427},
+    {"io_uring_register$IORING_REGISTER_EVENTFD", 427},
+    {"io_uring_register$IORING_REGISTER_FILES", 427},
+    {"io_uring_register$IORING_REGISTER_FILES_UPDATE", 427},
+    {"io_uring_register$IORING_REGISTER_PERSONALITY", 427},
+    {"io_uring_register$IORING_UNREGISTER_BUFFERS", 427},
+    {"io_uring_register$IORING_UNREGISTER_EVENTFD", 427},
+    {"io_uring_register$IORING_UNREGISTER_FILES", 427},
+    {"io_uring_register$IORING_UNREGISTER_PERSONALITY", 427},
+    {"io_uring_setup", 425},
     {"ioctl", 16},
     {"ioctl$ASHMEM_GET_NAME", 16},
     {"ioctl$ASHMEM_GET_PIN_STATUS", 16},
@@ -3298,6 +3353,10 @@ const call_t syscalls[] = {
     {"keyctl$setperm", 250},
     {"keyctl$unlink", 250},
     {"keyctl$update", 250},
+    {"landlock_add_rule$LANDLOCK_RULE_PATH_BENEATH", 445},
+    {"landlock_create_ruleset", 444},
+    {"landlock_create_ruleset$version", 444},
+    {"landlock_restrict_self", 446},
     {"lchown", 94},
     {"lgetxattr", 192},
     {"link", 86},
@@ -3364,12 +3423,25 @@ const call_t syscalls[] = {
     {"open$dir", 2},
     {"open_by_handle_at", 304},
     {"openat", 257},
+    {"openat$apparmor_load", 257},
+    {"openat$apparmor_profiles", 257},
+    {"openat$apparmor_remove", 257},
+    {"openat$apparmor_replace", 257},
+    {"openat$apparmor_revision", 257},
+    {"openat$apparmor_task_current", 257},
+    {"openat$apparmor_task_exec", 257},
+    {"openat$apparmor_thread_current", 257},
+    {"openat$apparmor_thread_exec", 257},
     {"openat$ashmem", 257},
     {"openat$audio", 257},
     {"openat$autofs", 257},
     {"openat$capi20", 257},
     {"openat$cgroup", 257},
+    {"openat$cgroup_devices", 257},
+    {"openat$cgroup_ifpriomap", 257},
     {"openat$cgroup_int", 257},
+    {"openat$cgroup_limit", 257},
+    {"openat$cgroup_partition", 257},
     {"openat$cgroup_procs", 257},
     {"openat$cgroup_ro", 257},
     {"openat$cgroup_root", 257},
@@ -3982,6 +4054,9 @@ const call_t syscalls[] = {
     {"syz_init_net_socket$llc", 0, (syscall_t)syz_init_net_socket},
     {"syz_init_net_socket$nfc_llcp", 0, (syscall_t)syz_init_net_socket},
     {"syz_init_net_socket$nfc_raw", 0, (syscall_t)syz_init_net_socket},
+    {"syz_io_uring_complete", 0, (syscall_t)syz_io_uring_complete},
+    {"syz_io_uring_setup", 0, (syscall_t)syz_io_uring_setup},
+    {"syz_io_uring_submit", 0, (syscall_t)syz_io_uring_submit},
     {"syz_kvm_setup_cpu$arm64", 0, (syscall_t)syz_kvm_setup_cpu},
     {"syz_kvm_setup_cpu$x86", 0, (syscall_t)syz_kvm_setup_cpu},
     {"syz_mount_image$bfs", 0, (syscall_t)syz_mount_image},
@@ -4045,6 +4120,11 @@ const call_t syscalls[] = {
     {"syz_open_procfs$namespace", 0, (syscall_t)syz_open_procfs},
     {"syz_open_pts", 0, (syscall_t)syz_open_pts},
     {"syz_read_part_table", 0, (syscall_t)syz_read_part_table},
+    {"syz_sysctl$bool", 0, (syscall_t)syz_sysctl},
+    {"syz_sysctl$int", 0, (syscall_t)syz_sysctl},
+    {"syz_sysctl$mode", 0, (syscall_t)syz_sysctl},
+    {"syz_sysctl$qdisc", 0, (syscall_t)syz_sysctl},
+    {"syz_sysctl$tcp_cong", 0, (syscall_t)syz_sysctl},
     {"tee", 276},
     {"tgkill", 234},
     {"time", 201},
@@ -4096,12 +4176,20 @@ const call_t syscalls[] = {
     {"write$RDMA_USER_CM_CMD_RESOLVE_IP", 1},
     {"write$RDMA_USER_CM_CMD_RESOLVE_ROUTE", 1},
     {"write$RDMA_USER_CM_CMD_SET_OPTION", 1},
+    {"write$apparmor_current", 1},
+    {"write$apparmor_exec", 1},
+    {"write$apparmor_policy", 1},
+    {"write$apparmor_remove", 1},
     {"write$binfmt_aout", 1},
     {"write$binfmt_elf32", 1},
     {"write$binfmt_elf64", 1},
     {"write$binfmt_misc", 1},
     {"write$binfmt_script", 1},
+    {"write$cgroup_devices", 1},
+    {"write$cgroup_ifpriomap", 1},
     {"write$cgroup_int", 1},
+    {"write$cgroup_limit", 1},
+    {"write$cgroup_partition", 1},
     {"write$cgroup_pid", 1},
     {"write$cgroup_subtree", 1},
     {"write$cgroup_type", 1},
@@ -4124,13 +4212,13 @@ const call_t syscalls[] = {
 
 #if defined(__arm__) || 0
 #define GOARCH "arm"
-#define SYZ_REVISION "2d48f7b04fa6aa40cf950630cc96b02d6a248012"
+#define SYZ_REVISION "1310ecb9f7ad5b0a814eb17c2e323157817138cd"
 #define SYZ_EXECUTOR_USES_FORK_SERVER true
 #define SYZ_EXECUTOR_USES_SHMEM true
 #define SYZ_PAGE_SIZE 4096
 #define SYZ_NUM_PAGES 4096
 #define SYZ_DATA_OFFSET 536870912
-#define SYZ_SYSCALL_COUNT 2029
+#define SYZ_SYSCALL_COUNT 2073
 const call_t syscalls[] = {
     {"accept", 285},
     {"accept$alg", 285},
@@ -4531,6 +4619,17 @@ const call_t syscalls[] = {
     {"io_getevents", 245},
     {"io_setup", 243},
     {"io_submit", 246},
+    {"io_uring_enter", 426},
+    {"io_uring_register$IORING_REGISTER_BUFFERS", 427},
+    {"io_uring_register$IORING_REGISTER_EVENTFD", 427},
+    {"io_uring_register$IORING_REGISTER_FILES", 427},
+    {"io_uring_register$IORING_REGISTER_FILES_UPDATE", 427},
+    {"io_uring_register$IORING_REGISTER_PERSONALITY", 427},
+    {"io_uring_register$IORING_UNREGISTER_BUFFERS", 427},
+    {"io_uring_register$IORING_UNREGISTER_EVENTFD", 427},
+    {"io_uring_register$IORING_UNREGISTER_FILES", 427},
+    {"io_uring_register$IORING_UNREGISTER_PERSONALITY", 427},
+    {"io_uring_setup", 425},
     {"ioctl", 54},
     {"ioctl$ASHMEM_GET_NAME", 54},
     {"ioctl$ASHMEM_GET_PIN_STATUS", 54},
@@ -5350,6 +5449,10 @@ const call_t syscalls[] = {
     {"keyctl$setperm", 311},
     {"keyctl$unlink", 311},
     {"keyctl$update", 311},
+    {"landlock_add_rule$LANDLOCK_RULE_PATH_BENEATH", 445},
+    {"landlock_create_ruleset", 444},
+    {"landlock_create_ruleset$version", 444},
+    {"landlock_restrict_self", 446},
     {"lchown", 16},
     {"lgetxattr", 230},
     {"link", 9},
@@ -5411,12 +5514,25 @@ const call_t syscalls[] = {
     {"open$dir", 5},
     {"open_by_handle_at", 371},
     {"openat", 322},
+    {"openat$apparmor_load", 322},
+    {"openat$apparmor_profiles", 322},
+    {"openat$apparmor_remove", 322},
+    {"openat$apparmor_replace", 322},
+    {"openat$apparmor_revision", 322},
+    {"openat$apparmor_task_current", 322},
+    {"openat$apparmor_task_exec", 322},
+    {"openat$apparmor_thread_current", 322},
+    {"openat$apparmor_thread_exec", 322},
     {"openat$ashmem", 322},
     {"openat$audio", 322},
     {"openat$autofs", 322},
     {"openat$capi20", 322},
     {"openat$cgroup", 322},
+    {"openat$cgroup_devices", 322},
+    {"openat$cgroup_ifpriomap", 322},
     {"openat$cgroup_int", 322},
+    {"openat$cgroup_limit", 322},
+    {"openat$cgroup_partition", 322},
     {"openat$cgroup_procs", 322},
     {"openat$cgroup_ro", 322},
     {"openat$cgroup_root", 322},
@@ -6027,6 +6143,9 @@ const call_t syscalls[] = {
     {"syz_init_net_socket$llc", 0, (syscall_t)syz_init_net_socket},
     {"syz_init_net_socket$nfc_llcp", 0, (syscall_t)syz_init_net_socket},
     {"syz_init_net_socket$nfc_raw", 0, (syscall_t)syz_init_net_socket},
+    {"syz_io_uring_complete", 0, (syscall_t)syz_io_uring_complete},
+    {"syz_io_uring_setup", 0, (syscall_t)syz_io_uring_setup},
+    {"syz_io_uring_submit", 0, (syscall_t)syz_io_uring_submit},
     {"syz_kvm_setup_cpu$arm64", 0, (syscall_t)syz_kvm_setup_cpu},
     {"syz_kvm_setup_cpu$x86", 0, (syscall_t)syz_kvm_setup_cpu},
     {"syz_mount_image$bfs", 0, (syscall_t)syz_mount_image},
@@ -6090,6 +6209,11 @@ const call_t syscalls[] = {
     {"syz_open_procfs$namespace", 0, (syscall_t)syz_open_procfs},
     {"syz_open_pts", 0, (syscall_t)syz_open_pts},
     {"syz_read_part_table", 0, (syscall_t)syz_read_part_table},
+    {"syz_sysctl$bool", 0, (syscall_t)syz_sysctl},
+    {"syz_sysctl$int", 0, (syscall_t)syz_sysctl},
+    {"syz_sysctl$mode", 0, (syscall_t)syz_sysctl},
+    {"syz_sysctl$qdisc", 0, (syscall_t)syz_sysctl},
+    {"syz_sysctl$tcp_cong", 0, (syscall_t)syz_sysctl},
     {"tee", 342},
     {"tgkill", 268},
     {"timer_create", 257},
@@ -6139,12 +6263,20 @@ const call_t syscalls[] = {
     {"write$RDMA_USER_CM_CMD_RESOLVE_IP", 4},
     {"write$RDMA_USER_CM_CMD_RESOLVE_ROUTE", 4},
     {"write$RDMA_USER_CM_CMD_SET_OPTION", 4},
+    {"write$apparmor_current", 4},
+    {"write$apparmor_exec", 4},
+    {"write$apparmor_policy", 4},
+    {"write$apparmor_remove", 4},
     {"write$binfmt_aout", 4},
     {"write$binfmt_elf32", 4},
     {"write$binfmt_elf64", 4},
     {"write$binfmt_misc", 4},
     {"write$binfmt_script", 4},
+    {"write$cgroup_devices", 4},
+    {"write$cgroup_ifpriomap", 4},
     {"write$cgroup_int", 4},
+    {"write$cgroup_limit", 4},
+    {"write$cgroup_partition", 4},
     {"write$cgroup_pid", 4},
     {"write$cgroup_subtree", 4},
     {"write$cgroup_type", 4},
@@ -6167,13 +6299,13 @@ const call_t syscalls[] = {
 
 #if defined(__aarch64__) || 0
 #define GOARCH "arm64"
-#define SYZ_REVISION "e0996c58f44d1178a9173f9c61fc9868155e4f7f"
+#define SYZ_REVISION "cd9db574f356a1b3d6c27e63786e77d6e283bcef"
 #define SYZ_EXECUTOR_USES_FORK_SERVER true
 #define SYZ_EXECUTOR_USES_SHMEM true
 #define SYZ_PAGE_SIZE 4096
 #define SYZ_NUM_PAGES 4096
 #define SYZ_DATA_OFFSET 536870912
-#define SYZ_SYSCALL_COUNT 2001
+#define SYZ_SYSCALL_COUNT 2045
 const call_t syscalls[] = {
     {"accept", 202},
     {"accept$alg", 202},
@@ -6566,6 +6698,17 @@ const call_t syscalls[] = {
     {"io_pgetevents", 292},
     {"io_setup", 0},
     {"io_submit", 2},
+    {"io_uring_enter", 426},
+    {"io_uring_register$IORING_REGISTER_BUFFERS", 427},
+    {"io_uring_register$IORING_REGISTER_EVENTFD", 427},
+    {"io_uring_register$IORING_REGISTER_FILES", 427},
+    {"io_uring_register$IORING_REGISTER_FILES_UPDATE", 427},
+    {"io_uring_register$IORING_REGISTER_PERSONALITY", 427},
+    {"io_uring_register$IORING_UNREGISTER_BUFFERS", 427},
+    {"io_uring_register$IORING_UNREGISTER_EVENTFD", 427},
+    {"io_uring_register$IORING_UNREGISTER_FILES", 427},
+    {"io_uring_register$IORING_UNREGISTER_PERSONALITY", 427},
+    {"io_uring_setup", 425},
     {"ioctl", 29},
     {"ioctl$ASHMEM_GET_NAME", 29},
     {"ioctl$ASHMEM_GET_PIN_STATUS", 29},
@@ -7389,6 +7532,10 @@ const call_t syscalls[] = {
     {"keyctl$setperm", 219},
     {"keyctl$unlink", 219},
     {"keyctl$update", 219},
+    {"landlock_add_rule$LANDLOCK_RULE_PATH_BENEATH", 445},
+    {"landlock_create_ruleset", 444},
+    {"landlock_create_ruleset$version", 444},
+    {"landlock_restrict_self", 446},
     {"lgetxattr", 9},
     {"linkat", 37},
     {"listen", 201},
@@ -7443,12 +7590,25 @@ const call_t syscalls[] = {
     {"nanosleep", 101},
     {"open_by_handle_at", 265},
     {"openat", 56},
+    {"openat$apparmor_load", 56},
+    {"openat$apparmor_profiles", 56},
+    {"openat$apparmor_remove", 56},
+    {"openat$apparmor_replace", 56},
+    {"openat$apparmor_revision", 56},
+    {"openat$apparmor_task_current", 56},
+    {"openat$apparmor_task_exec", 56},
+    {"openat$apparmor_thread_current", 56},
+    {"openat$apparmor_thread_exec", 56},
     {"openat$ashmem", 56},
     {"openat$audio", 56},
     {"openat$autofs", 56},
     {"openat$capi20", 56},
     {"openat$cgroup", 56},
+    {"openat$cgroup_devices", 56},
+    {"openat$cgroup_ifpriomap", 56},
     {"openat$cgroup_int", 56},
+    {"openat$cgroup_limit", 56},
+    {"openat$cgroup_partition", 56},
     {"openat$cgroup_procs", 56},
     {"openat$cgroup_ro", 56},
     {"openat$cgroup_root", 56},
@@ -8046,6 +8206,9 @@ const call_t syscalls[] = {
     {"syz_init_net_socket$llc", 0, (syscall_t)syz_init_net_socket},
     {"syz_init_net_socket$nfc_llcp", 0, (syscall_t)syz_init_net_socket},
     {"syz_init_net_socket$nfc_raw", 0, (syscall_t)syz_init_net_socket},
+    {"syz_io_uring_complete", 0, (syscall_t)syz_io_uring_complete},
+    {"syz_io_uring_setup", 0, (syscall_t)syz_io_uring_setup},
+    {"syz_io_uring_submit", 0, (syscall_t)syz_io_uring_submit},
     {"syz_kvm_setup_cpu$arm64", 0, (syscall_t)syz_kvm_setup_cpu},
     {"syz_kvm_setup_cpu$x86", 0, (syscall_t)syz_kvm_setup_cpu},
     {"syz_mount_image$bfs", 0, (syscall_t)syz_mount_image},
@@ -8109,6 +8272,11 @@ const call_t syscalls[] = {
     {"syz_open_procfs$namespace", 0, (syscall_t)syz_open_procfs},
     {"syz_open_pts", 0, (syscall_t)syz_open_pts},
     {"syz_read_part_table", 0, (syscall_t)syz_read_part_table},
+    {"syz_sysctl$bool", 0, (syscall_t)syz_sysctl},
+    {"syz_sysctl$int", 0, (syscall_t)syz_sysctl},
+    {"syz_sysctl$mode", 0, (syscall_t)syz_sysctl},
+    {"syz_sysctl$qdisc", 0, (syscall_t)syz_sysctl},
+    {"syz_sysctl$tcp_cong", 0, (syscall_t)syz_sysctl},
     {"tee", 77},
     {"tgkill", 131},
     {"timer_create", 107},
@@ -8154,12 +8322,20 @@ const call_t syscalls[] = {
     {"write$RDMA_USER_CM_CMD_RESOLVE_IP", 64},
     {"write$RDMA_USER_CM_CMD_RESOLVE_ROUTE", 64},
     {"write$RDMA_USER_CM_CMD_SET_OPTION", 64},
+    {"write$apparmor_current", 64},
+    {"write$apparmor_exec", 64},
+    {"write$apparmor_policy", 64},
+    {"write$apparmor_remove", 64},
     {"write$binfmt_aout", 64},
     {"write$binfmt_elf32", 64},
     {"write$binfmt_elf64", 64},
     {"write$binfmt_misc", 64},
     {"write$binfmt_script", 64},
+    {"write$cgroup_devices", 64},
+    {"write$cgroup_ifpriomap", 64},
     {"write$cgroup_int", 64},
+    {"write$cgroup_limit", 64},
+    {"write$cgroup_partition", 64},
     {"write$cgroup_pid", 64},
     {"write$cgroup_subtree", 64},
     {"write$cgroup_type", 64},
@@ -8182,13 +8358,13 @@ const call_t syscalls[] = {
 
 #if defined(__ppc64__) || defined(__PPC64__) || defined(__powerpc64__) || 0
 #define GOARCH "ppc64le"
-#define SYZ_REVISION "e810a038c6e7e93c823407bb0a01ba0d48281b55"
+#define SYZ_REVISION "aa4c32981844589697c59b7fabdd858110ee819e"
 #define SYZ_EXECUTOR_USES_FORK_SERVER true
 #define SYZ_EXECUTOR_USES_SHMEM true
 #define SYZ_PAGE_SIZE 4096
 #define SYZ_NUM_PAGES 4096
 #define SYZ_DATA_OFFSET 536870912
-#define SYZ_SYSCALL_COUNT 1891
+#define SYZ_SYSCALL_COUNT 1935
 const call_t syscalls[] = {
     {"accept", 330},
     {"accept$alg", 330},
@@ -8593,6 +8769,17 @@ const call_t syscalls[] = {
     {"io_pgetevents", 388},
     {"io_setup", 227},
     {"io_submit", 230},
+    {"io_uring_enter", 426},
+    {"io_uring_register$IORING_REGISTER_BUFFERS", 427},
+    {"io_uring_register$IORING_REGISTER_EVENTFD", 427},
+    {"io_uring_register$IORING_REGISTER_FILES", 427},
+    {"io_uring_register$IORING_REGISTER_FILES_UPDATE", 427},
+    {"io_uring_register$IORING_REGISTER_PERSONALITY", 427},
+    {"io_uring_register$IORING_UNREGISTER_BUFFERS", 427},
+    {"io_uring_register$IORING_UNREGISTER_EVENTFD", 427},
+    {"io_uring_register$IORING_UNREGISTER_FILES", 427},
+    {"io_uring_register$IORING_UNREGISTER_PERSONALITY", 427},
+    {"io_uring_setup", 425},
     {"ioctl", 54},
     {"ioctl$ASHMEM_GET_NAME", 54},
     {"ioctl$ASHMEM_GET_PIN_STATUS", 54},
@@ -9302,6 +9489,10 @@ const call_t syscalls[] = {
     {"keyctl$setperm", 271},
     {"keyctl$unlink", 271},
     {"keyctl$update", 271},
+    {"landlock_add_rule$LANDLOCK_RULE_PATH_BENEATH", 445},
+    {"landlock_create_ruleset", 444},
+    {"landlock_create_ruleset$version", 444},
+    {"landlock_restrict_self", 446},
     {"lchown", 16},
     {"lgetxattr", 213},
     {"link", 9},
@@ -9358,12 +9549,25 @@ const call_t syscalls[] = {
     {"open$dir", 5},
     {"open_by_handle_at", 346},
     {"openat", 286},
+    {"openat$apparmor_load", 286},
+    {"openat$apparmor_profiles", 286},
+    {"openat$apparmor_remove", 286},
+    {"openat$apparmor_replace", 286},
+    {"openat$apparmor_revision", 286},
+    {"openat$apparmor_task_current", 286},
+    {"openat$apparmor_task_exec", 286},
+    {"openat$apparmor_thread_current", 286},
+    {"openat$apparmor_thread_exec", 286},
     {"openat$ashmem", 286},
     {"openat$audio", 286},
     {"openat$autofs", 286},
     {"openat$capi20", 286},
     {"openat$cgroup", 286},
+    {"openat$cgroup_devices", 286},
+    {"openat$cgroup_ifpriomap", 286},
     {"openat$cgroup_int", 286},
+    {"openat$cgroup_limit", 286},
+    {"openat$cgroup_partition", 286},
     {"openat$cgroup_procs", 286},
     {"openat$cgroup_ro", 286},
     {"openat$cgroup_root", 286},
@@ -9945,6 +10149,9 @@ const call_t syscalls[] = {
     {"syz_init_net_socket$llc", 0, (syscall_t)syz_init_net_socket},
     {"syz_init_net_socket$nfc_llcp", 0, (syscall_t)syz_init_net_socket},
     {"syz_init_net_socket$nfc_raw", 0, (syscall_t)syz_init_net_socket},
+    {"syz_io_uring_complete", 0, (syscall_t)syz_io_uring_complete},
+    {"syz_io_uring_setup", 0, (syscall_t)syz_io_uring_setup},
+    {"syz_io_uring_submit", 0, (syscall_t)syz_io_uring_submit},
     {"syz_kvm_setup_cpu$arm64", 0, (syscall_t)syz_kvm_setup_cpu},
     {"syz_kvm_setup_cpu$x86", 0, (syscall_t)syz_kvm_setup_cpu},
     {"syz_mount_image$bfs", 0, (syscall_t)syz_mount_image},
@@ -10008,6 +10215,11 @@ const call_t syscalls[] = {
     {"syz_open_procfs$namespace", 0, (syscall_t)syz_open_procfs},
     {"syz_open_pts", 0, (syscall_t)syz_open_pts},
     {"syz_read_part_table", 0, (syscall_t)syz_read_part_table},
+    {"syz_sysctl$bool", 0, (syscall_t)syz_sysctl},
+    {"syz_sysctl$int", 0, (syscall_t)syz_sysctl},
+    {"syz_sysctl$mode", 0, (syscall_t)syz_sysctl},
+    {"syz_sysctl$qdisc", 0, (syscall_t)syz_sysctl},
+    {"syz_sysctl$tcp_cong", 0, (syscall_t)syz_sysctl},
     {"tee", 284},
     {"tgkill", 250},
     {"time", 13},
@@ -10059,12 +10271,20 @@ const call_t syscalls[] = {
     {"write$RDMA_USER_CM_CMD_RESOLVE_IP", 4},
     {"write$RDMA_USER_CM_CMD_RESOLVE_ROUTE", 4},
     {"write$RDMA_USER_CM_CMD_SET_OPTION", 4},
+    {"write$apparmor_current", 4},
+    {"write$apparmor_exec", 4},
+    {"write$apparmor_policy", 4},
+    {"write$apparmor_remove", 4},
     {"write$binfmt_aout", 4},
     {"write$binfmt_elf32", 4},
     {"write$binfmt_elf64", 4},
     {"write$binfmt_misc", 4},
     {"write$binfmt_script", 4},
+    {"write$cgroup_devices", 4},
+    {"write$cgroup_ifpriomap", 4},
     {"write$cgroup_int", 4},
+    {"write$cgroup_limit", 4},
+    {"write$cgroup_partition", 4},
     {"write$cgroup_pid", 4},
     {"write$cgroup_subtree", 4},
     {"write$cgroup_type", 4},
@@ -0,0 +1,277 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+// Package agent implements a small guest agent that executes commands and writes files
+// on behalf of the host over a single stream connection (e.g. virtio-serial port or TCP).
+// It replaces ssh round-trips for VMs and keeps working when guest networking is broken
+// (for virtio-serial). Several commands can run concurrently over one connection,
+// requests and responses are JSON-encoded and tagged with session IDs.
+package agent
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"sync"
+)
+
+type request struct {
+	ID    uint64
+	Token string `json:",omitempty"`
+	Cmd   string `json:",omitempty"` // shell command to execute
+	Dir   string `json:",omitempty"` // working dir for Cmd
+	File  string `json:",omitempty"` // file to write Data to
+	Data  []byte `json:",omitempty"`
+	Kill  bool   `json:",omitempty"` // kill command of the session ID
+}
+
+type response struct {
+	ID     uint64
+	Output []byte `json:",omitempty"`
+	Done   bool   `json:",omitempty"` // the command has finished or the file is written
+	Error  string `json:",omitempty"` // command exit status or file write error
+}
+
+// Serve serves requests from conn until the connection fails or a request with a token
+// other than token is received (the token protects agents that serve TCP connections).
+// Running commands are killed when Serve returns.
+func Serve(conn io.ReadWriter, token string) error {
+	s := &server{
+		enc:  json.NewEncoder(conn),
+		cmds: make(map[uint64]*exec.Cmd),
+	}
+	defer s.killAll()
+	dec := json.NewDecoder(conn)
+	for {
+		req := new(request)
+		if err := dec.Decode(req); err != nil {
+			return err
+		}
+		if req.Token != token {
+			return fmt.Errorf("bad token")
+		}
+		switch {
+		case req.Kill:
+			s.kill(req.ID)
+		case req.File != "":
+			res := &response{ID: req.ID, Done: true}
+			if err := ioutil.WriteFile(req.File, req.Data, 0755); err != nil {
+				res.Error = err.Error()
+			}
+			s.send(res)
+		default:
+			s.start(req)
+		}
+	}
+}
+
+type server struct {
+	mu   sync.Mutex
+	enc  *json.Encoder
+	cmds map[uint64]*exec.Cmd
+}
+
+func (s *server) send(res *response) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.enc.Encode(res)
+}
+
+func (s *server) start(req *request) {
+	cmd := exec.Command("sh", "-c", req.Cmd)
+	cmd.Dir = req.Dir
+	setProcessGroup(cmd)
+	rpipe, wpipe, err := os.Pipe()
+	if err != nil {
+		s.send(&response{ID: req.ID, Done: true, Error: err.Error()})
+		return
+	}
+	cmd.Stdout = wpipe
+	cmd.Stderr = wpipe
+	if err := cmd.Start(); err != nil {
+		rpipe.Close()
+		wpipe.Close()
+		s.send(&response{ID: req.ID, Done: true, Error: err.Error()})
+		return
+	}
+	wpipe.Close()
+	s.mu.Lock()
+	s.cmds[req.ID] = cmd
+	s.mu.Unlock()
+	go func() {
+		var buf [64 << 10]byte
+		for {
+			n, err := rpipe.Read(buf[:])
+			if n != 0 {
+				s.send(&response{ID: req.ID, Output: append([]byte{}, buf[:n]...)})
+			}
+			if err != nil {
+				break
+			}
+		}
+		rpipe.Close()
+		res := &response{ID: req.ID, Done: true}
+		if err := cmd.Wait(); err != nil {
+			res.Error = err.Error()
+		}
+		s.mu.Lock()
+		delete(s.cmds, req.ID)
+		s.mu.Unlock()
+		s.send(res)
+	}()
+}
+
+func (s *server) kill(id uint64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if cmd := s.cmds[id]; cmd != nil {
+		killProcessGroup(cmd)
+	}
+}
+
+func (s *server) killAll() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, cmd := range s.cmds {
+		killProcessGroup(cmd)
+	}
+}
+
+// Client sends requests to an agent.
+type Client struct {
+	conn     io.ReadWriteCloser
+	token    string
+	mu       sync.Mutex
+	enc      *json.Encoder
+	lastID   uint64
+	sessions map[uint64]*Session
+	err      error // set when the connection fails
+}
+
+// Session is a command running in the agent or a file being written.
+type Session struct {
+	client *Client
+	id     uint64
+	// Output receives combined stdout/stderr of the command.
+	// It is closed when the command finishes or the connection fails.
+	// Output must be read, otherwise it blocks output of all other sessions.
+	Output io.ReadCloser
+	wpipe  *io.PipeWriter
+	done   chan error
+}
+
+func NewClient(conn io.ReadWriteCloser, token string) *Client {
+	c := &Client{
+		conn:     conn,
+		token:    token,
+		enc:      json.NewEncoder(conn),
+		sessions: make(map[uint64]*Session),
+	}
+	go c.loop()
+	return c
+}
+
+func (c *Client) loop() {
+	dec := json.NewDecoder(c.conn)
+	for {
+		res := new(response)
+		if err := dec.Decode(res); err != nil {
+			c.mu.Lock()
+			c.err = fmt.Errorf("lost connection to agent: %v", err)
+			sessions := c.sessions
+			c.sessions = nil
+			c.mu.Unlock()
+			for _, s := range sessions {
+				s.finish(c.err)
+			}
+			return
+		}
+		c.mu.Lock()
+		s := c.sessions[res.ID]
+		if res.Done {
+			delete(c.sessions, res.ID)
+		}
+		c.mu.Unlock()
+		if s == nil {
+			continue
+		}
+		if len(res.Output) != 0 {
+			s.wpipe.Write(res.Output)
+		}
+		if res.Done {
+			var err error
+			if res.Error != "" {
+				err = fmt.Errorf("%v", res.Error)
+			}
+			s.finish(err)
+		}
+	}
+}
+
+func (c *Client) newSession(req *request) (*Session, error) {
+	rpipe, wpipe := io.Pipe()
+	s := &Session{
+		client: c,
+		Output: rpipe,
+		wpipe:  wpipe,
+		done:   make(chan error, 1),
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.err != nil {
+		return nil, c.err
+	}
+	c.lastID++
+	s.id = c.lastID
+	req.ID = s.id
+	req.Token = c.token
+	c.sessions[s.id] = s
+	if err := c.enc.Encode(req); err != nil {
+		delete(c.sessions, s.id)
+		return nil, err
+	}
+	return s, nil
+}
+
+// Exec starts command in dir.
+func (c *Client) Exec(dir, command string) (*Session, error) {
+	return c.newSession(&request{Cmd: command, Dir: dir})
+}
+
+// WriteFile writes an executable file with data in the guest.
+func (c *Client) WriteFile(file string, data []byte) error {
+	s, err := c.newSession(&request{File: file, Data: data})
+	if err != nil {
+		return err
+	}
+	return s.Wait()
+}
+
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+func (s *Session) finish(err error) {
+	s.wpipe.Close()
+	s.done <- err
+}
+
+// Wait waits for the command to finish and returns its exit status.
+func (s *Session) Wait() error {
+	err := <-s.done
+	s.done <- err
+	return err
+}
+
+// Kill kills the command (with all of its children).
+func (s *Session) Kill() {
+	c := s.client
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.err == nil {
+		c.enc.Encode(&request{ID: s.id, Token: c.token, Kill: true})
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package agent
+
+import (
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+)
+
+func TestAgent(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("no sh on windows")
+	}
+	dir, err := ioutil.TempDir("", "syz-agent")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	serverConn, clientConn := net.Pipe()
+	served := make(chan error)
+	go func() {
+		served <- Serve(serverConn, "token")
+	}()
+	client := NewClient(clientConn, "token")
+
+	// Long-running command runs concurrently with other requests.
+	sleep, err := client.Exec(dir, "sleep 1000")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go ioutil.ReadAll(sleep.Output)
+
+	file := filepath.Join(dir, "file")
+	if err := client.WriteFile(file, []byte("data")); err != nil {
+		t.Fatal(err)
+	}
+	s, err := client.Exec(dir, "cat file; echo -n ' stderr' 1>&2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	output, _ := ioutil.ReadAll(s.Output)
+	if err := s.Wait(); err != nil {
+		t.Fatalf("command failed: %v", err)
+	}
+	if string(output) != "data stderr" {
+		t.Fatalf("got output %q, want %q", output, "data stderr")
+	}
+
+	s, err = client.Exec(dir, "exit 3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ioutil.ReadAll(s.Output)
+	if err := s.Wait(); err == nil {
+		t.Fatalf("failed command did not return error")
+	}
+
+	sleep.Kill()
+	waited := make(chan error)
+	go func() {
+		waited <- sleep.Wait()
+	}()
+	select {
+	case err := <-waited:
+		if err == nil {
+			t.Fatalf("killed command did not return error")
+		}
+	case <-time.After(time.Minute):
+		t.Fatalf("killed command did not finish")
+	}
+
+	client.Close()
+	if err := <-served; err == nil {
+		t.Fatalf("Serve did not fail after client close")
+	}
+	if _, err := client.Exec(dir, "true"); err == nil {
+		t.Fatalf("Exec did not fail after close")
+	}
+
+	// Requests with a wrong token are rejected.
+	serverConn, clientConn = net.Pipe()
+	go func() {
+		served <- Serve(serverConn, "token")
+	}()
+	client = NewClient(clientConn, "wrong")
+	defer client.Close()
+	s, err = client.Exec(dir, "true")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := <-served; err == nil {
+		t.Fatalf("Serve accepted a wrong token")
+	}
+	serverConn.Close()
+	ioutil.ReadAll(s.Output)
+	if err := s.Wait(); err == nil {
+		t.Fatalf("command with a wrong token did not fail")
+	}
+}
@@ -0,0 +1,131 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package bisect
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/syzkaller/pkg/instance"
+	"github.com/google/syzkaller/pkg/vcs"
+)
+
+type BranchConfig struct {
+	Repo   string
+	Branch string
+}
+
+type BackportStatus int
+
+const (
+	// BackportFixPresent: the fix (commit with the same title) is already in the branch.
+	BackportFixPresent BackportStatus = iota
+	// BackportNeeded: the crash is reproduced on the branch head.
+	BackportNeeded
+	// BackportNotNeeded: the crash is not reproduced on the branch head.
+	BackportNotNeeded
+	// BackportUnknown: the kernel failed to build/boot, or tests were inconclusive.
+	BackportUnknown
+)
+
+func (status BackportStatus) String() string {
+	switch status {
+	case BackportFixPresent:
+		return "fix present"
+	case BackportNeeded:
+		return "backport needed"
+	case BackportNotNeeded:
+		return "not affected"
+	case BackportUnknown:
+		return "unknown"
+	default:
+		return fmt.Sprintf("BackportStatus(%v)", int(status))
+	}
+}
+
+type BackportResult struct {
+	Branch BranchConfig
+	Status BackportStatus
+	Commit *vcs.Commit // the tested branch head
+}
+
+// CheckBackport checks whether the fix for the crash (given by the fix commit title, e.g. on mainline)
+// is needed on each of the branches. A branch does not need the fix if it already contains a commit
+// with the same title (stable backports preserve titles, backport prefixes like "BACKPORT:"
+// are ignored, see vcs.CanonicalizeCommit), otherwise the reproducer is tested on the branch head.
+// cfg.Kernel.Repo/Branch/Commit are not used.
+func CheckBackport(cfg *Config, fixTitle string, branches []BranchConfig) ([]*BackportResult, error) {
+	repo, err := vcs.NewRepo(cfg.Manager.TargetOS, cfg.Manager.Type, cfg.Manager.KernelSrc)
+	if err != nil {
+		return nil, err
+	}
+	env := &env{
+		cfg:  cfg,
+		repo: repo,
+	}
+	env.log("checking fix %q on %v branches", fixTitle, len(branches))
+	start := time.Now()
+	if env.inst, err = instance.NewEnv(&cfg.Manager); err != nil {
+		return nil, err
+	}
+	syzkallerBuilt := false
+	var results []*BackportResult
+	for _, branch := range branches {
+		res := &BackportResult{
+			Branch: branch,
+		}
+		env.log("checking %v/%v", branch.Repo, branch.Branch)
+		if res.Commit, err = env.repo.Poll(branch.Repo, branch.Branch); err != nil {
+			return nil, err
+		}
+		present, err := fixPresent(env.repo, res.Commit.Hash, fixTitle)
+		if err != nil {
+			return nil, err
+		}
+		if present {
+			res.Status = BackportFixPresent
+		} else {
+			if !syzkallerBuilt {
+				env.log("building syzkaller on %v", cfg.Syzkaller.Commit)
+				if err := env.inst.BuildSyzkaller(cfg.Syzkaller.Repo, cfg.Syzkaller.Commit); err != nil {
+					return nil, err
+				}
+				syzkallerBuilt = true
+			}
+			testRes, err := env.test()
+			if err != nil {
+				return nil, err
+			}
+			switch testRes {
+			case vcs.BisectBad:
+				res.Status = BackportNeeded
+			case vcs.BisectGood:
+				res.Status = BackportNotNeeded
+			default:
+				res.Status = BackportUnknown
+			}
+		}
+		env.log("%v/%v: %v", branch.Repo, branch.Branch, res.Status)
+		results = append(results, res)
+	}
+	env.log("revisions tested: %v, total time: %v (build: %v, test: %v)",
+		env.numTests, time.Since(start), env.buildTime, env.testTime)
+	return results, nil
+}
+
+// fixPresent checks if a commit with the fix title is reachable from head.
+// Titles are canonicalized, so that e.g. "BACKPORT: foo" matches fix "foo".
+func fixPresent(repo vcs.Repo, head, fixTitle string) (bool, error) {
+	titles, err := repo.ListRecentCommits(head)
+	if err != nil {
+		return false, err
+	}
+	fixTitle = vcs.CanonicalizeCommit(fixTitle)
+	for _, title := range titles {
+		if vcs.CanonicalizeCommit(title) == fixTitle {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,52 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package bisect
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/google/syzkaller/pkg/vcs"
+)
+
+func TestFixPresent(t *testing.T) {
+	os.Setenv("SYZ_DISABLE_SANDBOXING", "yes")
+	dir, err := ioutil.TempDir("", "syz-backport")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	testRepo := vcs.MakeTestRepo(t, dir)
+	testRepo.CommitChange("mm: first commit")
+	beforeFix := testRepo.CommitChange("net: unrelated change")
+	testRepo.CommitChange("BACKPORT: net: fix use-after-free in foo")
+	head := testRepo.CommitChange("fs: last commit")
+	repo, err := vcs.NewRepo("linux", "qemu", dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		head    string
+		title   string
+		present bool
+	}{
+		{head, "net: fix use-after-free in foo", true},
+		{head, "UPSTREAM: net: fix use-after-free in foo", true},
+		{head, "mm: first commit", true},
+		{head, "net: fix use-after-free in bar", false},
+		{head, "net: fix use-after-free", false},
+		{beforeFix, "net: fix use-after-free in foo", false},
+		{beforeFix, "mm: first commit", true},
+	}
+	for i, test := range tests {
+		present, err := fixPresent(repo, test.head, test.title)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if present != test.present {
+			t.Errorf("test #%v: fixPresent(%q) = %v, want %v", i, test.title, present, test.present)
+		}
+	}
+}
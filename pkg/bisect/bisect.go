@@ -35,6 +35,17 @@ type KernelConfig struct {
 	Sysctl    string
 	Config    []byte
 	Userspace string
+	// Image backend for userspace, see build.CheckImageBackend.
+	ImageBackend string
+	// Storage of the image root filesystem, see build.CheckRootStorage.
+	RootStorage string
+	// LSM profile of the kernel and image, see build.CheckLSM.
+	LSM string
+	// Compiler to build all kernels with, if set compilers from BinDir are not used
+	// (used by MinimizeConfig that builds only Commit).
+	Compiler string
+	// Small config the search for a minimal config starts from, see MinimizeConfig.
+	BaselineConfig []byte
 }
 
 type SyzkallerConfig struct {
@@ -231,8 +242,16 @@ func (env *env) test() (vcs.BisectResult, error) {
 		cfg.Manager.Type, cfg.Manager.KernelSrc); err != nil {
 		return 0, fmt.Errorf("kernel clean failed: %v", err)
 	}
-	err = env.inst.BuildKernel(be.compiler, cfg.Kernel.Userspace,
-		cfg.Kernel.Cmdline, cfg.Kernel.Sysctl, cfg.Kernel.Config)
+	err = env.inst.BuildKernel(&build.Params{
+		Compiler:     be.compiler,
+		UserspaceDir: cfg.Kernel.Userspace,
+		ImageBackend: cfg.Kernel.ImageBackend,
+		RootStorage:  cfg.Kernel.RootStorage,
+		LSM:          cfg.Kernel.LSM,
+		CmdlineFile:  cfg.Kernel.Cmdline,
+		SysctlFile:   cfg.Kernel.Sysctl,
+		Config:       cfg.Kernel.Config,
+	})
 	env.buildTime += time.Since(buildStart)
 	if err != nil {
 		if verr, ok := err.(*osutil.VerboseError); ok {
@@ -309,6 +328,9 @@ func (env *env) processResults(current *vcs.Commit, results []error) (bad, good
 // Note: linux-specific.
 func (env *env) buildEnvForCommit(commit string) (*buildEnv, error) {
 	cfg := env.cfg
+	if cfg.Kernel.Compiler != "" {
+		return &buildEnv{compiler: cfg.Kernel.Compiler}, nil
+	}
 	tags, err := env.repo.PreviousReleaseTags(commit)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,231 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package bisect
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/google/syzkaller/pkg/build"
+	"github.com/google/syzkaller/pkg/instance"
+	"github.com/google/syzkaller/pkg/vcs"
+)
+
+// MinimizeConfig searches for a near-minimal kernel config that still reproduces the crash
+// on cfg.Kernel.Commit. The search starts from cfg.Kernel.BaselineConfig (a small config
+// that contains at least the debugging options required to detect the crash, e.g. KASAN)
+// and minimizes options of the original config cfg.Kernel.Config that differ from the baseline
+// with delta debugging. The result is the baseline config with the found options appended
+// (later values override earlier ones in kernel configs, dependencies are resolved by make oldconfig).
+// Kernels are built with cfg.Kernel.Compiler, cfg.BinDir is not used.
+func MinimizeConfig(cfg *Config) ([]byte, error) {
+	if len(cfg.Kernel.BaselineConfig) == 0 {
+		return nil, fmt.Errorf("no baseline config")
+	}
+	repo, err := vcs.NewRepo(cfg.Manager.TargetOS, cfg.Manager.Type, cfg.Manager.KernelSrc)
+	if err != nil {
+		return nil, err
+	}
+	// Kernel config is changed for every test, so work on a copy of cfg.
+	envCfg := *cfg
+	env := &env{
+		cfg:  &envCfg,
+		repo: repo,
+	}
+	env.log("minimizing config for crash on %v", cfg.Kernel.Commit)
+	start := time.Now()
+	res, err := env.minimizeConfig()
+	env.log("configs tested: %v, total time: %v (build: %v, test: %v)",
+		env.numTests, time.Since(start), env.buildTime, env.testTime)
+	if err != nil {
+		env.log("error: %v", err)
+		return nil, err
+	}
+	return res, nil
+}
+
+func (env *env) minimizeConfig() ([]byte, error) {
+	cfg := env.cfg
+	var err error
+	if env.inst, err = instance.NewEnv(&cfg.Manager); err != nil {
+		return nil, err
+	}
+	if _, err := env.repo.CheckoutCommit(cfg.Kernel.Repo, cfg.Kernel.Commit); err != nil {
+		return nil, err
+	}
+	if err := build.Clean(cfg.Manager.TargetOS, cfg.Manager.TargetArch,
+		cfg.Manager.Type, cfg.Manager.KernelSrc); err != nil {
+		return nil, fmt.Errorf("kernel clean failed: %v", err)
+	}
+	env.log("building syzkaller on %v", cfg.Syzkaller.Commit)
+	if err := env.inst.BuildSyzkaller(cfg.Syzkaller.Repo, cfg.Syzkaller.Commit); err != nil {
+		return nil, err
+	}
+	original := cfg.Kernel.Config
+	env.log("testing the original config")
+	if res, err := env.testConfig(original); err != nil {
+		return nil, err
+	} else if res != vcs.BisectBad {
+		return nil, fmt.Errorf("the crash wasn't reproduced with the original config")
+	}
+	return minimizeConfigOptions(cfg.Kernel.BaselineConfig, original, env.log, env.testConfig)
+}
+
+func (env *env) testConfig(config []byte) (vcs.BisectResult, error) {
+	env.cfg.Kernel.Config = config
+	return env.test()
+}
+
+// minimizeConfigOptions does the actual minimization, test returns vcs.BisectBad
+// if the crash is reproduced with the given config.
+func minimizeConfigOptions(baseline, original []byte, logf func(msg string, args ...interface{}),
+	test func(config []byte) (vcs.BisectResult, error)) ([]byte, error) {
+	options := configDiff(baseline, original)
+	logf("%v options differ from the baseline config", len(options))
+	logf("testing the baseline config")
+	res, err := test(baseline)
+	if err != nil {
+		return nil, err
+	}
+	if res == vcs.BisectBad {
+		logf("the crash is reproduced with the baseline config")
+		return baseline, nil
+	}
+	// Delta debugging (ddmin): split the options into n chunks and test each chunk and each complement
+	// of a chunk. If one of them reproduces the crash, continue with it, otherwise split into smaller chunks.
+	// The result is 1-minimal: removing any single option makes the crash go away.
+	// Chunks are frequently the same on different iterations, so results are cached.
+	tested := make(map[string]vcs.BisectResult)
+	n := 2
+	for len(options) > 1 {
+		chunks := splitConfigOptions(options, n)
+		var candidates [][]string
+		candidates = append(candidates, chunks...)
+		if n > 2 {
+			// For n == 2 complements are the same as the chunks.
+			for i := range chunks {
+				var complement []string
+				for j, chunk := range chunks {
+					if j != i {
+						complement = append(complement, chunk...)
+					}
+				}
+				candidates = append(candidates, complement)
+			}
+		}
+		reduced := false
+		for i, candidate := range candidates {
+			config := string(appendConfigOptions(baseline, candidate))
+			res, ok := tested[config]
+			if !ok {
+				logf("testing %v options", len(candidate))
+				if res, err = test([]byte(config)); err != nil {
+					return nil, err
+				}
+				tested[config] = res
+			}
+			if res == vcs.BisectBad {
+				options = candidate
+				if i < len(chunks) {
+					n = 2
+				} else if n > 2 {
+					n--
+				}
+				reduced = true
+				break
+			}
+		}
+		if reduced {
+			continue
+		}
+		if n >= len(options) {
+			break
+		}
+		n *= 2
+		if n > len(options) {
+			n = len(options)
+		}
+	}
+	// The config that we return was not necessarily tested (e.g. the baseline config with all options
+	// is different from the original config), and the crash may be flaky, so test it once more.
+	config := appendConfigOptions(baseline, options)
+	logf("testing the minimized config with %v options", len(options))
+	res, err = test(config)
+	if err != nil {
+		return nil, err
+	}
+	if res != vcs.BisectBad {
+		return nil, fmt.Errorf("the crash wasn't reproduced with the minimized config")
+	}
+	logf("minimized config: %v options on top of the baseline config", len(options))
+	return config, nil
+}
+
+// splitConfigOptions splits options into n chunks of roughly equal size.
+func splitConfigOptions(options []string, n int) [][]string {
+	var chunks [][]string
+	for i := 0; i < n; i++ {
+		chunk := options[i*len(options)/n : (i+1)*len(options)/n]
+		if len(chunk) != 0 {
+			chunks = append(chunks, chunk)
+		}
+	}
+	return chunks
+}
+
+var configOptionRe = regexp.MustCompile(`^(?:(CONFIG_[A-Za-z0-9_]+)=.*|# (CONFIG_[A-Za-z0-9_]+) is not set)$`)
+
+// parseConfig returns config option lines keyed by option names
+// and option names in the order of appearance.
+func parseConfig(config []byte) (map[string]string, []string) {
+	lines := make(map[string]string)
+	var names []string
+	s := bufio.NewScanner(bytes.NewReader(config))
+	for s.Scan() {
+		line := s.Text()
+		match := configOptionRe.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+		name := match[1] + match[2]
+		if _, ok := lines[name]; !ok {
+			names = append(names, name)
+		}
+		lines[name] = line
+	}
+	return lines, names
+}
+
+// configDiff returns lines of options that have different values in original and baseline.
+// Options that are not set in original and are not mentioned in baseline are ignored.
+func configDiff(baseline, original []byte) []string {
+	baseLines, _ := parseConfig(baseline)
+	origLines, names := parseConfig(original)
+	var diff []string
+	for _, name := range names {
+		line := origLines[name]
+		baseLine, ok := baseLines[name]
+		if line == baseLine || !ok && line[0] == '#' {
+			continue
+		}
+		diff = append(diff, line)
+	}
+	return diff
+}
+
+func appendConfigOptions(baseline []byte, options []string) []byte {
+	res := append([]byte{}, baseline...)
+	if len(res) != 0 && res[len(res)-1] != '\n' {
+		res = append(res, '\n')
+	}
+	res = append(res, "# Options from the original config (see bisect.MinimizeConfig).\n"...)
+	for _, opt := range options {
+		res = append(res, opt...)
+		res = append(res, '\n')
+	}
+	return res
+}
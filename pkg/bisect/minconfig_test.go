@@ -0,0 +1,134 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package bisect
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/google/syzkaller/pkg/vcs"
+)
+
+func TestConfigDiff(t *testing.T) {
+	baseline := []byte(`
+CONFIG_KASAN=y
+CONFIG_NET=y
+CONFIG_USB=y
+# CONFIG_SND is not set
+`)
+	original := []byte(`
+#
+# Automatically generated file; DO NOT EDIT.
+#
+CONFIG_KASAN=y
+CONFIG_NET=y
+# CONFIG_USB is not set
+CONFIG_SND=y
+CONFIG_NR_CPUS=8
+CONFIG_CMDLINE="foo=bar"
+# CONFIG_DEBUG_INFO is not set
+`)
+	want := []string{
+		"# CONFIG_USB is not set",
+		"CONFIG_SND=y",
+		"CONFIG_NR_CPUS=8",
+		`CONFIG_CMDLINE="foo=bar"`,
+	}
+	if got := configDiff(baseline, original); !reflect.DeepEqual(got, want) {
+		t.Fatalf("got diff:\n%q\nwant:\n%q", got, want)
+	}
+}
+
+func TestMinimizeConfigOptions(t *testing.T) {
+	baseline := []byte("CONFIG_KASAN=y\n")
+	var original []byte
+	for i := 0; i < 100; i++ {
+		original = append(original, fmt.Sprintf("CONFIG_OPT%v=y\n", i)...)
+	}
+	tests := []struct {
+		required []string // options required to reproduce the crash
+		want     []string // options in the minimized config
+		tests    int
+	}{
+		{
+			required: nil,
+			want:     nil,
+			tests:    1,
+		},
+		{
+			required: []string{"CONFIG_OPT42=y"},
+			want:     []string{"CONFIG_OPT42=y"},
+			tests:    14,
+		},
+		{
+			required: []string{"CONFIG_OPT0=y", "CONFIG_OPT99=y"},
+			want:     []string{"CONFIG_OPT0=y", "CONFIG_OPT99=y"},
+			tests:    42,
+		},
+		{
+			required: []string{"CONFIG_OPT60=y", "CONFIG_OPT61=y"},
+			want:     []string{"CONFIG_OPT60=y", "CONFIG_OPT61=y"},
+			tests:    14,
+		},
+		{
+			required: []string{"CONFIG_OPT10=y", "CONFIG_OPT30=y", "CONFIG_OPT50=y", "CONFIG_OPT70=y"},
+			want:     []string{"CONFIG_OPT10=y", "CONFIG_OPT30=y", "CONFIG_OPT50=y", "CONFIG_OPT70=y"},
+			tests:    112,
+		},
+	}
+	for i, test := range tests {
+		t.Run(fmt.Sprint(i), func(t *testing.T) {
+			numTests := 0
+			res, err := minimizeConfigOptions(baseline, original, t.Logf,
+				func(config []byte) (vcs.BisectResult, error) {
+					numTests++
+					for _, opt := range test.required {
+						if !strings.Contains(string(config), opt+"\n") {
+							return vcs.BisectGood, nil
+						}
+					}
+					return vcs.BisectBad, nil
+				})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if test.tests != 0 && numTests != test.tests {
+				t.Errorf("tested %v configs, want %v", numTests, test.tests)
+			}
+			if test.required == nil {
+				if string(res) != string(baseline) {
+					t.Fatalf("got config:\n%s\nwant the baseline config", res)
+				}
+				return
+			}
+			want := test.want
+			if want == nil {
+				want = configDiff(baseline, original)
+			}
+			if got := string(res); got != string(appendConfigOptions(baseline, want)) {
+				t.Fatalf("got config:\n%s\nwant options: %q", got, want)
+			}
+		})
+	}
+}
+
+func TestMinimizeConfigOptionsFlaky(t *testing.T) {
+	baseline := []byte("CONFIG_KASAN=y\n")
+	original := []byte("CONFIG_OPT0=y\nCONFIG_OPT1=y\nCONFIG_OPT2=y\nCONFIG_OPT3=y\n")
+	// The crash reproduces with OPT1 only once, the minimized config must be re-tested.
+	reproduced := false
+	_, err := minimizeConfigOptions(baseline, original, t.Logf,
+		func(config []byte) (vcs.BisectResult, error) {
+			if !strings.Contains(string(config), "CONFIG_OPT1=y\n") || reproduced {
+				return vcs.BisectGood, nil
+			}
+			reproduced = true
+			return vcs.BisectBad, nil
+		})
+	if err == nil {
+		t.Fatalf("minimization of a flaky crash succeeded")
+	}
+}
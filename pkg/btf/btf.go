@@ -0,0 +1,285 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+// Package btf parses BTF type information (.BTF section of vmlinux built with CONFIG_DEBUG_INFO_BTF)
+// and checks layouts of structs in syscall descriptions against the kernel.
+package btf
+
+import (
+	"bytes"
+	"debug/elf"
+	"encoding/binary"
+	"fmt"
+)
+
+type Kind int
+
+const (
+	KindUnknown Kind = iota
+	KindInt
+	KindPtr
+	KindArray
+	KindStruct
+	KindUnion
+	KindEnum
+	KindFwd
+	KindTypedef
+	KindVolatile
+	KindConst
+	KindRestrict
+	KindFunc
+	KindFuncProto
+	KindVar
+	KindDatasec
+	KindFloat
+	KindDeclTag
+	KindTypeTag
+	KindEnum64
+)
+
+type Type struct {
+	ID      uint32
+	Kind    Kind
+	Name    string
+	Size    uint64   // for int, struct, union, enum, float
+	Ref     uint32   // referenced type for ptr, typedef, qualifiers, array elements
+	Nelems  uint64   // for arrays
+	Members []Member // for structs and unions
+}
+
+type Member struct {
+	Name         string
+	Type         uint32
+	BitOffset    uint64
+	BitfieldSize uint64 // 0 if not a bitfield
+}
+
+type Spec struct {
+	PtrSize uint64
+	types   []*Type // indexed by type ID, ID 0 is void
+	structs map[string]*Type
+}
+
+const (
+	btfMagic      = 0xeb9f
+	btfHeaderSize = 24
+)
+
+// Load reads BTF from the .BTF section of the vmlinux ELF file.
+func Load(vmlinux string) (*Spec, error) {
+	file, err := elf.Open(vmlinux)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	sec := file.Section(".BTF")
+	if sec == nil {
+		return nil, fmt.Errorf("%v does not have .BTF section (build kernel with CONFIG_DEBUG_INFO_BTF)", vmlinux)
+	}
+	data, err := sec.Data()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read .BTF section: %v", err)
+	}
+	spec, err := Parse(data)
+	if err != nil {
+		return nil, err
+	}
+	if file.Class == elf.ELFCLASS32 {
+		spec.PtrSize = 4
+	}
+	return spec, nil
+}
+
+// Parse parses raw BTF data.
+func Parse(data []byte) (*Spec, error) {
+	if len(data) < btfHeaderSize {
+		return nil, fmt.Errorf("BTF data is too short")
+	}
+	var order binary.ByteOrder = binary.LittleEndian
+	if order.Uint16(data) != btfMagic {
+		order = binary.BigEndian
+		if order.Uint16(data) != btfMagic {
+			return nil, fmt.Errorf("bad BTF magic 0x%x", binary.LittleEndian.Uint16(data))
+		}
+	}
+	hdrLen := uint64(order.Uint32(data[4:]))
+	typeOff := hdrLen + uint64(order.Uint32(data[8:]))
+	typeEnd := typeOff + uint64(order.Uint32(data[12:]))
+	strOff := hdrLen + uint64(order.Uint32(data[16:]))
+	strEnd := strOff + uint64(order.Uint32(data[20:]))
+	if typeEnd > uint64(len(data)) || strEnd > uint64(len(data)) {
+		return nil, fmt.Errorf("BTF sections are out of bounds")
+	}
+	p := &parser{
+		order:   order,
+		data:    data[typeOff:typeEnd],
+		strings: data[strOff:strEnd],
+	}
+	spec := &Spec{
+		PtrSize: 8,
+		types:   []*Type{{Kind: KindUnknown}},
+		structs: make(map[string]*Type),
+	}
+	for len(p.data) != 0 {
+		typ, err := p.parseType()
+		if err != nil {
+			return nil, fmt.Errorf("type %v: %v", len(spec.types), err)
+		}
+		typ.ID = uint32(len(spec.types))
+		spec.types = append(spec.types, typ)
+		if (typ.Kind == KindStruct || typ.Kind == KindUnion) && typ.Name != "" {
+			if spec.structs[typ.Name] == nil {
+				spec.structs[typ.Name] = typ
+			}
+		}
+	}
+	return spec, nil
+}
+
+type parser struct {
+	order   binary.ByteOrder
+	data    []byte
+	strings []byte
+}
+
+func (p *parser) u32() (uint32, error) {
+	if len(p.data) < 4 {
+		return 0, fmt.Errorf("unexpected end of data")
+	}
+	v := p.order.Uint32(p.data)
+	p.data = p.data[4:]
+	return v, nil
+}
+
+func (p *parser) str(off uint32) (string, error) {
+	if uint64(off) >= uint64(len(p.strings)) {
+		return "", fmt.Errorf("bad string offset %v", off)
+	}
+	s := p.strings[off:]
+	if n := bytes.IndexByte(s, 0); n != -1 {
+		s = s[:n]
+	}
+	return string(s), nil
+}
+
+func (p *parser) parseType() (*Type, error) {
+	nameOff, err := p.u32()
+	if err != nil {
+		return nil, err
+	}
+	info, err := p.u32()
+	if err != nil {
+		return nil, err
+	}
+	sizeOrType, err := p.u32()
+	if err != nil {
+		return nil, err
+	}
+	typ := &Type{
+		Kind: Kind((info >> 24) & 0x1f),
+	}
+	if typ.Name, err = p.str(nameOff); err != nil {
+		return nil, err
+	}
+	vlen := int(info & 0xffff)
+	kindFlag := info&(1<<31) != 0
+	// Size of kind-specific data following the type, for kinds that we don't need.
+	skip := 0
+	switch typ.Kind {
+	case KindInt:
+		typ.Size = uint64(sizeOrType)
+		skip = 4
+	case KindEnum:
+		typ.Size = uint64(sizeOrType)
+		skip = vlen * 8
+	case KindFloat:
+		typ.Size = uint64(sizeOrType)
+	case KindEnum64:
+		typ.Size = uint64(sizeOrType)
+		skip = vlen * 12
+	case KindPtr, KindTypedef, KindVolatile, KindConst, KindRestrict, KindTypeTag, KindFunc:
+		typ.Ref = sizeOrType
+	case KindFwd:
+	case KindArray:
+		elem, err := p.u32()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := p.u32(); err != nil { // index type
+			return nil, err
+		}
+		nelems, err := p.u32()
+		if err != nil {
+			return nil, err
+		}
+		typ.Ref = elem
+		typ.Nelems = uint64(nelems)
+	case KindStruct, KindUnion:
+		typ.Size = uint64(sizeOrType)
+		for i := 0; i < vlen; i++ {
+			var vals [3]uint32
+			for j := range vals {
+				if vals[j], err = p.u32(); err != nil {
+					return nil, err
+				}
+			}
+			m := Member{
+				Type:      vals[1],
+				BitOffset: uint64(vals[2]),
+			}
+			if kindFlag {
+				m.BitOffset = uint64(vals[2] & 0xffffff)
+				m.BitfieldSize = uint64(vals[2] >> 24)
+			}
+			if m.Name, err = p.str(vals[0]); err != nil {
+				return nil, err
+			}
+			typ.Members = append(typ.Members, m)
+		}
+	case KindFuncProto:
+		skip = vlen * 8
+	case KindVar, KindDeclTag:
+		skip = 4
+	case KindDatasec:
+		skip = vlen * 12
+	default:
+		return nil, fmt.Errorf("unknown kind %v", typ.Kind)
+	}
+	if len(p.data) < skip {
+		return nil, fmt.Errorf("unexpected end of data")
+	}
+	p.data = p.data[skip:]
+	return typ, nil
+}
+
+// Struct returns struct or union with the given name, or nil.
+func (spec *Spec) Struct(name string) *Type {
+	return spec.structs[name]
+}
+
+// TypeSize returns size of type id in bytes.
+func (spec *Spec) TypeSize(id uint32) (uint64, error) {
+	for depth := 0; depth < 32; depth++ {
+		if id == 0 || int(id) >= len(spec.types) {
+			return 0, fmt.Errorf("bad type id %v", id)
+		}
+		typ := spec.types[id]
+		switch typ.Kind {
+		case KindInt, KindStruct, KindUnion, KindEnum, KindEnum64, KindFloat:
+			return typ.Size, nil
+		case KindPtr:
+			return spec.PtrSize, nil
+		case KindTypedef, KindVolatile, KindConst, KindRestrict, KindTypeTag:
+			id = typ.Ref
+		case KindArray:
+			elem, err := spec.TypeSize(typ.Ref)
+			if err != nil {
+				return 0, err
+			}
+			return elem * typ.Nelems, nil
+		default:
+			return 0, fmt.Errorf("type %v of kind %v does not have size", id, typ.Kind)
+		}
+	}
+	return 0, fmt.Errorf("type %v: too deep type chain", id)
+}
@@ -0,0 +1,133 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package btf
+
+import (
+	"bytes"
+	"encoding/binary"
+	"reflect"
+	"testing"
+
+	"github.com/google/syzkaller/prog"
+)
+
+type builder struct {
+	types   bytes.Buffer
+	strings bytes.Buffer
+}
+
+func (b *builder) str(s string) uint32 {
+	if s == "" {
+		return 0
+	}
+	off := uint32(b.strings.Len())
+	b.strings.WriteString(s)
+	b.strings.WriteByte(0)
+	return off
+}
+
+func (b *builder) typ(name string, kind Kind, kindFlag bool, vlen int, sizeOrType uint32, extra ...uint32) {
+	info := uint32(kind)<<24 | uint32(vlen)
+	if kindFlag {
+		info |= 1 << 31
+	}
+	for _, v := range append([]uint32{b.str(name), info, sizeOrType}, extra...) {
+		binary.Write(&b.types, binary.LittleEndian, v)
+	}
+}
+
+func (b *builder) data() []byte {
+	buf := new(bytes.Buffer)
+	hdr := []uint32{btfHeaderSize, 0, uint32(b.types.Len()), uint32(b.types.Len()), uint32(b.strings.Len())}
+	binary.Write(buf, binary.LittleEndian, uint16(btfMagic))
+	buf.Write([]byte{1, 0})
+	binary.Write(buf, binary.LittleEndian, hdr)
+	buf.Write(b.types.Bytes())
+	buf.Write(b.strings.Bytes())
+	return buf.Bytes()
+}
+
+func TestParse(t *testing.T) {
+	b := new(builder)
+	b.strings.WriteByte(0)
+	b.typ("int", KindInt, false, 0, 4, 32)                                      // 1
+	b.typ("long", KindInt, false, 0, 8, 64)                                     // 2
+	b.typ("foo", KindStruct, false, 3, 24, b.str("a"), 1, 0, b.str("b"), 2, 64, // 3
+		b.str("c"), 6, 128)
+	b.typ("bar", KindStruct, true, 2, 4, b.str("x"), 1, 3<<24, b.str("y"), 1, 5<<24|3) // 4
+	b.typ("", KindArray, false, 0, 0, 1, 1, 2)                                         // 5
+	b.typ("arr_t", KindTypedef, false, 0, 5)                                           // 6
+	b.typ("", KindPtr, false, 0, 3)                                                    // 7
+	b.typ("e", KindEnum, false, 2, 4, b.str("E0"), 0, b.str("E1"), 1)                  // 8
+	b.typ("fn", KindFuncProto, false, 1, 0, 0, 1)                                      // 9
+	spec, err := Parse(b.data())
+	if err != nil {
+		t.Fatal(err)
+	}
+	foo := spec.Struct("foo")
+	if foo == nil || foo.ID != 3 || foo.Size != 24 {
+		t.Fatalf("bad struct foo: %+v", foo)
+	}
+	wantMembers := []Member{{"a", 1, 0, 0}, {"b", 2, 64, 0}, {"c", 6, 128, 0}}
+	if !reflect.DeepEqual(foo.Members, wantMembers) {
+		t.Fatalf("bad foo members: %+v", foo.Members)
+	}
+	bar := spec.Struct("bar")
+	wantMembers = []Member{{"x", 1, 0, 3}, {"y", 1, 3, 5}}
+	if bar == nil || !reflect.DeepEqual(bar.Members, wantMembers) {
+		t.Fatalf("bad struct bar: %+v", bar)
+	}
+	for id, want := range map[uint32]uint64{1: 4, 2: 8, 3: 24, 5: 8, 6: 8, 7: 8, 8: 4} {
+		size, err := spec.TypeSize(id)
+		if err != nil {
+			t.Fatalf("type %v: %v", id, err)
+		}
+		if size != want {
+			t.Fatalf("type %v: size %v, want %v", id, size, want)
+		}
+	}
+	if _, err := spec.TypeSize(9); err == nil {
+		t.Fatalf("func proto has size")
+	}
+	if _, err := Parse(b.data()[:30]); err == nil {
+		t.Fatalf("truncated data parsed successfully")
+	}
+
+	intType := func(name string, size uint64) prog.Type {
+		return &prog.IntType{IntTypeCommon: prog.IntTypeCommon{
+			TypeCommon: prog.TypeCommon{TypeName: "int", FldName: name, TypeSize: size},
+		}}
+	}
+	pad := func(size uint64) prog.Type {
+		return &prog.ConstType{IntTypeCommon: prog.IntTypeCommon{
+			TypeCommon: prog.TypeCommon{TypeName: "pad", TypeSize: size},
+		}, IsPad: true}
+	}
+	desc := &prog.StructDesc{
+		TypeCommon: prog.TypeCommon{TypeName: "foo", TypeSize: 20},
+		Fields:     []prog.Type{intType("a", 4), pad(4), intType("b", 4), intType("c", 8)},
+	}
+	got := checkStruct(desc, spec)
+	want := []*Mismatch{
+		{Struct: "foo", What: "size", Desc: 20, Kernel: 24},
+		{Struct: "foo", Field: "b", What: "size", Desc: 4, Kernel: 8},
+		{Struct: "foo", Field: "c", What: "offset", Desc: 12, Kernel: 16},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got mismatches:\n%v\nwant:\n%v", got, want)
+	}
+	desc.Fields = []prog.Type{intType("x", 4), intType("y", 4)}
+	desc.Fields[0].(*prog.IntType).BitfieldLen = 3
+	desc.Fields[0].(*prog.IntType).BitfieldMdl = true
+	desc.Fields[1].(*prog.IntType).BitfieldLen = 5
+	desc.Fields[1].(*prog.IntType).BitfieldOff = 3
+	desc.TypeName, desc.TypeSize = "bar", 4
+	if got := checkStruct(desc, spec); len(got) != 0 {
+		t.Fatalf("got mismatches for matching bitfields: %v", got)
+	}
+	desc.TypeName = "unrelated"
+	if got := checkStruct(desc, spec); len(got) != 0 {
+		t.Fatalf("got mismatches for unknown struct: %v", got)
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package btf
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/syzkaller/prog"
+)
+
+// Mismatch describes a difference between a struct in descriptions and the kernel.
+type Mismatch struct {
+	Struct string
+	Field  string // empty for struct size mismatches
+	What   string // "size", "offset" or "bit offset"
+	Desc   uint64 // value in descriptions
+	Kernel uint64 // value in the kernel
+}
+
+func (m *Mismatch) String() string {
+	name := m.Struct
+	if m.Field != "" {
+		name += "." + m.Field
+	}
+	return fmt.Sprintf("%v: %v %v in descriptions, %v in kernel", name, m.What, m.Desc, m.Kernel)
+}
+
+// CheckLayouts compares sizes and field offsets of structs used by syscalls of the target
+// with kernel structs of the same name. Descriptions frequently give structs own names
+// and field names, so only structs that share most field names with the kernel struct are checked.
+func CheckLayouts(target *prog.Target, spec *Spec) []*Mismatch {
+	seen := make(map[*prog.StructDesc]bool)
+	var res []*Mismatch
+	for _, meta := range target.Syscalls {
+		prog.ForeachType(meta, func(typ prog.Type) {
+			t, ok := typ.(*prog.StructType)
+			if !ok || seen[t.StructDesc] {
+				return
+			}
+			seen[t.StructDesc] = true
+			res = append(res, checkStruct(t.StructDesc, spec)...)
+		})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].Struct != res[j].Struct {
+			return res[i].Struct < res[j].Struct
+		}
+		return res[i].Field < res[j].Field
+	})
+	return res
+}
+
+func checkStruct(desc *prog.StructDesc, spec *Spec) []*Mismatch {
+	name := desc.Name()
+	if strings.Contains(name, "[") {
+		// Template instantiation.
+		return nil
+	}
+	ktyp := spec.Struct(name)
+	if ktyp == nil || ktyp.Kind != KindStruct {
+		return nil
+	}
+	members := make(map[string]*Member)
+	for i := range ktyp.Members {
+		members[ktyp.Members[i].Name] = &ktyp.Members[i]
+	}
+	named, matched := 0, 0
+	for _, f := range desc.Fields {
+		if prog.IsPad(f) {
+			continue
+		}
+		named++
+		if members[f.FieldName()] != nil {
+			matched++
+		}
+	}
+	if matched == 0 || matched*2 < named {
+		return nil
+	}
+	var res []*Mismatch
+	if !desc.Varlen() && desc.Size() != ktyp.Size {
+		res = append(res, &Mismatch{Struct: name, What: "size", Desc: desc.Size(), Kernel: ktyp.Size})
+	}
+	offset := uint64(0)
+	for _, f := range desc.Fields {
+		if f.Varlen() {
+			// Offsets of the following fields are not known.
+			break
+		}
+		if m := members[f.FieldName()]; m != nil && !prog.IsPad(f) {
+			res = append(res, checkField(name, f, offset, m, spec)...)
+		}
+		if !f.BitfieldMiddle() {
+			offset += f.Size()
+		}
+	}
+	return res
+}
+
+func checkField(name string, f prog.Type, offset uint64, m *Member, spec *Spec) []*Mismatch {
+	if f.BitfieldLength() != 0 || m.BitfieldSize != 0 {
+		bitOffset := offset*8 + f.BitfieldOffset()
+		if bitOffset != m.BitOffset {
+			return []*Mismatch{{Struct: name, Field: m.Name, What: "bit offset", Desc: bitOffset, Kernel: m.BitOffset}}
+		}
+		return nil
+	}
+	if offset*8 != m.BitOffset {
+		return []*Mismatch{{Struct: name, Field: m.Name, What: "offset", Desc: offset, Kernel: m.BitOffset / 8}}
+	}
+	size, err := spec.TypeSize(m.Type)
+	if err != nil || size == 0 {
+		// Flexible arrays, etc.
+		return nil
+	}
+	if f.Size() != size {
+		return []*Mismatch{{Struct: name, Field: m.Name, What: "size", Desc: f.Size(), Kernel: size}}
+	}
+	return nil
+}
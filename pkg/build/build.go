@@ -5,35 +5,64 @@
 package build
 
 import (
+	"bytes"
 	"fmt"
 	"path/filepath"
+	"regexp"
 	"strings"
 	"time"
 
 	"github.com/google/syzkaller/pkg/osutil"
 )
 
+// Params are parameters of Image.
+type Params struct {
+	TargetOS     string
+	TargetArch   string
+	VMType       string
+	KernelDir    string
+	OutputDir    string
+	Compiler     string
+	UserspaceDir string
+	// ImageBackend selects how the image is created from UserspaceDir (see CheckImageBackend),
+	// empty means the default debootstrap backend.
+	ImageBackend string
+	// RootStorage selects the storage stack of the image root filesystem (see CheckRootStorage),
+	// empty means a plain ext4 partition.
+	RootStorage string
+	// LSM selects the security module profile of the image (see CheckLSM),
+	// the kernel config needs to have the module enabled (see LSMConfig).
+	LSM string
+	// If CmdlineFile is not empty, contents of the file are appended to the kernel command line.
+	CmdlineFile string
+	// If SysctlFile is not empty, contents of the file are appended to the image /etc/sysctl.conf.
+	SysctlFile string
+	Config     []byte
+}
+
 // Image creates a disk image for the specified OS/ARCH/VM.
-// Kernel is taken from kernelDir, userspace system is taken from userspaceDir.
-// If cmdlineFile is not empty, contents of the file are appended to the kernel command line.
-// If sysctlFile is not empty, contents of the file are appended to the image /etc/sysctl.conf.
-// Output is stored in outputDir and includes (everything except for image is optional):
+// Kernel is taken from KernelDir, userspace system is taken from UserspaceDir.
+// ImageBackend, RootStorage and LSM are supported only for Linux kernels (not for gvisor),
+// other targets return an error if they are set.
+// Output is stored in OutputDir and includes (everything except for image is optional):
 //  - image: the image
 //  - key: ssh key for the image
 //  - kernel: kernel for injected boot
 //  - initrd: initrd for injected boot
+//  - dtb: device tree blob to boot with
+//  - firmware: firmware/bootloader to boot with (e.g. UEFI firmware for arm64)
+//  - modules.tar: kernel modules
 //  - kernel.config: actual kernel config used during build
 //  - obj/: directory with kernel object files (e.g. vmlinux for linux)
-func Image(targetOS, targetArch, vmType, kernelDir, outputDir, compiler, userspaceDir,
-	cmdlineFile, sysctlFile string, config []byte) error {
-	builder, err := getBuilder(targetOS, targetArch, vmType)
+func Image(params *Params) error {
+	builder, err := getBuilder(params.TargetOS, params.TargetArch, params.VMType)
 	if err != nil {
 		return err
 	}
-	if err := osutil.MkdirAll(filepath.Join(outputDir, "obj")); err != nil {
+	if err := osutil.MkdirAll(filepath.Join(params.OutputDir, "obj")); err != nil {
 		return err
 	}
-	return builder.build(targetArch, vmType, kernelDir, outputDir, compiler, userspaceDir, cmdlineFile, sysctlFile, config)
+	return builder.build(params)
 }
 
 func Clean(targetOS, targetArch, vmType, kernelDir string) error {
@@ -48,9 +77,45 @@ type KernelBuildError struct {
 	*osutil.VerboseError
 }
 
+// maxExcerptLines limits size of KernelBuildError.Excerpt.
+const maxExcerptLines = 50
+
+// kbuildLineRe matches progress lines of kbuild (e.g. "  CC      net/ipv4/route.o").
+var kbuildLineRe = regexp.MustCompile(`^  [A-Z][A-Z0-9_]* +[^ ]`)
+
+// Excerpt returns compiler and linker error messages from the build output
+// along with the source lines that compilers print after errors,
+// or nil if the output does not contain known error messages.
+func (err KernelBuildError) Excerpt() []byte {
+	lines := bytes.Split(err.Output, []byte{'\n'})
+	var excerpt [][]byte
+	for i := 0; i < len(lines) && len(excerpt) < maxExcerptLines; i++ {
+		if !isBuildFailureCause(lines[i]) {
+			continue
+		}
+		if i != 0 && bytes.Contains(lines[i-1], []byte(": In function ")) &&
+			(len(excerpt) == 0 || !bytes.Equal(excerpt[len(excerpt)-1], lines[i-1])) {
+			excerpt = append(excerpt, lines[i-1])
+		}
+		excerpt = append(excerpt, lines[i])
+		// GCC and clang print the source line and a caret line indented after the error.
+		for ctx := 0; ctx < 2 && i+1 < len(lines) && len(lines[i+1]) != 0 && lines[i+1][0] == ' ' &&
+			!kbuildLineRe.Match(lines[i+1]); ctx++ {
+			i++
+			excerpt = append(excerpt, lines[i])
+		}
+	}
+	if len(excerpt) == 0 {
+		return nil
+	}
+	if len(excerpt) > maxExcerptLines {
+		excerpt = excerpt[:maxExcerptLines]
+	}
+	return append(bytes.Join(excerpt, []byte{'\n'}), '\n')
+}
+
 type builder interface {
-	build(targetArch, vmType, kernelDir, outputDir, compiler, userspaceDir,
-		cmdlineFile, sysctlFile string, config []byte) error
+	build(params *Params) error
 	clean(kernelDir string) error
 }
 
@@ -60,6 +125,9 @@ func getBuilder(targetOS, targetArch, vmType string) (builder, error) {
 		return gvisor{}, nil
 	case targetOS == "linux" && targetArch == "amd64" && (vmType == "qemu" || vmType == "gce"):
 		return linux{}, nil
+	case targetOS == "linux" && linuxKernelImages[targetArch] != "" && vmType == "qemu":
+		// Cross-compiled kernels, bootable only with qemu -kernel.
+		return linux{}, nil
 	case targetOS == "fuchsia" && (targetArch == "amd64" || targetArch == "arm64") && vmType == "qemu":
 		return fuchsia{}, nil
 	default:
@@ -67,6 +135,25 @@ func getBuilder(targetOS, targetArch, vmType string) (builder, error) {
 	}
 }
 
+// rejectImageOptions returns an error if params use image options that are supported
+// only by the linux builder (for builders that don't create images from userspace).
+func rejectImageOptions(params *Params) error {
+	for _, opt := range []struct {
+		name string
+		val  string
+	}{
+		{"image backend", params.ImageBackend},
+		{"root storage", params.RootStorage},
+		{"LSM profile", params.LSM},
+	} {
+		if opt.val != "" {
+			return fmt.Errorf("%v %q is not supported for %v/%v/%v",
+				opt.name, opt.val, params.TargetOS, params.TargetArch, params.VMType)
+		}
+	}
+	return nil
+}
+
 func CompilerIdentity(compiler string) (string, error) {
 	if compiler == "" {
 		return "", nil
@@ -4,9 +4,14 @@
 package build
 
 import (
+	"io/ioutil"
+	"os"
 	"os/exec"
+	"path/filepath"
 	"strings"
 	"testing"
+
+	"github.com/google/syzkaller/pkg/osutil"
 )
 
 func TestCompilerIdentity(t *testing.T) {
@@ -34,3 +39,206 @@ func TestCompilerIdentity(t *testing.T) {
 		})
 	}
 }
+
+func TestLinuxCrossBuild(t *testing.T) {
+	for _, arch := range []string{"amd64", "arm64", "arm", "ppc64le"} {
+		if _, err := getBuilder("linux", arch, "qemu"); err != nil {
+			t.Errorf("linux/%v/qemu: %v", arch, err)
+		}
+	}
+	if _, err := getBuilder("linux", "arm64", "gce"); err == nil {
+		t.Errorf("linux/arm64/gce is supported")
+	}
+	args := strings.Join(linux{}.makeArgs("arm64", "clang"), " ")
+	if !strings.Contains(args, "ARCH=arm64") || !strings.Contains(args, "CC=clang") {
+		t.Errorf("bad make args for arm64: %v", args)
+	}
+}
+
+func TestLockdownConfig(t *testing.T) {
+	config := LockdownConfig([]byte("CONFIG_KCOV=y\n# CONFIG_MODULES is not set"))
+	lines := strings.Split(string(config), "\n")
+	if lines[0] != "CONFIG_KCOV=y" || lines[1] != "# CONFIG_MODULES is not set" {
+		t.Fatalf("original config is not preserved:\n%s", config)
+	}
+	// The override must go after the original value.
+	if !strings.Contains(strings.Join(lines[2:], "\n"), "\nCONFIG_MODULES=y\n") ||
+		!strings.Contains(string(config), "\nCONFIG_MODULE_SIG_FORCE=y\n") ||
+		!strings.Contains(string(config), "\nCONFIG_MODULE_SIG_KEY=\"certs/signing_key.pem\"\n") ||
+		!strings.Contains(string(config), "\nCONFIG_SECURITY_LOCKDOWN_LSM=y\n") {
+		t.Fatalf("module signing or lockdown is not enabled:\n%s", config)
+	}
+}
+
+func TestKernelBuildErrorExcerpt(t *testing.T) {
+	output := `  CC      net/ipv4/route.o
+net/ipv4/route.c: In function 'ip_route_input':
+net/ipv4/route.c:123:2: error: implicit declaration of function 'foo' [-Werror=implicit-function-declaration]
+  foo(skb);
+  ^~~
+  CC      net/ipv4/tcp.o
+net/ipv4/route.c:130:9: error: 'bar' undeclared (first use in this function)
+  return bar;
+         ^~~
+make[2]: *** [net/ipv4/route.o] Error 1
+`
+	want := `net/ipv4/route.c: In function 'ip_route_input':
+net/ipv4/route.c:123:2: error: implicit declaration of function 'foo' [-Werror=implicit-function-declaration]
+  foo(skb);
+  ^~~
+net/ipv4/route.c:130:9: error: 'bar' undeclared (first use in this function)
+  return bar;
+         ^~~
+`
+	err := KernelBuildError{&osutil.VerboseError{Output: []byte(output)}}
+	if got := string(err.Excerpt()); got != want {
+		t.Fatalf("got excerpt:\n%s\nwant:\n%s", got, want)
+	}
+	err = KernelBuildError{&osutil.VerboseError{Output: []byte("make: *** Error 1\n")}}
+	if got := err.Excerpt(); got != nil {
+		t.Fatalf("got excerpt for output without errors:\n%s", got)
+	}
+}
+
+func TestSanitizerConfig(t *testing.T) {
+	for _, sanitizers := range [][]string{{"foo"}, {"kasan", "kasan"}, {"kasan", "kcsan"}} {
+		if _, err := SanitizerConfig(nil, sanitizers); err == nil {
+			t.Errorf("sanitizers %v are accepted", sanitizers)
+		}
+	}
+	config, err := SanitizerConfig([]byte("CONFIG_KCOV=y\n# CONFIG_KASAN is not set"), []string{"kasan", "ubsan"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(string(config), "CONFIG_KCOV=y\n# CONFIG_KASAN is not set\n") ||
+		!strings.Contains(string(config), "\nCONFIG_KASAN=y\n") ||
+		!strings.Contains(string(config), "\nCONFIG_UBSAN=y\n") {
+		t.Fatalf("sanitizers are not enabled:\n%s", config)
+	}
+	if err := checkSanitizerConfig(config, []byte("CONFIG_KASAN=y\nCONFIG_KASAN_INLINE=y\nCONFIG_UBSAN=y\n")); err != nil {
+		t.Fatal(err)
+	}
+	// The compiler does not support inline instrumentation.
+	if err := checkSanitizerConfig(config, []byte("CONFIG_KASAN=y\nCONFIG_UBSAN=y\n")); err == nil {
+		t.Fatalf("missing CONFIG_KASAN_INLINE is not detected")
+	}
+	if err := checkSanitizerConfig([]byte("CONFIG_KCOV=y\n"), nil); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRootStorageConfig(t *testing.T) {
+	if _, err := RootStorageConfig(nil, "foo"); err == nil {
+		t.Errorf("unknown root storage is accepted")
+	}
+	config, err := RootStorageConfig([]byte("CONFIG_KCOV=y"), RootStoragePlain)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(config) != "CONFIG_KCOV=y\n" {
+		t.Fatalf("plain root storage changed config:\n%s", config)
+	}
+	config, err = RootStorageConfig([]byte("CONFIG_KCOV=y\n# CONFIG_DM_VERITY is not set\n"), RootStorageVerity)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(string(config), "CONFIG_KCOV=y\n# CONFIG_DM_VERITY is not set\n") ||
+		!strings.Contains(string(config), "\nCONFIG_DM_VERITY=y\n") ||
+		!strings.Contains(string(config), "\nCONFIG_DM_INIT=y\n") {
+		t.Fatalf("dm-verity is not enabled:\n%s", config)
+	}
+}
+
+func TestLSMConfig(t *testing.T) {
+	if _, err := LSMConfig(nil, "smack"); err == nil {
+		t.Errorf("unknown LSM profile is accepted")
+	}
+	config, err := LSMConfig([]byte("CONFIG_KCOV=y"), LSMNone)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(config) != "CONFIG_KCOV=y\n" {
+		t.Fatalf("empty LSM profile changed config:\n%s", config)
+	}
+	config, err = LSMConfig([]byte("CONFIG_KCOV=y\n"), LSMSELinuxEnforcing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(string(config), "CONFIG_KCOV=y\n") ||
+		!strings.Contains(string(config), "\nCONFIG_SECURITY_SELINUX=y\n") ||
+		!strings.Contains(string(config), "\nCONFIG_LSM=\"landlock,lockdown,yama,integrity,selinux,bpf\"\n") {
+		t.Fatalf("SELinux is not enabled:\n%s", config)
+	}
+}
+
+func TestImageBackends(t *testing.T) {
+	for _, name := range []string{"", ImageBackendDebootstrap, ImageBackendBuildroot} {
+		if err := CheckImageBackend(name); err != nil {
+			t.Errorf("backend %q: %v", name, err)
+		}
+	}
+	for _, name := range []string{"foo", "create.sh", "/nonexistent/create.sh"} {
+		if err := CheckImageBackend(name); err == nil {
+			t.Errorf("backend %q is accepted", name)
+		}
+	}
+}
+
+func TestUnsupportedImageOptions(t *testing.T) {
+	for _, params := range []*Params{
+		{TargetOS: "linux", TargetArch: "amd64", VMType: "gvisor", LSM: LSMLandlock},
+		{TargetOS: "fuchsia", TargetArch: "amd64", VMType: "qemu", ImageBackend: ImageBackendBuildroot},
+		{TargetOS: "fuchsia", TargetArch: "arm64", VMType: "qemu", RootStorage: RootStorageVerity},
+	} {
+		builder, err := getBuilder(params.TargetOS, params.TargetArch, params.VMType)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := builder.build(params); err == nil {
+			t.Errorf("%v/%v/%v: unsupported options are accepted", params.TargetOS, params.TargetArch, params.VMType)
+		}
+	}
+}
+
+func TestScriptImageBackend(t *testing.T) {
+	dir, err := ioutil.TempDir("", "syz-build-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	script := filepath.Join(dir, "create.sh")
+	if err := osutil.WriteExecFile(script, []byte("#!/bin/sh\necho \"$1 $2 $SYZ_VM_TYPE\" > disk.raw\n"+
+		"echo key > key\necho dtb > dtb\n")); err != nil {
+		t.Fatal(err)
+	}
+	backend, err := getImageBackend(script)
+	if err != nil {
+		t.Fatal(err)
+	}
+	params := &imageParams{
+		targetArch:   "amd64",
+		vmType:       "qemu",
+		kernelImage:  "bzImage",
+		outputDir:    dir,
+		userspaceDir: "userspace",
+	}
+	if err := backend.createImage(params); err != nil {
+		t.Fatal(err)
+	}
+	image, err := ioutil.ReadFile(filepath.Join(dir, "image"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(image), "userspace bzImage qemu\n"; got != want {
+		t.Fatalf("got image %q, want %q", got, want)
+	}
+	if !osutil.IsExist(filepath.Join(dir, "key")) {
+		t.Fatalf("no key in output")
+	}
+	if !osutil.IsExist(filepath.Join(dir, "dtb")) {
+		t.Fatalf("no dtb in output")
+	}
+	if osutil.IsExist(filepath.Join(dir, "firmware")) {
+		t.Fatalf("firmware in output, but the script did not create it")
+	}
+}
@@ -14,12 +14,15 @@ import (
 
 type fuchsia struct{}
 
-func (fu fuchsia) build(targetArch, vmType, kernelDir, outputDir, compiler, userspaceDir,
-	cmdlineFile, sysctlFile string, config []byte) error {
-	sysTarget := targets.Get("fuchsia", targetArch)
+func (fu fuchsia) build(params *Params) error {
+	if err := rejectImageOptions(params); err != nil {
+		return err
+	}
+	sysTarget := targets.Get("fuchsia", params.TargetArch)
 	if sysTarget == nil {
-		return fmt.Errorf("unsupported fuchsia arch %v", targetArch)
+		return fmt.Errorf("unsupported fuchsia arch %v", params.TargetArch)
 	}
+	kernelDir := params.KernelDir
 	arch := sysTarget.KernelHeaderArch
 	if _, err := osutil.RunCmd(10*time.Minute, kernelDir, "scripts/fx", "set", arch,
 		"--packages", "garnet/packages/products/sshd"); err != nil {
@@ -36,7 +39,7 @@ func (fu fuchsia) build(targetArch, vmType, kernelDir, outputDir, compiler, user
 		"out/" + arch + "/bootdata-blob.bin":             "initrd",
 	} {
 		fullSrc := filepath.Join(kernelDir, filepath.FromSlash(src))
-		fullDst := filepath.Join(outputDir, filepath.FromSlash(dst))
+		fullDst := filepath.Join(params.OutputDir, filepath.FromSlash(dst))
 		if err := osutil.CopyFile(fullSrc, fullDst); err != nil {
 			return fmt.Errorf("faied to copy %v: %v", src, err)
 		}
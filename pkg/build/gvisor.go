@@ -14,25 +14,27 @@ import (
 
 type gvisor struct{}
 
-func (gvisor gvisor) build(targetArch, vmType, kernelDir, outputDir, compiler, userspaceDir,
-	cmdlineFile, sysctlFile string, config []byte) error {
+func (gvisor gvisor) build(params *Params) error {
+	if err := rejectImageOptions(params); err != nil {
+		return err
+	}
 	args := []string{"build", "--verbose_failures"}
-	if strings.Contains(" "+string(config)+" ", " -race ") {
+	if strings.Contains(" "+string(params.Config)+" ", " -race ") {
 		args = append(args, "--features=race")
 	}
 	args = append(args, "runsc")
-	if _, err := osutil.RunCmd(20*time.Minute, kernelDir, compiler, args...); err != nil {
+	if _, err := osutil.RunCmd(20*time.Minute, params.KernelDir, params.Compiler, args...); err != nil {
 		return err
 	}
-	if err := gvisor.copyBinary(kernelDir, outputDir); err != nil {
+	if err := gvisor.copyBinary(params.KernelDir, params.OutputDir); err != nil {
 		return err
 	}
-	if len(config) != 0 {
-		if err := osutil.WriteFile(filepath.Join(outputDir, "kernel.config"), config); err != nil {
+	if len(params.Config) != 0 {
+		if err := osutil.WriteFile(filepath.Join(params.OutputDir, "kernel.config"), params.Config); err != nil {
 			return fmt.Errorf("failed to save kernel config: %v", err)
 		}
 	}
-	osutil.RunCmd(10*time.Minute, kernelDir, compiler, "shutdown")
+	osutil.RunCmd(10*time.Minute, params.KernelDir, params.Compiler, "shutdown")
 	return nil
 }
 
@@ -20,22 +20,116 @@ import (
 	"time"
 
 	"github.com/google/syzkaller/pkg/osutil"
+	"github.com/google/syzkaller/sys/targets"
 )
 
 type linux struct{}
 
-func (linux linux) build(targetArch, vmType, kernelDir, outputDir, compiler, userspaceDir,
-	cmdlineFile, sysctlFile string, config []byte) error {
-	if err := linux.buildKernel(kernelDir, outputDir, compiler, config); err != nil {
+// linuxKernelImages maps supported arches to bootable kernel images (relative to kernel dir),
+// base name of the image is also the make target that builds it.
+// Only x86 images are bootable from disk, for other arches the kernel is injected with qemu -kernel.
+var linuxKernelImages = map[string]string{
+	"amd64":   "arch/x86/boot/bzImage",
+	"arm64":   "arch/arm64/boot/Image",
+	"arm":     "arch/arm/boot/zImage",
+	"ppc64le": "vmlinux",
+}
+
+func (linux linux) build(params *Params) error {
+	if err := linux.buildKernel(params.TargetArch, params.KernelDir, params.OutputDir,
+		params.Compiler, params.Config); err != nil {
 		return err
 	}
-	if err := linux.createImage(vmType, kernelDir, outputDir, userspaceDir, cmdlineFile, sysctlFile); err != nil {
+	if err := linux.createImage(params); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (linux) buildKernel(kernelDir, outputDir, compiler string, config []byte) error {
+// makeArgs returns arch and compiler arguments for make.
+// For cross-compilation compiler must target the arch (e.g. aarch64-linux-gnu-gcc or clang),
+// binutils are taken from CROSS_COMPILE.
+func (linux) makeArgs(targetArch, compiler string) []string {
+	target := targets.Get("linux", targetArch)
+	args := []string{"ARCH=" + target.KernelArch, "CC=" + compiler}
+	if target.CCompilerPrefix != "" {
+		args = append(args, "CROSS_COMPILE="+target.CCompilerPrefix)
+	}
+	return args
+}
+
+// appendConfig returns a copy of kernel config with the config fragments appended.
+// Later values override earlier ones in kernel configs, so the fragments take precedence.
+func appendConfig(config []byte, fragments ...string) []byte {
+	res := append([]byte{}, config...)
+	if len(res) != 0 && res[len(res)-1] != '\n' {
+		res = append(res, '\n')
+	}
+	for _, frag := range fragments {
+		res = append(res, frag...)
+	}
+	return res
+}
+
+// LockdownConfig returns kernel config with module signing and lockdown enabled on top of config,
+// so that fuzzing covers the hardened configurations used by production systems.
+// Lockdown is enforced in integrity mode, modules must be signed with the key generated
+// for every build (see buildKernel). Note: integrity mode restricts debugfs (LOCKDOWN_DEBUGFS),
+// so kcov does not work and the kernel can be fuzzed only without coverage.
+func LockdownConfig(config []byte) []byte {
+	return appendConfig(config, lockdownConfig)
+}
+
+const lockdownConfig = `
+# Module signing and lockdown (see LockdownConfig).
+CONFIG_MODULES=y
+CONFIG_MODULE_SIG=y
+CONFIG_MODULE_SIG_FORCE=y
+CONFIG_MODULE_SIG_ALL=y
+CONFIG_MODULE_SIG_SHA256=y
+CONFIG_MODULE_SIG_KEY="certs/signing_key.pem"
+CONFIG_SECURITY=y
+CONFIG_SECURITY_LOCKDOWN_LSM=y
+CONFIG_SECURITY_LOCKDOWN_LSM_EARLY=y
+CONFIG_LOCK_DOWN_KERNEL_FORCE_INTEGRITY=y
+`
+
+// DeepDiveConfig returns kernel config with expensive debugging options enabled on top of config.
+// The options slow down the kernel considerably, so they are used only for periodic
+// "deep dive" runs over the existing corpus rather than for continuous fuzzing.
+// Options that start background activity (e.g. built-in torture tests) are not enabled,
+// it would produce hangs and reports unrelated to the fuzzed programs.
+func DeepDiveConfig(config []byte) []byte {
+	return appendConfig(config, deepDiveConfig)
+}
+
+const deepDiveConfig = `
+# Expensive debugging options (see DeepDiveConfig).
+CONFIG_DEBUG_PAGEALLOC=y
+CONFIG_DEBUG_PAGEALLOC_ENABLE_DEFAULT=y
+CONFIG_PAGE_POISONING=y
+CONFIG_SLUB_DEBUG_ON=y
+CONFIG_KASAN_STACK=y
+CONFIG_KASAN_STACK_ENABLE=y
+CONFIG_DEBUG_VM=y
+CONFIG_DEBUG_VM_PGFLAGS=y
+CONFIG_DEBUG_OBJECTS=y
+CONFIG_DEBUG_OBJECTS_FREE=y
+CONFIG_DEBUG_OBJECTS_TIMERS=y
+CONFIG_DEBUG_OBJECTS_WORK=y
+CONFIG_DEBUG_OBJECTS_RCU_HEAD=y
+CONFIG_PROVE_LOCKING=y
+CONFIG_DEBUG_ATOMIC_SLEEP=y
+`
+
+func (linux linux) buildKernel(targetArch, kernelDir, outputDir, compiler string, config []byte) error {
+	// Remove the module signing key left from the previous build (if any),
+	// so that the kernel generates a new key for every build with module signing enabled.
+	for _, file := range []string{"certs/signing_key.pem", "certs/signing_key.x509"} {
+		if err := os.RemoveAll(filepath.Join(kernelDir, filepath.FromSlash(file))); err != nil {
+			return err
+		}
+	}
 	configFile := filepath.Join(kernelDir, ".config")
 	if err := osutil.WriteFile(configFile, config); err != nil {
 		return fmt.Errorf("failed to write config file: %v", err)
@@ -46,7 +140,8 @@ func (linux) buildKernel(kernelDir, outputDir, compiler string, config []byte) e
 	// One would expect olddefconfig here, but olddefconfig is not present in v3.6 and below.
 	// oldconfig is the same as olddefconfig if stdin is not set.
 	// Note: passing in compiler is important since 4.17 (at the very least it's noted in the config).
-	cmd := osutil.Command("make", "oldconfig", "CC="+compiler)
+	makeArgs := linux.makeArgs(targetArch, compiler)
+	cmd := osutil.Command("make", append([]string{"oldconfig"}, makeArgs...)...)
 	if err := osutil.Sandbox(cmd, true, true); err != nil {
 		return err
 	}
@@ -54,9 +149,17 @@ func (linux) buildKernel(kernelDir, outputDir, compiler string, config []byte) e
 	if _, err := osutil.Run(10*time.Minute, cmd); err != nil {
 		return err
 	}
-	// We build only bzImage as we currently don't use modules.
+	finalConfig, err := ioutil.ReadFile(configFile)
+	if err != nil {
+		return err
+	}
+	if err := checkSanitizerConfig(config, finalConfig); err != nil {
+		return KernelBuildError{&osutil.VerboseError{Title: err.Error(), Output: finalConfig}}
+	}
+	// We build only the kernel image as we currently don't use modules.
 	cpu := strconv.Itoa(runtime.NumCPU())
-	cmd = osutil.Command("make", "bzImage", "-j", cpu, "CC="+compiler)
+	image := filepath.Base(linuxKernelImages[targetArch])
+	cmd = osutil.Command("make", append([]string{image, "-j", cpu}, makeArgs...)...)
 	if err := osutil.Sandbox(cmd, true, true); err != nil {
 		return err
 	}
@@ -76,40 +179,44 @@ func (linux) buildKernel(kernelDir, outputDir, compiler string, config []byte) e
 	return nil
 }
 
-func (linux) createImage(vmType, kernelDir, outputDir, userspaceDir, cmdlineFile, sysctlFile string) error {
-	tempDir, err := ioutil.TempDir("", "syz-build")
+func (linux) createImage(params *Params) error {
+	backend, err := getImageBackend(params.ImageBackend)
 	if err != nil {
 		return err
 	}
-	defer os.RemoveAll(tempDir)
-	scriptFile := filepath.Join(tempDir, "create.sh")
-	if err := osutil.WriteExecFile(scriptFile, []byte(createImageScript)); err != nil {
-		return fmt.Errorf("failed to write script file: %v", err)
-	}
-	bzImage := filepath.Join(kernelDir, filepath.FromSlash("arch/x86/boot/bzImage"))
-	cmd := osutil.Command(scriptFile, userspaceDir, bzImage)
-	cmd.Dir = tempDir
-	cmd.Env = append([]string{}, os.Environ()...)
-	cmd.Env = append(cmd.Env,
-		"SYZ_VM_TYPE="+vmType,
-		"SYZ_CMDLINE_FILE="+osutil.Abs(cmdlineFile),
-		"SYZ_SYSCTL_FILE="+osutil.Abs(sysctlFile),
-	)
-	if _, err = osutil.Run(time.Hour, cmd); err != nil {
-		return fmt.Errorf("image build failed: %v", err)
-	}
-	// Note: we use CopyFile instead of Rename because src and dst can be on different filesystems.
-	imageFile := filepath.Join(outputDir, "image")
-	if err := osutil.CopyFile(filepath.Join(tempDir, "disk.raw"), imageFile); err != nil {
+	if err := CheckRootStorage(params.RootStorage); err != nil {
 		return err
 	}
-	keyFile := filepath.Join(outputDir, "key")
-	if err := osutil.CopyFile(filepath.Join(tempDir, "key"), keyFile); err != nil {
+	if params.RootStorage != RootStoragePlain && !backend.bootable(params.TargetArch) {
+		// The devices are set up with the kernel command line from the image bootloader config.
+		return fmt.Errorf("root storage %q requires a bootable image (not supported for %v/%v)",
+			params.RootStorage, params.ImageBackend, params.TargetArch)
+	}
+	if err := CheckLSM(params.LSM); err != nil {
 		return err
 	}
-	if err := os.Chmod(keyFile, 0600); err != nil {
+	kernelImage := filepath.Join(params.KernelDir, filepath.FromSlash(linuxKernelImages[params.TargetArch]))
+	imgParams := &imageParams{
+		targetArch:   params.TargetArch,
+		vmType:       params.VMType,
+		kernelImage:  kernelImage,
+		outputDir:    params.OutputDir,
+		userspaceDir: params.UserspaceDir,
+		rootStorage:  params.RootStorage,
+		lsm:          params.LSM,
+		cmdlineFile:  params.CmdlineFile,
+		sysctlFile:   params.SysctlFile,
+	}
+	if err := backend.createImage(imgParams); err != nil {
 		return err
 	}
+	if !backend.bootable(params.TargetArch) {
+		// The image is not bootable, the kernel is injected with qemu -kernel (see instance.SetConfigImage).
+		// Note: CmdlineFile is not used in this case, the command line is taken from qemu config.
+		if err := osutil.CopyFile(kernelImage, filepath.Join(params.OutputDir, "kernel")); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -147,6 +254,15 @@ func extractRootCause(err error) error {
 	return KernelBuildError{verr}
 }
 
+func isBuildFailureCause(line []byte) bool {
+	for _, pattern := range buildFailureCauses {
+		if bytes.Contains(line, pattern.pattern) {
+			return true
+		}
+	}
+	return false
+}
+
 type buildFailureCause struct {
 	pattern []byte
 	weak    bool
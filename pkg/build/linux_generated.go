@@ -15,7 +15,8 @@ if [ ! -e $1/sbin/init ]; then
 	exit 1
 fi
 
-if [ "$(basename $2)" != "bzImage" ]; then
+SYZ_KERNEL_ARCH="${SYZ_KERNEL_ARCH:-x86_64}"
+if [ "$SYZ_KERNEL_ARCH" == "x86_64" ] && [ "$(basename $2)" != "bzImage" ]; then
 	echo "usage: create-gce-image.sh /dir/with/user/space/system /path/to/bzImage"
 	exit 1
 fi
@@ -24,20 +25,44 @@ SYZ_VM_TYPE="${SYZ_VM_TYPE:-qemu}"
 if [ "$SYZ_VM_TYPE" == "qemu" ]; then
 	:
 elif [ "$SYZ_VM_TYPE" == "gce" ]; then
-	:
+	if [ "$SYZ_KERNEL_ARCH" != "x86_64" ]; then
+		echo "SYZ_VM_TYPE=gce is not supported for SYZ_KERNEL_ARCH=$SYZ_KERNEL_ARCH"
+		exit 1
+	fi
 else
 	echo "SYZ_VM_TYPE has unsupported value $SYZ_VM_TYPE"
 	exit 1
 fi
 
+SYZ_ROOT_STORAGE="${SYZ_ROOT_STORAGE:-}"
+if [ "$SYZ_ROOT_STORAGE" != "" ]; then
+	if [ "$SYZ_ROOT_STORAGE" != "crypt" ] && [ "$SYZ_ROOT_STORAGE" != "verity" ]; then
+		echo "SYZ_ROOT_STORAGE has unsupported value $SYZ_ROOT_STORAGE"
+		exit 1
+	fi
+	if [ "$SYZ_KERNEL_ARCH" != "x86_64" ]; then
+		echo "SYZ_ROOT_STORAGE=$SYZ_ROOT_STORAGE is not supported for SYZ_KERNEL_ARCH=$SYZ_KERNEL_ARCH"
+		exit 1
+	fi
+fi
+
+SYZ_LSM="${SYZ_LSM:-}"
+if [ "$SYZ_LSM" != "" ] && [ "$SYZ_LSM" != "selinux-permissive" ] && [ "$SYZ_LSM" != "selinux-enforcing" ] &&
+	[ "$SYZ_LSM" != "apparmor-permissive" ] && [ "$SYZ_LSM" != "apparmor-enforcing" ] && [ "$SYZ_LSM" != "landlock" ]; then
+	echo "SYZ_LSM has unsupported value $SYZ_LSM"
+	exit 1
+fi
+
 sudo umount disk.mnt || true
+sudo umount boot.mnt || true
+sudo dmsetup remove syzroot || true
 if [ "$SYZ_VM_TYPE" == "qemu" ]; then
 	:
 elif [ "$SYZ_VM_TYPE" == "gce" ]; then
 	sudo modprobe nbd
 	sudo qemu-nbd -d /dev/nbd0 || true
 fi
-rm -rf disk.mnt disk.raw || true
+rm -rf disk.mnt boot.mnt disk.raw || true
 
 fallocate -l 2G disk.raw
 if [ "$SYZ_VM_TYPE" == "qemu" ]; then
@@ -48,16 +73,46 @@ elif [ "$SYZ_VM_TYPE" == "gce" ]; then
 	sudo qemu-nbd -c $DISKDEV --format=raw disk.raw
 	CLEANUP="sudo qemu-nbd -d $DISKDEV; $CLEANUP"
 fi
-echo -en "o\nn\np\n1\n\n\na\nw\n" | sudo fdisk $DISKDEV
-PARTDEV=$DISKDEV"p1"
-until [ -e $PARTDEV ]; do sleep 1; done
-sudo -E mkfs.ext4 $PARTDEV
+BOOTDIR=disk.mnt/boot
+if [ "$SYZ_KERNEL_ARCH" == "x86_64" ] && [ "$SYZ_ROOT_STORAGE" != "" ]; then
+	echo -en "o\nn\np\n1\n\n+256M\na\nn\np\n2\n\n+1600M\nn\np\n3\n\n\nw\n" | sudo fdisk $DISKDEV
+	PARTDEV=$DISKDEV"p2"
+	HASHDEV=$DISKDEV"p3"
+	until [ -e $HASHDEV ]; do sleep 1; done
+	sudo -E mkfs.ext4 $DISKDEV"p1"
+	mkdir -p boot.mnt
+	CLEANUP="rm -rf boot.mnt; $CLEANUP"
+	sudo mount $DISKDEV"p1" boot.mnt
+	CLEANUP="sudo umount boot.mnt; $CLEANUP"
+	BOOTDIR=boot.mnt
+elif [ "$SYZ_KERNEL_ARCH" == "x86_64" ]; then
+	echo -en "o\nn\np\n1\n\n\na\nw\n" | sudo fdisk $DISKDEV
+	PARTDEV=$DISKDEV"p1"
+	until [ -e $PARTDEV ]; do sleep 1; done
+else
+	PARTDEV=$DISKDEV
+fi
+ROOTDEV=$PARTDEV
+ROOT_CMDLINE="root=/dev/sda1"
+if [ "$SYZ_ROOT_STORAGE" == "crypt" ]; then
+	CRYPT_KEY=$(head -c 64 /dev/urandom | od -An -tx1 | tr -d ' \n')
+	ROOT_SECTORS=$(sudo blockdev --getsz $PARTDEV)
+	sudo dmsetup create syzroot --table "0 $ROOT_SECTORS crypt aes-xts-plain64 $CRYPT_KEY 0 $PARTDEV 0"
+	CLEANUP="sudo dmsetup remove syzroot; $CLEANUP"
+	ROOTDEV=/dev/mapper/syzroot
+	ROOT_CMDLINE="dm-mod.waitfor=/dev/sda2 'dm-mod.create=\"syzroot,,,rw,0 $ROOT_SECTORS crypt aes-xts-plain64 $CRYPT_KEY 0 /dev/sda2 0\"' root=/dev/dm-0"
+fi
+sudo -E mkfs.ext4 $ROOTDEV
 mkdir -p disk.mnt
 CLEANUP="rm -rf disk.mnt; $CLEANUP"
-sudo mount $PARTDEV disk.mnt
+sudo mount $ROOTDEV disk.mnt
 CLEANUP="sudo umount disk.mnt; $CLEANUP"
 sudo cp -a $1/. disk.mnt/.
-sudo cp $2 disk.mnt/vmlinuz
+if [ "$SYZ_ROOT_STORAGE" == "" ]; then
+	sudo cp $2 disk.mnt/vmlinuz
+else
+	sudo cp $2 boot.mnt/vmlinuz
+fi
 sudo sed -i "/^root/ { s/:x:/::/ }" disk.mnt/etc/passwd
 echo "T0:23:respawn:/sbin/getty -L ttyS0 115200 vt100" | sudo tee -a disk.mnt/etc/inittab
 echo -en "auto lo\niface lo inet loopback\nauto eth0\niface eth0 inet dhcp\n" | sudo tee disk.mnt/etc/network/interfaces
@@ -67,7 +122,29 @@ for i in {0..31}; do
 	echo "KERNEL==\"binder$i\", NAME=\"binder$i\", MODE=\"0666\"" | \
 		sudo tee -a disk.mnt/etc/udev/50-binder.rules
 done
-echo 'SELINUX=disabled' | sudo tee disk.mnt/etc/selinux/config
+if [ "$SYZ_LSM" == "selinux-permissive" ] || [ "$SYZ_LSM" == "selinux-enforcing" ]; then
+	if [ ! -e disk.mnt/sbin/setfiles ] || [ ! -d disk.mnt/etc/selinux/default ]; then
+		echo "SYZ_LSM=$SYZ_LSM requires selinux tools and the default policy in the userspace system"
+		exit 1
+	fi
+	SELINUX_MODE=permissive
+	if [ "$SYZ_LSM" == "selinux-enforcing" ]; then
+		SELINUX_MODE=enforcing
+	fi
+	echo -en "SELINUX=$SELINUX_MODE\nSELINUXTYPE=default\n" | sudo tee disk.mnt/etc/selinux/config
+	sudo chroot disk.mnt setfiles /etc/selinux/default/contexts/files/file_contexts /
+else
+	echo 'SELINUX=disabled' | sudo tee disk.mnt/etc/selinux/config
+fi
+if [ "$SYZ_LSM" == "apparmor-permissive" ] || [ "$SYZ_LSM" == "apparmor-enforcing" ]; then
+	if [ ! -e disk.mnt/sbin/apparmor_parser ]; then
+		echo "SYZ_LSM=$SYZ_LSM requires apparmor in the userspace system"
+		exit 1
+	fi
+	if [ "$SYZ_LSM" == "apparmor-permissive" ]; then
+		echo "complain" | sudo tee -a disk.mnt/etc/apparmor/parser.conf
+	fi
+fi
 
 echo "kernel.printk = 7 4 1 3" | sudo tee -a disk.mnt/etc/sysctl.conf
 echo "debug.exception-trace = 0" | sudo tee -a disk.mnt/etc/sysctl.conf
@@ -85,15 +162,34 @@ ssh-keygen -f key -t rsa -N ""
 sudo mkdir -p disk.mnt/root/.ssh
 sudo cp key.pub disk.mnt/root/.ssh/authorized_keys
 sudo chown root disk.mnt/root/.ssh/authorized_keys
-sudo mkdir -p disk.mnt/boot/grub
-
-CMDLINE=""
-SYZ_CMDLINE_FILE="${SYZ_CMDLINE_FILE:-}"
-if [ "$SYZ_CMDLINE_FILE" != "" ]; then
-	CMDLINE=$(awk '{printf("%s ", $0)}' $SYZ_CMDLINE_FILE)
+if [ "$SYZ_ROOT_STORAGE" == "verity" ]; then
+	printf '\043!/bin/sh\n' | sudo tee disk.mnt/sbin/overlay-init
+	cat << EOF | sudo tee -a disk.mnt/sbin/overlay-init
+mount -t tmpfs tmpfs /mnt
+mkdir -p /mnt/upper /mnt/work /mnt/root
+mount -t overlay overlay -o lowerdir=/,upperdir=/mnt/upper,workdir=/mnt/work /mnt/root
+cd /mnt/root
+pivot_root . mnt
+exec chroot . /sbin/init
+EOF
+	sudo chmod +x disk.mnt/sbin/overlay-init
+	sudo mount -o remount,ro disk.mnt
+	VERITY_INFO=$(sudo veritysetup format $PARTDEV $HASHDEV)
+	VERITY_BLOCKS=$(echo "$VERITY_INFO" | awk '/^Data blocks:/ {print $3}')
+	VERITY_SALT=$(echo "$VERITY_INFO" | awk '/^Salt:/ {print $2}')
+	VERITY_HASH=$(echo "$VERITY_INFO" | awk '/^Root hash:/ {print $3}')
+	ROOT_CMDLINE="dm-mod.waitfor=/dev/sda2,/dev/sda3 'dm-mod.create=\"syzroot,,,ro,0 $((VERITY_BLOCKS * 8)) verity 1 /dev/sda2 /dev/sda3 4096 4096 $VERITY_BLOCKS 1 sha256 $VERITY_HASH $VERITY_SALT\"' root=/dev/dm-0 ro init=/sbin/overlay-init"
 fi
+if [ "$SYZ_KERNEL_ARCH" == "x86_64" ]; then
+	sudo mkdir -p $BOOTDIR/grub
+
+	CMDLINE=""
+	SYZ_CMDLINE_FILE="${SYZ_CMDLINE_FILE:-}"
+	if [ "$SYZ_CMDLINE_FILE" != "" ]; then
+		CMDLINE=$(awk '{printf("%s ", $0)}' $SYZ_CMDLINE_FILE)
+	fi
 
-cat << EOF | sudo tee disk.mnt/boot/grub/grub.cfg
+	cat << EOF | sudo tee $BOOTDIR/grub/grub.cfg
 terminal_input console
 terminal_output console
 set timeout=0
@@ -106,8 +202,10 @@ menuentry 'linux' --class gnu-linux --class gnu --class os {
 	insmod part_msdos
 	insmod ext2
 	set root='(hd0,1)'
-	linux /vmlinuz root=/dev/sda1 console=ttyS0 earlyprintk=serial vsyscall=native rodata=n ftrace_dump_on_oops=orig_cpu oops=panic panic_on_warn=1 nmi_watchdog=panic panic=86400 $CMDLINE
+	linux /vmlinuz $ROOT_CMDLINE console=ttyS0 earlyprintk=serial vsyscall=native rodata=n ftrace_dump_on_oops=orig_cpu oops=panic panic_on_warn=1 nmi_watchdog=panic panic=86400 $CMDLINE
 }
 EOF
-sudo grub-install --target=i386-pc --boot-directory=disk.mnt/boot --no-floppy $DISKDEV
+	sudo grub-install --target=i386-pc --boot-directory=$BOOTDIR --no-floppy $DISKDEV
+fi
 `
+
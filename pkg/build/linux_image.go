@@ -0,0 +1,187 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package build
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/google/syzkaller/pkg/osutil"
+	"github.com/google/syzkaller/sys/targets"
+)
+
+// Names of the image backends for Image.
+const (
+	ImageBackendDebootstrap = "debootstrap"
+	ImageBackendBuildroot   = "buildroot"
+)
+
+// imageArtifacts are optional files that image backends can create in addition to the image and key
+// for boot configurations that need more than a disk image (e.g. arm64 boot with UEFI firmware
+// and a device tree). They are stored in the output dir under the same names (see Image).
+var imageArtifacts = []string{"initrd", "dtb", "firmware", "modules.tar"}
+
+// imageBackend creates a linux disk image from the userspace system and the kernel.
+// The image is stored in outputDir/image, ssh key (optional) in outputDir/key.
+type imageBackend interface {
+	createImage(params *imageParams) error
+	// bootable says if the kernel boots from the image,
+	// otherwise it's injected with qemu -kernel.
+	bootable(targetArch string) bool
+}
+
+type imageParams struct {
+	targetArch   string
+	vmType       string
+	kernelImage  string
+	outputDir    string
+	userspaceDir string
+	rootStorage  string
+	lsm          string
+	cmdlineFile  string
+	sysctlFile   string
+}
+
+// CheckImageBackend checks that the image backend passed to Image is valid.
+// Supported backends are:
+//  - "" or "debootstrap": userspace is a root filesystem created with debootstrap
+//    (e.g. by tools/create-image.sh), the image is created with tools/create-gce-image.sh
+//  - "buildroot": userspace is buildroot output/images dir with rootfs.ext4
+//    and optionally ssh key for the image in key file
+//  - absolute path to an executable with the same interface as tools/create-gce-image.sh,
+//    the script can also create imageArtifacts files
+//    (the script gets root storage in SYZ_ROOT_STORAGE env var and LSM profile in SYZ_LSM
+//    env var and needs to reject unsupported values)
+func CheckImageBackend(name string) error {
+	_, err := getImageBackend(name)
+	return err
+}
+
+func getImageBackend(name string) (imageBackend, error) {
+	switch {
+	case name == "" || name == ImageBackendDebootstrap:
+		return debootstrapImage{}, nil
+	case name == ImageBackendBuildroot:
+		return buildrootImage{}, nil
+	case filepath.IsAbs(name):
+		if !osutil.IsExist(name) {
+			return nil, fmt.Errorf("image script %v does not exist", name)
+		}
+		return scriptImage{name}, nil
+	default:
+		return nil, fmt.Errorf("unknown image backend %q", name)
+	}
+}
+
+type debootstrapImage struct{}
+
+func (debootstrapImage) createImage(params *imageParams) error {
+	tempDir, err := ioutil.TempDir("", "syz-build")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tempDir)
+	scriptFile := filepath.Join(tempDir, "create.sh")
+	if err := osutil.WriteExecFile(scriptFile, []byte(createImageScript)); err != nil {
+		return fmt.Errorf("failed to write script file: %v", err)
+	}
+	return runImageScript(scriptFile, params)
+}
+
+func (debootstrapImage) bootable(targetArch string) bool {
+	return targetArch == "amd64"
+}
+
+// scriptImage is a user-supplied replacement for tools/create-gce-image.sh.
+type scriptImage struct {
+	script string
+}
+
+func (script scriptImage) createImage(params *imageParams) error {
+	return runImageScript(script.script, params)
+}
+
+func (scriptImage) bootable(targetArch string) bool {
+	return targetArch == "amd64"
+}
+
+// runImageScript runs script with the tools/create-gce-image.sh interface:
+// it accepts userspace dir and kernel image as arguments, parameters in SYZ_* env vars
+// and creates disk.raw and key files and optionally imageArtifacts files in the current dir.
+func runImageScript(script string, params *imageParams) error {
+	tempDir, err := ioutil.TempDir("", "syz-image")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tempDir)
+	cmd := osutil.Command(script, params.userspaceDir, params.kernelImage)
+	cmd.Dir = tempDir
+	cmd.Env = append([]string{}, os.Environ()...)
+	cmd.Env = append(cmd.Env,
+		"SYZ_VM_TYPE="+params.vmType,
+		"SYZ_KERNEL_ARCH="+targets.Get("linux", params.targetArch).KernelArch,
+		"SYZ_ROOT_STORAGE="+params.rootStorage,
+		"SYZ_LSM="+params.lsm,
+		"SYZ_CMDLINE_FILE="+osutil.Abs(params.cmdlineFile),
+		"SYZ_SYSCTL_FILE="+osutil.Abs(params.sysctlFile),
+	)
+	if _, err = osutil.Run(time.Hour, cmd); err != nil {
+		return fmt.Errorf("image build failed: %v", err)
+	}
+	// Note: we use CopyFile instead of Rename because src and dst can be on different filesystems.
+	if err := osutil.CopyFile(filepath.Join(tempDir, "disk.raw"), filepath.Join(params.outputDir, "image")); err != nil {
+		return err
+	}
+	for _, name := range imageArtifacts {
+		src := filepath.Join(tempDir, name)
+		if !osutil.IsExist(src) {
+			continue
+		}
+		if err := osutil.CopyFile(src, filepath.Join(params.outputDir, name)); err != nil {
+			return err
+		}
+	}
+	return copyImageKey(filepath.Join(tempDir, "key"), params.outputDir)
+}
+
+// buildrootImage uses rootfs image built by buildroot as is,
+// the kernel is always injected with qemu -kernel.
+type buildrootImage struct{}
+
+func (buildrootImage) createImage(params *imageParams) error {
+	if params.vmType != "qemu" {
+		return fmt.Errorf("buildroot images are supported only for qemu, not %v", params.vmType)
+	}
+	if params.sysctlFile != "" {
+		return fmt.Errorf("sysctl file is not supported for buildroot images, add it to the rootfs overlay")
+	}
+	if params.lsm != LSMNone && params.lsm != LSMLandlock {
+		// Landlock does not need a system policy, others need to be provisioned in the image.
+		return fmt.Errorf("LSM profile %v is not supported for buildroot images,"+
+			" add the policy to the rootfs overlay", params.lsm)
+	}
+	rootfs := filepath.Join(params.userspaceDir, "rootfs.ext4")
+	if err := osutil.CopyFile(rootfs, filepath.Join(params.outputDir, "image")); err != nil {
+		return err
+	}
+	if key := filepath.Join(params.userspaceDir, "key"); osutil.IsExist(key) {
+		return copyImageKey(key, params.outputDir)
+	}
+	return nil
+}
+
+func (buildrootImage) bootable(targetArch string) bool {
+	return false
+}
+
+func copyImageKey(key, outputDir string) error {
+	keyFile := filepath.Join(outputDir, "key")
+	if err := osutil.CopyFile(key, keyFile); err != nil {
+		return err
+	}
+	return os.Chmod(keyFile, 0600)
+}
@@ -0,0 +1,96 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package build
+
+import (
+	"fmt"
+	"sort"
+)
+
+// LSM profiles for Image.
+// Security module code paths (hooks, policy loading, label management) are exercised only
+// when the module is active and has a policy, so kernels and images can be built with one
+// of the profiles:
+//  - selinux-permissive/selinux-enforcing: SELinux with the image default policy
+//    (the image userspace needs selinux-policy-default, policycoreutils), the image root
+//    filesystem is labeled during the image build
+//  - apparmor-permissive/apparmor-enforcing: AppArmor with the image profiles
+//    (the image userspace needs apparmor and apparmor-profiles), in the permissive mode
+//    all profiles are loaded in complain mode
+//  - landlock: Landlock, there is no system policy, rulesets are created by the fuzzer
+// The kernel needs LSMConfig options, the image is provisioned by the image backend
+// (the debootstrap backend and image scripts get the profile in SYZ_LSM env var).
+// Empty profile means the kernel config and image are used as is.
+const (
+	LSMNone               = ""
+	LSMSELinuxPermissive  = "selinux-permissive"
+	LSMSELinuxEnforcing   = "selinux-enforcing"
+	LSMAppArmorPermissive = "apparmor-permissive"
+	LSMAppArmorEnforcing  = "apparmor-enforcing"
+	LSMLandlock           = "landlock"
+)
+
+const selinuxConfig = `
+# SELinux (see LSMConfig).
+CONFIG_AUDIT=y
+CONFIG_SECURITY=y
+CONFIG_SECURITY_NETWORK=y
+CONFIG_SECURITY_SELINUX=y
+CONFIG_SECURITY_SELINUX_BOOTPARAM=y
+CONFIG_SECURITY_SELINUX_DEVELOP=y
+CONFIG_DEFAULT_SECURITY_SELINUX=y
+CONFIG_LSM="landlock,lockdown,yama,integrity,selinux,bpf"
+`
+
+const apparmorConfig = `
+# AppArmor (see LSMConfig).
+CONFIG_AUDIT=y
+CONFIG_SECURITY=y
+CONFIG_SECURITY_NETWORK=y
+CONFIG_SECURITY_APPARMOR=y
+CONFIG_DEFAULT_SECURITY_APPARMOR=y
+CONFIG_LSM="landlock,lockdown,yama,integrity,apparmor,bpf"
+`
+
+var lsmConfigs = map[string]string{
+	LSMNone:               "",
+	LSMSELinuxPermissive:  selinuxConfig,
+	LSMSELinuxEnforcing:   selinuxConfig,
+	LSMAppArmorPermissive: apparmorConfig,
+	LSMAppArmorEnforcing:  apparmorConfig,
+	LSMLandlock: `
+# Landlock (see LSMConfig).
+CONFIG_SECURITY=y
+CONFIG_SECURITY_LANDLOCK=y
+CONFIG_LSM="landlock,lockdown,yama,integrity,bpf"
+`,
+}
+
+// CheckLSM checks that the LSM profile passed to Image is valid.
+func CheckLSM(profile string) error {
+	if _, ok := lsmConfigs[profile]; !ok {
+		return fmt.Errorf("unknown LSM profile %q, supported: %q", profile, lsmNames())
+	}
+	return nil
+}
+
+func lsmNames() []string {
+	var names []string
+	for name := range lsmConfigs {
+		if name != LSMNone {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// LSMConfig returns kernel config with the security module of the profile enabled
+// (and made the only major LSM) on top of config.
+func LSMConfig(config []byte, profile string) ([]byte, error) {
+	if err := CheckLSM(profile); err != nil {
+		return nil, err
+	}
+	return appendConfig(config, lsmConfigs[profile]), nil
+}
@@ -0,0 +1,68 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package build
+
+import (
+	"fmt"
+)
+
+// Root storage configurations for Image.
+// Some storage stack code (device mapper targets, crypto) is exercised only when the root
+// filesystem is on top of it, so images can be created with the root filesystem
+// on a dm-crypt or dm-verity device:
+//  - RootStorageCrypt: the root partition is encrypted with dm-crypt (aes-xts-plain64)
+//    with a random key generated for every image build
+//  - RootStorageVerity: the root partition is verified with dm-verity (sha256) against
+//    the root hash computed during the image build, the read-only root is overlayed
+//    with tmpfs at boot (the VM needs writable root to run fuzzer binaries)
+// The devices are set up by the kernel itself with dm-mod.create command line argument
+// (no initramfs is required), the keys and hashes are baked into the kernel command line
+// in the image bootloader config. For this reason these configurations are supported only
+// for bootable (x86_64) images, the kernel also needs RootStorageConfig options.
+const (
+	RootStoragePlain  = ""
+	RootStorageCrypt  = "crypt"
+	RootStorageVerity = "verity"
+)
+
+var rootStorageConfigs = map[string]string{
+	RootStoragePlain: "",
+	RootStorageCrypt: `
+# Root filesystem on dm-crypt (see RootStorageConfig).
+CONFIG_MD=y
+CONFIG_BLK_DEV_DM=y
+CONFIG_DM_INIT=y
+CONFIG_DM_CRYPT=y
+CONFIG_CRYPTO_AES=y
+CONFIG_CRYPTO_XTS=y
+`,
+	RootStorageVerity: `
+# Root filesystem on dm-verity (see RootStorageConfig).
+CONFIG_MD=y
+CONFIG_BLK_DEV_DM=y
+CONFIG_DM_INIT=y
+CONFIG_DM_VERITY=y
+CONFIG_CRYPTO_SHA256=y
+CONFIG_OVERLAY_FS=y
+CONFIG_TMPFS=y
+`,
+}
+
+// CheckRootStorage checks that the root storage passed to Image is valid.
+func CheckRootStorage(storage string) error {
+	if _, ok := rootStorageConfigs[storage]; !ok {
+		return fmt.Errorf("unknown root storage %q, supported: %q, %q",
+			storage, RootStorageCrypt, RootStorageVerity)
+	}
+	return nil
+}
+
+// RootStorageConfig returns kernel config with options required to boot from the root storage
+// enabled on top of config.
+func RootStorageConfig(config []byte, storage string) ([]byte, error) {
+	if err := CheckRootStorage(storage); err != nil {
+		return nil, err
+	}
+	return appendConfig(config, rootStorageConfigs[storage]), nil
+}
@@ -0,0 +1,111 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package build
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"sort"
+)
+
+// sanitizerConfigs are kernel config fragments that enable the corresponding sanitizers.
+// All CONFIG_FOO=y options of a fragment must be enabled in the final kernel config
+// (after oldconfig), otherwise the build fails (e.g. the compiler does not support the sanitizer).
+var sanitizerConfigs = map[string]string{
+	"kasan": `
+CONFIG_KASAN=y
+CONFIG_KASAN_INLINE=y
+# CONFIG_KMSAN is not set
+# CONFIG_KCSAN is not set
+`,
+	"kmsan": `
+CONFIG_KMSAN=y
+# CONFIG_KASAN is not set
+# CONFIG_KCSAN is not set
+`,
+	"kcsan": `
+CONFIG_KCSAN=y
+# CONFIG_KASAN is not set
+# CONFIG_KMSAN is not set
+`,
+	"ubsan": `
+CONFIG_UBSAN=y
+`,
+}
+
+// Sanitizers that can't be enabled in the same kernel.
+var incompatibleSanitizers = [][2]string{
+	{"kasan", "kmsan"},
+	{"kasan", "kcsan"},
+	{"kmsan", "kcsan"},
+}
+
+// CheckSanitizers checks that the sanitizers are known and can be enabled together.
+func CheckSanitizers(sanitizers []string) error {
+	enabled := make(map[string]bool)
+	for _, name := range sanitizers {
+		if _, ok := sanitizerConfigs[name]; !ok {
+			return fmt.Errorf("unknown sanitizer %q, supported: %v", name, sanitizerNames())
+		}
+		if enabled[name] {
+			return fmt.Errorf("duplicate sanitizer %q", name)
+		}
+		enabled[name] = true
+	}
+	for _, pair := range incompatibleSanitizers {
+		if enabled[pair[0]] && enabled[pair[1]] {
+			return fmt.Errorf("sanitizers %v and %v can't be enabled together", pair[0], pair[1])
+		}
+	}
+	return nil
+}
+
+func sanitizerNames() []string {
+	var names []string
+	for name := range sanitizerConfigs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// SanitizerConfig returns kernel config with the sanitizers enabled on top of config.
+// The fragments are marked with "# Sanitizer: name" comments, buildKernel uses them
+// to check that the sanitizers are actually enabled after oldconfig.
+func SanitizerConfig(config []byte, sanitizers []string) ([]byte, error) {
+	if err := CheckSanitizers(sanitizers); err != nil {
+		return nil, err
+	}
+	var fragments []string
+	for _, name := range sanitizers {
+		fragments = append(fragments, fmt.Sprintf("\n# Sanitizer: %v%v", name, sanitizerConfigs[name]))
+	}
+	return appendConfig(config, fragments...), nil
+}
+
+var (
+	sanitizerMarkerRe = regexp.MustCompile(`(?m)^# Sanitizer: ([a-z]+)$`)
+	enabledOptionRe   = regexp.MustCompile(`(?m)^(CONFIG_[A-Z0-9_]+)=y$`)
+)
+
+// checkSanitizerConfig checks that all sanitizers requested in config (see SanitizerConfig)
+// are enabled in the final kernel config.
+func checkSanitizerConfig(config, finalConfig []byte) error {
+	for _, match := range sanitizerMarkerRe.FindAllSubmatch(config, -1) {
+		name := string(match[1])
+		fragment, ok := sanitizerConfigs[name]
+		if !ok {
+			return fmt.Errorf("unknown sanitizer %q", name)
+		}
+		for _, opt := range enabledOptionRe.FindAllStringSubmatch(fragment, -1) {
+			if !bytes.Contains(finalConfig, []byte("\n"+opt[0]+"\n")) &&
+				!bytes.HasPrefix(finalConfig, []byte(opt[0]+"\n")) {
+				return fmt.Errorf("sanitizer %v: %v is not enabled after oldconfig"+
+					" (not supported by the kernel or compiler?)", name, opt[1])
+			}
+		}
+	}
+	return nil
+}
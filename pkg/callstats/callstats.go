@@ -0,0 +1,98 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+// Package callstats collects per-syscall latency histograms and errno distributions.
+// Fuzzers sample executed programs and send the stats to the manager, which aggregates
+// and shows them on the web UI. This allows to notice kernels where a subsystem silently
+// degrades after an update (e.g. all calls start returning EINVAL or become much slower).
+package callstats
+
+import (
+	"time"
+)
+
+// NumBuckets is the number of latency histogram buckets. Bucket 0 counts calls
+// that took less than 1us, bucket i counts calls that took [2^(i-1), 2^i) us,
+// the last bucket counts all calls that took longer.
+const NumBuckets = 24
+
+// Call is stats of a single syscall.
+type Call struct {
+	Count   uint64
+	Latency [NumBuckets]uint64
+	Errnos  map[int]uint64 // number of calls per errno, 0 is success
+}
+
+// Set is stats of all syscalls indexed by syscall name.
+type Set map[string]*Call
+
+// Add adds a single call execution to the set.
+func (set Set) Add(name string, errno int, duration time.Duration) {
+	c := set.call(name)
+	c.Count++
+	c.Latency[bucket(duration)]++
+	c.Errnos[errno]++
+}
+
+// Merge adds all stats from other to the set.
+func (set Set) Merge(other Set) {
+	for name, oc := range other {
+		c := set.call(name)
+		c.Count += oc.Count
+		for i, v := range oc.Latency {
+			c.Latency[i] += v
+		}
+		for errno, v := range oc.Errnos {
+			c.Errnos[errno] += v
+		}
+	}
+}
+
+func (set Set) call(name string) *Call {
+	c := set[name]
+	if c == nil {
+		c = &Call{Errnos: make(map[int]uint64)}
+		set[name] = c
+	}
+	return c
+}
+
+func bucket(duration time.Duration) int {
+	us := uint64(duration / time.Microsecond)
+	b := 0
+	for us != 0 && b < NumBuckets-1 {
+		us >>= 1
+		b++
+	}
+	return b
+}
+
+// BucketLimit returns the upper bound of latencies counted in the bucket (0 for the last bucket).
+func BucketLimit(b int) time.Duration {
+	if b >= NumBuckets-1 {
+		return 0
+	}
+	return time.Duration(1<<uint(b)) * time.Microsecond
+}
+
+// Quantile returns the upper bound of latency of q fraction (0 < q <= 1) of calls.
+// Returns 0 if there are no calls or the quantile falls into the last (unbounded) bucket.
+func (c *Call) Quantile(q float64) time.Duration {
+	need := uint64(q * float64(c.Count))
+	if need == 0 {
+		need = 1
+	}
+	sum := uint64(0)
+	for b, v := range c.Latency {
+		sum += v
+		if sum >= need {
+			return BucketLimit(b)
+		}
+	}
+	return 0
+}
+
+// Errors returns number of calls that failed.
+func (c *Call) Errors() uint64 {
+	return c.Count - c.Errnos[0]
+}
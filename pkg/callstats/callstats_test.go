@@ -0,0 +1,60 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package callstats
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestBucket(t *testing.T) {
+	tests := []struct {
+		duration time.Duration
+		bucket   int
+	}{
+		{0, 0},
+		{999 * time.Nanosecond, 0},
+		{time.Microsecond, 1},
+		{2 * time.Microsecond, 2},
+		{3 * time.Microsecond, 2},
+		{4 * time.Microsecond, 3},
+		{time.Millisecond, 10},
+		{time.Hour, NumBuckets - 1},
+	}
+	for _, test := range tests {
+		b := bucket(test.duration)
+		if b != test.bucket {
+			t.Errorf("bucket(%v)=%v, want %v", test.duration, b, test.bucket)
+		}
+		if limit := BucketLimit(b); limit != 0 && test.duration >= limit {
+			t.Errorf("duration %v is above bucket %v limit %v", test.duration, b, limit)
+		}
+	}
+}
+
+func TestSet(t *testing.T) {
+	set1, set2 := make(Set), make(Set)
+	for i := 0; i < 90; i++ {
+		set1.Add("open", 0, 3*time.Microsecond)
+	}
+	for i := 0; i < 10; i++ {
+		set2.Add("open", 2, time.Millisecond)
+	}
+	set2.Add("read", 22, 0)
+	set1.Merge(set2)
+	open := set1["open"]
+	if open.Count != 100 || open.Errors() != 10 || !reflect.DeepEqual(open.Errnos, map[int]uint64{0: 90, 2: 10}) {
+		t.Fatalf("bad open stats: %+v", open)
+	}
+	if q := open.Quantile(0.5); q != 4*time.Microsecond {
+		t.Errorf("p50=%v, want 4us", q)
+	}
+	if q := open.Quantile(0.99); q != 1024*time.Microsecond {
+		t.Errorf("p99=%v, want 1024us", q)
+	}
+	if read := set1["read"]; read.Count != 1 || read.Errors() != 1 || read.Quantile(1) != time.Microsecond {
+		t.Fatalf("bad read stats: %+v", read)
+	}
+}
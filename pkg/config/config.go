@@ -86,7 +86,7 @@ func checkUnknownFieldsRec(data []byte, prefix string, typ reflect.Type) error {
 			return fmt.Errorf("unknown field '%v%v' in config", prefix, k)
 		}
 		if v != nil && field.Kind() == reflect.Slice &&
-			(field.PkgPath() != "encoding/json" || field.Name() != "RawMessage") {
+			field != reflect.TypeOf(json.RawMessage(nil)) {
 			vv := reflect.ValueOf(v)
 			if vv.Type().Kind() != reflect.Slice {
 				return fmt.Errorf("bad json array type '%v%v'", prefix, k)
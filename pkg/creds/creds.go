@@ -0,0 +1,151 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+// Package creds describes credential contexts that programs are executed in.
+// A context is written as "root" (full privileges) or as a '/'-separated list of:
+//
+//	uid=N        - switch to uid/gid N (all capabilities are dropped unless caps= is given)
+//	caps=a+b     - keep only the listed capabilities (names as in capabilities(7) without cap_ prefix)
+//	nnp          - set no_new_privs
+//
+// For example: "uid=65534", "uid=65534/caps=net_raw", "caps=net_admin+sys_admin", "uid=65534/nnp".
+package creds
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Creds is a credential context, zero value is full root privileges.
+type Creds struct {
+	UID        int    // uid/gid to switch to, 0 keeps root
+	DropCaps   uint64 // bitmask of capabilities to drop
+	NoNewPrivs bool
+}
+
+// Root is the default credential context with full privileges.
+const Root = "root"
+
+// capNames are capabilities in the order of their numbers (see linux/capability.h).
+var capNames = []string{
+	"chown",
+	"dac_override",
+	"dac_read_search",
+	"fowner",
+	"fsetid",
+	"kill",
+	"setgid",
+	"setuid",
+	"setpcap",
+	"linux_immutable",
+	"net_bind_service",
+	"net_broadcast",
+	"net_admin",
+	"net_raw",
+	"ipc_lock",
+	"ipc_owner",
+	"sys_module",
+	"sys_rawio",
+	"sys_chroot",
+	"sys_ptrace",
+	"sys_pacct",
+	"sys_admin",
+	"sys_boot",
+	"sys_nice",
+	"sys_resource",
+	"sys_time",
+	"sys_tty_config",
+	"mknod",
+	"lease",
+	"audit_write",
+	"audit_control",
+	"setfcap",
+	"mac_override",
+	"mac_admin",
+	"syslog",
+	"wake_alarm",
+	"block_suspend",
+	"audit_read",
+}
+
+// AllCaps is the bitmask of all capabilities, including ones that are newer than capNames.
+const AllCaps = ^uint64(0)
+
+// Parse parses a credential context in the format described in the package comment.
+func Parse(s string) (Creds, error) {
+	var c Creds
+	if s == "" || s == Root {
+		return c, nil
+	}
+	hasCaps := false
+	for _, part := range strings.Split(s, "/") {
+		switch {
+		case part == "nnp":
+			c.NoNewPrivs = true
+		case strings.HasPrefix(part, "uid="):
+			uid, err := strconv.ParseUint(part[len("uid="):], 10, 31)
+			if err != nil {
+				return c, fmt.Errorf("bad uid in credential context %q: %v", s, err)
+			}
+			c.UID = int(uid)
+		case strings.HasPrefix(part, "caps="):
+			hasCaps = true
+			keep := uint64(0)
+			if names := part[len("caps="):]; names != "" {
+				for _, name := range strings.Split(names, "+") {
+					cap := capNumber(name)
+					if cap == -1 {
+						return c, fmt.Errorf("unknown capability %q in credential context %q", name, s)
+					}
+					keep |= 1 << uint(cap)
+				}
+			}
+			c.DropCaps = AllCaps &^ keep
+		default:
+			return c, fmt.Errorf("bad credential context %q: unknown %q", s, part)
+		}
+	}
+	if c.UID != 0 && !hasCaps {
+		c.DropCaps = AllCaps
+	}
+	return c, nil
+}
+
+// String returns canonical representation of the context accepted by Parse.
+func (c Creds) String() string {
+	var parts []string
+	if c.UID != 0 {
+		parts = append(parts, fmt.Sprintf("uid=%v", c.UID))
+	}
+	if c.UID == 0 && c.DropCaps != 0 || c.UID != 0 && c.DropCaps != AllCaps {
+		var names []string
+		for i, name := range capNames {
+			if c.DropCaps&(1<<uint(i)) == 0 {
+				names = append(names, name)
+			}
+		}
+		parts = append(parts, "caps="+strings.Join(names, "+"))
+	}
+	if c.NoNewPrivs {
+		parts = append(parts, "nnp")
+	}
+	if len(parts) == 0 {
+		return Root
+	}
+	return strings.Join(parts, "/")
+}
+
+// IsRoot returns true for the default context with full privileges.
+func (c Creds) IsRoot() bool {
+	return c == Creds{}
+}
+
+func capNumber(name string) int {
+	for i, n := range capNames {
+		if n == name {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,47 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package creds
+
+import (
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		input  string
+		result Creds
+		str    string
+	}{
+		{"", Creds{}, "root"},
+		{"root", Creds{}, "root"},
+		{"uid=65534", Creds{UID: 65534, DropCaps: AllCaps}, "uid=65534"},
+		{"nnp", Creds{NoNewPrivs: true}, "nnp"},
+		{"uid=65534/nnp", Creds{UID: 65534, DropCaps: AllCaps, NoNewPrivs: true}, "uid=65534/nnp"},
+		{"uid=1000/caps=net_raw", Creds{UID: 1000, DropCaps: AllCaps &^ (1 << 13)}, "uid=1000/caps=net_raw"},
+		{"caps=sys_admin+net_admin", Creds{DropCaps: AllCaps &^ (1<<12 | 1<<21)}, "caps=net_admin+sys_admin"},
+		{"caps=", Creds{DropCaps: AllCaps}, "caps="},
+		{"uid=0/caps=chown+audit_read", Creds{DropCaps: AllCaps &^ (1<<0 | 1<<37)}, "caps=chown+audit_read"},
+	}
+	for _, test := range tests {
+		c, err := Parse(test.input)
+		if err != nil {
+			t.Errorf("failed to parse %q: %v", test.input, err)
+			continue
+		}
+		if c != test.result {
+			t.Errorf("parsed %q as %+v, want %+v", test.input, c, test.result)
+		}
+		if str := c.String(); str != test.str {
+			t.Errorf("%q is printed as %q, want %q", test.input, str, test.str)
+		}
+		if c1, err := Parse(c.String()); err != nil || c1 != c {
+			t.Errorf("%q does not round-trip: %+v, %v", test.input, c1, err)
+		}
+	}
+	for _, input := range []string{"foo", "uid=", "uid=-1", "uid=1/caps=foo", "caps=net_admin,sys_admin", "nnp/"} {
+		if _, err := Parse(input); err == nil {
+			t.Errorf("parsed bad context %q", input)
+		}
+	}
+}
@@ -0,0 +1,134 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+// Package crypt implements encryption of artifacts at rest (corpus, crash logs, reproducers).
+// Data is encrypted with AES-256-GCM, encrypted data is prefixed with a magic and a random nonce.
+// Data without the magic is treated as not encrypted, this allows to enable encryption
+// for existing workdirs. A nil *Key does not encrypt data.
+package crypt
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/google/syzkaller/pkg/osutil"
+)
+
+const KeySize = 32
+
+var (
+	magic = []byte("syzcrypt")
+
+	ErrNoKey = errors.New("data is encrypted, but no key is provided")
+)
+
+type Key struct {
+	aead cipher.AEAD
+}
+
+// NewKey creates a key from KeySize raw bytes.
+func NewKey(raw []byte) (*Key, error) {
+	if len(raw) != KeySize {
+		return nil, fmt.Errorf("bad key size %v, want %v", len(raw), KeySize)
+	}
+	block, err := aes.NewCipher(raw)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &Key{aead}, nil
+}
+
+// LoadKey loads a hex-encoded key either from file or from output of command
+// (e.g. a KMS client that decrypts a wrapped key). Returns nil key if both are empty.
+func LoadKey(file, command string) (*Key, error) {
+	var data []byte
+	var err error
+	switch {
+	case file != "" && command != "":
+		return nil, fmt.Errorf("both key file and key command are specified")
+	case file != "":
+		data, err = ioutil.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read key file: %v", err)
+		}
+	case command != "":
+		data, err = osutil.Run(time.Minute, exec.Command("sh", "-c", command))
+		if err != nil {
+			return nil, fmt.Errorf("failed to run key command: %v", err)
+		}
+	default:
+		return nil, nil
+	}
+	raw, err := hex.DecodeString(strings.TrimSpace(string(data)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode key: %v", err)
+	}
+	return NewKey(raw)
+}
+
+// IsEncrypted returns true if data was produced by Seal.
+func IsEncrypted(data []byte) bool {
+	return bytes.HasPrefix(data, magic)
+}
+
+// Seal encrypts data. If key is nil, returns data as is.
+func (key *Key) Seal(data []byte) []byte {
+	if key == nil {
+		return data
+	}
+	nonce := make([]byte, key.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		panic(fmt.Sprintf("failed to generate nonce: %v", err))
+	}
+	out := make([]byte, 0, len(magic)+len(nonce)+len(data)+key.aead.Overhead())
+	out = append(out, magic...)
+	out = append(out, nonce...)
+	return key.aead.Seal(out, nonce, data, magic)
+}
+
+// Open decrypts data produced by Seal. Data that is not encrypted is returned as is.
+func (key *Key) Open(data []byte) ([]byte, error) {
+	if !IsEncrypted(data) {
+		return data, nil
+	}
+	if key == nil {
+		return nil, ErrNoKey
+	}
+	data = data[len(magic):]
+	nonceSize := key.aead.NonceSize()
+	if len(data) < nonceSize {
+		return nil, fmt.Errorf("encrypted data is truncated")
+	}
+	plain, err := key.aead.Open(nil, data[:nonceSize], data[nonceSize:], magic)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt data: %v", err)
+	}
+	return plain, nil
+}
+
+// WriteFile encrypts data and writes it to file.
+func (key *Key) WriteFile(file string, data []byte) error {
+	return osutil.WriteFile(file, key.Seal(data))
+}
+
+// ReadFile reads file and decrypts its contents.
+func (key *Key) ReadFile(file string) ([]byte, error) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	return key.Open(data)
+}
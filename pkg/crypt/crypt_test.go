@@ -0,0 +1,94 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package crypt
+
+import (
+	"bytes"
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func testKey(t *testing.T, b byte) *Key {
+	key, err := NewKey(bytes.Repeat([]byte{b}, KeySize))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+func TestSealOpen(t *testing.T) {
+	key := testKey(t, 1)
+	for _, data := range [][]byte{nil, []byte("a"), bytes.Repeat([]byte("crash log\n"), 1000)} {
+		enc := key.Seal(data)
+		if !IsEncrypted(enc) {
+			t.Fatalf("sealed data is not encrypted")
+		}
+		if len(data) > 100 && bytes.Contains(enc, data) {
+			t.Fatalf("sealed data contains plain text")
+		}
+		dec, err := key.Open(enc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(dec, data) {
+			t.Fatalf("got %q, want %q", dec, data)
+		}
+		if _, err := testKey(t, 2).Open(enc); err == nil {
+			t.Fatalf("opened data with a wrong key")
+		}
+		if _, err := (*Key)(nil).Open(enc); err != ErrNoKey {
+			t.Fatalf("got %v for nil key, want %v", err, ErrNoKey)
+		}
+		if len(enc) > 0 {
+			enc[len(enc)-1] ^= 1
+			if _, err := key.Open(enc); err == nil {
+				t.Fatalf("opened corrupted data")
+			}
+		}
+	}
+	plain := []byte("not encrypted")
+	if dec, err := key.Open(plain); err != nil || !bytes.Equal(dec, plain) {
+		t.Fatalf("failed to open not encrypted data: %q, %v", dec, err)
+	}
+	if enc := (*Key)(nil).Seal(plain); !bytes.Equal(enc, plain) {
+		t.Fatalf("nil key encrypted data")
+	}
+}
+
+func TestLoadKey(t *testing.T) {
+	dir, err := ioutil.TempDir("", "syz-crypt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	keyFile := filepath.Join(dir, "key")
+	raw := bytes.Repeat([]byte{0xab}, KeySize)
+	if err := ioutil.WriteFile(keyFile, []byte(hex.EncodeToString(raw)+"\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	fromFile, err := LoadKey(keyFile, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	fromCommand, err := LoadKey("", "cat "+keyFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dec, err := fromCommand.Open(fromFile.Seal([]byte("data")))
+	if err != nil || string(dec) != "data" {
+		t.Fatalf("keys loaded from file and command differ: %q, %v", dec, err)
+	}
+	if key, err := LoadKey("", ""); key != nil || err != nil {
+		t.Fatalf("got key %v, err %v for empty config", key, err)
+	}
+	if _, err := LoadKey(keyFile, "cat "+keyFile); err == nil {
+		t.Fatalf("loaded key from both file and command")
+	}
+	if _, err := LoadKey("", "echo abcd"); err == nil {
+		t.Fatalf("loaded short key")
+	}
+}
@@ -86,6 +86,9 @@ func defineList(p, mmapProg *prog.Prog, opts Options) ([]string, error) {
 	if opts.Fault {
 		defines = append(defines, "SYZ_FAULT_INJECTION")
 	}
+	if opts.Creds != "" {
+		defines = append(defines, "SYZ_CREDS")
+	}
 	if opts.EnableTun {
 		defines = append(defines, "SYZ_TUN_ENABLE")
 	}
@@ -10,6 +10,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/google/syzkaller/pkg/creds"
 	"github.com/google/syzkaller/prog"
 	"github.com/google/syzkaller/sys/targets"
 )
@@ -211,6 +212,7 @@ func (ctx *context) generateTestFunc(calls []string, hasVars bool, name string)
 		if opts.Repro {
 			ctx.printf("\tif (write(1, \"executing program\\n\", strlen(\"executing program\\n\"))) {}\n")
 		}
+		ctx.writeSetupCreds()
 		for _, c := range calls {
 			ctx.printf("%s", c)
 		}
@@ -237,6 +239,7 @@ func (ctx *context) generateTestFunc(calls []string, hasVars bool, name string)
 		if opts.Repro {
 			ctx.printf("\tif (write(1, \"executing program\\n\", strlen(\"executing program\\n\"))) {}\n")
 		}
+		ctx.writeSetupCreds()
 		ctx.printf("\texecute(%v);\n", len(calls))
 		if opts.Collide {
 			ctx.printf("\tcollide = 1;\n")
@@ -246,6 +249,21 @@ func (ctx *context) generateTestFunc(calls []string, hasVars bool, name string)
 	}
 }
 
+func (ctx *context) writeSetupCreds() {
+	if ctx.opts.Creds == "" {
+		return
+	}
+	c, err := creds.Parse(ctx.opts.Creds)
+	if err != nil {
+		panic(fmt.Sprintf("bad creds in checked opts: %v", err))
+	}
+	nnp := 0
+	if c.NoNewPrivs {
+		nnp = 1
+	}
+	ctx.printf("\tsetup_creds(%v, 0x%xull, %v);\n", c.UID, c.DropCaps, nnp)
+}
+
 func (ctx *context) generateSyscallDefines() {
 	prefix := ctx.sysTarget.SyscallPrefix
 	for name, nr := range ctx.calls {
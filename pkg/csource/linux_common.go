@@ -60,6 +60,12 @@ var commonHeaderLinux = `
 #include <sys/mman.h>
 #include <sys/mount.h>
 #endif
+#if defined(SYZ_EXECUTOR) || defined(SYZ_CREDS)
+#include <errno.h>
+#include <grp.h>
+#include <linux/capability.h>
+#include <sys/prctl.h>
+#endif
 #if defined(SYZ_EXECUTOR) || defined(SYZ_TUN_ENABLE) || defined(SYZ_ENABLE_NETDEV)
 #include <arpa/inet.h>
 #include <errno.h>
@@ -134,8 +140,26 @@ var commonHeaderLinux = `
 #include <sys/types.h>
 #endif
 #if defined(SYZ_EXECUTOR) || defined(SYZ_ENABLE_CGROUPS)
+#include <dirent.h>
+#include <string.h>
 #include <sys/mount.h>
 #endif
+#if defined(SYZ_EXECUTOR) || defined(__NR_syz_sysctl)
+#include <errno.h>
+#include <fcntl.h>
+#include <stdbool.h>
+#include <string.h>
+#include <sys/mman.h>
+#include <sys/stat.h>
+#include <sys/types.h>
+#endif
+#if defined(SYZ_EXECUTOR) || defined(__NR_syz_io_uring_setup) || defined(__NR_syz_io_uring_submit) || defined(__NR_syz_io_uring_complete)
+#include <errno.h>
+#include <string.h>
+#include <sys/mman.h>
+#include <sys/syscall.h>
+#include <unistd.h>
+#endif
 #if defined(SYZ_EXECUTOR) || defined(__NR_syz_mount_image) || defined(__NR_syz_read_part_table)
 #include <errno.h>
 #include <fcntl.h>
@@ -485,6 +509,30 @@ static int tun_frags_enabled;
 extern bool flag_enable_tun;
 #endif
 
+#define VXLAN_PORT 4789
+#define GENEVE_PORT 6081
+#define WG_PORT 51820
+#define WG_LOCAL_PRIVATE_KEY "oKqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqmo="
+#define WG_REMOTE_PUBLIC_KEY "u7u7u7u7u7u7u7u7u7u7u7u7u7u7u7u7u7u7u7u7u7s="
+
+static void initialize_tunnels(void)
+{
+	execute_command(0, "ip link add syz_vxlan type vxlan id 0 local %s remote %s dev %s dstport %d",
+			LOCAL_IPV4, REMOTE_IPV4, TUN_IFACE, VXLAN_PORT);
+	execute_command(0, "ip link add syz_geneve type geneve id 0 remote %s dstport %d",
+			REMOTE_IPV4, GENEVE_PORT);
+	execute_command(0, "ip link add syz_wg type wireguard");
+	execute_command(0, "sh -c 'echo %s | wg set syz_wg listen-port %d private-key /dev/stdin'",
+			WG_LOCAL_PRIVATE_KEY, WG_PORT);
+	execute_command(0, "wg set syz_wg peer %s endpoint %s:%d allowed-ips 0.0.0.0/0",
+			WG_REMOTE_PUBLIC_KEY, REMOTE_IPV4, WG_PORT);
+	execute_command(0, "ip link set dev syz_vxlan address %s", LOCAL_MAC);
+	execute_command(0, "ip link set dev syz_geneve address %s", LOCAL_MAC);
+	execute_command(0, "ip link set dev syz_vxlan up");
+	execute_command(0, "ip link set dev syz_geneve up");
+	execute_command(0, "ip link set dev syz_wg up");
+}
+
 static void initialize_tun(void)
 {
 #ifdef SYZ_EXECUTOR
@@ -533,6 +581,7 @@ static void initialize_tun(void)
 	execute_command(0, "ip -6 neigh add %s lladdr %s dev %s nud permanent",
 			REMOTE_IPV6, REMOTE_MAC, TUN_IFACE);
 	execute_command(1, "ip link set dev %s up", TUN_IFACE);
+	initialize_tunnels();
 }
 #endif
 
@@ -1142,6 +1191,266 @@ error:
 }
 #endif
 
+#if defined(SYZ_EXECUTOR) || defined(__NR_syz_sysctl)
+#define SYZ_SYSCTL_DIR "/syzsysctl"
+#define SYZ_SYSCTL_MAX 16
+#define SYZ_SYSCTL_LEN 128
+
+struct sysctl_changes {
+	int count;
+	char name[SYZ_SYSCTL_MAX][SYZ_SYSCTL_LEN];
+};
+static struct sysctl_changes* sysctl_changes;
+
+static void sysctl_backup_file(char* buf, int size, const char* name)
+{
+	int pos = snprintf(buf, size, "%s/", SYZ_SYSCTL_DIR);
+	for (; *name && pos < size - 1; name++, pos++)
+		buf[pos] = *name == '/' ? '.' : *name;
+	buf[pos] = 0;
+}
+
+static bool sysctl_backup(const char* name, const char* file)
+{
+	char backup[256], tmp[300], val[SYZ_SYSCTL_LEN];
+	int fd, n;
+
+	sysctl_backup_file(backup, sizeof(backup), name);
+	if (access(backup, F_OK) == 0)
+		return true;
+	fd = open(file, O_RDONLY);
+	if (fd == -1)
+		return false;
+	n = read(fd, val, sizeof(val));
+	close(fd);
+	if (n <= 0 || n == sizeof(val))
+		return false;
+	mkdir(SYZ_SYSCTL_DIR, 0700);
+	snprintf(tmp, sizeof(tmp), "%s.%d", backup, getpid());
+	fd = open(tmp, O_WRONLY | O_CREAT | O_TRUNC, 0600);
+	if (fd == -1)
+		return false;
+	if (write(fd, val, n) != n) {
+		close(fd);
+		unlink(tmp);
+		return false;
+	}
+	close(fd);
+	if (link(tmp, backup) && errno != EEXIST) {
+		unlink(tmp);
+		return false;
+	}
+	unlink(tmp);
+	return true;
+}
+
+static uintptr_t syz_sysctl(uintptr_t a0, uintptr_t a1)
+{
+	char name[SYZ_SYSCTL_LEN], val[SYZ_SYSCTL_LEN], file[256];
+	int fd, i, len, res;
+
+	memset(name, 0, sizeof(name));
+	NONFAILING(strncpy(name, (char*)a0, sizeof(name) - 1));
+	memset(val, 0, sizeof(val));
+	NONFAILING(strncpy(val, (char*)a1, sizeof(val) - 1));
+	if (name[0] == 0 || name[0] == '/' || strstr(name, "..")) {
+		errno = EINVAL;
+		return -1;
+	}
+	snprintf(file, sizeof(file), "/proc/sys/%s", name);
+	if (sysctl_backup(name, file) && sysctl_changes) {
+		for (i = 0; i < sysctl_changes->count; i++) {
+			if (strcmp(sysctl_changes->name[i], name) == 0)
+				break;
+		}
+		if (i == sysctl_changes->count) {
+			if (i == SYZ_SYSCTL_MAX) {
+				errno = ENOSPC;
+				return -1;
+			}
+			strcpy(sysctl_changes->name[i], name);
+			sysctl_changes->count++;
+		}
+	}
+	fd = open(file, O_WRONLY);
+	if (fd == -1)
+		return -1;
+	len = strlen(val);
+	res = write(fd, val, len);
+	close(fd);
+	if (res != len)
+		return -1;
+	debug("syz_sysctl: %s=%s\n", name, val);
+	return 0;
+}
+#endif
+
+#if defined(SYZ_EXECUTOR) || defined(__NR_syz_io_uring_setup) || defined(__NR_syz_io_uring_submit) || defined(__NR_syz_io_uring_complete)
+#define SYZ_NR_io_uring_setup 425
+#define SYZ_NR_io_uring_enter 426
+#define SYZ_IORING_OFF_SQ_RING 0
+#define SYZ_IORING_OFF_CQ_RING 0x8000000ull
+#define SYZ_IORING_OFF_SQES 0x10000000ull
+#define SYZ_IORING_ENTER_GETEVENTS 1
+#define SYZ_IO_URING_SQE_SIZE 64
+#define SYZ_IO_URING_MAX 4
+
+struct io_uring_params_t {
+	uint32 sq_entries;
+	uint32 cq_entries;
+	uint32 flags;
+	uint32 sq_thread_cpu;
+	uint32 sq_thread_idle;
+	uint32 features;
+	uint32 wq_fd;
+	uint32 resv[3];
+	struct {
+		uint32 head;
+		uint32 tail;
+		uint32 ring_mask;
+		uint32 ring_entries;
+		uint32 flags;
+		uint32 dropped;
+		uint32 array;
+		uint32 resv1;
+		uint64 resv2;
+	} sq_off;
+	struct {
+		uint32 head;
+		uint32 tail;
+		uint32 ring_mask;
+		uint32 ring_entries;
+		uint32 overflow;
+		uint32 cqes;
+		uint64 resv[2];
+	} cq_off;
+};
+
+struct io_uring_cqe_t {
+	uint64 user_data;
+	int res;
+	uint32 flags;
+};
+
+struct io_uring_t {
+	int fd;
+	char* sq_ring;
+	char* cq_ring;
+	char* sqes;
+	struct io_uring_params_t params;
+};
+
+static struct io_uring_t io_urings[SYZ_IO_URING_MAX];
+
+static struct io_uring_t* io_uring_lookup(int fd)
+{
+	int i;
+
+	for (i = 0; i < SYZ_IO_URING_MAX; i++) {
+		if (io_urings[i].sq_ring && io_urings[i].fd == fd)
+			return &io_urings[i];
+	}
+	return 0;
+}
+
+static uintptr_t syz_io_uring_setup(uintptr_t a0, uintptr_t a1)
+{
+	struct io_uring_params_t params;
+	struct io_uring_t* ring;
+	int fd, i;
+
+	memset(&params, 0, sizeof(params));
+	NONFAILING(memcpy(&params, (void*)a1, sizeof(params)));
+	fd = syscall(SYZ_NR_io_uring_setup, (uint32)a0, &params);
+	if (fd == -1)
+		return -1;
+	NONFAILING(memcpy((void*)a1, &params, sizeof(params)));
+	ring = io_uring_lookup(fd);
+	for (i = 0; !ring && i < SYZ_IO_URING_MAX; i++) {
+		if (io_urings[i].sq_ring == 0)
+			ring = &io_urings[i];
+	}
+	if (!ring) {
+		debug("syz_io_uring_setup: too many rings\n");
+		return fd;
+	}
+	ring->sq_ring = (char*)mmap(0, params.sq_off.array + params.sq_entries * sizeof(uint32),
+				    PROT_READ | PROT_WRITE, MAP_SHARED | MAP_POPULATE, fd, SYZ_IORING_OFF_SQ_RING);
+	ring->cq_ring = (char*)mmap(0, params.cq_off.cqes + params.cq_entries * sizeof(struct io_uring_cqe_t),
+				    PROT_READ | PROT_WRITE, MAP_SHARED | MAP_POPULATE, fd, SYZ_IORING_OFF_CQ_RING);
+	ring->sqes = (char*)mmap(0, params.sq_entries * SYZ_IO_URING_SQE_SIZE,
+				 PROT_READ | PROT_WRITE, MAP_SHARED | MAP_POPULATE, fd, SYZ_IORING_OFF_SQES);
+	if (ring->sq_ring == MAP_FAILED || ring->cq_ring == MAP_FAILED || ring->sqes == MAP_FAILED) {
+		debug("syz_io_uring_setup: mmap failed (errno %d)\n", errno);
+		ring->sq_ring = 0;
+		close(fd);
+		return -1;
+	}
+	ring->fd = fd;
+	ring->params = params;
+	return fd;
+}
+
+static uintptr_t syz_io_uring_submit(uintptr_t a0, uintptr_t a1, uintptr_t a2, uintptr_t a3)
+{
+	struct io_uring_t* ring = io_uring_lookup(a0);
+	uint32 head, tail, mask, entries, idx, n, i, wait;
+	uint32* array;
+
+	if (!ring) {
+		errno = EBADF;
+		return -1;
+	}
+	head = __atomic_load_n((uint32*)(ring->sq_ring + ring->params.sq_off.head), __ATOMIC_ACQUIRE);
+	tail = *(uint32*)(ring->sq_ring + ring->params.sq_off.tail);
+	mask = *(uint32*)(ring->sq_ring + ring->params.sq_off.ring_mask);
+	entries = *(uint32*)(ring->sq_ring + ring->params.sq_off.ring_entries);
+	array = (uint32*)(ring->sq_ring + ring->params.sq_off.array);
+	n = a2;
+	if (n > entries - (tail - head)) {
+		errno = EBUSY;
+		return -1;
+	}
+	for (i = 0; i < n; i++) {
+		idx = (tail + i) & mask;
+		NONFAILING(memcpy(ring->sqes + idx * SYZ_IO_URING_SQE_SIZE, (char*)a1 + i * SYZ_IO_URING_SQE_SIZE, SYZ_IO_URING_SQE_SIZE));
+		array[idx] = idx;
+	}
+	__atomic_store_n((uint32*)(ring->sq_ring + ring->params.sq_off.tail), tail + n, __ATOMIC_RELEASE);
+	wait = a3 < n ? a3 : n;
+	return syscall(SYZ_NR_io_uring_enter, ring->fd, n, wait, wait ? SYZ_IORING_ENTER_GETEVENTS : 0, 0, 0);
+}
+
+static uintptr_t syz_io_uring_complete(uintptr_t a0)
+{
+	struct io_uring_t* ring = io_uring_lookup(a0);
+	struct io_uring_cqe_t* cqe;
+	uint32 head, tail, mask;
+	int res;
+
+	if (!ring) {
+		errno = EBADF;
+		return -1;
+	}
+	head = *(uint32*)(ring->cq_ring + ring->params.cq_off.head);
+	tail = __atomic_load_n((uint32*)(ring->cq_ring + ring->params.cq_off.tail), __ATOMIC_ACQUIRE);
+	if (head == tail) {
+		errno = EAGAIN;
+		return -1;
+	}
+	mask = *(uint32*)(ring->cq_ring + ring->params.cq_off.ring_mask);
+	cqe = (struct io_uring_cqe_t*)(ring->cq_ring + ring->params.cq_off.cqes) + (head & mask);
+	res = cqe->res;
+	debug("syz_io_uring_complete: user_data=%llu res=%d\n", cqe->user_data, res);
+	__atomic_store_n((uint32*)(ring->cq_ring + ring->params.cq_off.head), head + 1, __ATOMIC_RELEASE);
+	if (res < 0) {
+		errno = -res;
+		return -1;
+	}
+	return res;
+}
+#endif
+
 #if defined(SYZ_EXECUTOR) || defined(__NR_syz_kvm_setup_cpu)
 #if defined(__x86_64__)
 
@@ -2858,6 +3167,48 @@ static void reset_net_namespace(void)
 }
 #endif
 
+#if defined(SYZ_EXECUTOR) || (defined(__NR_syz_sysctl) && defined(SYZ_WAIT_REPEAT))
+static void setup_sysctls()
+{
+	sysctl_changes = (struct sysctl_changes*)mmap(0, sizeof(*sysctl_changes), PROT_READ | PROT_WRITE, MAP_SHARED | MAP_ANONYMOUS, -1, 0);
+	if (sysctl_changes == MAP_FAILED)
+		fail("mmap of sysctl changes failed");
+	sysctl_changes->count = 0;
+}
+
+static void restore_sysctls()
+{
+	char backup[256], file[256], val[SYZ_SYSCTL_LEN];
+	int count, i, fd, n;
+
+	count = sysctl_changes->count;
+	if (count > SYZ_SYSCTL_MAX)
+		count = SYZ_SYSCTL_MAX;
+	for (i = 0; i < count; i++) {
+		char* name = sysctl_changes->name[i];
+		name[SYZ_SYSCTL_LEN - 1] = 0;
+		sysctl_backup_file(backup, sizeof(backup), name);
+		fd = open(backup, O_RDONLY);
+		if (fd == -1)
+			continue;
+		n = read(fd, val, sizeof(val));
+		close(fd);
+		if (n <= 0)
+			continue;
+		snprintf(file, sizeof(file), "/proc/sys/%s", name);
+		fd = open(file, O_WRONLY);
+		if (fd == -1) {
+			debug("restore_sysctls: open(%s) failed: %d\n", file, errno);
+			continue;
+		}
+		if (write(fd, val, n) != n)
+			debug("restore_sysctls: write(%s) failed: %d\n", file, errno);
+		close(fd);
+	}
+	sysctl_changes->count = 0;
+}
+#endif
+
 #if defined(SYZ_EXECUTOR) || (defined(SYZ_REPEAT) && defined(SYZ_WAIT_REPEAT) && defined(SYZ_USE_TMP_DIR))
 static void remove_dir(const char* dir)
 {
@@ -2932,6 +3283,31 @@ retry:
 }
 #endif
 
+#if defined(SYZ_EXECUTOR) || defined(SYZ_ENABLE_CGROUPS)
+static void remove_cgroups(const char* dir)
+{
+	DIR* dp;
+	struct dirent* ep;
+
+	dp = opendir(dir);
+	if (dp == NULL) {
+		debug("opendir(%s) failed: %d\n", dir, errno);
+		return;
+	}
+	while ((ep = readdir(dp))) {
+		if (ep->d_type != DT_DIR || strcmp(ep->d_name, ".") == 0 || strcmp(ep->d_name, "..") == 0)
+			continue;
+		char path[FILENAME_MAX];
+		snprintf(path, sizeof(path), "%s/%s", dir, ep->d_name);
+		remove_cgroups(path);
+		if (rmdir(path)) {
+			debug("rmdir(%s) failed: %d\n", path, errno);
+		}
+	}
+	closedir(dp);
+}
+#endif
+
 #if defined(SYZ_EXECUTOR) || defined(SYZ_FAULT_INJECTION)
 static int inject_fault(int nth)
 {
@@ -2964,6 +3340,53 @@ static int fault_injected(int fail_fd)
 }
 #endif
 
+#if defined(SYZ_EXECUTOR) || defined(SYZ_CREDS)
+static void setup_creds(int uid, uint64 drop_caps, int nnp)
+{
+	static int done;
+	if (done)
+		return;
+	done = 1;
+	int cap;
+	for (cap = 0; cap < 64; cap++) {
+		if (!(drop_caps & (1ull << cap)))
+			continue;
+		if (prctl(PR_CAPBSET_DROP, cap, 0, 0, 0)) {
+			if (errno == EINVAL)
+				break;
+			fail("prctl(PR_CAPBSET_DROP, %d) failed", cap);
+		}
+	}
+	if (uid) {
+		if (prctl(PR_SET_KEEPCAPS, 1, 0, 0, 0))
+			fail("prctl(PR_SET_KEEPCAPS) failed");
+		if (setgroups(0, NULL))
+			fail("failed to setgroups");
+		if (syscall(SYS_setresgid, uid, uid, uid))
+			fail("failed to setresgid");
+		if (syscall(SYS_setresuid, uid, uid, uid))
+			fail("failed to setresuid");
+		prctl(PR_SET_DUMPABLE, 1, 0, 0, 0);
+	}
+	struct __user_cap_header_struct cap_hdr = {};
+	struct __user_cap_data_struct cap_data[2] = {};
+	cap_hdr.version = _LINUX_CAPABILITY_VERSION_3;
+	cap_data[0].effective = cap_data[0].permitted = ~(uint32)drop_caps;
+	cap_data[1].effective = cap_data[1].permitted = ~(uint32)(drop_caps >> 32);
+	struct __user_cap_data_struct cur_data[2] = {};
+	if (syscall(SYS_capget, &cap_hdr, &cur_data))
+		fail("capget failed");
+	cap_data[0].effective &= cur_data[0].permitted;
+	cap_data[0].permitted &= cur_data[0].permitted;
+	cap_data[1].effective &= cur_data[1].permitted;
+	cap_data[1].permitted &= cur_data[1].permitted;
+	if (syscall(SYS_capset, &cap_hdr, &cap_data))
+		fail("capset failed");
+	if (nnp && prctl(PR_SET_NO_NEW_PRIVS, 1, 0, 0, 0))
+		fail("prctl(PR_SET_NO_NEW_PRIVS) failed");
+}
+#endif
+
 #if defined(SYZ_EXECUTOR) || defined(SYZ_REPEAT)
 static void execute_one();
 extern unsigned long long procid;
@@ -2974,6 +3397,10 @@ void receive_execute();
 void reply_execute(int status);
 extern uint32* output_data;
 extern uint32* output_pos;
+extern bool flag_creds;
+extern int flag_creds_uid;
+extern uint64 flag_creds_drop_caps;
+extern bool flag_creds_nnp;
 #endif
 
 #if defined(SYZ_EXECUTOR) || defined(SYZ_WAIT_REPEAT)
@@ -2985,6 +3412,9 @@ static void loop()
 #if defined(SYZ_EXECUTOR) || defined(SYZ_RESET_NET_NAMESPACE)
 	checkpoint_net_namespace();
 #endif
+#if defined(SYZ_EXECUTOR) || defined(__NR_syz_sysctl)
+	setup_sysctls();
+#endif
 #if defined(SYZ_EXECUTOR) || defined(SYZ_ENABLE_CGROUPS)
 	char cgroupdir[64];
 	snprintf(cgroupdir, sizeof(cgroupdir), "/syzcgroup/unified/syz%llu", procid);
@@ -3068,6 +3498,10 @@ static void loop()
 			output_pos = output_data;
 #elif defined(SYZ_TUN_ENABLE)
 			flush_tun();
+#endif
+#if defined(SYZ_EXECUTOR)
+			if (flag_creds)
+				setup_creds(flag_creds_uid, flag_creds_drop_caps, flag_creds_nnp);
 #endif
 			execute_one();
 			debug("worker exiting\n");
@@ -3120,8 +3554,16 @@ static void loop()
 #if defined(SYZ_EXECUTOR) || defined(SYZ_USE_TMP_DIR)
 		remove_dir(cwdbuf);
 #endif
+#if defined(SYZ_EXECUTOR) || defined(SYZ_ENABLE_CGROUPS)
+		remove_cgroups(cgroupdir);
+		remove_cgroups(cgroupdir_cpu);
+		remove_cgroups(cgroupdir_net);
+#endif
 #if defined(SYZ_EXECUTOR) || defined(SYZ_RESET_NET_NAMESPACE)
 		reset_net_namespace();
+#endif
+#if defined(SYZ_EXECUTOR) || defined(__NR_syz_sysctl)
+		restore_sysctls();
 #endif
 	}
 }
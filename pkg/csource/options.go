@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/google/syzkaller/pkg/creds"
 	"github.com/google/syzkaller/syz-manager/mgrconfig"
 )
 
@@ -25,6 +26,9 @@ type Options struct {
 	FaultCall int  `json:"fault_call,omitempty"`
 	FaultNth  int  `json:"fault_nth,omitempty"`
 
+	// Credential context the program is executed in (see pkg/creds), empty for root.
+	Creds string `json:"creds,omitempty"`
+
 	// These options allow for a more fine-tuned control over the generated C code.
 	EnableTun     bool `json:"tun,omitempty"`
 	UseTmpDir     bool `json:"tmpdir,omitempty"`
@@ -65,6 +69,17 @@ func (opts Options) Check(OS string) error {
 			return fmt.Errorf("Sandbox=%v is not supported on fuchsia", opts.Sandbox)
 		}
 	}
+	if opts.Creds != "" {
+		if OS != linux {
+			return fmt.Errorf("Creds is not supported on %v", OS)
+		}
+		if opts.Sandbox != "none" {
+			return errors.New("Creds without Sandbox=none")
+		}
+		if _, err := creds.Parse(opts.Creds); err != nil {
+			return err
+		}
+	}
 	if !opts.Threaded && opts.Collide {
 		// Collide requires threaded.
 		return errors.New("Collide without Threaded")
@@ -161,6 +161,11 @@ func enumerateField(OS string, opt Options, field int) []Options {
 		opts = append(opts, opt)
 	} else if fldName == "FaultNth" {
 		opts = append(opts, opt)
+	} else if fldName == "Creds" {
+		for _, creds := range []string{"", "uid=65534/caps=net_raw/nnp"} {
+			fld.SetString(creds)
+			opts = append(opts, opt)
+		}
 	} else if fld.Kind() == reflect.Bool {
 		for _, v := range []bool{false, true} {
 			fld.SetBool(v)
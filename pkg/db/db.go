@@ -18,6 +18,7 @@ import (
 	"io/ioutil"
 	"os"
 
+	"github.com/google/syzkaller/pkg/crypt"
 	"github.com/google/syzkaller/pkg/log"
 	"github.com/google/syzkaller/pkg/osutil"
 )
@@ -27,6 +28,7 @@ type DB struct {
 	Records map[string]Record // in-memory cache, must not be modified directly
 
 	filename    string
+	key         *crypt.Key    // encryption key for record values (nil if not encrypted)
 	uncompacted int           // number of records in the file
 	pending     *bytes.Buffer // pending writes to the file
 }
@@ -37,15 +39,27 @@ type Record struct {
 }
 
 func Open(filename string) (*DB, error) {
+	return OpenEncrypted(filename, nil)
+}
+
+// OpenEncrypted opens a database with record values encrypted with the key.
+// Values that are not encrypted (e.g. in a database created before encryption was enabled)
+// are still readable and are encrypted on the next compaction.
+func OpenEncrypted(filename string, key *crypt.Key) (*DB, error) {
 	db := &DB{
 		filename: filename,
+		key:      key,
 	}
 	f, err := os.OpenFile(db.filename, os.O_RDONLY|os.O_CREATE, osutil.DefaultFilePerm)
 	if err != nil {
 		return nil, err
 	}
-	db.Version, db.Records, db.uncompacted = deserializeDB(bufio.NewReader(f))
+	db.Version, db.Records, db.uncompacted, err = deserializeDB(bufio.NewReader(f), key)
 	f.Close()
+	if err != nil {
+		// Don't compact the database, it would lose all records.
+		return nil, fmt.Errorf("failed to open %v: %v", filename, err)
+	}
 	if len(db.Records) == 0 || db.uncompacted/10*9 > len(db.Records) {
 		db.compact()
 	}
@@ -105,7 +119,7 @@ func (db *DB) compact() error {
 	buf := new(bytes.Buffer)
 	serializeHeader(buf, db.Version)
 	for key, rec := range db.Records {
-		serializeRecord(buf, key, rec.Val, rec.Seq)
+		serializeRecord(buf, db.key, key, rec.Val, rec.Seq)
 	}
 	f, err := os.Create(db.filename + ".tmp")
 	if err != nil {
@@ -128,7 +142,7 @@ func (db *DB) serialize(key string, val []byte, seq uint64) {
 	if db.pending == nil {
 		db.pending = new(bytes.Buffer)
 	}
-	serializeRecord(db.pending, key, val, seq)
+	serializeRecord(db.pending, db.key, key, val, seq)
 }
 
 const (
@@ -144,7 +158,7 @@ func serializeHeader(w *bytes.Buffer, version uint64) {
 	binary.Write(w, binary.LittleEndian, version)
 }
 
-func serializeRecord(w *bytes.Buffer, key string, val []byte, seq uint64) {
+func serializeRecord(w *bytes.Buffer, cryptKey *crypt.Key, key string, val []byte, seq uint64) {
 	binary.Write(w, binary.LittleEndian, recMagic)
 	binary.Write(w, binary.LittleEndian, uint32(len(key)))
 	w.WriteString(key)
@@ -157,38 +171,55 @@ func serializeRecord(w *bytes.Buffer, key string, val []byte, seq uint64) {
 	}
 	if len(val) == 0 {
 		binary.Write(w, binary.LittleEndian, uint32(len(val)))
+	} else if cryptKey != nil {
+		// Compress before encryption, encrypted data is not compressible.
+		compressed := new(bytes.Buffer)
+		compress(compressed, val)
+		sealed := cryptKey.Seal(compressed.Bytes())
+		binary.Write(w, binary.LittleEndian, uint32(len(sealed)))
+		w.Write(sealed)
 	} else {
 		lenPos := len(w.Bytes())
 		binary.Write(w, binary.LittleEndian, uint32(0))
 		startPos := len(w.Bytes())
-		fw, err := flate.NewWriter(w, flate.BestCompression)
-		if err != nil {
-			panic(err)
-		}
-		if _, err := fw.Write(val); err != nil {
-			panic(err)
-		}
-		fw.Flush()
-		fw.Close()
+		compress(w, val)
 		binary.Write(bytes.NewBuffer(w.Bytes()[lenPos:lenPos:lenPos+8]), binary.LittleEndian, uint32(len(w.Bytes())-startPos))
 	}
 }
 
-func deserializeDB(r *bufio.Reader) (version uint64, records map[string]Record, uncompacted int) {
+func compress(w *bytes.Buffer, val []byte) {
+	fw, err := flate.NewWriter(w, flate.BestCompression)
+	if err != nil {
+		panic(err)
+	}
+	if _, err := fw.Write(val); err != nil {
+		panic(err)
+	}
+	fw.Flush()
+	fw.Close()
+}
+
+func deserializeDB(r *bufio.Reader, cryptKey *crypt.Key) (version uint64, records map[string]Record,
+	uncompacted int, err error) {
 	records = make(map[string]Record)
 	ver, err := deserializeHeader(r)
 	if err != nil {
 		log.Logf(0, "failed to deserialize database header: %v", err)
+		err = nil
 		return
 	}
 	version = ver
 	for {
-		key, val, seq, err := deserializeRecord(r)
-		if err == io.EOF {
+		key, val, seq, err1 := deserializeRecord(r, cryptKey)
+		if err1 == io.EOF {
+			return
+		}
+		if _, ok := err1.(*decryptError); ok {
+			err = err1
 			return
 		}
-		if err != nil {
-			log.Logf(0, "failed to deserialize database record: %v", err)
+		if err1 != nil {
+			log.Logf(0, "failed to deserialize database record: %v", err1)
 			return
 		}
 		uncompacted++
@@ -226,7 +257,7 @@ func deserializeHeader(r *bufio.Reader) (uint64, error) {
 	return userVer, nil
 }
 
-func deserializeRecord(r *bufio.Reader) (key string, val []byte, seq uint64, err error) {
+func deserializeRecord(r *bufio.Reader, cryptKey *crypt.Key) (key string, val []byte, seq uint64, err error) {
 	var magic uint32
 	if err = binary.Read(r, binary.LittleEndian, &magic); err != nil {
 		return
@@ -255,7 +286,15 @@ func deserializeRecord(r *bufio.Reader) (key string, val []byte, seq uint64, err
 		return
 	}
 	if valLen != 0 {
-		fr := flate.NewReader(&io.LimitedReader{R: r, N: int64(valLen)})
+		data := make([]byte, valLen)
+		if _, err = io.ReadFull(r, data); err != nil {
+			return
+		}
+		if data, err = cryptKey.Open(data); err != nil {
+			err = &decryptError{err}
+			return
+		}
+		fr := flate.NewReader(bytes.NewReader(data))
 		if val, err = ioutil.ReadAll(fr); err != nil {
 			return
 		}
@@ -263,3 +302,14 @@ func deserializeRecord(r *bufio.Reader) (key string, val []byte, seq uint64, err
 	}
 	return
 }
+
+// decryptError is returned for records that can't be decrypted (no key or a wrong key).
+// Unlike other deserialization errors it fails database opening,
+// otherwise compaction would remove all records.
+type decryptError struct {
+	err error
+}
+
+func (err *decryptError) Error() string {
+	return err.err.Error()
+}
@@ -4,12 +4,15 @@
 package db
 
 import (
+	"bytes"
 	"fmt"
+	"io/ioutil"
 	"math/rand"
 	"os"
 	"reflect"
 	"testing"
 
+	"github.com/google/syzkaller/pkg/crypt"
 	"github.com/google/syzkaller/pkg/osutil"
 )
 
@@ -118,6 +121,61 @@ func TestLarge(t *testing.T) {
 	}
 }
 
+func TestEncrypted(t *testing.T) {
+	fn := tempFile(t)
+	defer os.Remove(fn)
+	key, err := crypt.NewKey(bytes.Repeat([]byte{1}, crypt.KeySize))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Start with a not encrypted database, it must be readable with the key.
+	db, err := Open(fn)
+	if err != nil {
+		t.Fatalf("failed to open db: %v", err)
+	}
+	db.Save("1", []byte("plain text value"), 0)
+	if err := db.Flush(); err != nil {
+		t.Fatalf("failed to flush db: %v", err)
+	}
+	db, err = OpenEncrypted(fn, key)
+	if err != nil {
+		t.Fatalf("failed to open db: %v", err)
+	}
+	db.Save("2", []byte("secret value"), 1)
+	if err := db.Flush(); err != nil {
+		t.Fatalf("failed to flush db: %v", err)
+	}
+	if err := db.BumpVersion(1); err != nil {
+		t.Fatalf("failed to compact db: %v", err)
+	}
+	want := map[string]Record{
+		"1": {Val: []byte("plain text value"), Seq: 0},
+		"2": {Val: []byte("secret value"), Seq: 1},
+	}
+	data, err := ioutil.ReadFile(fn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, rec := range want {
+		if bytes.Contains(data, rec.Val) {
+			t.Fatalf("db file contains plain text value %q", rec.Val)
+		}
+	}
+	db, err = OpenEncrypted(fn, key)
+	if err != nil {
+		t.Fatalf("failed to open db: %v", err)
+	}
+	if !reflect.DeepEqual(db.Records, want) {
+		t.Fatalf("bad db after reopen: %v, want: %v", db.Records, want)
+	}
+	if _, err := Open(fn); err == nil {
+		t.Fatalf("opened encrypted db without a key")
+	}
+	if _, err := OpenEncrypted(fn, key); err != nil {
+		t.Fatalf("db was damaged by opening without a key: %v", err)
+	}
+}
+
 func tempFile(t *testing.T) string {
 	fn, err := osutil.TempFile("syzkaller.test.db")
 	if err != nil {
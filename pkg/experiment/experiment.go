@@ -0,0 +1,277 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+// Package experiment implements long-term statistics for A/B experiments.
+// Several managers (experiment arms) that differ in a single aspect (e.g. a kernel config toggle)
+// periodically checkpoint their stats into a file. Then Compare computes differences
+// in coverage growth and crash discovery rates between arms and their statistical significance.
+// Feature flags compare VMs of a single manager instead, see FlagEnabled.
+package experiment
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/google/syzkaller/pkg/osutil"
+)
+
+// Checkpoint is a snapshot of stats of a single experiment arm.
+// Fuzzing, Execs and Crashes are cumulative over all manager restarts,
+// CrashTypes is number of unique crashes in the manager workdir,
+// Coverage and Corpus are current values.
+type Checkpoint struct {
+	Tag        string    `json:"tag"`
+	Time       time.Time `json:"time"`
+	Fuzzing    uint64    `json:"fuzzing"` // VM fuzzing time in seconds, summed over all VMs
+	Execs      uint64    `json:"execs"`
+	Crashes    uint64    `json:"crashes"`
+	CrashTypes uint64    `json:"crash_types"`
+	Coverage   uint64    `json:"coverage"`
+	Corpus     uint64    `json:"corpus"`
+}
+
+// Writer appends checkpoints to a stats file.
+type Writer struct {
+	file string
+	tag  string
+	base Checkpoint
+}
+
+// NewWriter creates a writer for the stats file. If the file already contains
+// checkpoints from previous runs, cumulative counters are continued from the last one.
+func NewWriter(file, tag string) (*Writer, error) {
+	w := &Writer{
+		file: file,
+		tag:  tag,
+	}
+	if osutil.IsExist(file) {
+		checkpoints, err := ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		if len(checkpoints) != 0 {
+			last := checkpoints[len(checkpoints)-1]
+			if last.Tag != tag {
+				return nil, fmt.Errorf("%v contains stats for experiment %q, want %q",
+					file, last.Tag, tag)
+			}
+			w.base = last
+		}
+	}
+	return w, nil
+}
+
+// Write appends a checkpoint with counters accumulated by the current manager run.
+func (w *Writer) Write(cp Checkpoint) error {
+	cp.Tag = w.tag
+	if cp.Time.IsZero() {
+		cp.Time = time.Now()
+	}
+	cp.Fuzzing += w.base.Fuzzing
+	cp.Execs += w.base.Execs
+	cp.Crashes += w.base.Crashes
+	data, err := json.Marshal(cp)
+	if err != nil {
+		return err
+	}
+	f, err := os.OpenFile(w.file, os.O_WRONLY|os.O_APPEND|os.O_CREATE, osutil.DefaultFilePerm)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if _, err := f.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("failed to write %v: %v", w.file, err)
+	}
+	return nil
+}
+
+// ReadFile reads all checkpoints from a stats file.
+func ReadFile(file string) ([]Checkpoint, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	var checkpoints []Checkpoint
+	dec := json.NewDecoder(bufio.NewReader(f))
+	for dec.More() {
+		var cp Checkpoint
+		if err := dec.Decode(&cp); err != nil {
+			return nil, fmt.Errorf("failed to decode %v: %v", file, err)
+		}
+		checkpoints = append(checkpoints, cp)
+	}
+	return checkpoints, nil
+}
+
+// Window returns checkpoints within the last window of wall time (all checkpoints if window is 0).
+func Window(checkpoints []Checkpoint, window time.Duration) []Checkpoint {
+	if window == 0 || len(checkpoints) == 0 {
+		return checkpoints
+	}
+	start := checkpoints[len(checkpoints)-1].Time.Add(-window)
+	idx := sort.Search(len(checkpoints), func(i int) bool {
+		return !checkpoints[i].Time.Before(start)
+	})
+	return checkpoints[idx:]
+}
+
+// Metric is a comparison of a single rate between two experiment arms.
+type Metric struct {
+	Name string
+	A    float64 // rate per fuzzing hour in arm A
+	B    float64 // rate per fuzzing hour in arm B
+	P    float64 // two-sided p-value of the difference
+}
+
+// Diff returns relative difference of B against A.
+func (m *Metric) Diff() float64 {
+	if m.A == 0 {
+		if m.B == 0 {
+			return 0
+		}
+		return math.Inf(1)
+	}
+	return (m.B - m.A) / m.A
+}
+
+// Significant returns true if the difference is statistically significant at the given level.
+func (m *Metric) Significant(alpha float64) bool {
+	return m.P < alpha
+}
+
+// Compare compares coverage growth and crash discovery rates between two arms.
+// Rates are normalized by VM fuzzing time, so arms may use different number of VMs.
+// Coverage growth is compared with Welch's t-test over per-checkpoint growth rates,
+// crash counts are compared with a conditional test for two Poisson rates
+// (both use normal approximation).
+func Compare(a, b []Checkpoint) ([]*Metric, error) {
+	if err := checkArm(a); err != nil {
+		return nil, err
+	}
+	if err := checkArm(b); err != nil {
+		return nil, err
+	}
+	hoursA, hoursB := fuzzingHours(a), fuzzingHours(b)
+	coverage := &Metric{
+		Name: "coverage",
+		A:    float64(delta(a, coverageOf)) / hoursA,
+		B:    float64(delta(b, coverageOf)) / hoursB,
+		P:    welchTest(growthRates(a), growthRates(b)),
+	}
+	crashesA, crashesB := delta(a, crashesOf), delta(b, crashesOf)
+	crashes := &Metric{
+		Name: "crashes",
+		A:    float64(crashesA) / hoursA,
+		B:    float64(crashesB) / hoursB,
+		P:    poissonTest(float64(crashesA), hoursA, float64(crashesB), hoursB),
+	}
+	typesA, typesB := delta(a, crashTypesOf), delta(b, crashTypesOf)
+	crashTypes := &Metric{
+		Name: "crash types",
+		A:    float64(typesA) / hoursA,
+		B:    float64(typesB) / hoursB,
+		P:    poissonTest(float64(typesA), hoursA, float64(typesB), hoursB),
+	}
+	return []*Metric{coverage, crashes, crashTypes}, nil
+}
+
+func checkArm(checkpoints []Checkpoint) error {
+	if len(checkpoints) < 3 {
+		return fmt.Errorf("need at least 3 checkpoints per arm, have %v", len(checkpoints))
+	}
+	if fuzzingHours(checkpoints) <= 0 {
+		return fmt.Errorf("experiment %v: no fuzzing time in the window", checkpoints[0].Tag)
+	}
+	return nil
+}
+
+func coverageOf(cp *Checkpoint) uint64   { return cp.Coverage }
+func crashesOf(cp *Checkpoint) uint64    { return cp.Crashes }
+func crashTypesOf(cp *Checkpoint) uint64 { return cp.CrashTypes }
+
+// delta returns increase of the value over the checkpoints.
+// Coverage can go backwards (e.g. after manager restart), so negative deltas are clamped.
+func delta(checkpoints []Checkpoint, val func(*Checkpoint) uint64) uint64 {
+	first, last := val(&checkpoints[0]), val(&checkpoints[len(checkpoints)-1])
+	if last < first {
+		return 0
+	}
+	return last - first
+}
+
+func fuzzingHours(checkpoints []Checkpoint) float64 {
+	first, last := checkpoints[0].Fuzzing, checkpoints[len(checkpoints)-1].Fuzzing
+	if last <= first {
+		return 0
+	}
+	return float64(last-first) / 3600
+}
+
+// growthRates returns coverage growth per fuzzing hour between consecutive checkpoints.
+func growthRates(checkpoints []Checkpoint) []float64 {
+	var rates []float64
+	for i := 1; i < len(checkpoints); i++ {
+		prev, cur := &checkpoints[i-1], &checkpoints[i]
+		if cur.Fuzzing <= prev.Fuzzing {
+			continue
+		}
+		hours := float64(cur.Fuzzing-prev.Fuzzing) / 3600
+		rates = append(rates, (float64(cur.Coverage)-float64(prev.Coverage))/hours)
+	}
+	return rates
+}
+
+func welchTest(a, b []float64) float64 {
+	if len(a) < 2 || len(b) < 2 {
+		return 1
+	}
+	meanA, varA := meanVar(a)
+	meanB, varB := meanVar(b)
+	se := math.Sqrt(varA/float64(len(a)) + varB/float64(len(b)))
+	if se == 0 {
+		if meanA == meanB {
+			return 1
+		}
+		return 0
+	}
+	return pvalue((meanB - meanA) / se)
+}
+
+func poissonTest(countA, hoursA, countB, hoursB float64) float64 {
+	// Conditional on the total number of events, under the null hypothesis
+	// the number of events in A is binomially distributed.
+	n := countA + countB
+	if n == 0 {
+		return 1
+	}
+	p := hoursA / (hoursA + hoursB)
+	sd := math.Sqrt(n * p * (1 - p))
+	if sd == 0 {
+		return 1
+	}
+	return pvalue((countA - n*p) / sd)
+}
+
+func meanVar(vals []float64) (float64, float64) {
+	sum := 0.0
+	for _, v := range vals {
+		sum += v
+	}
+	mean := sum / float64(len(vals))
+	sq := 0.0
+	for _, v := range vals {
+		sq += (v - mean) * (v - mean)
+	}
+	return mean, sq / float64(len(vals)-1)
+}
+
+// pvalue returns two-sided p-value for the standard normal z statistic.
+func pvalue(z float64) float64 {
+	return math.Erfc(math.Abs(z) / math.Sqrt2)
+}
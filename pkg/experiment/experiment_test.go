@@ -0,0 +1,108 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package experiment
+
+import (
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestWriterRestart(t *testing.T) {
+	dir, err := ioutil.TempDir("", "syz-experiment")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "experiment.stats")
+	w, err := NewWriter(file, "kasan")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Write(Checkpoint{Fuzzing: 100, Execs: 1000, Crashes: 1, CrashTypes: 1, Coverage: 10}); err != nil {
+		t.Fatal(err)
+	}
+	// Emulate manager restart: cumulative counters must continue.
+	w, err = NewWriter(file, "kasan")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Write(Checkpoint{Fuzzing: 50, Execs: 500, Crashes: 2, CrashTypes: 2, Coverage: 5}); err != nil {
+		t.Fatal(err)
+	}
+	checkpoints, err := ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(checkpoints) != 2 {
+		t.Fatalf("got %v checkpoints, want 2", len(checkpoints))
+	}
+	cp := checkpoints[1]
+	if cp.Tag != "kasan" || cp.Fuzzing != 150 || cp.Execs != 1500 || cp.Crashes != 3 ||
+		cp.CrashTypes != 2 || cp.Coverage != 5 {
+		t.Fatalf("bad checkpoint after restart: %+v", cp)
+	}
+	if _, err := NewWriter(file, "kmsan"); err == nil {
+		t.Fatalf("opened stats file with a different experiment tag")
+	}
+}
+
+func TestWindow(t *testing.T) {
+	start := time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC)
+	var checkpoints []Checkpoint
+	for i := 0; i < 10; i++ {
+		checkpoints = append(checkpoints, Checkpoint{Time: start.Add(time.Duration(i) * time.Hour)})
+	}
+	if got := len(Window(checkpoints, 0)); got != 10 {
+		t.Fatalf("got %v checkpoints for zero window, want 10", got)
+	}
+	if got := len(Window(checkpoints, 3*time.Hour)); got != 4 {
+		t.Fatalf("got %v checkpoints for 3h window, want 4", got)
+	}
+}
+
+func TestCompare(t *testing.T) {
+	rnd := rand.New(rand.NewSource(0))
+	gen := func(tag string, coverPerHour, crashEvery int) []Checkpoint {
+		var checkpoints []Checkpoint
+		cp := Checkpoint{Tag: tag}
+		for i := 0; i < 100; i++ {
+			cp.Fuzzing += 3600
+			cp.Coverage += uint64(coverPerHour + rnd.Intn(coverPerHour/5))
+			if i%crashEvery == 0 {
+				cp.Crashes++
+				cp.CrashTypes++
+			}
+			checkpoints = append(checkpoints, cp)
+		}
+		return checkpoints
+	}
+	same, err := Compare(gen("a", 1000, 5), gen("b", 1000, 5))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, m := range same {
+		if m.Significant(0.01) {
+			t.Errorf("%v: got significant difference for equal arms: %+v", m.Name, *m)
+		}
+	}
+	diff, err := Compare(gen("a", 1000, 10), gen("b", 2000, 2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, m := range diff {
+		if !m.Significant(0.01) {
+			t.Errorf("%v: got no significant difference for different arms: %+v", m.Name, *m)
+		}
+		if m.Diff() <= 0 {
+			t.Errorf("%v: got non-positive diff %v", m.Name, m.Diff())
+		}
+	}
+	if _, err := Compare(gen("a", 1000, 5)[:2], gen("b", 1000, 5)); err == nil {
+		t.Errorf("compared arm with too few checkpoints")
+	}
+}
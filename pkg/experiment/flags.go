@@ -0,0 +1,89 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package experiment
+
+import (
+	"fmt"
+	"hash/fnv"
+	"strings"
+	"time"
+)
+
+// Feature flags are a lighter-weight alternative to experiment arms: an experimental fuzzer behavior
+// is enabled on a percentage of VMs of a single manager, the rest of VMs serve as the control group.
+// Both groups fuzz the same kernel with the same corpus at the same time, so differences
+// in their stats can be attributed to the flag.
+
+// Names of feature flags understood by syz-fuzzer. VMs without a flag run without the behavior,
+// even if it's enabled by default (e.g. comparison tracing is used whenever the kernel supports it).
+const (
+	// Collect comparison operands for hints (if comparison tracing is supported by the kernel).
+	FlagComparisons = "comparisons"
+	// Inject faults into calls of new inputs (if fault injection is supported by the kernel).
+	FlagFaultInjection = "fault_injection"
+	// FlagMutator+name enables pkg/mutator strategy name. On VMs without the flag
+	// the strategy is not used even if it's enabled with mutators config parameter.
+	FlagMutator = "mutator:"
+)
+
+// CheckFlag checks that the feature flag name is known.
+func CheckFlag(name string) error {
+	switch {
+	case name == FlagComparisons, name == FlagFaultInjection:
+		return nil
+	case strings.HasPrefix(name, FlagMutator) && len(name) > len(FlagMutator):
+		return nil
+	}
+	return fmt.Errorf("unknown feature flag %q (known: %v, %v, %vNAME)",
+		name, FlagComparisons, FlagFaultInjection, FlagMutator)
+}
+
+// FlagEnabled says if the flag enabled on percent of count VMs is enabled on VM index.
+// The assignment is stable across VM and manager restarts. Different flags are enabled
+// on different subsets of VMs, so that flags don't completely overlap.
+func FlagEnabled(name string, percent, index, count int) bool {
+	if count <= 0 || index < 0 {
+		return false
+	}
+	enabled := (count*percent + 50) / 100
+	if enabled == 0 && percent > 0 {
+		enabled = 1
+	}
+	h := fnv.New32a()
+	h.Write([]byte(name))
+	offset := int(h.Sum32() % uint32(count))
+	return (index+offset)%count < enabled
+}
+
+// ArmStats are cumulative stats of VMs with a feature flag enabled or disabled.
+type ArmStats struct {
+	Fuzzing   time.Duration // VM fuzzing time summed over all VMs
+	Execs     uint64
+	NewInputs uint64 // inputs that were added to corpus
+	NewSignal uint64 // new corpus signal from these inputs
+	Crashes   uint64
+}
+
+// CompareFlag compares rates of VMs with the flag disabled (A) and enabled (B).
+// Returns nil if one of the groups did not fuzz yet.
+func CompareFlag(off, on *ArmStats) []*Metric {
+	hoursA, hoursB := off.Fuzzing.Hours(), on.Fuzzing.Hours()
+	if hoursA <= 0 || hoursB <= 0 {
+		return nil
+	}
+	metric := func(name string, a, b uint64) *Metric {
+		return &Metric{
+			Name: name,
+			A:    float64(a) / hoursA,
+			B:    float64(b) / hoursB,
+			P:    poissonTest(float64(a), hoursA, float64(b), hoursB),
+		}
+	}
+	return []*Metric{
+		metric("execs", off.Execs, on.Execs),
+		metric("new inputs", off.NewInputs, on.NewInputs),
+		metric("new signal", off.NewSignal, on.NewSignal),
+		metric("crashes", off.Crashes, on.Crashes),
+	}
+}
@@ -0,0 +1,96 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package experiment
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFlagEnabled(t *testing.T) {
+	tests := []struct {
+		percent int
+		count   int
+		enabled int
+	}{
+		{0, 10, 0},
+		{10, 10, 1},
+		{25, 10, 3},
+		{50, 7, 4},
+		{1, 3, 1},
+		{100, 5, 5},
+	}
+	for _, test := range tests {
+		for _, name := range []string{"comparisons", "mutator:splice"} {
+			enabled := 0
+			for i := 0; i < test.count; i++ {
+				if FlagEnabled(name, test.percent, i, test.count) {
+					enabled++
+				}
+				if FlagEnabled(name, test.percent, i, test.count) !=
+					FlagEnabled(name, test.percent, i, test.count) {
+					t.Fatalf("flag assignment is not stable")
+				}
+			}
+			if enabled != test.enabled {
+				t.Errorf("%v: %v%% of %v VMs: got %v enabled, want %v",
+					name, test.percent, test.count, enabled, test.enabled)
+			}
+		}
+	}
+}
+
+func TestCompareFlag(t *testing.T) {
+	off := &ArmStats{
+		Fuzzing:   10 * time.Hour,
+		Execs:     1000000,
+		NewInputs: 100,
+		NewSignal: 1000,
+		Crashes:   10,
+	}
+	on := &ArmStats{
+		Fuzzing:   5 * time.Hour,
+		Execs:     400000,
+		NewInputs: 100,
+		NewSignal: 500,
+		Crashes:   5,
+	}
+	metrics := CompareFlag(off, on)
+	if len(metrics) != 4 {
+		t.Fatalf("got %v metrics, want 4", len(metrics))
+	}
+	execs, inputs, signal, crashes := metrics[0], metrics[1], metrics[2], metrics[3]
+	if execs.A != 100000 || execs.B != 80000 || !execs.Significant(0.01) {
+		t.Errorf("bad execs metric: %+v", execs)
+	}
+	if inputs.A != 10 || inputs.B != 20 || !inputs.Significant(0.01) {
+		t.Errorf("bad new inputs metric: %+v", inputs)
+	}
+	if signal.Diff() != 0 || signal.Significant(0.05) {
+		t.Errorf("bad new signal metric: %+v", signal)
+	}
+	if crashes.Diff() != 0 || crashes.Significant(0.05) {
+		t.Errorf("bad crashes metric: %+v", crashes)
+	}
+	if CompareFlag(off, &ArmStats{}) != nil {
+		t.Errorf("comparison with an arm without fuzzing time")
+	}
+}
+
+func TestCheckFlag(t *testing.T) {
+	for name, ok := range map[string]bool{
+		FlagComparisons:          true,
+		FlagFaultInjection:       true,
+		FlagMutator + "splice":   true,
+		FlagMutator:              false,
+		"":                       false,
+		"comparison":             false,
+		"mutator":                false,
+		"fault_injection:always": false,
+	} {
+		if err := CheckFlag(name); (err == nil) != ok {
+			t.Errorf("flag %q: got error %v, want ok=%v", name, err, ok)
+		}
+	}
+}
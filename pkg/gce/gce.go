@@ -12,9 +12,11 @@
 package gce
 
 import (
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"math/rand"
+	"net"
 	"net/http"
 	"strings"
 	"time"
@@ -34,7 +36,14 @@ type Context struct {
 	ExternalIP string
 	Network    string
 	Subnetwork string
+	// ImageProject is the project where images are created and taken from
+	// (defaults to ProjectID, can be changed to share images across projects).
+	ImageProject string
+	// ServiceAccount is the email of the service account the program runs under
+	// (used only to produce informative permission errors).
+	ServiceAccount string
 
+	httpClient     *http.Client
 	computeService *compute.Service
 
 	// apiCallTicker ticks regularly, preventing us from accidentally making
@@ -52,54 +61,153 @@ func NewContext() (*Context, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to get a token source: %v", err)
 	}
-	httpClient := oauth2.NewClient(background, tokenSource)
-	ctx.computeService, _ = compute.New(httpClient)
+	ctx.httpClient = oauth2.NewClient(background, tokenSource)
+	ctx.computeService, _ = compute.New(ctx.httpClient)
 	// Obtain project name, zone and current instance IP address.
-	ctx.ProjectID, err = ctx.getMeta("project/project-id")
+	ctx.ProjectID, err = getMeta("project/project-id")
 	if err != nil {
 		return nil, fmt.Errorf("failed to query gce project-id: %v", err)
 	}
-	ctx.ZoneID, err = ctx.getMeta("instance/zone")
+	ctx.ZoneID, err = getMeta("instance/zone")
 	if err != nil {
 		return nil, fmt.Errorf("failed to query gce zone: %v", err)
 	}
 	if i := strings.LastIndexByte(ctx.ZoneID, '/'); i != -1 {
 		ctx.ZoneID = ctx.ZoneID[i+1:] // the query returns some nonsense prefix
 	}
-	ctx.Instance, err = ctx.getMeta("instance/name")
+	ctx.Instance, err = getMeta("instance/name")
 	if err != nil {
 		return nil, fmt.Errorf("failed to query gce instance name: %v", err)
 	}
-	inst, err := ctx.computeService.Instances.Get(ctx.ProjectID, ctx.ZoneID, ctx.Instance).Do()
+	ctx.ImageProject = ctx.ProjectID
+	// The instance may run without a service account, then this is empty.
+	ctx.ServiceAccount, _ = getMeta("instance/service-accounts/default/email")
+	inst, err := ctx.getInstanceNetwork(ctx.Instance)
 	if err != nil {
-		return nil, fmt.Errorf("error getting instance info: %v", err)
+		return nil, fmt.Errorf("error getting instance info: %v", ctx.explainError(err, ctx.ProjectID))
 	}
 	for _, iface := range inst.NetworkInterfaces {
-		if strings.HasPrefix(iface.NetworkIP, "10.") {
-			ctx.InternalIP = iface.NetworkIP
-		}
-		for _, ac := range iface.AccessConfigs {
-			if ac.NatIP != "" {
-				ctx.ExternalIP = ac.NatIP
-			}
-		}
 		ctx.Network = iface.Network
 		ctx.Subnetwork = iface.Subnetwork
 	}
+	ctx.InternalIP, ctx.ExternalIP = inst.addrs()
 	if ctx.InternalIP == "" {
 		return nil, fmt.Errorf("failed to get current instance internal IP")
 	}
 	return ctx, nil
 }
 
-func (ctx *Context) CreateInstance(name, machineType, image, sshkey string) (string, error) {
+var internalNets = func() []*net.IPNet {
+	var nets []*net.IPNet
+	for _, cidr := range []string{"10.0.0.0/8", "172.16.0.0/12", "192.168.0.0/16", "fc00::/7"} {
+		_, n, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		nets = append(nets, n)
+	}
+	return nets
+}()
+
+// isInternalIP says if addr is a private (RFC 1918) IPv4 or a unique local IPv6 address.
+func isInternalIP(addr string) bool {
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return false
+	}
+	for _, n := range internalNets {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// instanceNetwork is the part of the GCE instance resource that describes its network interfaces.
+// The vendored compute API predates IPv6 support and drops ipv6Address and ipv6AccessConfigs,
+// so it is queried with a raw request (see getInstanceNetwork).
+type instanceNetwork struct {
+	NetworkInterfaces []struct {
+		Network       string `json:"network"`
+		Subnetwork    string `json:"subnetwork"`
+		NetworkIP     string `json:"networkIP"`
+		Ipv6Address   string `json:"ipv6Address"`
+		AccessConfigs []struct {
+			NatIP string `json:"natIP"`
+		} `json:"accessConfigs"`
+		Ipv6AccessConfigs []struct {
+			ExternalIpv6 string `json:"externalIpv6"`
+		} `json:"ipv6AccessConfigs"`
+	} `json:"networkInterfaces"`
+}
+
+// addrs returns internal and external addresses of the instance.
+// IPv4 addresses are preferred for dual-stack instances, IPv6 addresses are used for IPv6-only instances.
+func (inst *instanceNetwork) addrs() (internal, external string) {
+	var internals, externals []string
+	for _, iface := range inst.NetworkInterfaces {
+		internals = append(internals, iface.NetworkIP)
+		for _, ac := range iface.AccessConfigs {
+			externals = append(externals, ac.NatIP)
+		}
+	}
+	for _, iface := range inst.NetworkInterfaces {
+		internals = append(internals, iface.Ipv6Address)
+		for _, ac := range iface.Ipv6AccessConfigs {
+			externals = append(externals, ac.ExternalIpv6)
+		}
+	}
+	for _, addr := range internals {
+		if isInternalIP(addr) {
+			internal = addr
+			break
+		}
+	}
+	for _, addr := range externals {
+		if ip := net.ParseIP(addr); ip != nil && !isInternalIP(addr) {
+			external = addr
+			break
+		}
+	}
+	return
+}
+
+func (ctx *Context) getInstanceNetwork(name string) (*instanceNetwork, error) {
+	url := fmt.Sprintf("%v%v/zones/%v/instances/%v?fields=networkInterfaces",
+		ctx.computeService.BasePath, ctx.ProjectID, ctx.ZoneID, name)
+	inst := new(instanceNetwork)
+	err := ctx.apiCall(func() error {
+		resp, err := ctx.httpClient.Get(url)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+		if err := googleapi.CheckResponse(resp); err != nil {
+			return err
+		}
+		return json.NewDecoder(resp.Body).Decode(inst)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return inst, nil
+}
+
+// WorkerDescription is the description of instances created by CreateInstance.
+const WorkerDescription = "syzkaller worker"
+
+// CreateInstance creates an instance and returns its internal IP address.
+// Preemptible instances are cheaper, but can be stopped by GCE at any time (see IsInstancePreempted).
+// If there is no capacity for preemptible instances, a regular instance is created.
+func (ctx *Context) CreateInstance(name, machineType, image, sshkey string, preemptible bool) (string, error) {
 	prefix := "https://www.googleapis.com/compute/v1/projects/" + ctx.ProjectID
+	imagePrefix := "https://www.googleapis.com/compute/v1/projects/" + ctx.ImageProject
 	sshkeyAttr := "syzkaller:" + sshkey
 	oneAttr := "1"
 	falseAttr := false
 	instance := &compute.Instance{
 		Name:        name,
-		Description: "syzkaller worker",
+		Description: WorkerDescription,
 		MachineType: prefix + "/zones/" + ctx.ZoneID + "/machineTypes/" + machineType,
 		Disks: []*compute.AttachedDisk{
 			{
@@ -108,7 +216,7 @@ func (ctx *Context) CreateInstance(name, machineType, image, sshkey string) (str
 				Type:       "PERSISTENT",
 				InitializeParams: &compute.AttachedDiskInitializeParams{
 					DiskName:    name,
-					SourceImage: prefix + "/global/images/" + image,
+					SourceImage: imagePrefix + "/global/images/" + image,
 				},
 			},
 		},
@@ -132,7 +240,7 @@ func (ctx *Context) CreateInstance(name, machineType, image, sshkey string) (str
 		},
 		Scheduling: &compute.Scheduling{
 			AutomaticRestart:  &falseAttr,
-			Preemptible:       true,
+			Preemptible:       preemptible,
 			OnHostMaintenance: "TERMINATE",
 		},
 	}
@@ -144,9 +252,9 @@ retry:
 		return
 	})
 	if err != nil {
-		return "", fmt.Errorf("failed to create instance: %v", err)
+		return "", fmt.Errorf("failed to create instance: %v", ctx.explainError(err, ctx.ProjectID))
 	}
-	if err := ctx.waitForCompletion("zone", "create image", op.Name, false); err != nil {
+	if err := ctx.waitForCompletion(ctx.ProjectID, "zone", "create image", op.Name, false); err != nil {
 		if _, ok := err.(resourcePoolExhaustedError); ok && instance.Scheduling.Preemptible {
 			instance.Scheduling.Preemptible = false
 			goto retry
@@ -154,23 +262,13 @@ retry:
 		return "", err
 	}
 
-	var inst *compute.Instance
-	err = ctx.apiCall(func() (err error) {
-		inst, err = ctx.computeService.Instances.Get(ctx.ProjectID, ctx.ZoneID, name).Do()
-		return
-	})
+	inst, err := ctx.getInstanceNetwork(name)
 	if err != nil {
 		return "", fmt.Errorf("error getting instance %s details after creation: %v", name, err)
 	}
 
 	// Finds its internal IP.
-	ip := ""
-	for _, iface := range inst.NetworkInterfaces {
-		if strings.HasPrefix(iface.NetworkIP, "10.") {
-			ip = iface.NetworkIP
-			break
-		}
-	}
+	ip, _ := inst.addrs()
 	if ip == "" {
 		return "", fmt.Errorf("didn't find instance internal IP address")
 	}
@@ -187,10 +285,10 @@ func (ctx *Context) DeleteInstance(name string, wait bool) error {
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("failed to delete instance: %v", err)
+		return fmt.Errorf("failed to delete instance: %v", ctx.explainError(err, ctx.ProjectID))
 	}
 	if wait {
-		if err := ctx.waitForCompletion("zone", "delete image", op.Name, true); err != nil {
+		if err := ctx.waitForCompletion(ctx.ProjectID, "zone", "delete image", op.Name, true); err != nil {
 			return err
 		}
 	}
@@ -209,7 +307,25 @@ func (ctx *Context) IsInstanceRunning(name string) bool {
 	return inst.Status == "RUNNING"
 }
 
-func (ctx *Context) CreateImage(imageName, gcsFile string) error {
+// IsInstancePreempted returns true if a preemptible instance was stopped by GCE.
+// Instances are created without automatic restart, so this also covers termination
+// due to host maintenance, which is the same for our purposes.
+func (ctx *Context) IsInstancePreempted(name string) bool {
+	var inst *compute.Instance
+	err := ctx.apiCall(func() (err error) {
+		inst, err = ctx.computeService.Instances.Get(ctx.ProjectID, ctx.ZoneID, name).Do()
+		return
+	})
+	if err != nil {
+		return false
+	}
+	return inst.Scheduling != nil && inst.Scheduling.Preemptible &&
+		(inst.Status == "STOPPING" || inst.Status == "TERMINATED")
+}
+
+// CreateImage creates image from the gcsFile raw disk archive.
+// If uefi is set, the image is marked as UEFI bootable (required e.g. for arm64 instances).
+func (ctx *Context) CreateImage(imageName, gcsFile string, uefi bool) error {
 	image := &compute.Image{
 		Name: imageName,
 		RawDisk: &compute.ImageRawDisk{
@@ -219,23 +335,26 @@ func (ctx *Context) CreateImage(imageName, gcsFile string) error {
 			"https://www.googleapis.com/compute/v1/projects/vm-options/global/licenses/enable-vmx",
 		},
 	}
+	if uefi {
+		image.GuestOsFeatures = []*compute.GuestOsFeature{{Type: "UEFI_COMPATIBLE"}}
+	}
 	var op *compute.Operation
 	err := ctx.apiCall(func() (err error) {
-		op, err = ctx.computeService.Images.Insert(ctx.ProjectID, image).Do()
+		op, err = ctx.computeService.Images.Insert(ctx.ImageProject, image).Do()
 		return
 	})
 	if err != nil {
 		// Try again without the vmx license in case it is not supported.
 		image.Licenses = nil
 		err := ctx.apiCall(func() (err error) {
-			op, err = ctx.computeService.Images.Insert(ctx.ProjectID, image).Do()
+			op, err = ctx.computeService.Images.Insert(ctx.ImageProject, image).Do()
 			return
 		})
 		if err != nil {
-			return fmt.Errorf("failed to create image: %v", err)
+			return fmt.Errorf("failed to create image: %v", ctx.explainError(err, ctx.ImageProject))
 		}
 	}
-	if err := ctx.waitForCompletion("global", "create image", op.Name, false); err != nil {
+	if err := ctx.waitForCompletion(ctx.ImageProject, "global", "create image", op.Name, false); err != nil {
 		return err
 	}
 	return nil
@@ -244,16 +363,108 @@ func (ctx *Context) CreateImage(imageName, gcsFile string) error {
 func (ctx *Context) DeleteImage(imageName string) error {
 	var op *compute.Operation
 	err := ctx.apiCall(func() (err error) {
-		op, err = ctx.computeService.Images.Delete(ctx.ProjectID, imageName).Do()
+		op, err = ctx.computeService.Images.Delete(ctx.ImageProject, imageName).Do()
+		return
+	})
+	if apiErr, ok := err.(*googleapi.Error); ok && apiErr.Code == 404 {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to delete image: %v", ctx.explainError(err, ctx.ImageProject))
+	}
+	if err := ctx.waitForCompletion(ctx.ImageProject, "global", "delete image", op.Name, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Resource describes a GCE instance, disk or image.
+type Resource struct {
+	Name        string
+	Description string
+	Created     time.Time
+}
+
+// ListInstances returns all instances in the current project/zone.
+func (ctx *Context) ListInstances() ([]*Resource, error) {
+	var res []*Resource
+	err := ctx.apiCall(func() error {
+		res = nil
+		return ctx.computeService.Instances.List(ctx.ProjectID, ctx.ZoneID).Pages(context.Background(),
+			func(list *compute.InstanceList) error {
+				for _, inst := range list.Items {
+					res = append(res, newResource(inst.Name, inst.Description, inst.CreationTimestamp))
+				}
+				return nil
+			})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list instances: %v", ctx.explainError(err, ctx.ProjectID))
+	}
+	return res, nil
+}
+
+// ListDisks returns all disks in the current project/zone.
+func (ctx *Context) ListDisks() ([]*Resource, error) {
+	var res []*Resource
+	err := ctx.apiCall(func() error {
+		res = nil
+		return ctx.computeService.Disks.List(ctx.ProjectID, ctx.ZoneID).Pages(context.Background(),
+			func(list *compute.DiskList) error {
+				for _, disk := range list.Items {
+					res = append(res, newResource(disk.Name, disk.Description, disk.CreationTimestamp))
+				}
+				return nil
+			})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list disks: %v", ctx.explainError(err, ctx.ProjectID))
+	}
+	return res, nil
+}
+
+// ListImages returns all images in the image project.
+func (ctx *Context) ListImages() ([]*Resource, error) {
+	var res []*Resource
+	err := ctx.apiCall(func() error {
+		res = nil
+		return ctx.computeService.Images.List(ctx.ImageProject).Pages(context.Background(),
+			func(list *compute.ImageList) error {
+				for _, image := range list.Items {
+					res = append(res, newResource(image.Name, image.Description, image.CreationTimestamp))
+				}
+				return nil
+			})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list images: %v", ctx.explainError(err, ctx.ImageProject))
+	}
+	return res, nil
+}
+
+func newResource(name, description, created string) *Resource {
+	res := &Resource{
+		Name:        name,
+		Description: description,
+	}
+	// Created stays zero if the timestamp can't be parsed.
+	res.Created, _ = time.Parse(time.RFC3339, created)
+	return res
+}
+
+func (ctx *Context) DeleteDisk(name string) error {
+	var op *compute.Operation
+	err := ctx.apiCall(func() (err error) {
+		op, err = ctx.computeService.Disks.Delete(ctx.ProjectID, ctx.ZoneID, name).Do()
 		return
 	})
 	if apiErr, ok := err.(*googleapi.Error); ok && apiErr.Code == 404 {
 		return nil
 	}
 	if err != nil {
-		return fmt.Errorf("failed to delete image: %v", err)
+		return fmt.Errorf("failed to delete disk: %v", ctx.explainError(err, ctx.ProjectID))
 	}
-	if err := ctx.waitForCompletion("global", "delete image", op.Name, true); err != nil {
+	if err := ctx.waitForCompletion(ctx.ProjectID, "zone", "delete disk", op.Name, true); err != nil {
 		return err
 	}
 	return nil
@@ -265,16 +476,16 @@ func (err resourcePoolExhaustedError) Error() string {
 	return string(err)
 }
 
-func (ctx *Context) waitForCompletion(typ, desc, opName string, ignoreNotFound bool) error {
+func (ctx *Context) waitForCompletion(project, typ, desc, opName string, ignoreNotFound bool) error {
 	for {
 		time.Sleep(2 * time.Second)
 		var op *compute.Operation
 		err := ctx.apiCall(func() (err error) {
 			switch typ {
 			case "global":
-				op, err = ctx.computeService.GlobalOperations.Get(ctx.ProjectID, opName).Do()
+				op, err = ctx.computeService.GlobalOperations.Get(project, opName).Do()
 			case "zone":
-				op, err = ctx.computeService.ZoneOperations.Get(ctx.ProjectID, ctx.ZoneID, opName).Do()
+				op, err = ctx.computeService.ZoneOperations.Get(project, ctx.ZoneID, opName).Do()
 			default:
 				panic("unknown operation type: " + typ)
 			}
@@ -296,6 +507,10 @@ func (ctx *Context) waitForCompletion(typ, desc, opName string, ignoreNotFound b
 					if ignoreNotFound && operr.Code == "RESOURCE_NOT_FOUND" {
 						return nil
 					}
+					if operr.Code == "PERMISSION_DENIED" || operr.Code == "FORBIDDEN" {
+						return fmt.Errorf("%v operation failed: %v", desc,
+							ctx.permissionError(project, fmt.Sprintf("%+v", operr)))
+					}
 					reason += fmt.Sprintf("%+v.", operr)
 				}
 				return fmt.Errorf("%v operation failed: %v", desc, reason)
@@ -307,7 +522,31 @@ func (ctx *Context) waitForCompletion(typ, desc, opName string, ignoreNotFound b
 	}
 }
 
-func (ctx *Context) getMeta(path string) (string, error) {
+// explainError turns permission errors returned by the API into errors that say
+// what project and service account are involved, so that IAM misconfigurations
+// in cross-project setups are easier to diagnose.
+func (ctx *Context) explainError(err error, project string) error {
+	if apiErr, ok := err.(*googleapi.Error); ok && apiErr.Code == http.StatusForbidden {
+		return ctx.permissionError(project, apiErr.Error())
+	}
+	return err
+}
+
+func (ctx *Context) permissionError(project, reason string) error {
+	account := ctx.ServiceAccount
+	if account == "" {
+		account = "the instance service account"
+	}
+	return fmt.Errorf("permission denied in project %v (check that %v has the necessary IAM roles there): %v",
+		project, account, reason)
+}
+
+// GetInstanceAttribute returns value of the custom metadata attribute of the current instance.
+func GetInstanceAttribute(name string) (string, error) {
+	return getMeta("instance/attributes/" + name)
+}
+
+func getMeta(path string) (string, error) {
 	req, err := http.NewRequest("GET", "http://metadata.google.internal/computeMetadata/v1/"+path, nil)
 	if err != nil {
 		return "", err
@@ -322,6 +561,9 @@ func (ctx *Context) getMeta(path string) (string, error) {
 	if err != nil {
 		return "", err
 	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("metadata query %v failed: %v", path, resp.Status)
+	}
 	return string(body), nil
 }
 
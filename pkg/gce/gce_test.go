@@ -0,0 +1,85 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package gce
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestIsInternalIP(t *testing.T) {
+	for addr, want := range map[string]bool{
+		"10.128.0.2":      true,
+		"172.16.0.1":      true,
+		"172.31.255.1":    true,
+		"192.168.1.1":     true,
+		"fd20:ab:cd::1":   true,
+		"::ffff:10.0.0.1": true,
+		"172.32.0.1":      false,
+		"8.8.8.8":         false,
+		"35.1.2.3":        false,
+		"2001:db8::1":     false,
+		"::1":             false,
+		"fe80::1":         false,
+		"":                false,
+		"foo":             false,
+	} {
+		if got := isInternalIP(addr); got != want {
+			t.Errorf("%q: got %v, want %v", addr, got, want)
+		}
+	}
+}
+
+func TestInstanceAddrs(t *testing.T) {
+	tests := []struct {
+		name     string
+		json     string
+		internal string
+		external string
+	}{
+		{
+			name: "ipv4",
+			json: `{"networkInterfaces": [{"networkIP": "10.128.0.2",
+				"accessConfigs": [{"natIP": "35.1.2.3"}]}]}`,
+			internal: "10.128.0.2",
+			external: "35.1.2.3",
+		},
+		{
+			name: "dual-stack",
+			json: `{"networkInterfaces": [{"networkIP": "10.128.0.2", "ipv6Address": "fd20:ab:cd::1",
+				"accessConfigs": [{"natIP": "35.1.2.3"}],
+				"ipv6AccessConfigs": [{"externalIpv6": "2600:1900::1"}]}]}`,
+			internal: "10.128.0.2",
+			external: "35.1.2.3",
+		},
+		{
+			name: "ipv6-only",
+			json: `{"networkInterfaces": [{"ipv6Address": "fd20:ab:cd::1",
+				"ipv6AccessConfigs": [{"externalIpv6": "2600:1900::1"}]}]}`,
+			internal: "fd20:ab:cd::1",
+			external: "2600:1900::1",
+		},
+		{
+			name:     "ipv6-only-internal",
+			json:     `{"networkInterfaces": [{"ipv6Address": "fd20:ab:cd::1"}]}`,
+			internal: "fd20:ab:cd::1",
+		},
+		{
+			name: "no-internal",
+			json: `{"networkInterfaces": [{"networkIP": "35.1.2.3"}]}`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			inst := new(instanceNetwork)
+			if err := json.Unmarshal([]byte(test.json), inst); err != nil {
+				t.Fatal(err)
+			}
+			internal, external := inst.addrs()
+			if internal != test.internal || external != test.external {
+				t.Fatalf("got %q/%q, want %q/%q", internal, external, test.internal, test.external)
+			}
+		})
+	}
+}
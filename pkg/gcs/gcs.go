@@ -13,14 +13,20 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"net/http"
 	"os"
 	"strings"
 	"time"
 
 	"cloud.google.com/go/storage"
+	"google.golang.org/api/googleapi"
 )
 
 type Client struct {
+	// UserProject is the project billed for requests (required for requester pays
+	// buckets owned by other projects). Empty means the bucket owner project.
+	UserProject string
+
 	client *storage.Client
 	ctx    context.Context
 }
@@ -58,11 +64,10 @@ func (client *Client) Read(gcsFile string) (*File, error) {
 	if err != nil {
 		return nil, err
 	}
-	bkt := client.client.Bucket(bucket)
-	f := bkt.Object(filename)
+	f := client.bucket(bucket).Object(filename)
 	attrs, err := f.Attrs(client.ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read %v attributes: %v", gcsFile, err)
+		return nil, fmt.Errorf("failed to read %v attributes: %v", gcsFile, explainError(err, bucket))
 	}
 	if !attrs.Deleted.IsZero() {
 		return nil, fmt.Errorf("file %v is deleted", gcsFile)
@@ -101,12 +106,41 @@ func (client *Client) FileWriter(gcsFile string) (io.WriteCloser, error) {
 	if err != nil {
 		return nil, err
 	}
-	bkt := client.client.Bucket(bucket)
-	f := bkt.Object(filename)
-	w := f.NewWriter(client.ctx)
+	f := client.bucket(bucket).Object(filename)
+	w := &writer{
+		Writer: f.NewWriter(client.ctx),
+		bucket: bucket,
+	}
 	return w, nil
 }
 
+// writer reports permission errors (which are returned only when the file is closed)
+// in a more informative way.
+type writer struct {
+	*storage.Writer
+	bucket string
+}
+
+func (w *writer) Close() error {
+	return explainError(w.Writer.Close(), w.bucket)
+}
+
+func (client *Client) bucket(name string) *storage.BucketHandle {
+	bkt := client.client.Bucket(name)
+	if client.UserProject != "" {
+		bkt = bkt.UserProject(client.UserProject)
+	}
+	return bkt
+}
+
+func explainError(err error, bucket string) error {
+	if apiErr, ok := err.(*googleapi.Error); ok && apiErr.Code == http.StatusForbidden {
+		return fmt.Errorf("permission denied for bucket %v (check IAM roles of the service account"+
+			" in the bucket project, requester pays buckets also need user project): %v", bucket, apiErr)
+	}
+	return err
+}
+
 func split(file string) (bucket, filename string, err error) {
 	pos := strings.IndexByte(file, '/')
 	if pos == -1 {
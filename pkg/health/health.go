@@ -0,0 +1,132 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+// Package health implements standard health endpoints for syzkaller services:
+//
+//	/healthz - liveness: the process works and does not need to be restarted
+//	/readyz  - readiness: all components are up and the service does useful work
+//
+// Both return 200 if all relevant checks pass and 503 otherwise, the body contains
+// JSON-encoded Status with per-component results. The endpoints are suitable
+// for load balancer health checks and Kubernetes liveness/readiness probes.
+package health
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"sync"
+)
+
+type Checker struct {
+	mu     sync.Mutex
+	checks []check
+}
+
+type check struct {
+	name     string
+	liveness bool
+	fn       func() error
+}
+
+type Status struct {
+	OK         bool               `json:"ok"`
+	Components []*ComponentStatus `json:"components"`
+}
+
+type ComponentStatus struct {
+	Name  string `json:"name"`
+	OK    bool   `json:"ok"`
+	Error string `json:"error,omitempty"`
+}
+
+func NewChecker() *Checker {
+	return new(Checker)
+}
+
+// Add adds a component check. All checks are used for readiness,
+// liveness checks are additionally used for liveness.
+// Liveness checks should fail only if restart of the process can fix the problem.
+func (c *Checker) Add(name string, liveness bool, fn func() error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.checks = append(c.checks, check{name, liveness, fn})
+}
+
+// Status runs liveness checks (if liveness is set) or all checks.
+func (c *Checker) Status(liveness bool) *Status {
+	c.mu.Lock()
+	checks := append([]check{}, c.checks...)
+	c.mu.Unlock()
+	status := &Status{OK: true}
+	for _, check := range checks {
+		if liveness && !check.liveness {
+			continue
+		}
+		comp := &ComponentStatus{Name: check.name, OK: true}
+		if err := check.fn(); err != nil {
+			comp.OK = false
+			comp.Error = err.Error()
+			status.OK = false
+		}
+		status.Components = append(status.Components, comp)
+	}
+	return status
+}
+
+// Register registers /healthz and /readyz handlers on mux.
+func (c *Checker) Register(mux *http.ServeMux) {
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		c.serve(w, true)
+	})
+	mux.HandleFunc("/readyz", func(w http.ResponseWriter, r *http.Request) {
+		c.serve(w, false)
+	})
+}
+
+func (c *Checker) serve(w http.ResponseWriter, liveness bool) {
+	status := c.Status(liveness)
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-cache")
+	if !status.OK {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	json.NewEncoder(w).Encode(status)
+}
+
+// Flag is a check that fails with the given error until Set is called.
+type Flag struct {
+	mu  sync.Mutex
+	err error
+}
+
+func NewFlag(err error) *Flag {
+	return &Flag{err: err}
+}
+
+func (f *Flag) Set() {
+	f.mu.Lock()
+	f.err = nil
+	f.mu.Unlock()
+}
+
+func (f *Flag) Check() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.err
+}
+
+// CheckDir returns a check that dir is writable.
+func CheckDir(dir string) func() error {
+	return func() error {
+		f, err := ioutil.TempFile(dir, "healthz")
+		if err != nil {
+			return fmt.Errorf("storage is not writable: %v", err)
+		}
+		f.Close()
+		os.Remove(f.Name())
+		return nil
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package health
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+)
+
+func TestChecker(t *testing.T) {
+	dir, err := ioutil.TempDir("", "syz-health-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	rpc := NewFlag(errors.New("rpc server is not started"))
+	c := NewChecker()
+	c.Add("rpc", true, rpc.Check)
+	c.Add("storage", false, CheckDir(dir))
+	c.Add("vms", false, func() error { return errors.New("no VMs") })
+	mux := http.NewServeMux()
+	c.Register(mux)
+
+	get := func(path string, code int) *Status {
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, httptest.NewRequest("GET", path, nil))
+		if w.Code != code {
+			t.Fatalf("%v: got code %v, want %v", path, w.Code, code)
+		}
+		status := new(Status)
+		if err := json.Unmarshal(w.Body.Bytes(), status); err != nil {
+			t.Fatalf("%v: failed to parse status: %v", path, err)
+		}
+		return status
+	}
+
+	status := get("/healthz", http.StatusServiceUnavailable)
+	if status.OK || len(status.Components) != 1 || status.Components[0].Name != "rpc" ||
+		status.Components[0].Error != "rpc server is not started" {
+		t.Fatalf("bad liveness status: %+v", status.Components[0])
+	}
+	rpc.Set()
+	status = get("/healthz", http.StatusOK)
+	if !status.OK || len(status.Components) != 1 || !status.Components[0].OK {
+		t.Fatalf("bad liveness status: %+v", status)
+	}
+	status = get("/readyz", http.StatusServiceUnavailable)
+	if status.OK || len(status.Components) != 3 {
+		t.Fatalf("bad readiness status: %+v", status)
+	}
+	for _, comp := range status.Components {
+		if comp.OK != (comp.Name != "vms") {
+			t.Fatalf("bad component status: %+v", comp)
+		}
+	}
+	os.RemoveAll(dir)
+	if err := CheckDir(dir)(); err == nil {
+		t.Fatalf("storage check did not fail for missing dir")
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package host
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Fingerprint describes the environment in which programs were executed:
+// executor build, descriptions revision, enabled features, sandbox and LSM profile.
+// It is recorded with crashes and checked when reproducers are replayed,
+// because replaying in a different environment is a frequent reason
+// for crashes that don't reproduce.
+type Fingerprint struct {
+	GitRevision    string   `json:"git_revision"`    // syzkaller revision the executor is built from
+	TargetRevision string   `json:"target_revision"` // descriptions revision
+	Sandbox        string   `json:"sandbox"`
+	Features       []string `json:"features"`      // names of enabled features
+	LSM            string   `json:"lsm,omitempty"` // LSM profile of the kernel and image (see build.CheckLSM)
+}
+
+// FingerprintPrefix is used to embed fingerprints into program files and logs as comments.
+const FingerprintPrefix = "# fingerprint: "
+
+func MakeFingerprint(gitRevision, targetRevision, sandbox string, features *Features) *Fingerprint {
+	fp := &Fingerprint{
+		GitRevision:    gitRevision,
+		TargetRevision: targetRevision,
+		Sandbox:        sandbox,
+	}
+	if features != nil {
+		for _, feat := range features {
+			if feat.Enabled {
+				fp.Features = append(fp.Features, feat.Name)
+			}
+		}
+	}
+	return fp
+}
+
+// String returns single-line serialized form of the fingerprint.
+func (fp *Fingerprint) String() string {
+	data, err := json.Marshal(fp)
+	if err != nil {
+		panic(err)
+	}
+	return string(data)
+}
+
+func ParseFingerprint(data []byte) (*Fingerprint, error) {
+	fp := new(Fingerprint)
+	if err := json.Unmarshal(bytes.TrimSpace(data), fp); err != nil {
+		return nil, fmt.Errorf("failed to parse fingerprint: %v", err)
+	}
+	return fp, nil
+}
+
+// ExtractFingerprint finds fingerprint embedded with FingerprintPrefix in a program file or log.
+// Returns nil if there is no fingerprint.
+func ExtractFingerprint(data []byte) (*Fingerprint, error) {
+	pos := bytes.Index(data, []byte(FingerprintPrefix))
+	if pos == -1 {
+		return nil, nil
+	}
+	data = data[pos+len(FingerprintPrefix):]
+	if nl := bytes.IndexByte(data, '\n'); nl != -1 {
+		data = data[:nl]
+	}
+	return ParseFingerprint(data)
+}
+
+// Mismatch returns human-readable descriptions of differences between the fingerprints,
+// or nil if they match. Empty revisions (e.g. in non-release builds) and LSM profiles
+// (unknown to syz-execprog) are not compared.
+func (fp *Fingerprint) Mismatch(other *Fingerprint) []string {
+	var res []string
+	if fp.GitRevision != "" && other.GitRevision != "" && fp.GitRevision != other.GitRevision {
+		res = append(res, fmt.Sprintf("executor revision: %v vs %v", fp.GitRevision, other.GitRevision))
+	}
+	if fp.TargetRevision != other.TargetRevision {
+		res = append(res, fmt.Sprintf("descriptions revision: %v vs %v", fp.TargetRevision, other.TargetRevision))
+	}
+	if fp.Sandbox != other.Sandbox {
+		res = append(res, fmt.Sprintf("sandbox: %v vs %v", fp.Sandbox, other.Sandbox))
+	}
+	if fp.LSM != "" && other.LSM != "" && fp.LSM != other.LSM {
+		res = append(res, fmt.Sprintf("LSM profile: %v vs %v", fp.LSM, other.LSM))
+	}
+	features := make(map[string]int)
+	for _, feat := range fp.Features {
+		features[feat] |= 1
+	}
+	for _, feat := range other.Features {
+		features[feat] |= 2
+	}
+	var missing, extra []string
+	for _, feat := range fp.Features {
+		if features[feat] == 1 {
+			missing = append(missing, feat)
+		}
+	}
+	for _, feat := range other.Features {
+		if features[feat] == 2 {
+			extra = append(extra, feat)
+		}
+	}
+	if len(missing) != 0 {
+		res = append(res, fmt.Sprintf("features not enabled: %v", strings.Join(missing, ", ")))
+	}
+	if len(extra) != 0 {
+		res = append(res, fmt.Sprintf("features additionally enabled: %v", strings.Join(extra, ", ")))
+	}
+	return res
+}
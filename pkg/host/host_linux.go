@@ -208,10 +208,30 @@ func isSupportedSyzkall(sandbox string, c *prog.Syscall) (bool, string) {
 		return true, ""
 	case "syz_read_part_table":
 		return onlySandboxNone(sandbox)
+	case "syz_sysctl":
+		if !osutil.IsExist("/proc/sys") {
+			return false, "/proc/sys does not exist"
+		}
+		// Under setuid sandbox all writes fail with EPERM.
+		return onlySandboxNoneOrNamespace(sandbox)
+	case "syz_io_uring_setup", "syz_io_uring_submit", "syz_io_uring_complete":
+		return isSupportedIOUring()
 	}
 	panic("unknown syzkall: " + c.Name)
 }
 
+// io_uring_setup has the same number on all supported arches.
+const sysIOUringSetup = 425
+
+func isSupportedIOUring() (bool, string) {
+	// Setup with 0 entries is always invalid, so this does not create an instance.
+	_, _, errno := syscall.Syscall(sysIOUringSetup, 0, 0, 0)
+	if errno == syscall.ENOSYS {
+		return false, "io_uring_setup is not supported (ENOSYS)"
+	}
+	return true, ""
+}
+
 func onlySandboxNone(sandbox string) (bool, string) {
 	if syscall.Getuid() != 0 || sandbox != "none" {
 		return false, "only supported under root with sandbox=none"
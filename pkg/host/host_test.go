@@ -5,6 +5,7 @@ package host
 
 import (
 	"fmt"
+	"reflect"
 	"runtime"
 	"testing"
 
@@ -56,3 +57,64 @@ func TestCheck(t *testing.T) {
 		t.Logf("%-24v: %v", feat.Name, feat.Reason)
 	}
 }
+
+func TestParseModules(t *testing.T) {
+	data := []byte(`vhost_net 24576 0 - Live 0xffffffffa0210000
+tun 45056 1 vhost_net, Live 0xffffffffa01f0000 (E)
+hidden 16384 0 - Live 0x0000000000000000
+
+`)
+	modules, err := parseModules(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []KernelModule{
+		{Name: "vhost_net", Addr: 0xffffffffa0210000, Size: 24576},
+		{Name: "tun", Addr: 0xffffffffa01f0000, Size: 45056},
+	}
+	if !reflect.DeepEqual(modules, want) {
+		t.Fatalf("got modules %+v, want %+v", modules, want)
+	}
+	if _, err := parseModules([]byte("vhost_net 24576\n")); err == nil {
+		t.Fatalf("parsed bad line")
+	}
+}
+
+func TestFingerprint(t *testing.T) {
+	features := &Features{
+		FeatureCoverage:       {Name: "code coverage", Enabled: true},
+		FeatureFaultInjection: {Name: "fault injection", Enabled: true},
+		FeatureLeakChecking:   {Name: "leak checking"},
+	}
+	fp := MakeFingerprint("abcd", "1234", "none", features)
+	data := []byte("# {Threaded:true}\n" + FingerprintPrefix + fp.String() + "\nmmap()\n")
+	fp1, err := ExtractFingerprint(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(fp, fp1) {
+		t.Fatalf("fingerprint changed after serialization:\n%+v\n%+v", fp, fp1)
+	}
+	if diff := fp.Mismatch(fp1); diff != nil {
+		t.Fatalf("same fingerprints mismatch: %v", diff)
+	}
+	if fp2, err := ExtractFingerprint([]byte("mmap()\n")); fp2 != nil || err != nil {
+		t.Fatalf("extracted fingerprint from a program without it: %+v, %v", fp2, err)
+	}
+	fp.LSM = "selinux-enforcing"
+	fp2 := MakeFingerprint("", "5678", "setuid", &Features{
+		FeatureCoverage:     {Name: "code coverage", Enabled: true},
+		FeatureLeakChecking: {Name: "leak checking", Enabled: true},
+	})
+	fp2.LSM = "apparmor-enforcing"
+	want := []string{
+		"descriptions revision: 1234 vs 5678",
+		"sandbox: none vs setuid",
+		"LSM profile: selinux-enforcing vs apparmor-enforcing",
+		"features not enabled: fault injection",
+		"features additionally enabled: leak checking",
+	}
+	if diff := fp.Mismatch(fp2); !reflect.DeepEqual(diff, want) {
+		t.Fatalf("bad mismatch:\n%q\nwant:\n%q", diff, want)
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package host
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// KernelModule describes a kernel module loaded on the machine.
+type KernelModule struct {
+	Name string
+	Addr uint64
+	Size uint64
+}
+
+// KernelModules returns modules loaded into the kernel,
+// or nil if the OS does not provide this information.
+func KernelModules() ([]KernelModule, error) {
+	data, err := ioutil.ReadFile("/proc/modules")
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read /proc/modules: %v", err)
+	}
+	return parseModules(data)
+}
+
+func parseModules(data []byte) ([]KernelModule, error) {
+	var modules []KernelModule
+	for _, line := range strings.Split(string(data), "\n") {
+		// name size refcount deps state addr, e.g.:
+		// vhost_net 24576 0 - Live 0xffffffffa0210000
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) < 6 {
+			return nil, fmt.Errorf("bad /proc/modules line: %q", line)
+		}
+		size, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("bad module size in /proc/modules line %q: %v", line, err)
+		}
+		addr, err := strconv.ParseUint(fields[5], 0, 64)
+		if err != nil {
+			return nil, fmt.Errorf("bad module address in /proc/modules line %q: %v", line, err)
+		}
+		if addr == 0 {
+			// Addresses are hidden by kptr_restrict.
+			continue
+		}
+		modules = append(modules, KernelModule{
+			Name: fields[0],
+			Addr: addr,
+			Size: size,
+		})
+	}
+	return modules, nil
+}
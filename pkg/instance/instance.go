@@ -78,17 +78,30 @@ func (env *Env) BuildSyzkaller(repo, commit string) error {
 	return nil
 }
 
-func (env *Env) BuildKernel(compilerBin, userspaceDir, cmdlineFile, sysctlFile string, kernelConfig []byte) error {
+// BuildKernel builds kernel and image with params, target, kernel source and output dirs
+// are taken from the manager config.
+func (env *Env) BuildKernel(params *build.Params) error {
 	cfg := env.cfg
 	imageDir := filepath.Join(cfg.Workdir, "image")
-	if err := build.Image(cfg.TargetOS, cfg.TargetVMArch, cfg.Type,
-		cfg.KernelSrc, imageDir, compilerBin, userspaceDir,
-		cmdlineFile, sysctlFile, kernelConfig); err != nil {
+	buildParams := *params
+	buildParams.TargetOS = cfg.TargetOS
+	buildParams.TargetArch = cfg.TargetVMArch
+	buildParams.VMType = cfg.Type
+	buildParams.KernelDir = cfg.KernelSrc
+	buildParams.OutputDir = imageDir
+	if err := build.Image(&buildParams); err != nil {
 		return err
 	}
 	return SetConfigImage(cfg, imageDir)
 }
 
+var qemuBootFiles = map[string]string{
+	"kernel":   "kernel",
+	"initrd":   "initrd",
+	"dtb":      "dtb",
+	"firmware": "bios",
+}
+
 func SetConfigImage(cfg *mgrconfig.Config, imageDir string) error {
 	cfg.KernelObj = filepath.Join(imageDir, "obj")
 	cfg.Image = filepath.Join(imageDir, "image")
@@ -96,24 +109,20 @@ func SetConfigImage(cfg *mgrconfig.Config, imageDir string) error {
 		cfg.SSHKey = keyFile
 	}
 	if cfg.Type == "qemu" {
-		kernel := filepath.Join(imageDir, "kernel")
-		if !osutil.IsExist(kernel) {
-			kernel = ""
-		}
-		initrd := filepath.Join(imageDir, "initrd")
-		if !osutil.IsExist(initrd) {
-			initrd = ""
+		// Optional files of the build and the corresponding qemu config params.
+		params := make(map[string]string)
+		for file, param := range qemuBootFiles {
+			if path := filepath.Join(imageDir, file); osutil.IsExist(path) {
+				params[param] = path
+			}
 		}
-		if kernel != "" || initrd != "" {
+		if len(params) != 0 {
 			qemu := make(map[string]interface{})
 			if err := json.Unmarshal(cfg.VM, &qemu); err != nil {
 				return fmt.Errorf("failed to parse qemu config: %v", err)
 			}
-			if kernel != "" {
-				qemu["kernel"] = kernel
-			}
-			if initrd != "" {
-				qemu["initrd"] = initrd
+			for param, path := range params {
+				qemu[param] = path
 			}
 			vmCfg, err := json.Marshal(qemu)
 			if err != nil {
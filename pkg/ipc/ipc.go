@@ -18,6 +18,7 @@ import (
 	"time"
 	"unsafe"
 
+	"github.com/google/syzkaller/pkg/creds"
 	"github.com/google/syzkaller/pkg/osutil"
 	"github.com/google/syzkaller/prog"
 	"github.com/google/syzkaller/sys/targets"
@@ -75,6 +76,9 @@ type ExecOpts struct {
 	Flags     ExecFlags
 	FaultCall int // call index for fault injection (0-based)
 	FaultNth  int // fault n-th operation in the call (0-based)
+	// Credential context to execute the program in (linux, sandbox=none only).
+	// Zero value executes the program with full privileges.
+	Creds creds.Creds
 }
 
 // ExecutorFailure is returned from MakeEnv or from env.Exec when executor terminates by calling fail function.
@@ -164,6 +168,7 @@ type CallInfo struct {
 	Comps         prog.CompMap // per-call comparison operands
 	Errno         int          // call errno (0 if the call was successful)
 	FaultInjected bool
+	Duration      time.Duration // duration of the call as measured by executor
 }
 
 type Env struct {
@@ -389,9 +394,9 @@ func (env *Env) readOutCoverage(p *prog.Prog) (info []CallInfo, err0 error) {
 		return buf.String()
 	}
 	for i := uint32(0); i < ncmd; i++ {
-		var callIndex, callNum, errno, faultInjected, signalSize, coverSize, compsSize uint32
+		var callIndex, callNum, errno, faultInjected, duration, signalSize, coverSize, compsSize uint32
 		if !readOut(&callIndex) || !readOut(&callNum) || !readOut(&errno) ||
-			!readOut(&faultInjected) || !readOut(&signalSize) ||
+			!readOut(&faultInjected) || !readOut(&duration) || !readOut(&signalSize) ||
 			!readOut(&coverSize) || !readOut(&compsSize) {
 			err0 = fmt.Errorf("executor %v: failed to read output coverage", env.pid)
 			return
@@ -416,6 +421,7 @@ func (env *Env) readOutCoverage(p *prog.Prog) (info []CallInfo, err0 error) {
 		}
 		info[callIndex].Errno = int(errno)
 		info[callIndex].FaultInjected = faultInjected != 0
+		info[callIndex].Duration = time.Duration(duration) * time.Microsecond
 		if signalSize > uint32(len(out)) {
 			err0 = fmt.Errorf("executor %v: failed to read output signal: record %v, call %v, signalsize=%v coversize=%v",
 				env.pid, i, callIndex, signalSize, coverSize)
@@ -517,6 +523,9 @@ type executeReq struct {
 	pid       uint64
 	faultCall uint64
 	faultNth  uint64
+	credsUID  uint64
+	credsDrop uint64 // capabilities to drop
+	credsNNP  uint64
 	progSize  uint64
 	// prog follows on pipe or in shmem
 }
@@ -537,6 +546,7 @@ type callReply struct {
 	callNum       uint32
 	errno         uint32
 	faultInjected uint32
+	duration      uint32
 	signalSize    uint32
 	coverSize     uint32
 	compsSize     uint32
@@ -759,8 +769,13 @@ func (c *command) exec(opts *ExecOpts, progData []byte) (output []byte, failed,
 		pid:       uint64(c.pid),
 		faultCall: uint64(opts.FaultCall),
 		faultNth:  uint64(opts.FaultNth),
+		credsUID:  uint64(opts.Creds.UID),
+		credsDrop: opts.Creds.DropCaps,
 		progSize:  uint64(len(progData)),
 	}
+	if opts.Creds.NoNewPrivs {
+		req.credsNNP = 1
+	}
 	reqData := (*[unsafe.Sizeof(*req)]byte)(unsafe.Pointer(req))[:]
 	if _, err := c.outwp.Write(reqData); err != nil {
 		output = <-c.readDone
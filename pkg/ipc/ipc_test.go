@@ -90,6 +90,9 @@ func TestExecute(t *testing.T) {
 			if info[0].Errno != 0 {
 				t.Fatalf("simple call failed: %v\n%s", info[0].Errno, output)
 			}
+			if info[0].Duration > timeout {
+				t.Fatalf("bad call duration %v", info[0].Duration)
+			}
 			if len(output) != 0 {
 				t.Fatalf("output on empty program")
 			}
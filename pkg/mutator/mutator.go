@@ -0,0 +1,169 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+// Package mutator allows to plug custom program mutation strategies into syz-fuzzer.
+// Strategies register themselves with Register in init functions and are linked into syz-fuzzer
+// by a blank import of their package in a syz-fuzzer source file (optionally under a build tag,
+// then syz-fuzzer is built with make GOTAGS=tag), so that it's possible to experiment
+// with mutations without changing the fuzzing loop.
+// Enabled strategies are selected with mutators manager config parameter.
+package mutator
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"sync/atomic"
+
+	"github.com/google/syzkaller/prog"
+)
+
+// Strategy is a program mutation strategy.
+// Strategies are used concurrently by all fuzzer procs.
+type Strategy interface {
+	// Mutate mutates p in place. p is a copy of a corpus program.
+	Mutate(p *prog.Prog, r *rand.Rand, ctx *Context)
+	// Feedback is called after execution of every program mutated by the strategy
+	// with indexes of calls that produced new coverage signal.
+	Feedback(p *prog.Prog, newSignalCalls []int)
+}
+
+// Context is the fuzzer state that is available to strategies. It must not be modified.
+type Context struct {
+	NCalls      int // max number of calls in a program
+	ChoiceTable *prog.ChoiceTable
+	Corpus      []*prog.Prog
+}
+
+type ctorFunc func(target *prog.Target) (Strategy, error)
+
+type registration struct {
+	weight int
+	ctor   ctorFunc
+}
+
+var strategies = make(map[string]registration)
+
+// Default is the name of the strategy that uses prog.Mutate.
+const Default = "default"
+
+// DefaultWeight is the weight of the default strategy.
+const DefaultWeight = 100
+
+// Register registers a new strategy. weight is the relative frequency of the strategy
+// among all enabled strategies (see DefaultWeight).
+func Register(name string, weight int, ctor ctorFunc) {
+	if _, ok := strategies[name]; ok {
+		panic(fmt.Sprintf("mutator %v is registered twice", name))
+	}
+	if weight <= 0 {
+		panic(fmt.Sprintf("mutator %v has bad weight %v", name, weight))
+	}
+	strategies[name] = registration{weight, ctor}
+}
+
+// Names returns sorted names of all registered strategies.
+func Names() []string {
+	var names []string
+	for name := range strategies {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Set is a set of enabled strategies.
+// It chooses strategies for mutations according to their weights
+// and collects effectiveness stats of the strategies.
+type Set struct {
+	strategies  []*strategy
+	totalWeight int
+}
+
+type strategy struct {
+	Strategy
+	name      string
+	weight    int
+	execs     uint64 // number of mutated programs
+	newSignal uint64 // number of mutated programs that produced new signal
+}
+
+// New creates a set of strategies with the given names (all registered strategies if names is empty).
+func New(target *prog.Target, names []string) (*Set, error) {
+	if len(names) == 0 {
+		names = Names()
+	}
+	set := new(Set)
+	dup := make(map[string]bool)
+	for _, name := range names {
+		reg, ok := strategies[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown mutator %v (registered: %v)", name, Names())
+		}
+		if dup[name] {
+			return nil, fmt.Errorf("duplicate mutator %v", name)
+		}
+		dup[name] = true
+		s, err := reg.ctor(target)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create mutator %v: %v", name, err)
+		}
+		set.strategies = append(set.strategies, &strategy{
+			Strategy: s,
+			name:     name,
+			weight:   reg.weight,
+		})
+		set.totalWeight += reg.weight
+	}
+	return set, nil
+}
+
+// Mutate mutates p with a randomly chosen strategy and returns a callback
+// that must be invoked with calls that produced new signal after execution of p.
+func (set *Set) Mutate(p *prog.Prog, r *rand.Rand, ctx *Context) func(newSignalCalls []int) {
+	s := set.choose(r)
+	s.Mutate(p, r, ctx)
+	return func(newSignalCalls []int) {
+		atomic.AddUint64(&s.execs, 1)
+		if len(newSignalCalls) != 0 {
+			atomic.AddUint64(&s.newSignal, 1)
+		}
+		s.Feedback(p, newSignalCalls)
+	}
+}
+
+func (set *Set) choose(r *rand.Rand) *strategy {
+	v := r.Intn(set.totalWeight)
+	for _, s := range set.strategies {
+		if v < s.weight {
+			return s
+		}
+		v -= s.weight
+	}
+	panic("bad strategy weights")
+}
+
+// Stats returns stats of the strategies since the previous call.
+func (set *Set) Stats() map[string]uint64 {
+	stats := make(map[string]uint64)
+	for _, s := range set.strategies {
+		stats[fmt.Sprintf("mutator %v execs", s.name)] = atomic.SwapUint64(&s.execs, 0)
+		stats[fmt.Sprintf("mutator %v new signal", s.name)] = atomic.SwapUint64(&s.newSignal, 0)
+	}
+	return stats
+}
+
+type defaultStrategy struct{}
+
+func (defaultStrategy) Mutate(p *prog.Prog, r *rand.Rand, ctx *Context) {
+	p.Mutate(r, ctx.NCalls, ctx.ChoiceTable, ctx.Corpus)
+}
+
+func (defaultStrategy) Feedback(p *prog.Prog, newSignalCalls []int) {
+}
+
+func init() {
+	Register(Default, DefaultWeight, func(target *prog.Target) (Strategy, error) {
+		return defaultStrategy{}, nil
+	})
+}
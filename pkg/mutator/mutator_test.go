@@ -0,0 +1,136 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package mutator
+
+import (
+	"bytes"
+	"math/rand"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/syzkaller/prog"
+	_ "github.com/google/syzkaller/sys"
+)
+
+type testStrategy struct {
+	mutated  int
+	feedback [][]int
+}
+
+func (s *testStrategy) Mutate(p *prog.Prog, r *rand.Rand, ctx *Context) {
+	s.mutated++
+}
+
+func (s *testStrategy) Feedback(p *prog.Prog, newSignalCalls []int) {
+	s.feedback = append(s.feedback, newSignalCalls)
+}
+
+var testStrategies = make(map[string]*testStrategy)
+
+func init() {
+	for _, name := range []string{"test1", "test2"} {
+		name := name
+		Register(name, DefaultWeight, func(target *prog.Target) (Strategy, error) {
+			s := new(testStrategy)
+			testStrategies[name] = s
+			return s, nil
+		})
+	}
+}
+
+func TestNames(t *testing.T) {
+	if got, want := Names(), []string{Default, "test1", "test2"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got names %v, want %v", got, want)
+	}
+}
+
+func TestNew(t *testing.T) {
+	target, err := prog.GetTarget("test", "64")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, names := range [][]string{{"foo"}, {"test1", "test1"}} {
+		if _, err := New(target, names); err == nil {
+			t.Errorf("created mutators %v", names)
+		}
+	}
+	set, err := New(target, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(set.strategies) != 3 {
+		t.Fatalf("created %v strategies, want 3", len(set.strategies))
+	}
+}
+
+func TestMutate(t *testing.T) {
+	target, err := prog.GetTarget("test", "64")
+	if err != nil {
+		t.Fatal(err)
+	}
+	set, err := New(target, []string{"test1", "test2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	seed := time.Now().UnixNano()
+	t.Logf("seed=%v", seed)
+	r := rand.New(rand.NewSource(seed))
+	const iters = 1000
+	for i := 0; i < iters; i++ {
+		p := &prog.Prog{Target: target}
+		feedback := set.Mutate(p, r, &Context{})
+		if i%2 == 0 {
+			feedback([]int{i})
+		} else {
+			feedback(nil)
+		}
+	}
+	s1, s2 := testStrategies["test1"], testStrategies["test2"]
+	if s1.mutated+s2.mutated != iters || s1.mutated < iters/4 || s2.mutated < iters/4 {
+		t.Fatalf("bad distribution of mutations: %v/%v", s1.mutated, s2.mutated)
+	}
+	if len(s1.feedback) != s1.mutated || len(s2.feedback) != s2.mutated {
+		t.Fatalf("missing feedback")
+	}
+	stats := set.Stats()
+	if stats["mutator test1 execs"]+stats["mutator test2 execs"] != iters ||
+		stats["mutator test1 new signal"]+stats["mutator test2 new signal"] != iters/2 ||
+		stats["mutator test1 execs"] != uint64(s1.mutated) {
+		t.Fatalf("bad stats: %v", stats)
+	}
+	for name, v := range set.Stats() {
+		if v != 0 {
+			t.Fatalf("stat %v is not reset: %v", name, v)
+		}
+	}
+}
+
+func TestDefault(t *testing.T) {
+	target, err := prog.GetTarget("test", "64")
+	if err != nil {
+		t.Fatal(err)
+	}
+	set, err := New(target, []string{Default})
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	ct := target.BuildChoiceTable(nil, nil)
+	mutated := 0
+	for i := 0; i < 100; i++ {
+		p := target.Generate(r, 10, ct)
+		data := p.Serialize()
+		set.Mutate(p, r, &Context{NCalls: 10, ChoiceTable: ct})(nil)
+		if _, err := target.Deserialize(p.Serialize()); err != nil {
+			t.Fatalf("failed to deserialize mutated program: %v\n%s", err, p.Serialize())
+		}
+		if !bytes.Equal(data, p.Serialize()) {
+			mutated++
+		}
+	}
+	if mutated < 50 {
+		t.Fatalf("only %v programs out of 100 are mutated", mutated)
+	}
+}
@@ -10,6 +10,9 @@ import (
 	"os/exec"
 )
 
+func UmountAll(dir string) {
+}
+
 func prolongPipe(r, w *os.File) {
 }
 
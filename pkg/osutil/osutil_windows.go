@@ -7,12 +7,77 @@ package osutil
 
 import (
 	"fmt"
+	"io"
+	"io/ioutil"
 	"os"
 	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"strconv"
 	"syscall"
 )
 
+// ProcessTempDir creates a new temp dir in where and returns its path.
+// Windows does not have flock, so we rely on atomicity of directory creation
+// and check liveness of owners of old dirs with OpenProcess.
+func ProcessTempDir(where string) (string, error) {
+	for i := 0; i < 1e3; i++ {
+		path := filepath.Join(where, fmt.Sprintf("instance-%v", i))
+		pidfile := filepath.Join(path, ".pid")
+		err := os.Mkdir(path, DefaultDirPerm)
+		if os.IsExist(err) {
+			// Try to clean up.
+			data, err := ioutil.ReadFile(pidfile)
+			if err == nil && len(data) > 0 {
+				pid, err := strconv.Atoi(string(data))
+				if err == nil && pid > 0 {
+					p, err := os.FindProcess(pid)
+					if err == nil {
+						p.Release()
+					} else if os.Remove(pidfile) == nil {
+						if os.RemoveAll(path) == nil {
+							i--
+							continue
+						}
+					}
+				}
+			}
+			continue
+		}
+		if err != nil {
+			return "", err
+		}
+		if err := WriteFile(pidfile, []byte(strconv.Itoa(syscall.Getpid()))); err != nil {
+			return "", err
+		}
+		return path, nil
+	}
+	return "", fmt.Errorf("too many live instances")
+}
+
+// HandleInterrupts closes shutdown chan on first Ctrl+C
+// and terminates the process on third Ctrl+C.
 func HandleInterrupts(shutdown chan struct{}) {
+	go func() {
+		c := make(chan os.Signal, 3)
+		signal.Notify(c, os.Interrupt)
+		<-c
+		close(shutdown)
+		fmt.Fprint(os.Stderr, "SIGINT: shutting down...\n")
+		<-c
+		fmt.Fprint(os.Stderr, "SIGINT: shutting down harder...\n")
+		<-c
+		fmt.Fprint(os.Stderr, "SIGINT: terminating\n")
+		os.Exit(int(syscall.SIGINT))
+	}()
+}
+
+func LongPipe() (io.ReadCloser, io.WriteCloser, error) {
+	r, w, err := os.Pipe()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create pipe: %v", err)
+	}
+	return r, w, err
 }
 
 func UmountAll(dir string) {
@@ -0,0 +1,144 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package report
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Crashes with different titles frequently have the same root cause
+// (e.g. the same use-after-free reached via different syscalls or detected at different accesses).
+// Such crashes have similar stacks, so grouping them by stack similarity cuts duplicate triage work.
+
+var (
+	reportFrameRe = regexp.MustCompile(`(\? )?([a-zA-Z0-9_]+)(?:\.[a-z]+\.[0-9]+)?\+0x[0-9a-f]+`)
+	clusterSkipRe = regexp.MustCompile(strings.Join(clusterSkipPatterns(), "|"))
+)
+
+// clusterSkipPatterns returns frames that are present in most reports and don't say anything about the bug:
+// the same frames that are skipped when titles are extracted, plus allocator and syscall entry frames.
+func clusterSkipPatterns() []string {
+	skip := append([]string{}, linuxStackParams.skipPatterns...)
+	skip = append(skip, linuxMemoryAllocSkip...)
+	return append(skip,
+		"kfree",
+		"slab_free",
+		"cache_free",
+		"save_stack",
+		"set_track",
+		"show_stack",
+		"do_syscall",
+		"entry_SYSCALL",
+		"entry_INT80",
+		"SyS_",
+		"SYSC_",
+		"__x64_sys",
+		"__ia32_sys",
+		"ret_from_fork",
+		"kthread",
+		"worker_thread",
+		"process_one_work",
+	)
+}
+
+// maxClusterFrames limits the number of frames taken from a report,
+// top frames are the most specific to the bug and the rest is mostly noise.
+const maxClusterFrames = 32
+
+// StackFrames returns function names from stack traces in the report in order of appearance.
+// Unreliable frames (marked with '?') and frames common to all reports are skipped.
+func StackFrames(report []byte) []string {
+	return extractFrames(report, clusterSkipRe, maxClusterFrames)
+}
+
+// extractFrames returns reliable frames of the report that don't match skip (if not nil),
+// consecutive duplicates are removed. If max is not 0, at most max frames are returned.
+func extractFrames(report []byte, skip *regexp.Regexp, max int) []string {
+	var frames []string
+	for _, match := range reportFrameRe.FindAllSubmatch(report, -1) {
+		if len(match[1]) != 0 {
+			continue
+		}
+		frame := string(match[2])
+		if skip != nil && skip.MatchString(frame) {
+			continue
+		}
+		if len(frames) != 0 && frames[len(frames)-1] == frame {
+			continue
+		}
+		frames = append(frames, frame)
+		if len(frames) == max {
+			break
+		}
+	}
+	return frames
+}
+
+// StackSimilarity returns similarity of two stacks in [0, 1],
+// which is the Jaccard index of the sets of their frames.
+func StackSimilarity(a, b []string) float64 {
+	set := make(map[string]bool)
+	for _, frame := range a {
+		set[frame] = true
+	}
+	common, union := 0, len(set)
+	seen := make(map[string]bool)
+	for _, frame := range b {
+		if seen[frame] {
+			continue
+		}
+		seen[frame] = true
+		if set[frame] {
+			common++
+		} else {
+			union++
+		}
+	}
+	if union == 0 {
+		return 0
+	}
+	return float64(common) / float64(union)
+}
+
+// ClusterStacks groups stacks that have similarity of at least threshold with some other stack
+// in the group (single-linkage clustering). Returns groups of more than one stack
+// as indexes into stacks, ordered by the first index. Empty stacks are never grouped.
+func ClusterStacks(stacks [][]string, threshold float64) [][]int {
+	parent := make([]int, len(stacks))
+	for i := range parent {
+		parent[i] = i
+	}
+	var find func(i int) int
+	find = func(i int) int {
+		if parent[i] != i {
+			parent[i] = find(parent[i])
+		}
+		return parent[i]
+	}
+	for i := range stacks {
+		for j := i + 1; j < len(stacks); j++ {
+			if find(i) == find(j) || StackSimilarity(stacks[i], stacks[j]) < threshold {
+				continue
+			}
+			parent[find(j)] = find(i)
+		}
+	}
+	groups := make(map[int][]int)
+	var roots []int
+	for i := range stacks {
+		root := find(i)
+		if groups[root] == nil {
+			roots = append(roots, root)
+		}
+		groups[root] = append(groups[root], i)
+	}
+	var clusters [][]int
+	for _, root := range roots {
+		if len(groups[root]) > 1 {
+			clusters = append(clusters, groups[root])
+		}
+	}
+	return clusters
+}
@@ -0,0 +1,49 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package report
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestStackFrames(t *testing.T) {
+	rep := `
+BUG: KASAN: use-after-free in sctp_outq_tail+0x4f2/0x560 net/sctp/outqueue.c:293
+Call Trace:
+ __dump_stack lib/dump_stack.c:17 [inline]
+ dump_stack+0x194/0x257 lib/dump_stack.c:53
+ print_address_description+0x73/0x250 mm/kasan/report.c:252
+ kasan_report+0x23b/0x360 mm/kasan/report.c:409
+ sctp_outq_tail+0x4f2/0x560 net/sctp/outqueue.c:293
+ sctp_outq_tail+0x4f2/0x560 net/sctp/outqueue.c:293
+ ? sctp_sendmsg+0x100/0x200
+ sctp_sendmsg.isra.12+0x1a/0x20 net/sctp/socket.c:2013
+ SYSC_sendto+0x1a/0x30 net/socket.c:1700
+ entry_SYSCALL_64_fastpath+0x1f/0x96
+`
+	want := []string{"sctp_outq_tail", "sctp_sendmsg"}
+	if got := StackFrames([]byte(rep)); !reflect.DeepEqual(got, want) {
+		t.Fatalf("got frames %q, want %q", got, want)
+	}
+}
+
+func TestClusterStacks(t *testing.T) {
+	stacks := [][]string{
+		{"sctp_outq_tail", "sctp_sendmsg", "sock_sendmsg"},
+		{"tcp_v4_rcv", "ip_local_deliver"},
+		{"sctp_outq_tail", "sctp_sendmsg", "sock_write_iter"},
+		nil,
+		{"tcp_v4_rcv", "ip_local_deliver", "ip_rcv"},
+		{"ext4_readdir"},
+		nil,
+	}
+	want := [][]int{{0, 2}, {1, 4}}
+	if got := ClusterStacks(stacks, 0.5); !reflect.DeepEqual(got, want) {
+		t.Fatalf("got clusters %v, want %v", got, want)
+	}
+	if sim := StackSimilarity(stacks[0], stacks[2]); sim != 0.5 {
+		t.Fatalf("got similarity %v, want 0.5", sim)
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package report
+
+import (
+	"regexp"
+)
+
+// Managers that fuzz different kernel builds (compilers, configs, architectures) hit the same bug
+// with slightly different titles: function names get compiler clone suffixes (.isra.N, .constprop.N),
+// syscall entry points are named differently (__x64_sys_foo, __ia32_sys_foo, SyS_foo, sys_foo), etc.
+// DedupTitle maps such titles to the same title, so that these crashes end up in the same crash dir
+// and the same dashboard bug.
+
+var dedupTitleReplacement = []replacement{
+	{
+		// Compiler clone suffixes: foo.isra.12, foo.constprop.0, foo.cold.3, foo.llvm.ADDR.
+		regexp.MustCompile(`([a-zA-Z0-9_])\.(?:isra|constprop|part|cold|clone|llvm)(?:\.(?:[0-9]+|NUM|ADDR))*`),
+		"${1}",
+	},
+	{
+		// Arch/version-specific syscall entry points: __x64_sys_foo, __se_sys_foo, SyS_foo, SYSC_foo -> sys_foo.
+		regexp.MustCompile(`\b(?:__(?:x64|ia32|x32|arm64|se|do)_)?(compat_)?(?:sys|SyS|SYSC)_([a-zA-Z0-9_]+)`),
+		"${1}sys_${2}",
+	},
+	{
+		// Kernel worker names contain CPU/pool numbers: kworker/u4:2, kworker/0:1H.
+		regexp.MustCompile(`kworker/[^ ]+`),
+		"kworker",
+	},
+}
+
+// DedupTitle returns normalized crash title that is the same for the same bug
+// across kernel builds and managers. It is idempotent.
+func DedupTitle(title string) string {
+	title = replaceTable(dynamicTitleReplacement, title)
+	title = replaceTable(dedupTitleReplacement, title)
+	return sanitizeTitle(title)
+}
@@ -0,0 +1,65 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package report
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/google/syzkaller/syz-manager/mgrconfig"
+)
+
+func TestDedupTitle(t *testing.T) {
+	tests := map[string]string{
+		"KASAN: use-after-free Read in sctp_outq_tail":              "KASAN: use-after-free Read in sctp_outq_tail",
+		"KASAN: use-after-free Read in sctp_sendmsg.isra.12":        "KASAN: use-after-free Read in sctp_sendmsg",
+		"WARNING in ip6_fragment.constprop.3":                       "WARNING in ip6_fragment",
+		"BUG: unable to handle kernel paging request in foo.cold.1": "BUG: unable to handle kernel paging request in foo",
+		"general protection fault in bar.llvm.1234567890123":        "general protection fault in bar",
+		"WARNING in __x64_sys_ioctl":                                "WARNING in sys_ioctl",
+		"WARNING in __ia32_sys_ioctl":                               "WARNING in sys_ioctl",
+		"WARNING in SyS_ioctl":                                      "WARNING in sys_ioctl",
+		"WARNING in SYSC_ioctl":                                     "WARNING in sys_ioctl",
+		"WARNING in __se_sys_ioctl":                                 "WARNING in sys_ioctl",
+		"WARNING in __ia32_compat_sys_ioctl":                        "WARNING in compat_sys_ioctl",
+		"WARNING in do_sys_open":                                    "WARNING in do_sys_open",
+		"WARNING in ksys_read":                                      "WARNING in ksys_read",
+		"INFO: task hung in kworker/u4:2":                           "INFO: task hung in kworker",
+		"possible deadlock in ext4_file_write_iter":                 "possible deadlock in ext4_file_write_iter",
+		"BUG: bad unlock balance in foo at addr ffff88003a2b1c40":   "BUG: bad unlock balance in foo at addr ADDR",
+		"WARNING: kernel/foo.c:123 bar":                             "WARNING: kernel/foo.c:LINE bar",
+		"inconsistent lock state in   syz-executor3/4567":           "inconsistent lock state in syz-executor",
+		"KASAN: slab-out-of-bounds Write in part_round_stats":       "KASAN: slab-out-of-bounds Write in part_round_stats",
+	}
+	for title, want := range tests {
+		got := DedupTitle(title)
+		if got != want {
+			t.Errorf("title %q: got %q, want %q", title, got, want)
+		}
+		if again := DedupTitle(got); again != got {
+			t.Errorf("title %q: not idempotent: %q -> %q", title, got, again)
+		}
+	}
+}
+
+func TestParseDedupTitle(t *testing.T) {
+	reporter, err := NewReporter(&mgrconfig.Config{TargetOS: "linux"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	rep := reporter.Parse([]byte(`
+[   29.161436] ==================================================================
+[   29.161602] BUG: KASAN: use-after-free in SyS_ioctl+0x8c/0xa0
+[   29.161689] Read of size 8 at addr ffff88006a9b3a08 by task syz-executor0/4023
+`))
+	if rep == nil {
+		t.Fatalf("no report")
+	}
+	if want := "KASAN: use-after-free Read in sys_ioctl"; rep.Title != want {
+		t.Fatalf("got title %q, want %q", rep.Title, want)
+	}
+	if want := []string{"KASAN: use-after-free Read in SyS_ioctl"}; !reflect.DeepEqual(rep.AltTitles, want) {
+		t.Fatalf("got alt titles %q, want %q", rep.AltTitles, want)
+	}
+}
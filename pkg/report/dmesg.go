@@ -0,0 +1,83 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package report
+
+import (
+	"bytes"
+	"regexp"
+
+	"github.com/google/syzkaller/syz-manager/mgrconfig"
+)
+
+// DmesgScanner finds non-fatal, but worrying kernel messages in console output
+// (e.g. page allocation stalls, filesystem errors, device resets).
+// Such messages don't stop the VM and are not crashes, but a sudden increase in their number
+// or a new kind of message after a kernel update usually means that something is broken.
+// DmesgScanner is stateful (it buffers incomplete lines), so a separate scanner is needed
+// for every console output stream.
+type DmesgScanner struct {
+	patterns []*dmesgPattern
+	line     []byte
+}
+
+type dmesgPattern struct {
+	re *regexp.Regexp
+	// title is expanded with submatches of re (see regexp.Regexp.Expand).
+	title string
+}
+
+var dmesgPatterns = map[string][]*dmesgPattern{
+	"linux": linuxDmesgPatterns,
+}
+
+// maxDmesgLine limits length of buffered incomplete lines.
+const maxDmesgLine = 4 << 10
+
+// NewDmesgScanner creates scanner for the specified OS/Type.
+// The scanner does not find anything for OSes without known messages.
+func NewDmesgScanner(cfg *mgrconfig.Config) *DmesgScanner {
+	typ := cfg.TargetOS
+	if cfg.Type == "gvisor" {
+		typ = cfg.Type
+	}
+	return &DmesgScanner{patterns: dmesgPatterns[typ]}
+}
+
+// Scan consumes next chunk of console output and returns titles of messages
+// found in complete lines of the output (one title per line).
+func (s *DmesgScanner) Scan(output []byte) []string {
+	if len(s.patterns) == 0 {
+		return nil
+	}
+	var titles []string
+	for len(output) != 0 {
+		pos := bytes.IndexByte(output, '\n')
+		if pos == -1 {
+			s.line = append(s.line, output...)
+			if len(s.line) > maxDmesgLine {
+				s.line = s.line[:0]
+			}
+			break
+		}
+		line := output[:pos]
+		if len(s.line) != 0 {
+			line = append(s.line, line...)
+			s.line = s.line[:0]
+		}
+		output = output[pos+1:]
+		if title := s.match(line); title != "" {
+			titles = append(titles, title)
+		}
+	}
+	return titles
+}
+
+func (s *DmesgScanner) match(line []byte) string {
+	for _, p := range s.patterns {
+		if match := p.re.FindSubmatchIndex(line); match != nil {
+			return string(p.re.Expand(nil, []byte(p.title), line, match))
+		}
+	}
+	return ""
+}
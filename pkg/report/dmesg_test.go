@@ -0,0 +1,60 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package report
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/google/syzkaller/syz-manager/mgrconfig"
+)
+
+func TestDmesgScanner(t *testing.T) {
+	output := `
+[   42.105103] syz-executor0: page allocation stalls for 10004ms, order:0, mode:0x14200ca(GFP_HIGHUSER_MOVABLE)
+[   43.181722] EXT4-fs error (device loop0): ext4_fill_super:4321: comm syz-executor1: bad inode
+[   43.181800] FAT-fs (loop1): error, fat_get_cluster: invalid cluster chain (i_pos 0)
+[   43.181900] XFS (loop2): Metadata corruption detected at xfs_agf_read_verify+0x6c/0x150
+[   44.200000] blk_update_request: I/O error, dev loop3, sector 0
+[   45.300000] ata1: hard resetting link
+[   45.400000] usb 1-1: reset high-speed USB device number 2 using ehci-pci
+[   45.500000] nvme nvme0: I/O 12 QID 0 timeout, reset controller
+[   45.600000] e1000 0000:00:03.0 eth0: Reset adapter
+[   46.000000] syz-executor0: some harmless message
+[   47.000000] BTRFS error (device loop4): bad tree block start
+`
+	want := []string{
+		"page allocation stall",
+		"EXT4-fs error",
+		"FAT-fs error",
+		"XFS error",
+		"block I/O error",
+		"ata link reset",
+		"usb device reset",
+		"nvme controller reset",
+		"e1000 adapter reset",
+		"BTRFS error",
+	}
+	// Feed output in small chunks to check that lines split across chunks are matched.
+	for _, chunk := range []int{1, 7, 100, len(output)} {
+		s := NewDmesgScanner(&mgrconfig.Config{TargetOS: "linux"})
+		var got []string
+		data := []byte(output)
+		for len(data) != 0 {
+			n := chunk
+			if n > len(data) {
+				n = len(data)
+			}
+			got = append(got, s.Scan(data[:n])...)
+			data = data[n:]
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("chunk %v: got titles:\n%q\nwant:\n%q", chunk, got, want)
+		}
+	}
+	s := NewDmesgScanner(&mgrconfig.Config{TargetOS: "freebsd"})
+	if got := s.Scan([]byte(output)); len(got) != 0 {
+		t.Fatalf("freebsd scanner found %q", got)
+	}
+}
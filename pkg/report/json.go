@@ -0,0 +1,161 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// JSONVersion is the version of the JSON representation of crash reports (JSONReport).
+// New fields can be added without changing the version, but removal or renaming of fields
+// and changes in their meaning require a new version, so that tools can detect incompatible data.
+const JSONVersion = 1
+
+// JSONReport is a stable machine-readable representation of a parsed crash report,
+// it is stored by syz-manager along with text reports and uploaded to dashboard.
+// See docs/crash_json.md for description of the fields.
+type JSONReport struct {
+	Version         int               `json:"version"`
+	Type            string            `json:"type"`
+	Title           string            `json:"title"`
+	Corrupted       bool              `json:"corrupted"`
+	CorruptedReason string            `json:"corrupted_reason,omitempty"`
+	Frames          []string          `json:"frames"`
+	Annotations     map[string]string `json:"annotations,omitempty"`
+	Lockdep         *Lockdep          `json:"lockdep,omitempty"`
+	Maintainers     []string          `json:"maintainers,omitempty"`
+	BuildTag        string            `json:"build_tag,omitempty"`
+	Report          string            `json:"report"`
+}
+
+// Crash types in JSONReport.Type.
+const (
+	TypeUnknown    = "UNKNOWN"
+	TypeKASAN      = "KASAN"
+	TypeKMSAN      = "KMSAN"
+	TypeUBSAN      = "UBSAN"
+	TypeGPF        = "GPF"
+	TypeBUG        = "BUG"
+	TypeWARNING    = "WARNING"
+	TypeLOCKDEP    = "LOCKDEP"
+	TypeHang       = "HANG"
+	TypeMemoryLeak = "MEMORY_LEAK"
+	TypeOOM        = "OOM"
+	TypePanic      = "PANIC"
+)
+
+// titleTypes maps title prefixes to crash types, the first match wins.
+var titleTypes = []struct {
+	prefix string
+	typ    string
+}{
+	{"KASAN:", TypeKASAN},
+	{"KMSAN:", TypeKMSAN},
+	{"UBSAN:", TypeUBSAN},
+	{"general protection fault", TypeGPF},
+	{"possible deadlock", TypeLOCKDEP},
+	{"inconsistent lock state", TypeLOCKDEP},
+	{"suspicious RCU usage", TypeLOCKDEP},
+	{"INFO: possible", TypeLOCKDEP},
+	{"INFO: task hung", TypeHang},
+	{"INFO: rcu detected stall", TypeHang},
+	{"INFO: rcu_sched", TypeHang},
+	{"INFO: rcu_preempt", TypeHang},
+	{"BUG: soft lockup", TypeHang},
+	{"memory leak", TypeMemoryLeak},
+	{"out of memory", TypeOOM},
+	{"page allocation failure", TypeOOM},
+	{"memcg OOM", TypeOOM},
+	{"WARNING", TypeWARNING},
+	{"BUG:", TypeBUG},
+	{"kernel BUG", TypeBUG},
+	{"unable to handle kernel", TypeBUG},
+	{"kernel panic", TypePanic},
+	{"KERNEL PANIC", TypePanic},
+	{"panic:", TypePanic},
+	{"Fatal trap", TypePanic},
+}
+
+// CrashType returns crash type for the report title, one of Type* constants.
+func CrashType(title string) string {
+	for _, tt := range titleTypes {
+		if strings.HasPrefix(title, tt.prefix) {
+			return tt.typ
+		}
+	}
+	return TypeUnknown
+}
+
+// Annotation keys in JSONReport.Annotations.
+const (
+	// Type of the bad memory access ("Read" or "Write") and its size for sanitizer reports.
+	AnnotationAccessType = "access_type"
+	AnnotationAccessSize = "access_size"
+	// Name of the task that crashed.
+	AnnotationTask = "task"
+	// Source file that most likely caused the crash (Linux only).
+	AnnotationGuiltyFile = "guilty_file"
+)
+
+var (
+	annotationAccessRe = regexp.MustCompile(`(Read|Write) of size ([0-9]+)`)
+	annotationTaskRe   = regexp.MustCompile(`by task ([^ /\n]+)`)
+)
+
+// NewJSONReport creates JSON representation of the report.
+// reporter is used to extract additional annotations and can be nil.
+func NewJSONReport(reporter Reporter, rep *Report, buildTag string) *JSONReport {
+	res := &JSONReport{
+		Version:         JSONVersion,
+		Type:            CrashType(rep.Title),
+		Title:           rep.Title,
+		Corrupted:       rep.Corrupted,
+		CorruptedReason: rep.corruptedReason,
+		Frames:          extractFrames(rep.Report, nil, 0),
+		Annotations:     make(map[string]string),
+		BuildTag:        buildTag,
+		Lockdep:         ParseLockdep(rep.Report),
+		Report:          string(rep.Report),
+	}
+	if res.Frames == nil {
+		res.Frames = []string{}
+	}
+	if match := annotationAccessRe.FindSubmatch(rep.Report); match != nil {
+		res.Annotations[AnnotationAccessType] = string(match[1])
+		res.Annotations[AnnotationAccessSize] = string(match[2])
+	}
+	if match := annotationTaskRe.FindSubmatch(rep.Report); match != nil {
+		res.Annotations[AnnotationTask] = string(match[1])
+	}
+	if len(rep.Maintainers) != 0 {
+		res.Maintainers = rep.Maintainers
+	}
+	if wrap, ok := reporter.(*reporterWrapper); ok {
+		if g, ok := wrap.Reporter.(guilter); ok {
+			if file := g.extractGuiltyFile(rep.Report); file != "" {
+				res.Annotations[AnnotationGuiltyFile] = file
+			}
+		}
+	}
+	if len(res.Annotations) == 0 {
+		res.Annotations = nil
+	}
+	return res
+}
+
+// ParseJSONReport parses report serialized with json.Marshal(NewJSONReport(...)).
+// Returns an error for reports of unsupported versions.
+func ParseJSONReport(data []byte) (*JSONReport, error) {
+	rep := new(JSONReport)
+	if err := json.Unmarshal(data, rep); err != nil {
+		return nil, fmt.Errorf("failed to parse report: %v", err)
+	}
+	if rep.Version != JSONVersion {
+		return nil, fmt.Errorf("unsupported report version %v, want %v", rep.Version, JSONVersion)
+	}
+	return rep, nil
+}
@@ -0,0 +1,63 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package report
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"reflect"
+	"testing"
+)
+
+func TestJSONReport(t *testing.T) {
+	forEachFile(t, "report", func(t *testing.T, reporter Reporter, fn string) {
+		data, err := ioutil.ReadFile(fn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		rep := reporter.Parse(data)
+		if rep == nil {
+			return
+		}
+		jrep := NewJSONReport(reporter, rep, "tag")
+		if jrep.Version != JSONVersion || jrep.Title != rep.Title || jrep.BuildTag != "tag" {
+			t.Fatalf("bad report: %+v", jrep)
+		}
+		serialized, err := json.Marshal(jrep)
+		if err != nil {
+			t.Fatal(err)
+		}
+		parsed, err := ParseJSONReport(serialized)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(jrep, parsed) {
+			t.Fatalf("report changed after serialization:\n%+v\n%+v", jrep, parsed)
+		}
+	})
+}
+
+func TestJSONReportVersion(t *testing.T) {
+	if _, err := ParseJSONReport([]byte(`{"version": 1000, "title": "foo"}`)); err == nil {
+		t.Fatalf("report with unsupported version is parsed")
+	}
+}
+
+func TestCrashType(t *testing.T) {
+	tests := map[string]string{
+		"KASAN: use-after-free Read in sctp_outq_tail":          TypeKASAN,
+		"general protection fault in __list_del_entry_valid":    TypeGPF,
+		"WARNING in __alloc_pages_slowpath":                     TypeWARNING,
+		"possible deadlock in rtnl_lock":                        TypeLOCKDEP,
+		"INFO: task hung in __blkdev_get":                       TypeHang,
+		"BUG: unable to handle kernel NULL pointer dereference": TypeBUG,
+		"memory leak in foo":                                    TypeMemoryLeak,
+		"lost connection to test machine":                       TypeUnknown,
+	}
+	for title, want := range tests {
+		if got := CrashType(title); got != want {
+			t.Errorf("title %q: got type %v, want %v", title, got, want)
+		}
+	}
+}
@@ -503,6 +503,21 @@ var (
 	linuxRipFrame    = compile(`IP: (?:(?:[0-9]+:)?(?:{{PC}} +){0,2}{{FUNC}}|[0-9]+:0x[0-9a-f]+|(?:[0-9]+:)?{{PC}} +\[< *\(null\)>\] +\(null\)|[0-9]+: +\(null\))`)
 )
 
+// linuxDmesgPatterns match non-fatal kernel messages tracked by DmesgScanner.
+// Lockdep and other reports that have stack traces are handled as crashes.
+var linuxDmesgPatterns = []*dmesgPattern{
+	{regexp.MustCompile(`page allocation stalls for`), "page allocation stall"},
+	{regexp.MustCompile(`page allocation failure: order:`), "page allocation failure"},
+	{regexp.MustCompile(`(EXT[234]-fs|BTRFS) error`), "$1 error"},
+	{regexp.MustCompile(`(XFS|FAT-fs|F2FS-fs|NILFS|ISOFS|GFS2|OCFS2) \([^)]+\): ` +
+		`(?:[Ee]rror|[Cc]orruption|[Mm]etadata corruption)`), "$1 error"},
+	{regexp.MustCompile(`I/O error, dev [a-z]+`), "block I/O error"},
+	{regexp.MustCompile(`ata[0-9]+(?:\.[0-9]+)?: (?:hard |soft )?resetting link`), "ata link reset"},
+	{regexp.MustCompile(`usb [0-9.-]+: reset [a-zA-Z-]+ USB device`), "usb device reset"},
+	{regexp.MustCompile(`nvme[0-9]+: .*reset controller`), "nvme controller reset"},
+	{regexp.MustCompile(`([a-zA-Z0-9_]+) [0-9a-f:.]+ [a-zA-Z0-9_]+: Reset adapter`), "$1 adapter reset"},
+}
+
 var linuxCorruptedTitles = []*regexp.Regexp{
 	// Sometimes timestamps get merged into the middle of report description.
 	regexp.MustCompile(`\[ *[0-9]+\.[0-9]+\]`),
@@ -579,6 +594,27 @@ var linuxStackParams = &stackParams{
 	},
 }
 
+// linuxMemoryAllocSkip matches memory allocator and OOM killer frames,
+// so that out-of-memory reports are titled with the allocation site.
+var linuxMemoryAllocSkip = []string{
+	"dump_header",
+	"oom_kill",
+	"out_of_memory",
+	"alloc_pages",
+	"get_free_pages",
+	"get_zeroed_page",
+	"kmalloc",
+	"kzalloc",
+	"kcalloc",
+	"krealloc",
+	"kmem_cache",
+	"vmalloc",
+	"vzalloc",
+	"charge",
+	"handle_mm_fault",
+	"page_fault",
+}
+
 func warningStackFmt(skip ...string) *stackFmt {
 	return &stackFmt{
 		// In newer kernels WARNING traps and actual stack starts after invalid_op frame,
@@ -1171,4 +1207,50 @@ var linuxOopses = []*oops{
 		},
 		[]*regexp.Regexp{},
 	},
+	&oops{
+		[]byte("invoked oom-killer:"),
+		[]oopsFormat{
+			{
+				title:  compile("invoked oom-killer:"),
+				report: compile("invoked oom-killer:(?:.*\\n){0,20}?.*mem_cgroup_out_of_memory"),
+				fmt:    "memcg out of memory in %[1]v",
+				stack: &stackFmt{
+					parts: []*regexp.Regexp{
+						compile("Call Trace:"),
+						parseStackTrace,
+					},
+					skip: linuxMemoryAllocSkip,
+				},
+			},
+			{
+				title: compile("invoked oom-killer:"),
+				fmt:   "out of memory in %[1]v",
+				stack: &stackFmt{
+					parts: []*regexp.Regexp{
+						compile("Call Trace:"),
+						parseStackTrace,
+					},
+					skip: linuxMemoryAllocSkip,
+				},
+			},
+		},
+		[]*regexp.Regexp{},
+	},
+	&oops{
+		[]byte("page allocation failure:"),
+		[]oopsFormat{
+			{
+				title: compile("page allocation failure:"),
+				fmt:   "page allocation failure in %[1]v",
+				stack: &stackFmt{
+					parts: []*regexp.Regexp{
+						compile("Call Trace:"),
+						parseStackTrace,
+					},
+					skip: linuxMemoryAllocSkip,
+				},
+			},
+		},
+		[]*regexp.Regexp{},
+	},
 }
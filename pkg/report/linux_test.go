@@ -68,6 +68,120 @@ func TestLinuxIgnores(t *testing.T) {
 	}
 }
 
+func TestLinuxOOM(t *testing.T) {
+	cfg := &mgrconfig.Config{
+		TargetOS: "linux",
+	}
+	reporter, err := NewReporter(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg.ReportOOM = true
+	reporter1, err := NewReporter(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const log = `
+[    0.000000] syz-executor0 invoked oom-killer: gfp_mask=0x14200ca(GFP_HIGHUSER_MOVABLE), order=0
+[    0.000000] Call Trace:
+[    0.000000]  dump_stack+0x1b9/0x294
+[    0.000000]  oom_kill_process+0x10/0x95a
+[    0.000000]  out_of_memory+0xa84/0x1430
+[    0.000000]  __alloc_pages_nodemask+0xa80/0xd60
+[    0.000000]  foo+0x4fa/0xf30
+[    0.000000]  bar+0x64d/0x960
+	`
+	if reporter.ContainsCrash([]byte(log)) {
+		t.Fatalf("found crash, should be ignored")
+	}
+	if rep := reporter.Parse([]byte(log)); rep != nil {
+		t.Fatalf("found `%v`, should be ignored", rep.Title)
+	}
+	if !reporter1.ContainsCrash([]byte(log)) {
+		t.Fatalf("no crash")
+	}
+	if rep := reporter1.Parse([]byte(log)); rep.Title != "out of memory in foo" {
+		t.Fatalf("want `out of memory in foo`, found `%v`", rep.Title)
+	}
+}
+
+func TestLinuxWarnings(t *testing.T) {
+	const log = `
+[    0.000000] WARNING: CPU: 0 PID: 1 at net/core/dev.c:2641 skb_warn_bad_offload+0x2bd/0x3b0
+[    0.000000] WARNING: CPU: 0 PID: 1 at mm/page_alloc.c:3903 __alloc_pages_slowpath+0x1e7b/0x2d10
+[    0.000000] WARNING: suspicious RCU usage
+	`
+	tests := []struct {
+		warnings string
+		ignore   []string
+		title    string
+	}{
+		{"crash", nil, "WARNING in skb_warn_bad_offload"},
+		{"crash", []string{"skb_warn_bad_offload"}, "WARNING in __alloc_pages_slowpath"},
+		{"crash", []string{"net/core/dev.c", "mm/"}, "WARNING: suspicious RCU usage"},
+		{"ignore", nil, "WARNING: suspicious RCU usage"},
+	}
+	for i, test := range tests {
+		cfg := &mgrconfig.Config{
+			TargetOS:       "linux",
+			Warnings:       test.warnings,
+			IgnoreWarnings: test.ignore,
+		}
+		reporter, err := NewReporter(cfg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		rep := reporter.Parse([]byte(log))
+		if rep == nil {
+			t.Fatalf("#%v: no crash", i)
+		}
+		if rep.Title != test.title {
+			t.Fatalf("#%v: want `%v`, found `%v`", i, test.title, rep.Title)
+		}
+	}
+}
+
+func TestLinuxIsWarning(t *testing.T) {
+	tests := []struct {
+		log     string
+		warning bool
+	}{
+		{`
+[    0.000000] WARNING: CPU: 0 PID: 1 at net/core/dev.c:2641 skb_warn_bad_offload+0x2bd/0x3b0
+`, true},
+		{`
+[    0.000000] WARNING: CPU: 1 PID: 4 at kernel/locking/lockdep.c:3311 lock_release+0x1a/0x20
+`, true},
+		{`
+[   36.351334] ======================================================
+[   36.351334] WARNING: possible circular locking dependency detected
+[   36.351334] 4.15.0-rc7+ #255 Not tainted
+[   36.351334] ------------------------------------------------------
+`, false},
+		{`
+[    0.000000] WARNING: suspicious RCU usage
+`, false},
+		{`
+[    0.000000] BUG: KASAN: use-after-free in skb_release_data+0x1d8/0x1f0 at addr ffff8800302e7e10
+`, false},
+	}
+	cfg := &mgrconfig.Config{TargetOS: "linux"}
+	reporter, err := NewReporter(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, test := range tests {
+		rep := reporter.Parse([]byte(test.log))
+		if rep == nil {
+			t.Fatalf("#%v: no crash", i)
+		}
+		if got := IsWarning(rep); got != test.warning {
+			t.Errorf("#%v: %v: IsWarning=%v, want %v", i, rep.Title, got, test.warning)
+		}
+	}
+}
+
 func TestLinuxSymbolizeLine(t *testing.T) {
 	tests := []struct {
 		line   string
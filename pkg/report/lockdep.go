@@ -0,0 +1,95 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package report
+
+import (
+	"bytes"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Lockdep is structured information extracted from a Linux lockdep report.
+// Titles of lockdep reports contain the function that tried to acquire a lock, so the same
+// deadlock scenario reached via different paths produces many different titles. The set of
+// involved lock classes is much more stable, so it is used to deduplicate such reports (see Key).
+type Lockdep struct {
+	// Kind of the report, e.g. "possible circular locking dependency" or "inconsistent lock state".
+	Kind string `json:"kind"`
+	// Locks are normalized lock classes involved in the report, sorted.
+	Locks []string `json:"locks"`
+	// Chain is the existing dependency chain for circular dependency reports, in reverse order
+	// (as printed by lockdep, the last lock is the one the task is trying to acquire).
+	Chain []string `json:"chain,omitempty"`
+}
+
+var (
+	lockdepKindRe = regexp.MustCompile(`(?:WARNING|INFO): (possible circular locking dependency|` +
+		`possible irq lock inversion dependency|(?:SOFT|HARD)IRQ-safe -> (?:SOFT|HARD)IRQ-unsafe lock order|` +
+		`possible recursive locking|inconsistent lock state)`)
+	// Lock classes are printed as "(class){usage}", class can contain parenthesis itself,
+	// e.g. "(&(&hashinfo->ehash_locks[i])->rlock){+.?...}".
+	lockdepClassRe = regexp.MustCompile(`\((.+?)\)\{[-+.?]+\}`)
+	lockdepChainRe = regexp.MustCompile(`^-> #[0-9]+ `)
+	// Lockdep appends instance numbers to classes with the same name ("&type->i_mutex_key#10"),
+	// and prints subclasses ("&mm->mmap_sem/1"). Per-protocol socket lock classes are merged
+	// as they are the same lock for the purpose of deadlock scenarios.
+	lockdepNormalizeRe = []struct {
+		re   *regexp.Regexp
+		repl string
+	}{
+		{regexp.MustCompile(`(?:#[0-9]+|/[0-9]+)+$`), ""},
+		{regexp.MustCompile(`-AF_[A-Z0-9]+`), "-AF_*"},
+	}
+	// Lock classes printed after this line (held locks, scenario) are not necessary involved.
+	lockdepEndMarker = []byte("other info that might help us debug this")
+)
+
+// ParseLockdep extracts structured information from a lockdep report,
+// it returns nil if the report is not a lockdep report or does not mention any locks.
+func ParseLockdep(report []byte) *Lockdep {
+	kind := lockdepKindRe.FindSubmatch(report)
+	if kind == nil {
+		return nil
+	}
+	report = report[bytes.Index(report, kind[0]):]
+	if end := bytes.Index(report, lockdepEndMarker); end != -1 {
+		report = report[:end]
+	}
+	res := &Lockdep{Kind: string(kind[1])}
+	locks := make(map[string]bool)
+	for _, line := range bytes.Split(report, []byte{'\n'}) {
+		line = bytes.TrimSpace(line)
+		match := lockdepClassRe.FindSubmatch(line)
+		if match == nil {
+			continue
+		}
+		class := normalizeLockClass(string(match[1]))
+		locks[class] = true
+		if lockdepChainRe.Match(line) {
+			res.Chain = append(res.Chain, class)
+		}
+	}
+	if len(locks) == 0 {
+		return nil
+	}
+	for class := range locks {
+		res.Locks = append(res.Locks, class)
+	}
+	sort.Strings(res.Locks)
+	return res
+}
+
+func normalizeLockClass(class string) string {
+	for _, n := range lockdepNormalizeRe {
+		class = n.re.ReplaceAllString(class, n.repl)
+	}
+	return class
+}
+
+// Key returns deduplication key of the report: reports with the same key describe
+// the same locking problem even if they have different titles.
+func (ld *Lockdep) Key() string {
+	return ld.Kind + ": " + strings.Join(ld.Locks, ", ")
+}
@@ -0,0 +1,80 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package report
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/google/syzkaller/syz-manager/mgrconfig"
+)
+
+func TestParseLockdep(t *testing.T) {
+	reporter, err := NewReporter(&mgrconfig.Config{TargetOS: "linux"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	parse := func(file string) (string, *Lockdep) {
+		data, err := ioutil.ReadFile(filepath.Join("testdata", "linux", "report", file))
+		if err != nil {
+			t.Fatal(err)
+		}
+		rep := reporter.Parse(data)
+		if rep == nil {
+			t.Fatalf("%v: no report", file)
+		}
+		return rep.Title, ParseLockdep(rep.Report)
+	}
+	// 185-188 have the same root cause, but different titles.
+	_, ld := parse("185")
+	want := &Lockdep{
+		Kind:  "possible circular locking dependency",
+		Locks: []string{"&xt[i].mutex", "rtnl_mutex", "sk_lock-AF_*"},
+		Chain: []string{"rtnl_mutex", "&xt[i].mutex", "sk_lock-AF_*"},
+	}
+	if !reflect.DeepEqual(ld, want) {
+		t.Fatalf("got lockdep %+v, want %+v", ld, want)
+	}
+	titles := map[string]bool{}
+	for _, file := range []string{"185", "186", "187", "188"} {
+		title, ld1 := parse(file)
+		titles[title] = true
+		if ld1 == nil || ld1.Key() != ld.Key() {
+			t.Fatalf("%v: got lockdep %+v, want key %q", file, ld1, ld.Key())
+		}
+	}
+	if len(titles) != 4 {
+		t.Fatalf("expected different titles, got %v", titles)
+	}
+	if _, ld1 := parse("189"); ld1 == nil || ld1.Key() == ld.Key() {
+		t.Fatalf("189: got lockdep %+v", ld1)
+	}
+	_, ld1 := parse("33")
+	want = &Lockdep{
+		Kind:  "inconsistent lock state",
+		Locks: []string{"&(&hashinfo->ehash_locks[i])->rlock"},
+	}
+	if !reflect.DeepEqual(ld1, want) {
+		t.Fatalf("33: got lockdep %+v, want %+v", ld1, want)
+	}
+	if _, ld1 := parse("104"); ld1 != nil {
+		t.Fatalf("104: got lockdep %+v for non-lockdep report", ld1)
+	}
+}
+
+func TestNormalizeLockClass(t *testing.T) {
+	for class, want := range map[string]string{
+		"&type->i_mutex_key#10": "&type->i_mutex_key",
+		"&mm->mmap_sem/1":       "&mm->mmap_sem",
+		"sk_lock-AF_INET6":      "sk_lock-AF_*",
+		"slock-AF_NETLINK":      "slock-AF_*",
+		"rtnl_mutex":            "rtnl_mutex",
+	} {
+		if got := normalizeLockClass(class); got != want {
+			t.Errorf("normalizeLockClass(%q) = %q, want %q", class, got, want)
+		}
+	}
+}
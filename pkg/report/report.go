@@ -30,6 +30,9 @@ type Reporter interface {
 type Report struct {
 	// Title contains a representative description of the first oops.
 	Title string
+	// AltTitles contains the title before normalization with DedupTitle (if it's different),
+	// it's used to match bugs reported before the normalization was introduced.
+	AltTitles []string
 	// Report contains whole oops text.
 	Report []byte
 	// Output contains whole raw console output as passed to Reporter.Parse.
@@ -45,6 +48,9 @@ type Report struct {
 	corruptedReason string
 	// Maintainers is list of maintainer emails.
 	Maintainers []string
+	// PostMortem contains output of post-mortem debugging of the crashed VM (e.g. gdb script),
+	// filled by the caller with vm.Instance.PostMortem.
+	PostMortem []byte
 }
 
 // NewReporter creates reporter for the specified OS/Type.
@@ -57,7 +63,17 @@ func NewReporter(cfg *mgrconfig.Config) (Reporter, error) {
 	if ctor == nil {
 		return nil, fmt.Errorf("unknown OS: %v", typ)
 	}
-	ignores, err := compileRegexps(cfg.Ignores)
+	ignoreList := append([]string{}, cfg.Ignores...)
+	if !cfg.ReportOOM {
+		ignoreList = append(ignoreList, memoryPressureIgnores...)
+	}
+	if cfg.Warnings == "ignore" {
+		ignoreList = append(ignoreList, warningSiteIgnore)
+	}
+	for _, site := range cfg.IgnoreWarnings {
+		ignoreList = append(ignoreList, warningSiteIgnore+".*"+site)
+	}
+	ignores, err := compileRegexps(ignoreList)
 	if err != nil {
 		return nil, err
 	}
@@ -82,6 +98,34 @@ var ctors = map[string]fn{
 	"windows": ctorStub,
 }
 
+// memoryPressureIgnores match first lines of out-of-memory reports.
+// Such reports are ignored unless cfg.ReportOOM is set,
+// because they are usually caused by the test load rather than by kernel bugs.
+var memoryPressureIgnores = []string{
+	"invoked oom-killer:",
+	"page allocation failure:",
+}
+
+// warningSiteIgnore matches first lines of WARNINGs issued by WARN_ON and friends
+// (but not lockdep and other reports that also start with WARNING).
+const warningSiteIgnore = "WARNING: .* at "
+
+var warningSiteRe = regexp.MustCompile(warningSiteIgnore)
+
+// IsWarning says if rep is a WARNING issued by WARN_ON and friends, i.e. a report that
+// the warnings and ignore_warnings config parameters apply to. Lockdep and other reports
+// that also start with WARNING are not warnings in this sense.
+func IsWarning(rep *Report) bool {
+	line := rep.Report
+	if rep.StartPos < len(rep.Output) {
+		line = rep.Output[rep.StartPos:]
+	}
+	if pos := bytes.IndexByte(line, '\n'); pos != -1 {
+		line = line[:pos]
+	}
+	return warningSiteRe.Match(line)
+}
+
 type fn func(string, string, []*regexp.Regexp) (Reporter, []string, error)
 
 func compileRegexps(list []string) ([]*regexp.Regexp, error) {
@@ -106,7 +150,11 @@ func (wrap *reporterWrapper) Parse(output []byte) *Report {
 	if rep == nil {
 		return nil
 	}
-	rep.Title = sanitizeTitle(replaceTable(dynamicTitleReplacement, rep.Title))
+	title := sanitizeTitle(replaceTable(dynamicTitleReplacement, rep.Title))
+	rep.Title = DedupTitle(title)
+	if rep.Title != title {
+		rep.AltTitles = []string{title}
+	}
 	rep.Suppressed = matchesAny(rep.Output, wrap.suppressions)
 	return rep
 }
@@ -233,7 +233,8 @@ func forEachFile(t *testing.T, dir string, fn func(t *testing.T, reporter Report
 			t.Fatal(err)
 		}
 		cfg := &mgrconfig.Config{
-			TargetOS: os,
+			TargetOS:  os,
+			ReportOOM: true,
 		}
 		reporter, err := NewReporter(cfg)
 		if err != nil {
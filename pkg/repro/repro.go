@@ -5,9 +5,11 @@ package repro
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"os"
 	"sort"
+	"strings"
 	"sync"
 	"time"
 
@@ -38,6 +40,12 @@ type Result struct {
 	// Information about the final (non-symbolized) crash that we reproduced.
 	// Can be different from what we started reproducing.
 	Report *report.Report
+	// Additional kernel command line of the VM where the crash was reproduced
+	// (see mgrconfig.ReproGuest), empty if none.
+	Cmdline string
+	// Set if Prog does not contain host-identifying strings,
+	// only checked with mgrconfig.ReproScrub enabled.
+	Scrubbed bool
 }
 
 type context struct {
@@ -48,11 +56,18 @@ type context struct {
 	bootRequests chan int
 	stats        Stats
 	report       *report.Report
+	cmdline      string    // additional kernel command line of the VM that produced report
+	deadline     time.Time // end of mgrconfig.Config.ReproTimeBudget (zero if no limit)
+	// Used in tests instead of running programs in VMs, returns if the programs crashed.
+	testHook func(entries []*prog.LogEntry, opts csource.Options, cprog bool) bool
 }
 
+var errTimeBudget = errors.New("repro time budget exhausted")
+
 type instance struct {
 	*vm.Instance
 	index       int
+	cmdline     string
 	execprogBin string
 	executorBin string
 }
@@ -84,7 +99,17 @@ func Run(crashLog []byte, cfg *mgrconfig.Config, reporter report.Reporter, vmPoo
 		instances:    make(chan *instance, len(vmIndexes)),
 		bootRequests: make(chan int, len(vmIndexes)),
 	}
+	if cfg.ReproTimeBudget != 0 {
+		ctx.deadline = time.Now().Add(time.Duration(cfg.ReproTimeBudget) * time.Minute)
+	}
 	ctx.reproLog(0, "%v programs, %v VMs", len(entries), len(vmIndexes))
+	useCmdline := guestCmdline(&cfg.ReproGuest, 0) != ""
+	if useCmdline && !vmPool.SupportsCmdline() {
+		ctx.reproLog(0, "VM type does not control kernel command line, ignoring repro_guest config")
+		useCmdline = false
+	}
+	var bootMu sync.Mutex
+	boots := 0
 	var wg sync.WaitGroup
 	wg.Add(len(vmIndexes))
 	for _, vmIndex := range vmIndexes {
@@ -101,7 +126,18 @@ func Run(crashLog []byte, cfg *mgrconfig.Config, reporter report.Reporter, vmPoo
 						continue
 					default:
 					}
-					vmInst, err := vmPool.Create(vmIndex)
+					var vmInst *vm.Instance
+					var err error
+					cmdline := ""
+					if useCmdline {
+						bootMu.Lock()
+						cmdline = guestCmdline(&cfg.ReproGuest, boots)
+						boots++
+						bootMu.Unlock()
+						vmInst, err = vmPool.CreateWithCmdline(vmIndex, cmdline)
+					} else {
+						vmInst, err = vmPool.Create(vmIndex)
+					}
 					if err != nil {
 						ctx.reproLog(0, "failed to create VM: %v", err)
 						time.Sleep(10 * time.Second)
@@ -125,6 +161,7 @@ func Run(crashLog []byte, cfg *mgrconfig.Config, reporter report.Reporter, vmPoo
 					inst = &instance{
 						Instance:    vmInst,
 						index:       vmIndex,
+						cmdline:     cmdline,
 						execprogBin: execprogBin,
 						executorBin: executorBin,
 					}
@@ -141,6 +178,13 @@ func Run(crashLog []byte, cfg *mgrconfig.Config, reporter report.Reporter, vmPoo
 		wg.Wait()
 		close(ctx.instances)
 	}()
+	// Release the VMs on all paths, reproduction can be aborted by the time budget at any point.
+	defer func() {
+		close(ctx.bootRequests)
+		for inst := range ctx.instances {
+			inst.Close()
+		}
+	}()
 
 	res, err := ctx.repro(entries, crashStart)
 	if err != nil {
@@ -157,20 +201,29 @@ func Run(crashLog []byte, cfg *mgrconfig.Config, reporter report.Reporter, vmPoo
 			} else {
 				_, err = ctx.testProg(res.Prog, res.Duration, res.Opts)
 			}
+			if err == errTimeBudget {
+				// Keep the repro with the corrupted report.
+				err = nil
+				break
+			}
 			if err != nil {
 				return nil, err
 			}
 		}
+		if cfg.ReproScrub.Enabled {
+			if err := ctx.scrub(res); err != nil {
+				return nil, err
+			}
+		}
 		ctx.reproLog(3, "final repro crashed as (corrupted=%v):\n%s",
 			ctx.report.Corrupted, ctx.report.Report)
 		res.Report = ctx.report
+		res.Cmdline = ctx.cmdline
+		if res.Cmdline != "" {
+			ctx.reproLog(3, "reproduced with kernel command line: %v", res.Cmdline)
+		}
 		res.Stats = ctx.stats
 	}
-
-	close(ctx.bootRequests)
-	for inst := range ctx.instances {
-		inst.Close()
-	}
 	return res, err
 }
 
@@ -200,22 +253,24 @@ func (ctx *context) repro(entries []*prog.LogEntry, crashStart int) (*Result, er
 			res.Opts.Repro = false
 		}
 	}()
+	// The stages below only improve the found reproducer: they update res only with programs
+	// and options that still crash, so res stays a working reproducer if a stage fails.
 	res, err = ctx.minimizeProg(res)
 	if err != nil {
-		return nil, err
+		return ctx.stopRepro(res, err)
 	}
 
 	// Try extracting C repro without simplifying options first.
 	res, err = ctx.extractC(res)
 	if err != nil {
-		return nil, err
+		return ctx.stopRepro(res, err)
 	}
 
 	// Simplify options and try extracting C repro.
 	if !res.CRepro {
 		res, err = ctx.simplifyProg(res)
 		if err != nil {
-			return nil, err
+			return ctx.stopRepro(res, err)
 		}
 	}
 
@@ -223,13 +278,23 @@ func (ctx *context) repro(entries []*prog.LogEntry, crashStart int) (*Result, er
 	if res.CRepro {
 		res, err = ctx.simplifyC(res)
 		if err != nil {
-			return nil, err
+			return ctx.stopRepro(res, err)
 		}
 	}
 
 	return res, nil
 }
 
+// stopRepro handles an error of a stage that improves an already found reproducer res.
+// If the time budget is exhausted, the remaining stages are skipped and res is returned.
+func (ctx *context) stopRepro(res *Result, err error) (*Result, error) {
+	if err == errTimeBudget {
+		ctx.reproLog(3, "keeping the reproducer found so far")
+		return res, nil
+	}
+	return nil, err
+}
+
 func (ctx *context) extractProg(entries []*prog.LogEntry) (*Result, error) {
 	ctx.reproLog(2, "extracting reproducer from %v programs", len(entries))
 	start := time.Now()
@@ -300,6 +365,7 @@ func (ctx *context) extractProgSingle(entries []*prog.LogEntry, duration time.Du
 		if opts.FaultCall < 0 || opts.FaultCall >= len(ent.P.Calls) {
 			opts.FaultCall = len(ent.P.Calls) - 1
 		}
+		opts.Creds = ent.Creds
 		crashed, err := ctx.testProg(ent.P, duration, opts)
 		if err != nil {
 			return nil, err
@@ -352,6 +418,8 @@ func (ctx *context) extractProgBisect(entries []*prog.LogEntry, baseDuration tim
 		prog.Calls = append(prog.Calls, entry.P.Calls...)
 	}
 	dur := duration(len(entries)) * 3 / 2
+	// The guilty program is usually the last one, execute the concatenation in its credential context.
+	opts.Creds = entries[len(entries)-1].Creds
 
 	// Execute the program without fault injection.
 	crashed, err := ctx.testProg(prog, dur, opts)
@@ -410,9 +478,17 @@ func (ctx *context) minimizeProg(res *Result) (*Result, error) {
 	if res.Opts.Fault {
 		call = res.Opts.FaultCall
 	}
+	var budgetErr error
 	res.Prog, res.Opts.FaultCall = prog.Minimize(res.Prog, call, true,
 		func(p1 *prog.Prog, callIndex int) bool {
+			if budgetErr != nil {
+				return false
+			}
 			crashed, err := ctx.testProg(p1, res.Duration, res.Opts)
+			if err == errTimeBudget {
+				budgetErr = err
+				return false
+			}
 			if err != nil {
 				ctx.reproLog(0, "minimization failed with %v", err)
 				return false
@@ -420,7 +496,8 @@ func (ctx *context) minimizeProg(res *Result) (*Result, error) {
 			return crashed
 		})
 
-	return res, nil
+	// The program is minimized as far as the time budget allowed, the caller decides what to do next.
+	return res, budgetErr
 }
 
 // Simplify repro options (threaded, collide, sandbox, etc).
@@ -436,14 +513,14 @@ func (ctx *context) simplifyProg(res *Result) (*Result, error) {
 		if simplify(&opts) {
 			crashed, err := ctx.testProg(res.Prog, res.Duration, opts)
 			if err != nil {
-				return nil, err
+				return res, err
 			}
 			if crashed {
 				res.Opts = opts
 				// Simplification successful, try extracting C repro.
 				res, err := ctx.extractC(res)
 				if err != nil {
-					return nil, err
+					return res, err
 				}
 				if res.CRepro {
 					return res, nil
@@ -465,7 +542,7 @@ func (ctx *context) extractC(res *Result) (*Result, error) {
 
 	crashed, err := ctx.testCProg(res.Prog, res.Duration, res.Opts)
 	if err != nil {
-		return nil, err
+		return res, err
 	}
 	res.CRepro = crashed
 	return res, nil
@@ -484,7 +561,7 @@ func (ctx *context) simplifyC(res *Result) (*Result, error) {
 		if simplify(&opts) {
 			crashed, err := ctx.testCProg(res.Prog, res.Duration, opts)
 			if err != nil {
-				return nil, err
+				return res, err
 			}
 			if crashed {
 				res.Opts = opts
@@ -501,11 +578,18 @@ func (ctx *context) testProg(p *prog.Prog, duration time.Duration, opts csource.
 		entry.FaultCall = opts.FaultCall
 		entry.FaultNth = opts.FaultNth
 	}
+	entry.Creds = opts.Creds
 	return ctx.testProgs([]*prog.LogEntry{&entry}, duration, opts)
 }
 
 func (ctx *context) testProgs(entries []*prog.LogEntry, duration time.Duration, opts csource.Options) (
 	crashed bool, err error) {
+	if err := ctx.checkTimeBudget(); err != nil {
+		return false, err
+	}
+	if ctx.testHook != nil {
+		return ctx.testHook(entries, opts, false), nil
+	}
 	inst := <-ctx.instances
 	if inst == nil {
 		return false, fmt.Errorf("all VMs failed to boot")
@@ -549,10 +633,16 @@ func (ctx *context) testProgs(entries []*prog.LogEntry, duration time.Duration,
 		inst.execprogBin, inst.executorBin, ctx.cfg.TargetArch, opts.Procs, repeat,
 		opts.Sandbox, opts.Threaded, opts.Collide, vmProgFile)
 	ctx.reproLog(2, "testing program (duration=%v, %+v): %s", duration, opts, program)
-	return ctx.testImpl(inst.Instance, command, duration)
+	return ctx.testImpl(inst, command, duration)
 }
 
 func (ctx *context) testCProg(p *prog.Prog, duration time.Duration, opts csource.Options) (crashed bool, err error) {
+	if err := ctx.checkTimeBudget(); err != nil {
+		return false, err
+	}
+	if ctx.testHook != nil {
+		return ctx.testHook([]*prog.LogEntry{{P: p}}, opts, true), nil
+	}
 	src, err := csource.Write(p, opts)
 	if err != nil {
 		return false, err
@@ -581,10 +671,20 @@ func (ctx *context) testBin(bin string, duration time.Duration) (crashed bool, e
 	if err != nil {
 		return false, fmt.Errorf("failed to copy to VM: %v", err)
 	}
-	return ctx.testImpl(inst.Instance, bin, duration)
+	return ctx.testImpl(inst, bin, duration)
 }
 
-func (ctx *context) testImpl(inst *vm.Instance, command string, duration time.Duration) (crashed bool, err error) {
+// checkTimeBudget returns errTimeBudget if the time budget is exhausted,
+// it's checked before every test, so that no new VM runs are started after the deadline.
+func (ctx *context) checkTimeBudget() error {
+	if !ctx.deadline.IsZero() && time.Now().After(ctx.deadline) {
+		ctx.reproLog(0, "time budget of %v min exhausted", ctx.cfg.ReproTimeBudget)
+		return errTimeBudget
+	}
+	return nil
+}
+
+func (ctx *context) testImpl(inst *instance, command string, duration time.Duration) (crashed bool, err error) {
 	outc, errc, err := inst.Run(duration, nil, command)
 	if err != nil {
 		return false, fmt.Errorf("failed to run command in VM: %v", err)
@@ -599,10 +699,66 @@ func (ctx *context) testImpl(inst *vm.Instance, command string, duration time.Du
 		return false, nil
 	}
 	ctx.report = rep
+	ctx.cmdline = inst.cmdline
 	ctx.reproLog(2, "program crashed: %v", rep.Title)
 	return true, nil
 }
 
+// scrub replaces the program with the scrubbed one (see Scrubber) if it still reproduces the crash.
+func (ctx *context) scrub(res *Result) error {
+	scrubber, err := MakeScrubber(ctx.cfg)
+	if err != nil {
+		return err
+	}
+	p, changed := scrubber.ScrubProg(res.Prog)
+	if !changed {
+		res.Scrubbed = true
+		return nil
+	}
+	ctx.reproLog(3, "testing scrubbed program")
+	var crashed bool
+	if res.CRepro {
+		crashed, err = ctx.testCProg(p, res.Duration, res.Opts)
+	} else {
+		crashed, err = ctx.testProg(p, res.Duration, res.Opts)
+	}
+	if err != nil && err != errTimeBudget {
+		return err
+	}
+	if !crashed {
+		ctx.reproLog(3, "scrubbed program does not reproduce the crash, keeping the original one")
+		return nil
+	}
+	res.Prog = p
+	res.Scrubbed = true
+	return nil
+}
+
+// guestCmdline returns additional kernel command line for the boot-th reproduction VM boot.
+func guestCmdline(cfg *mgrconfig.ReproGuest, boot int) string {
+	var args []string
+	kaslr := cfg.KASLR
+	if kaslr == "alternate" {
+		kaslr = "on"
+		if boot%2 == 1 {
+			kaslr = "off"
+		}
+	}
+	switch kaslr {
+	case "on":
+		args = append(args, "kaslr")
+	case "off":
+		args = append(args, "nokaslr")
+	}
+	if cfg.NoRandMaps {
+		args = append(args, "norandmaps")
+	}
+	if cfg.Cmdline != "" {
+		args = append(args, cfg.Cmdline)
+	}
+	return strings.Join(args, " ")
+}
+
 func (ctx *context) returnInstance(inst *instance) {
 	ctx.bootRequests <- inst.index
 	inst.Close()
@@ -734,11 +890,18 @@ func reverseEntries(entries []*prog.LogEntry) []*prog.LogEntry {
 func encodeEntries(entries []*prog.LogEntry) []byte {
 	buf := new(bytes.Buffer)
 	for _, ent := range entries {
-		opts := ""
+		var opts []string
 		if ent.Fault {
-			opts = fmt.Sprintf(" (fault-call:%v fault-nth:%v)", ent.FaultCall, ent.FaultNth)
+			opts = append(opts, fmt.Sprintf("fault-call:%v fault-nth:%v", ent.FaultCall, ent.FaultNth))
+		}
+		if ent.Creds != "" {
+			opts = append(opts, "creds:"+ent.Creds)
+		}
+		strOpts := ""
+		if len(opts) != 0 {
+			strOpts = fmt.Sprintf(" (%v)", strings.Join(opts, " "))
 		}
-		fmt.Fprintf(buf, "executing program %v%v:\n%v", ent.Proc, opts, string(ent.P.Serialize()))
+		fmt.Fprintf(buf, "executing program %v%v:\n%v", ent.Proc, strOpts, string(ent.P.Serialize()))
 	}
 	return buf.Bytes()
 }
@@ -798,7 +961,8 @@ var progSimplifies = []Simplify{
 
 var cSimplifies = append(progSimplifies, []Simplify{
 	func(opts *csource.Options) bool {
-		if opts.Sandbox == "" {
+		// Credential context requires sandbox none (the context is part of the bug, not noise).
+		if opts.Sandbox == "" || opts.Creds != "" {
 			return false
 		}
 		opts.Sandbox = ""
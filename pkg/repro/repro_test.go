@@ -10,6 +10,7 @@ import (
 
 	"github.com/google/syzkaller/pkg/csource"
 	"github.com/google/syzkaller/prog"
+	"github.com/google/syzkaller/syz-manager/mgrconfig"
 )
 
 func initTest(t *testing.T) (*rand.Rand, int) {
@@ -95,4 +96,91 @@ func TestSimplifies(t *testing.T) {
 		}
 	}
 	check(opts, 0)
+	opts.Sandbox = "none"
+	opts.Creds = "uid=65534/nnp"
+	check(opts, 0)
+}
+
+func TestGuestCmdline(t *testing.T) {
+	tests := []struct {
+		cfg  mgrconfig.ReproGuest
+		want []string // for consecutive boots
+	}{
+		{
+			cfg:  mgrconfig.ReproGuest{},
+			want: []string{"", ""},
+		},
+		{
+			cfg:  mgrconfig.ReproGuest{KASLR: "off", NoRandMaps: true},
+			want: []string{"nokaslr norandmaps", "nokaslr norandmaps"},
+		},
+		{
+			cfg:  mgrconfig.ReproGuest{KASLR: "alternate", Cmdline: "foo=1"},
+			want: []string{"kaslr foo=1", "nokaslr foo=1", "kaslr foo=1"},
+		},
+		{
+			cfg:  mgrconfig.ReproGuest{KASLR: "on"},
+			want: []string{"kaslr", "kaslr"},
+		},
+	}
+	for i, test := range tests {
+		for boot, want := range test.want {
+			if got := guestCmdline(&test.cfg, boot); got != want {
+				t.Errorf("test #%v boot %v: got %q, want %q", i, boot, got, want)
+			}
+		}
+	}
+}
+
+func TestTimeBudgetDuringMinimization(t *testing.T) {
+	target, err := prog.GetTarget("test", "64")
+	if err != nil {
+		t.Fatal(err)
+	}
+	rd, _ := initTest(t)
+	p := target.Generate(rd, 10, nil)
+	guilty := p.Calls[len(p.Calls)-1].Meta
+	const budget = 3 // number of tests before the time budget is exhausted
+	tests := 0
+	ctx := &context{
+		cfg: &mgrconfig.Config{
+			TargetOS:        "test",
+			TargetArch:      "64",
+			Sandbox:         "none",
+			Procs:           1,
+			ReproTimeBudget: 1,
+		},
+		deadline: time.Now().Add(time.Hour),
+	}
+	ctx.testHook = func(entries []*prog.LogEntry, opts csource.Options, cprog bool) bool {
+		if tests++; tests == budget {
+			ctx.deadline = time.Now().Add(-time.Second)
+		}
+		for _, c := range entries[0].P.Calls {
+			if c.Meta == guilty {
+				return true
+			}
+		}
+		return false
+	}
+	res, err := ctx.repro([]*prog.LogEntry{{P: p}}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res == nil {
+		t.Fatalf("the reproducer found before the time budget is exhausted is lost")
+	}
+	if tests != budget {
+		t.Fatalf("%v tests were run, want %v", tests, budget)
+	}
+	if res.CRepro || res.Opts.Repro {
+		t.Fatalf("bad result: CRepro=%v Repro=%v", res.CRepro, res.Opts.Repro)
+	}
+	found := false
+	for _, c := range res.Prog.Calls {
+		found = found || c.Meta == guilty
+	}
+	if !found {
+		t.Fatalf("the reproducer does not crash:\n%s", res.Prog.Serialize())
+	}
 }
@@ -0,0 +1,99 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package repro
+
+import (
+	"bytes"
+	"net"
+	"os"
+	"regexp"
+	"sort"
+
+	"github.com/google/syzkaller/prog"
+	"github.com/google/syzkaller/syz-manager/mgrconfig"
+)
+
+// Scrubber removes host-identifying strings (host name and addresses, paths of the deployment
+// and mgrconfig.ReproScrub patterns) from reproducers, logs and reports before they leave
+// the manager. Matches are overwritten with 'x' of the same length, so that sizes and offsets
+// in programs and logs stay intact.
+type Scrubber struct {
+	patterns []*regexp.Regexp
+}
+
+func MakeScrubber(cfg *mgrconfig.Config) (*Scrubber, error) {
+	var literals []string
+	if hostname, err := os.Hostname(); err == nil {
+		literals = append(literals, hostname)
+	}
+	if addrs, err := net.InterfaceAddrs(); err == nil {
+		for _, addr := range addrs {
+			if ipnet, ok := addr.(*net.IPNet); ok && !ipnet.IP.IsLoopback() {
+				literals = append(literals, ipnet.IP.String())
+			}
+		}
+	}
+	literals = append(literals, cfg.Workdir, cfg.Syzkaller, cfg.KernelObj, cfg.KernelSrc,
+		cfg.Image, cfg.SSHKey)
+	// Longer strings first, so that e.g. workdir inside of syzkaller checkout is replaced as a whole.
+	sort.Slice(literals, func(i, j int) bool {
+		return len(literals[i]) > len(literals[j])
+	})
+	s := new(Scrubber)
+	for _, lit := range literals {
+		// Very short strings (e.g. "/" or a single-letter host name) would wipe everything.
+		if len(lit) < 3 {
+			continue
+		}
+		s.patterns = append(s.patterns, regexp.MustCompile(regexp.QuoteMeta(lit)))
+	}
+	for _, pattern := range cfg.ReproScrub.Patterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, err
+		}
+		s.patterns = append(s.patterns, re)
+	}
+	return s, nil
+}
+
+// Scrub returns a copy of data with all host-identifying strings overwritten.
+func (s *Scrubber) Scrub(data []byte) []byte {
+	res := append([]byte{}, data...)
+	s.scrub(res)
+	return res
+}
+
+// ScrubProg returns a copy of p with host-identifying strings overwritten in input data arguments,
+// and whether anything was overwritten.
+func (s *Scrubber) ScrubProg(p *prog.Prog) (*prog.Prog, bool) {
+	p = p.Clone()
+	changed := false
+	for _, c := range p.Calls {
+		prog.ForeachArg(c, func(arg prog.Arg, _ *prog.ArgCtx) {
+			a, ok := arg.(*prog.DataArg)
+			if !ok || a.Type().Dir() == prog.DirOut {
+				return
+			}
+			if s.scrub(a.Data()) {
+				changed = true
+			}
+		})
+	}
+	return p, changed
+}
+
+func (s *Scrubber) scrub(data []byte) bool {
+	changed := false
+	for _, re := range s.patterns {
+		for _, loc := range re.FindAllIndex(data, -1) {
+			if loc[0] == loc[1] {
+				continue
+			}
+			copy(data[loc[0]:loc[1]], bytes.Repeat([]byte{'x'}, loc[1]-loc[0]))
+			changed = true
+		}
+	}
+	return changed
+}
@@ -0,0 +1,77 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package repro
+
+import (
+	"testing"
+
+	"github.com/google/syzkaller/prog"
+	_ "github.com/google/syzkaller/sys"
+	"github.com/google/syzkaller/syz-manager/mgrconfig"
+)
+
+func TestScrub(t *testing.T) {
+	cfg := &mgrconfig.Config{
+		Workdir:   "/home/user/syzkaller/workdir",
+		Syzkaller: "/home/user/syzkaller",
+		ReproScrub: mgrconfig.ReproScrub{
+			Enabled:  true,
+			Patterns: []string{`[a-z0-9]+\.corp\.example\.com`},
+		},
+	}
+	s, err := MakeScrubber(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		in  string
+		out string
+	}{
+		{
+			"no host strings",
+			"no host strings",
+		},
+		{
+			"open /home/user/syzkaller/workdir/crashes failed",
+			"open xxxxxxxxxxxxxxxxxxxxxxxxxxxx/crashes failed",
+		},
+		{
+			"binary /home/user/syzkaller/bin/syz-executor",
+			"binary xxxxxxxxxxxxxxxxxxxx/bin/syz-executor",
+		},
+		{
+			"connected to fuzz1.corp.example.com:22",
+			"connected to xxxxxxxxxxxxxxxxxxxxxx:22",
+		},
+	}
+	for _, test := range tests {
+		if got := string(s.Scrub([]byte(test.in))); got != test.out {
+			t.Errorf("scrubbing %q:\ngot:  %q\nwant: %q", test.in, got, test.out)
+		}
+	}
+
+	target, err := prog.GetTarget("linux", "amd64")
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := target.Deserialize([]byte(
+		"open(&(0x7f0000000000)='/home/user/syzkaller/workdir/file0\\x00', 0x0, 0x0)\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	scrubbed, changed := s.ScrubProg(p)
+	if !changed {
+		t.Fatalf("program was not scrubbed")
+	}
+	want := "open(&(0x7f0000000000)='xxxxxxxxxxxxxxxxxxxxxxxxxxxx/file0\\x00', 0x0, 0x0)\n"
+	if got := string(scrubbed.Serialize()); got != want {
+		t.Fatalf("bad scrubbed program:\ngot:  %q\nwant: %q", got, want)
+	}
+	if got := string(p.Serialize()); got == want {
+		t.Fatalf("original program was modified")
+	}
+	if _, changed := s.ScrubProg(scrubbed); changed {
+		t.Fatalf("scrubbed program was scrubbed again")
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package rpctype
+
+// Types of the syz-manager control API. The API is served as JSON-RPC 1.0 over HTTP POST requests
+// to /api of the manager web UI, one request per HTTP request, e.g.:
+//	curl -d '{"method": "API.Stats", "params": [{}], "id": 0}' http://manager/api
+
+type APIStatsArgs struct{}
+
+type APIStatsRes struct {
+	Uptime      int64             `json:"uptime"`       // seconds
+	FuzzingTime int64             `json:"fuzzing_time"` // VM-seconds
+	Corpus      int               `json:"corpus"`
+	TriageQueue int               `json:"triage_queue"`
+	Cover       int               `json:"cover"`
+	Signal      int               `json:"signal"`
+	FuzzingVMs  int               `json:"fuzzing_vms"`
+	ReproJobs   int               `json:"repro_jobs"`
+	Paused      bool              `json:"paused"`
+	Counters    map[string]uint64 `json:"counters"` // crashes, executed programs, etc
+}
+
+type APICrashesArgs struct{}
+
+type APICrashesRes struct {
+	Crashes []*APICrash `json:"crashes"`
+}
+
+type APICrash struct {
+	ID      string `json:"id"`
+	Title   string `json:"title"`
+	Count   int    `json:"count"`
+	Triaged string `json:"triaged"` // "has C repro", "has repro", "reproducing", "non-reproducible" or ""
+}
+
+type APICrashArgs struct {
+	ID string `json:"id"`
+	// Include console logs of the crashes (can be large).
+	Logs bool `json:"logs"`
+}
+
+type APICrashRes struct {
+	APICrash
+	Crashes     []*APICrashInstance `json:"crashes"`
+	ReproSyz    string              `json:"repro_syz,omitempty"`
+	ReproC      string              `json:"repro_c,omitempty"`
+	ReproReport string              `json:"repro_report,omitempty"`
+}
+
+type APICrashInstance struct {
+	Index  int    `json:"index"`
+	Time   int64  `json:"time"` // unix time
+	Tag    string `json:"tag,omitempty"`
+	Report string `json:"report,omitempty"`
+	Log    string `json:"log,omitempty"`
+}
+
+type APIAddProgramsArgs struct {
+	Progs []string `json:"progs"` // programs in the text format
+}
+
+type APIAddProgramsRes struct {
+	Added        int `json:"added"`
+	Salvaged     int `json:"salvaged"`      // added programs that had disabled syscalls rewritten or dropped
+	DroppedCalls int `json:"dropped_calls"` // disabled syscalls dropped from salvaged programs
+	Dropped      int `json:"dropped"`       // unparsable programs or programs without enabled syscalls
+}
+
+type APIPauseArgs struct {
+	Paused bool `json:"paused"`
+}
+
+type APIPauseRes struct{}
+
+type APIMinimizeCorpusArgs struct{}
+
+type APIMinimizeCorpusRes struct {
+	Inputs int `json:"inputs"` // number of inputs queued for re-minimization
+}
+
+type APIPinProgramsArgs struct {
+	Sigs  []string `json:"sigs"` // hashes of corpus programs (as in /corpus and /cover?input=)
+	Unpin bool     `json:"unpin"`
+}
+
+type APIPinProgramsRes struct {
+	Changed int `json:"changed"` // number of programs that were not already (un)pinned
+}
@@ -16,28 +16,53 @@ import (
 )
 
 type RPCServer struct {
-	ln net.Listener
-	s  *rpc.Server
+	ln     net.Listener
+	s      *rpc.Server
+	closed chan struct{}
 }
 
-func NewRPCServer(addr string, receiver interface{}) (*RPCServer, error) {
-	ln, err := net.Listen("tcp", addr)
+// Network returns network name for net.Listen/Dial for the given address family preference:
+// "ipv4", "ipv6", "dual" (dual-stack) or "" (the def network).
+func Network(family, def string) (string, error) {
+	switch family {
+	case "":
+		return def, nil
+	case "dual":
+		return "tcp", nil
+	case "ipv4":
+		return "tcp4", nil
+	case "ipv6":
+		return "tcp6", nil
+	default:
+		return "", fmt.Errorf("unknown address family %q, want ipv4/ipv6/dual", family)
+	}
+}
+
+func NewRPCServer(network, addr string, receiver interface{}) (*RPCServer, error) {
+	ln, err := net.Listen(network, addr)
 	if err != nil {
 		return nil, fmt.Errorf("failed to listen on %v: %v", addr, err)
 	}
 	s := rpc.NewServer()
 	s.Register(receiver)
 	serv := &RPCServer{
-		ln: ln,
-		s:  s,
+		ln:     ln,
+		s:      s,
+		closed: make(chan struct{}),
 	}
 	return serv, nil
 }
 
+// Serve serves connections until Close.
 func (serv *RPCServer) Serve() {
 	for {
 		conn, err := serv.ln.Accept()
 		if err != nil {
+			select {
+			case <-serv.closed:
+				return
+			default:
+			}
 			log.Logf(0, "failed to accept an rpc connection: %v", err)
 			continue
 		}
@@ -51,6 +76,12 @@ func (serv *RPCServer) Addr() net.Addr {
 	return serv.ln.Addr()
 }
 
+// Close stops accepting new connections, already accepted connections are not closed.
+func (serv *RPCServer) Close() error {
+	close(serv.closed)
+	return serv.ln.Close()
+}
+
 type RPCClient struct {
 	conn net.Conn
 	c    *rpc.Client
@@ -6,8 +6,10 @@
 package rpctype
 
 import (
+	"github.com/google/syzkaller/pkg/callstats"
 	"github.com/google/syzkaller/pkg/host"
 	"github.com/google/syzkaller/pkg/signal"
+	"github.com/google/syzkaller/prog"
 )
 
 type RPCInput struct {
@@ -21,14 +23,25 @@ type RPCCandidate struct {
 	Prog      []byte
 	Minimized bool
 	Smashed   bool
+	// Reminimize requests re-minimization of the corpus input for Call,
+	// the result is sent back in NewInputArgs with Replaces set.
+	Reminimize bool
+	Call       string
 }
 
 type ConnectArgs struct {
-	Name string
+	Name    string
+	Modules []host.KernelModule // modules loaded on the VM, used to attribute coverage to modules
 }
 
 type ConnectRes struct {
 	EnabledCalls   []int
+	CallBudgets    []prog.CallBudget
+	CallWeights    map[int]float64 // multipliers of syscall priorities, see HubSyncRes.CallWeights
+	Mutators       []string
+	FeatureFlags   map[string]bool // all flags from mgrconfig.FeatureFlags, true if enabled on this VM
+	CrashTrail     int             // number of last coverage PCs per proc to send in TrailArgs, 0 if disabled
+	Creds          []string        // credential contexts to execute fuzzing programs in (see pkg/creds)
 	GitRevision    string
 	TargetRevision string
 	CheckResult    *CheckArgs
@@ -39,7 +52,11 @@ type CheckArgs struct {
 	Error         string
 	EnabledCalls  []int
 	DisabledCalls []SyscallReason
-	Features      *host.Features
+	// Calls that are supported but fail with permission errors,
+	// fuzzer generates them less frequently.
+	DownweightedCalls []SyscallReason
+	Features          *host.Features
+	Modules           []host.KernelModule
 }
 
 type SyscallReason struct {
@@ -48,7 +65,8 @@ type SyscallReason struct {
 }
 
 type NewInputArgs struct {
-	Name string
+	Name     string
+	Replaces string // hash of the corpus input this input is re-minimized from (see RPCCandidate.Reminimize)
 	RPCInput
 }
 
@@ -57,6 +75,7 @@ type PollArgs struct {
 	NeedCandidates bool
 	MaxSignal      signal.Serial
 	Stats          map[string]uint64
+	CallStats      callstats.Set // stats of a sample of executed calls
 }
 
 type PollRes struct {
@@ -65,6 +84,12 @@ type PollRes struct {
 	MaxSignal  signal.Serial
 }
 
+// TrailArgs contains coverage PCs of the last executed programs per proc (oldest first).
+type TrailArgs struct {
+	Name  string
+	Procs map[int][]uint32
+}
+
 type HubConnectArgs struct {
 	// Client/Key are used for authentication.
 	Client string
@@ -76,6 +101,8 @@ type HubConnectArgs struct {
 	// Set of system call names supported by this manager.
 	// Used to filter out programs with unsupported calls.
 	Calls []string
+	// If not empty, manager wants only programs that contain at least one of these calls.
+	PullCalls []string
 	// Current manager corpus.
 	Corpus [][]byte
 }
@@ -92,6 +119,11 @@ type HubSyncArgs struct {
 	Del []string
 	// Repros found since last sync.
 	Repros [][]byte
+	// If not empty, the manager takes part in coverage-complementary scheduling
+	// with other managers of the same group (they must fuzz the same kernel).
+	CoordinationGroup string
+	// Coverage of the manager corpus per syscall name (optional, only for CoordinationGroup).
+	CallCoverage map[string]int
 }
 
 type HubSyncRes struct {
@@ -102,4 +134,7 @@ type HubSyncRes struct {
 	// Number of remaining pending programs,
 	// if >0 manager should do sync again.
 	More int
+	// Weights of syscall priorities for the manager (only for HubSyncArgs.CoordinationGroup),
+	// syscalls with weight 1 are omitted.
+	CallWeights map[string]float64
 }
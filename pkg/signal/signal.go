@@ -5,6 +5,7 @@
 package signal
 
 import (
+	"container/heap"
 	"sort"
 )
 
@@ -191,3 +192,78 @@ func Minimize(corpus []Context) []interface{} {
 	}
 	return result
 }
+
+// MinimalCover returns a small subset of corpus that covers the same signal
+// (each element with the same max priority). Unlike Minimize, which keeps the best input
+// for every element, it uses greedy set cover: the input that covers the most
+// not-yet-covered elements is selected first. The result is ordered by selection.
+func MinimalCover(corpus []Context) []interface{} {
+	maxPrio := make(Signal)
+	for _, inp := range corpus {
+		maxPrio.Merge(inp.Signal)
+	}
+	covered := make(map[elemType]bool, len(maxPrio))
+	gain := func(inp Context) int {
+		n := 0
+		for e, p := range inp.Signal {
+			if p == maxPrio[e] && !covered[e] {
+				n++
+			}
+		}
+		return n
+	}
+	// Gains only decrease as more elements are covered, so we use lazy greedy:
+	// a candidate with stale gain is re-evaluated only when it reaches the top of the queue.
+	queue := make(coverQueue, 0, len(corpus))
+	for i, inp := range corpus {
+		if g := gain(inp); g != 0 {
+			queue = append(queue, coverCandidate{idx: i, gain: g})
+		}
+	}
+	heap.Init(&queue)
+	var result []interface{}
+	for len(covered) < len(maxPrio) && len(queue) != 0 {
+		top := &queue[0]
+		g := gain(corpus[top.idx])
+		if g == 0 {
+			heap.Pop(&queue)
+			continue
+		}
+		if g < top.gain {
+			top.gain = g
+			heap.Fix(&queue, 0)
+			continue
+		}
+		inp := corpus[heap.Pop(&queue).(coverCandidate).idx]
+		for e, p := range inp.Signal {
+			if p == maxPrio[e] {
+				covered[e] = true
+			}
+		}
+		result = append(result, inp.Context)
+	}
+	return result
+}
+
+type coverCandidate struct {
+	idx  int
+	gain int
+}
+
+type coverQueue []coverCandidate
+
+func (q coverQueue) Len() int { return len(q) }
+func (q coverQueue) Less(i, j int) bool {
+	if q[i].gain != q[j].gain {
+		return q[i].gain > q[j].gain
+	}
+	return q[i].idx < q[j].idx
+}
+func (q coverQueue) Swap(i, j int)       { q[i], q[j] = q[j], q[i] }
+func (q *coverQueue) Push(x interface{}) { *q = append(*q, x.(coverCandidate)) }
+func (q *coverQueue) Pop() interface{} {
+	old := *q
+	x := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return x
+}
@@ -0,0 +1,63 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package signal
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestMinimalCover(t *testing.T) {
+	mk := func(prio uint8, elems ...uint32) Signal {
+		return FromRaw(elems, prio)
+	}
+	corpus := []Context{
+		{mk(0, 1, 2), "small"},
+		{mk(0, 1, 2, 3, 4), "big"},
+		{mk(0, 3, 4), "redundant"},
+		{mk(1, 2), "prio"},
+		{mk(0, 5), "unique"},
+		{nil, "empty"},
+	}
+	var got []string
+	for _, ctx := range MinimalCover(corpus) {
+		got = append(got, ctx.(string))
+	}
+	want := []string{"big", "prio", "unique"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+
+	rnd := rand.New(rand.NewSource(0))
+	for iter := 0; iter < 100; iter++ {
+		corpus = nil
+		var all Signal
+		for i := 0; i < 50; i++ {
+			var elems []uint32
+			for j := rnd.Intn(20); j > 0; j-- {
+				elems = append(elems, uint32(rnd.Intn(200)))
+			}
+			s := FromRaw(elems, uint8(rnd.Intn(3)))
+			all.Merge(s)
+			corpus = append(corpus, Context{s, i})
+		}
+		var covered Signal
+		var idx []int
+		for _, ctx := range MinimalCover(corpus) {
+			covered.Merge(corpus[ctx.(int)].Signal)
+			idx = append(idx, ctx.(int))
+		}
+		if !reflect.DeepEqual(covered, all) {
+			t.Fatalf("cover does not match corpus signal: %v vs %v", covered.Len(), all.Len())
+		}
+		sort.Ints(idx)
+		for i := 1; i < len(idx); i++ {
+			if idx[i] == idx[i-1] {
+				t.Fatalf("input %v is selected twice", idx[i])
+			}
+		}
+	}
+}
@@ -0,0 +1,283 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package trace2syz
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/syzkaller/prog"
+)
+
+// Programs are built in the text format and then deserialized in non-strict mode,
+// which fills all arguments that we can't recover from the trace with default values.
+const encodingAddrBase = 0x7f0000000000
+
+// Convert converts the trace into programs of at most maxCalls calls each.
+// Calls that can't be matched to descriptions or are not enabled (if enabled is not nil)
+// are skipped, returns the number of skipped calls.
+func Convert(target *prog.Target, trace *Trace, enabled map[*prog.Syscall]bool, maxCalls int) (
+	[]*prog.Prog, int) {
+	ctx := &converter{
+		target: target,
+		byName: make(map[string][]*prog.Syscall),
+		byNR:   make(map[uint64]string),
+	}
+	for _, meta := range target.Syscalls {
+		if strings.HasPrefix(meta.CallName, "syz_") || enabled != nil && !enabled[meta] {
+			continue
+		}
+		ctx.byName[meta.CallName] = append(ctx.byName[meta.CallName], meta)
+		ctx.byNR[meta.NR] = meta.CallName
+	}
+	var progs []*prog.Prog
+	skipped := 0
+	calls := trace.Calls
+	for len(calls) != 0 {
+		ch := &chunk{
+			vars: make(map[uint64]*result),
+			mem:  target.NumPages * target.PageSize,
+		}
+		for len(calls) != 0 && ch.ncalls < maxCalls {
+			if !ctx.convertCall(ch, calls[0]) {
+				skipped++
+			}
+			calls = calls[1:]
+		}
+		if ch.ncalls == 0 {
+			continue
+		}
+		p, _, err := target.DeserializeNonStrict(ch.buf.Bytes())
+		if err != nil {
+			skipped += ch.ncalls
+			continue
+		}
+		progs = append(progs, p)
+	}
+	return progs, skipped
+}
+
+// Consts returns values of symbolic constants from descriptions of the target for Parse.
+func Consts(target *prog.Target) map[string]uint64 {
+	consts := make(map[string]uint64)
+	for _, c := range target.Consts {
+		consts[c.Name] = c.Value
+	}
+	return consts
+}
+
+type converter struct {
+	target *prog.Target
+	byName map[string][]*prog.Syscall // call name -> enabled variants
+	byNR   map[uint64]string          // syscall number -> call name
+}
+
+// chunk is the program being built.
+type chunk struct {
+	buf    bytes.Buffer
+	vars   map[uint64]*result // traced return value -> resource
+	nvars  int
+	ncalls int
+	addr   uint64 // next free address in the data area
+	mem    uint64 // size of the data area
+}
+
+type result struct {
+	name string
+	desc *prog.ResourceDesc
+}
+
+func (ctx *converter) convertCall(ch *chunk, c *Call) bool {
+	meta := ctx.selectCall(ch, c)
+	if meta == nil {
+		return false
+	}
+	var args []string
+	for i, typ := range meta.Args {
+		if i >= len(c.Args) {
+			break
+		}
+		args = append(args, ctx.convertArg(ch, typ, c.Args[i]))
+	}
+	if res, ok := meta.Ret.(*prog.ResourceType); ok && c.Ret >= 0 {
+		r := &result{
+			name: fmt.Sprintf("r%v", ch.nvars),
+			desc: res.Desc,
+		}
+		ch.nvars++
+		ch.vars[uint64(c.Ret)] = r
+		fmt.Fprintf(&ch.buf, "%v = ", r.name)
+	}
+	fmt.Fprintf(&ch.buf, "%v(%v)\n", meta.Name, strings.Join(args, ", "))
+	ch.ncalls++
+	return true
+}
+
+func (ctx *converter) convertArg(ch *chunk, typ prog.Type, v *Value) string {
+	switch t := typ.(type) {
+	case *prog.ResourceType:
+		if !v.IsInt {
+			break
+		}
+		if r := ch.vars[v.Int]; r != nil && compatibleResource(t.Desc, r.desc) {
+			return r.name
+		}
+		return fmt.Sprintf("0x%x", v.Int)
+	case *prog.ConstType:
+		// Default value of const arguments in non-strict deserialization is 0.
+		return fmt.Sprintf("0x%x", t.Val)
+	case *prog.IntType, *prog.FlagsType, *prog.LenType:
+		if v.IsInt {
+			return fmt.Sprintf("0x%x", v.Int)
+		}
+	case *prog.PtrType:
+		if v.IsInt && v.Int == 0 && t.Optional() {
+			return "0x0"
+		}
+		buf, ok := t.Type.(*prog.BufferType)
+		if !ok || !v.IsStr {
+			return fmt.Sprintf("&(0x%x)", ch.alloc(ctx.target.PageSize))
+		}
+		data := v.Str
+		if t.Type.Dir() == prog.DirOut {
+			return fmt.Sprintf("&(0x%x)=\"\"/0x%x", ch.alloc(uint64(len(data))), len(data))
+		}
+		if (buf.Kind == prog.BufferString || buf.Kind == prog.BufferFilename) && !buf.NoZ && !v.Truncated {
+			data = append(append([]byte{}, data...), 0)
+		}
+		return fmt.Sprintf("&(0x%x)=\"%x\"", ch.alloc(uint64(len(data))), data)
+	}
+	// Constants, structs, etc get default values.
+	return "nil"
+}
+
+// alloc returns address for a pointer argument of the given size.
+func (ch *chunk) alloc(size uint64) uint64 {
+	size = (size + 63) &^ 63
+	if size == 0 {
+		size = 64
+	}
+	if size > ch.mem {
+		size = ch.mem
+	}
+	if ch.addr+size > ch.mem {
+		ch.addr = 0
+	}
+	addr := ch.addr
+	ch.addr += size
+	return encodingAddrBase + addr
+}
+
+// selectCall selects the syscall variant that best matches the traced arguments:
+// constant arguments and string values must match, arguments of matching resource types are preferred.
+func (ctx *converter) selectCall(ch *chunk, c *Call) *prog.Syscall {
+	name := c.Name
+	if name == "" {
+		name = ctx.byNR[c.NR]
+	} else if ctx.byName[name] == nil {
+		// perf tracepoints use kernel function names (newfstat, newuname).
+		name = strings.TrimPrefix(name, "new")
+	}
+	variants := append([]*prog.Syscall{}, ctx.byName[name]...)
+	sort.Slice(variants, func(i, j int) bool {
+		generic1 := variants[i].Name == variants[i].CallName
+		generic2 := variants[j].Name == variants[j].CallName
+		if generic1 != generic2 {
+			return generic1
+		}
+		return variants[i].Name < variants[j].Name
+	})
+	var best *prog.Syscall
+	bestScore := -1
+	for _, meta := range variants {
+		if score := matchCall(ch, meta, c); score > bestScore {
+			best, bestScore = meta, score
+		}
+	}
+	return best
+}
+
+// matchCall returns how well the call matches the syscall variant, -1 if it does not match.
+func matchCall(ch *chunk, meta *prog.Syscall, c *Call) int {
+	score := 0
+	for i, typ := range meta.Args {
+		if i >= len(c.Args) {
+			break
+		}
+		v := c.Args[i]
+		switch t := typ.(type) {
+		case *prog.ConstType:
+			if !v.IsInt || t.IsPad {
+				break
+			}
+			if truncate(v.Int, t.Size()) != truncate(t.Val, t.Size()) {
+				return -1
+			}
+			score += 2
+		case *prog.ResourceType:
+			if !v.IsInt {
+				break
+			}
+			if ch.vars[v.Int] == nil {
+				// Special values (e.g. AT_FDCWD) match as well as constants.
+				for _, special := range t.Desc.Values {
+					if v.Int == special && special != t.Default() {
+						score += 2
+						break
+					}
+				}
+				break
+			}
+			if compatibleResource(t.Desc, ch.vars[v.Int].desc) {
+				score++
+			} else {
+				score -= 2
+			}
+		case *prog.PtrType:
+			buf, ok := t.Type.(*prog.BufferType)
+			if !ok || buf.Kind != prog.BufferString || len(buf.Values) == 0 || !v.IsStr || v.Truncated {
+				break
+			}
+			str := string(bytes.TrimRight(v.Str, "\x00"))
+			match := false
+			for _, val := range buf.Values {
+				if strings.TrimRight(val, "\x00") == str {
+					match = true
+					break
+				}
+			}
+			if !match {
+				return -1
+			}
+			score += 2
+		}
+	}
+	if score < 0 {
+		score = 0
+	}
+	return score
+}
+
+// compatibleResource returns whether a resource of type have can be passed as an argument of type want
+// (e.g. fd_kvm for fd, but not fd for fd_kvm).
+func compatibleResource(want, have *prog.ResourceDesc) bool {
+	if len(want.Kind) > len(have.Kind) {
+		return false
+	}
+	for i, kind := range want.Kind {
+		if have.Kind[i] != kind {
+			return false
+		}
+	}
+	return true
+}
+
+func truncate(v, size uint64) uint64 {
+	if size >= 8 {
+		return v
+	}
+	return v & (1<<(size*8) - 1)
+}
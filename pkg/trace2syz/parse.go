@@ -0,0 +1,370 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+// Package trace2syz converts syscall traces of real workloads into syzkaller programs.
+// Supported trace formats are strace output (strace -f [-s N] [-xx]) and perf script output
+// of syscalls:sys_enter_*/sys_exit_* or raw_syscalls:sys_enter/sys_exit tracepoints.
+// Traced argument values are preserved where the argument type allows it (integers, flags,
+// strings, resources returned by previous calls), everything else gets default values.
+package trace2syz
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Trace is a sequence of syscalls executed by a single thread.
+type Trace struct {
+	Pid   int
+	Calls []*Call
+}
+
+// Call is a single traced syscall.
+type Call struct {
+	Name string // syscall name, empty if only NR is known
+	NR   uint64 // syscall number, valid if Name is empty
+	Args []*Value
+	Ret  int64 // negative values are errors
+}
+
+// Value is a traced argument value.
+type Value struct {
+	Raw string // argument as it appears in the trace
+	// Integer value (numbers, NULL, known symbolic constants), valid if IsInt is set.
+	Int   uint64
+	IsInt bool
+	// Contents of a string argument, valid if IsStr is set.
+	Str       []byte
+	IsStr     bool
+	Truncated bool // the string was truncated by strace
+}
+
+// Parse parses a trace file and returns per-thread traces ordered by thread id.
+// consts resolves symbolic constants (e.g. O_RDWR) to values, it can be nil.
+func Parse(data []byte, consts map[string]uint64) ([]*Trace, error) {
+	p := &parser{
+		consts:     consts,
+		traces:     make(map[int]*Trace),
+		unfinished: make(map[int]string),
+		perfEnter:  make(map[int]*Call),
+	}
+	s := bufio.NewScanner(bytes.NewReader(data))
+	s.Buffer(nil, 64<<20)
+	for line := 1; s.Scan(); line++ {
+		if err := p.parseLine(s.Text()); err != nil {
+			return nil, fmt.Errorf("line #%v: %v", line, err)
+		}
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	var res []*Trace
+	for _, trace := range p.traces {
+		if len(trace.Calls) != 0 {
+			res = append(res, trace)
+		}
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Pid < res[j].Pid
+	})
+	return res, nil
+}
+
+type parser struct {
+	consts     map[string]uint64
+	traces     map[int]*Trace
+	unfinished map[int]string // pid -> beginning of an unfinished strace line
+	perfEnter  map[int]*Call  // pid -> perf sys_enter event waiting for sys_exit
+}
+
+var (
+	// perf script: "cat 123 [001] 1.234: syscalls:sys_enter_openat: dfd: 0xffffff9c, ..."
+	perfRe = regexp.MustCompile(`^\s*\S.*?\s(\d+)(?:/\d+)?\s+(?:\[\d+\]\s+)?[\d.]+:\s+` +
+		`(syscalls:sys_(?:enter|exit)_\w+|raw_syscalls:sys_(?:enter|exit)):\s*(.*)$`)
+	perfRawEnterRe = regexp.MustCompile(`^NR (\d+) \((.*)\)$`)
+	perfRawExitRe  = regexp.MustCompile(`^NR (\d+) = (-?\d+)$`)
+	// strace: optional pid ("123 " or "[pid 123] "), optional timestamp.
+	stracePrefixRe = regexp.MustCompile(`^(?:\[pid\s+(\d+)\]\s+|(\d+)\s+)?(?:[\d:.]+\s+)?`)
+	straceCallRe   = regexp.MustCompile(`^(\w+)\(`)
+	straceResumeRe = regexp.MustCompile(`^<\.\.\. (\w+) resumed>\s?`)
+)
+
+func (p *parser) parseLine(ln string) error {
+	ln = strings.TrimRight(ln, " \t\r")
+	if ln == "" {
+		return nil
+	}
+	if m := perfRe.FindStringSubmatch(ln); m != nil {
+		pid, _ := strconv.Atoi(m[1])
+		return p.parsePerf(pid, m[2], m[3])
+	}
+	m := stracePrefixRe.FindStringSubmatch(ln)
+	pid := 0
+	if m[1] != "" {
+		pid, _ = strconv.Atoi(m[1])
+	} else if m[2] != "" {
+		pid, _ = strconv.Atoi(m[2])
+	}
+	ln = ln[len(m[0]):]
+	if strings.HasPrefix(ln, "---") || strings.HasPrefix(ln, "+++") {
+		// Signals and exits.
+		return nil
+	}
+	if rm := straceResumeRe.FindStringSubmatch(ln); rm != nil {
+		start, ok := p.unfinished[pid]
+		if !ok {
+			return nil
+		}
+		delete(p.unfinished, pid)
+		ln = start + ln[len(rm[0]):]
+	} else if strings.HasSuffix(ln, "<unfinished ...>") {
+		p.unfinished[pid] = strings.TrimSpace(strings.TrimSuffix(ln, "<unfinished ...>"))
+		return nil
+	}
+	return p.parseStrace(pid, ln)
+}
+
+func (p *parser) parseStrace(pid int, ln string) error {
+	m := straceCallRe.FindStringSubmatch(ln)
+	if m == nil {
+		// Not a syscall (e.g. "strace: Process 123 attached").
+		return nil
+	}
+	args, end, err := splitArgs(ln, len(m[0]))
+	if err != nil {
+		return err
+	}
+	rest := strings.TrimSpace(ln[end:])
+	if !strings.HasPrefix(rest, "=") {
+		// The call did not return (e.g. exit_group).
+		return nil
+	}
+	fields := strings.Fields(rest[1:])
+	if len(fields) == 0 || fields[0] == "?" {
+		return nil
+	}
+	ret, err := strconv.ParseInt(fields[0], 0, 64)
+	if err != nil {
+		v, err1 := strconv.ParseUint(fields[0], 0, 64)
+		if err1 != nil {
+			return fmt.Errorf("bad return value %q", fields[0])
+		}
+		ret = int64(v)
+	}
+	c := &Call{
+		Name: m[1],
+		Ret:  ret,
+	}
+	for _, arg := range args {
+		c.Args = append(c.Args, p.parseStraceValue(arg))
+	}
+	p.add(pid, c)
+	return nil
+}
+
+// splitArgs splits top-level comma-separated arguments starting at ln[start]
+// up to the matching closing parenthesis, returns position after the parenthesis.
+func splitArgs(ln string, start int) ([]string, int, error) {
+	var args []string
+	depth, quote, escape := 0, false, false
+	argStart := start
+	for i := start; i < len(ln); i++ {
+		ch := ln[i]
+		switch {
+		case escape:
+			escape = false
+		case quote:
+			if ch == '\\' {
+				escape = true
+			} else if ch == '"' {
+				quote = false
+			}
+		case ch == '"':
+			quote = true
+		case ch == '(' || ch == '{' || ch == '[':
+			depth++
+		case (ch == '}' || ch == ']') && depth > 0:
+			depth--
+		case ch == ')' && depth > 0:
+			depth--
+		case ch == ')':
+			if arg := strings.TrimSpace(ln[argStart:i]); arg != "" || len(args) != 0 {
+				args = append(args, arg)
+			}
+			return args, i + 1, nil
+		case ch == ',' && depth == 0:
+			args = append(args, strings.TrimSpace(ln[argStart:i]))
+			argStart = i + 1
+		}
+	}
+	return nil, 0, fmt.Errorf("unterminated argument list")
+}
+
+func (p *parser) parseStraceValue(arg string) *Value {
+	v := &Value{Raw: arg}
+	if strings.HasPrefix(arg, "\"") {
+		str, n, ok := unquote(arg)
+		if ok {
+			v.Str, v.IsStr = str, true
+			v.Truncated = strings.HasPrefix(arg[n:], "...")
+		}
+		return v
+	}
+	if arg == "NULL" {
+		v.IsInt = true
+		return v
+	}
+	// Descriptors decoded with -y: 3</dev/kvm>.
+	if pos := strings.IndexByte(arg, '<'); pos > 0 && strings.HasSuffix(arg, ">") {
+		arg = arg[:pos]
+	}
+	v.Int, v.IsInt = p.parseFlags(arg)
+	return v
+}
+
+// parseFlags parses numbers and combinations of symbolic constants (O_RDWR|O_CLOEXEC).
+func (p *parser) parseFlags(s string) (uint64, bool) {
+	var res uint64
+	for _, part := range strings.Split(s, "|") {
+		part = strings.TrimSpace(part)
+		if v, err := strconv.ParseInt(part, 0, 64); err == nil {
+			res |= uint64(v)
+		} else if v, err := strconv.ParseUint(part, 0, 64); err == nil {
+			res |= v
+		} else if v, ok := p.consts[part]; ok {
+			res |= v
+		} else {
+			return 0, false
+		}
+	}
+	return res, true
+}
+
+// unquote decodes a C-escaped string literal at the beginning of s,
+// returns the string and the length of the literal.
+func unquote(s string) ([]byte, int, bool) {
+	var res []byte
+	for i := 1; i < len(s); i++ {
+		ch := s[i]
+		if ch == '"' {
+			return res, i + 1, true
+		}
+		if ch != '\\' {
+			res = append(res, ch)
+			continue
+		}
+		i++
+		if i == len(s) {
+			break
+		}
+		switch ch := s[i]; ch {
+		case 'n':
+			res = append(res, '\n')
+		case 't':
+			res = append(res, '\t')
+		case 'r':
+			res = append(res, '\r')
+		case 'v':
+			res = append(res, '\v')
+		case 'f':
+			res = append(res, '\f')
+		case 'x':
+			if i+2 >= len(s) {
+				return nil, 0, false
+			}
+			v, err := strconv.ParseUint(s[i+1:i+3], 16, 8)
+			if err != nil {
+				return nil, 0, false
+			}
+			res = append(res, byte(v))
+			i += 2
+		case '0', '1', '2', '3', '4', '5', '6', '7':
+			n := 1
+			for n < 3 && i+n < len(s) && s[i+n] >= '0' && s[i+n] <= '7' {
+				n++
+			}
+			v, err := strconv.ParseUint(s[i:i+n], 8, 8)
+			if err != nil {
+				return nil, 0, false
+			}
+			res = append(res, byte(v))
+			i += n - 1
+		default:
+			res = append(res, ch)
+		}
+	}
+	return nil, 0, false
+}
+
+func (p *parser) parsePerf(pid int, event, args string) error {
+	switch {
+	case event == "raw_syscalls:sys_enter":
+		m := perfRawEnterRe.FindStringSubmatch(args)
+		if m == nil {
+			return fmt.Errorf("bad sys_enter event %q", args)
+		}
+		nr, _ := strconv.ParseUint(m[1], 10, 64)
+		c := &Call{NR: nr}
+		for _, arg := range strings.Split(m[2], ",") {
+			arg = strings.TrimSpace(arg)
+			v, err := strconv.ParseUint(arg, 16, 64)
+			c.Args = append(c.Args, &Value{Raw: arg, Int: v, IsInt: err == nil})
+		}
+		p.perfEnter[pid] = c
+	case event == "raw_syscalls:sys_exit":
+		m := perfRawExitRe.FindStringSubmatch(args)
+		if m == nil {
+			return fmt.Errorf("bad sys_exit event %q", args)
+		}
+		nr, _ := strconv.ParseUint(m[1], 10, 64)
+		ret, _ := strconv.ParseInt(m[2], 10, 64)
+		c := p.perfEnter[pid]
+		delete(p.perfEnter, pid)
+		if c != nil && c.Name == "" && c.NR == nr {
+			c.Ret = ret
+			p.add(pid, c)
+		}
+	case strings.HasPrefix(event, "syscalls:sys_enter_"):
+		c := &Call{Name: strings.TrimPrefix(event, "syscalls:sys_enter_")}
+		if args != "" {
+			for _, arg := range strings.Split(args, ",") {
+				arg = strings.TrimSpace(arg)
+				val := arg
+				if pos := strings.Index(arg, ": "); pos != -1 {
+					val = arg[pos+2:]
+				}
+				v, err := strconv.ParseUint(val, 0, 64)
+				c.Args = append(c.Args, &Value{Raw: arg, Int: v, IsInt: err == nil})
+			}
+		}
+		p.perfEnter[pid] = c
+	case strings.HasPrefix(event, "syscalls:sys_exit_"):
+		name := strings.TrimPrefix(event, "syscalls:sys_exit_")
+		c := p.perfEnter[pid]
+		delete(p.perfEnter, pid)
+		if c == nil || c.Name != name {
+			return nil
+		}
+		ret, err := strconv.ParseUint(strings.TrimSpace(args), 0, 64)
+		if err != nil {
+			return fmt.Errorf("bad sys_exit event %q", args)
+		}
+		c.Ret = int64(ret)
+		p.add(pid, c)
+	}
+	return nil
+}
+
+func (p *parser) add(pid int, c *Call) {
+	trace := p.traces[pid]
+	if trace == nil {
+		trace = &Trace{Pid: pid}
+		p.traces[pid] = trace
+	}
+	trace.Calls = append(trace.Calls, c)
+}
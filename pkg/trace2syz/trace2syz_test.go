@@ -0,0 +1,132 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package trace2syz
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/syzkaller/prog"
+	_ "github.com/google/syzkaller/sys"
+)
+
+func TestParseStrace(t *testing.T) {
+	data := `
+strace: Process 101 attached
+100   openat(AT_FDCWD, "/dev/kvm", O_RDWR|O_CLOEXEC) = 3
+[pid   101] read(0,  <unfinished ...>
+100   ioctl(3, KVM_CREATE_VM, 0) = 4
+[pid   101] <... read resumed>"ab\n\0\x01", 5) = 5
+100   write(1, "hello, (world)"..., 100) = 100
+100   openat(AT_FDCWD, "/nonexistent", O_RDONLY) = -1 ENOENT (No such file or directory)
+101   --- SIGCHLD {si_signo=SIGCHLD, si_code=CLD_EXITED} ---
+100   exit_group(0)                     = ?
+100   +++ exited with 0 +++
+`
+	consts := map[string]uint64{
+		"AT_FDCWD":      0xffffffffffffff9c,
+		"O_RDWR":        2,
+		"O_CLOEXEC":     0x80000,
+		"KVM_CREATE_VM": 0xae01,
+	}
+	traces, err := Parse([]byte(data), consts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(traces) != 2 || traces[0].Pid != 100 || traces[1].Pid != 101 {
+		t.Fatalf("bad traces: %+v", traces)
+	}
+	calls := traces[0].Calls
+	if len(calls) != 4 {
+		t.Fatalf("want 4 calls, got %v", len(calls))
+	}
+	if c := calls[0]; c.Name != "openat" || c.Ret != 3 || len(c.Args) != 3 ||
+		c.Args[0].Int != consts["AT_FDCWD"] || string(c.Args[1].Str) != "/dev/kvm" ||
+		c.Args[2].Int != 0x80002 {
+		t.Errorf("bad openat call: %+v", c)
+	}
+	if c := calls[2]; !c.Args[1].Truncated || string(c.Args[1].Str) != "hello, (world)" || c.Args[2].Int != 100 {
+		t.Errorf("bad write call: %+v", c)
+	}
+	if c := calls[3]; c.Ret != -1 {
+		t.Errorf("bad failed call: %+v", c)
+	}
+	if c := traces[1].Calls[0]; c.Name != "read" || string(c.Args[1].Str) != "ab\n\x00\x01" || c.Ret != 5 {
+		t.Errorf("bad resumed call: %+v", c)
+	}
+}
+
+func TestParsePerf(t *testing.T) {
+	data := `
+  cat  200 [001] 10.000001: syscalls:sys_enter_openat: dfd: 0xffffff9c, filename: 0x7ffd1000, flags: 0x00000002, mode: 0x00000000
+  cat  200 [001] 10.000002: syscalls:sys_exit_openat: 0x3
+  cat  200 [001] 10.000003: raw_syscalls:sys_enter: NR 16 (3, ae01, 0, 0, 0, 0)
+  cat  200 [001] 10.000004: raw_syscalls:sys_exit: NR 16 = 4
+`
+	traces, err := Parse([]byte(data), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(traces) != 1 || len(traces[0].Calls) != 2 {
+		t.Fatalf("bad traces: %+v", traces)
+	}
+	calls := traces[0].Calls
+	if c := calls[0]; c.Name != "openat" || c.Ret != 3 || len(c.Args) != 4 || c.Args[2].Int != 2 {
+		t.Errorf("bad openat call: %+v", c)
+	}
+	if c := calls[1]; c.Name != "" || c.NR != 16 || c.Ret != 4 || c.Args[1].Int != 0xae01 {
+		t.Errorf("bad ioctl call: %+v", c)
+	}
+}
+
+func TestConvert(t *testing.T) {
+	target, err := prog.GetTarget("linux", "amd64")
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := `
+openat(AT_FDCWD, "/dev/kvm", O_RDWR|O_CLOEXEC) = 3
+ioctl(3, KVM_CREATE_VM, 0) = 4
+ioctl(4, KVM_CREATE_VCPU, 0) = 5
+write(1, "hello", 5) = 5
+read(0, "abc", 128) = 3
+openat(AT_FDCWD, "/etc/passwd", O_RDONLY) = 6
+close(6) = 0
+nonexistent_call(1, 2) = 0
+`
+	traces, err := Parse([]byte(data), Consts(target))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(traces) != 1 {
+		t.Fatalf("want 1 trace, got %v", len(traces))
+	}
+	progs, skipped := Convert(target, traces[0], nil, 4)
+	if skipped != 1 {
+		t.Errorf("want 1 skipped call, got %v", skipped)
+	}
+	if len(progs) != 2 {
+		t.Fatalf("want 2 programs, got %v", len(progs))
+	}
+	want := [][]string{
+		{
+			"r0 = openat$kvm(0xffffffffffffff9c, &(0x7f0000000000)='/dev/kvm\\x00', 0x80002, 0x0)",
+			"r1 = ioctl$KVM_CREATE_VM(r0, 0xae01, 0x0)",
+			"ioctl$KVM_CREATE_VCPU(r1, 0xae41, 0x0)",
+			"write(0x1, &(0x7f0000000040)='hello', 0x5)",
+		},
+		{
+			"read(0x0, &(0x7f0000000000)=\"\"/3, 0x80)",
+			"r0 = openat(0xffffffffffffff9c, &(0x7f0000000040)='/etc/passwd\\x00', 0x0, 0x0)",
+			"close(r0)",
+		},
+	}
+	for i, p := range progs {
+		got := strings.Split(strings.TrimSpace(string(p.Serialize())), "\n")
+		if strings.Join(got, "\n") != strings.Join(want[i], "\n") {
+			t.Errorf("program #%v:\ngot:\n%v\nwant:\n%v", i,
+				strings.Join(got, "\n"), strings.Join(want[i], "\n"))
+		}
+	}
+}
@@ -79,10 +79,18 @@ func (fu *fuchsia) ListRecentCommits(baseCommit string) ([]string, error) {
 	return nil, nil
 }
 
+func (fu *fuchsia) ListCommitRange(base, head string, max int) ([]*Commit, error) {
+	return nil, fmt.Errorf("not implemented for fuchsia")
+}
+
 func (fu *fuchsia) ExtractFixTagsFromCommits(baseCommit, email string) ([]FixCommit, error) {
 	return nil, fmt.Errorf("not implemented for fuchsia")
 }
 
+func (fu *fuchsia) ExtractFixInfoFromCommits(baseCommit, email string) ([]FixInfo, error) {
+	return nil, fmt.Errorf("not implemented for fuchsia")
+}
+
 func (fu *fuchsia) Bisect(bad, good string, trace io.Writer, pred func() (BisectResult, error)) (*Commit, error) {
 	return nil, fmt.Errorf("not implemented for fuchsia")
 }
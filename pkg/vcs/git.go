@@ -37,6 +37,8 @@ func (git *git) Poll(repo, branch string) (*Commit, error) {
 	dir := git.dir
 	runSandboxed(dir, "git", "bisect", "reset")
 	runSandboxed(dir, "git", "reset", "--hard")
+	// Remove files added by patches applied on top of the previous checkout (build artifacts are ignored).
+	runSandboxed(dir, "git", "clean", "-fd")
 	origin, err := runSandboxed(dir, "git", "remote", "get-url", "origin")
 	if err != nil || strings.TrimSpace(string(origin)) != repo {
 		// The repo is here, but it has wrong origin (e.g. repo in config has changed), re-clone.
@@ -135,6 +137,28 @@ func (git *git) initRepo() error {
 	return nil
 }
 
+// GitBranchPatches fetches the given branch into the git repository in dir
+// and returns commits of the branch that are not reachable from HEAD as patches
+// in the order they need to be applied (i.e. the commits that git cherry-pick HEAD..branch would pick).
+func GitBranchPatches(dir, repo, branch string) ([][]byte, error) {
+	if _, err := runSandboxed(dir, "git", "fetch", "--no-tags", repo, branch); err != nil {
+		return nil, err
+	}
+	output, err := runSandboxed(dir, "git", "rev-list", "--reverse", "--no-merges", "HEAD..FETCH_HEAD")
+	if err != nil {
+		return nil, err
+	}
+	var patches [][]byte
+	for _, hash := range strings.Fields(string(output)) {
+		patch, err := runSandboxed(dir, "git", "format-patch", "-1", "--stdout", hash)
+		if err != nil {
+			return nil, err
+		}
+		patches = append(patches, patch)
+	}
+	return patches, nil
+}
+
 func (git *git) HeadCommit() (*Commit, error) {
 	return git.getCommit("HEAD")
 }
@@ -200,7 +224,42 @@ func (git *git) ListRecentCommits(baseCommit string) ([]string, error) {
 	return strings.Split(string(output), "\n"), nil
 }
 
+func (git *git) ListCommitRange(base, head string, max int) ([]*Commit, error) {
+	output, err := runSandboxed(git.dir, "git", "log", "--format=%H %s", "--no-merges",
+		"-n", strconv.Itoa(max), base+".."+head)
+	if err != nil {
+		return nil, err
+	}
+	return gitParseCommitRange(output)
+}
+
+func gitParseCommitRange(output []byte) ([]*Commit, error) {
+	var commits []*Commit
+	for _, line := range strings.Split(string(output), "\n") {
+		if line == "" {
+			continue
+		}
+		pos := strings.IndexByte(line, ' ')
+		if pos != 40 {
+			return nil, fmt.Errorf("unexpected git log output: %q", line)
+		}
+		commits = append(commits, &Commit{
+			Hash:  line[:pos],
+			Title: line[pos+1:],
+		})
+	}
+	return commits, nil
+}
+
 func (git *git) ExtractFixTagsFromCommits(baseCommit, email string) ([]FixCommit, error) {
+	infos, err := git.ExtractFixInfoFromCommits(baseCommit, email)
+	if err != nil {
+		return nil, err
+	}
+	return fixInfoToCommits(infos), nil
+}
+
+func (git *git) ExtractFixInfoFromCommits(baseCommit, email string) ([]FixInfo, error) {
 	since := time.Now().Add(-time.Hour * 24 * 365).Format("01-02-2006")
 	cmd := exec.Command("git", "log", "--no-merges", "--since", since, baseCommit)
 	cmd.Dir = git.dir
@@ -213,27 +272,62 @@ func (git *git) ExtractFixTagsFromCommits(baseCommit, email string) ([]FixCommit
 	}
 	defer cmd.Wait()
 	defer cmd.Process.Kill()
-	return gitExtractFixTags(stdout, email)
+	return gitExtractFixInfo(stdout, email)
 }
 
 func gitExtractFixTags(r io.Reader, email string) ([]FixCommit, error) {
+	infos, err := gitExtractFixInfo(r, email)
+	if err != nil {
+		return nil, err
+	}
+	return fixInfoToCommits(infos), nil
+}
+
+func fixInfoToCommits(infos []FixInfo) []FixCommit {
+	var commits []FixCommit
+	for _, info := range infos {
+		commits = append(commits, FixCommit{info.Tag, info.Title})
+	}
+	return commits
+}
+
+func gitExtractFixInfo(r io.Reader, email string) ([]FixInfo, error) {
 	user, domain, err := splitEmail(email)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse email %q: %v", email, err)
 	}
 	var (
 		s           = bufio.NewScanner(r)
-		commits     []FixCommit
+		infos       []FixInfo
+		commitHash  = ""
 		commitTitle = ""
+		commitTags  []string
+		commitCVEs  []string
 		commitStart = []byte("commit ")
 		bodyPrefix  = []byte("    ")
 		userBytes   = []byte(user + "+")
 		domainBytes = []byte(domain)
 	)
+	// Tags and CVEs can appear anywhere in the commit message,
+	// so commit info is emitted only when the whole message is parsed.
+	flush := func() {
+		for _, tag := range commitTags {
+			infos = append(infos, FixInfo{
+				Tag:   tag,
+				Hash:  commitHash,
+				Title: commitTitle,
+				CVEs:  commitCVEs,
+			})
+		}
+		commitHash, commitTitle, commitTags, commitCVEs = "", "", nil, nil
+	}
 	for s.Scan() {
 		ln := s.Bytes()
 		if bytes.HasPrefix(ln, commitStart) {
-			commitTitle = ""
+			flush()
+			if fields := strings.Fields(string(ln[len(commitStart):])); len(fields) != 0 {
+				commitHash = fields[0]
+			}
 			continue
 		}
 		if !bytes.HasPrefix(ln, bodyPrefix) {
@@ -247,6 +341,9 @@ func gitExtractFixTags(r io.Reader, email string) ([]FixCommit, error) {
 			commitTitle = string(ln)
 			continue
 		}
+		for _, cve := range cveRe.FindAll(ln, -1) {
+			commitCVEs = appendUnique(commitCVEs, string(cve))
+		}
 		userPos := bytes.Index(ln, userBytes)
 		if userPos == -1 {
 			continue
@@ -257,10 +354,19 @@ func gitExtractFixTags(r io.Reader, email string) ([]FixCommit, error) {
 		}
 		startPos := userPos + len(userBytes)
 		endPos := userPos + len(userBytes) + domainPos + 1
-		tag := string(ln[startPos:endPos])
-		commits = append(commits, FixCommit{tag, commitTitle})
+		commitTags = append(commitTags, string(ln[startPos:endPos]))
+	}
+	flush()
+	return infos, s.Err()
+}
+
+func appendUnique(list []string, str string) []string {
+	for _, s := range list {
+		if s == str {
+			return list
+		}
 	}
-	return commits, s.Err()
+	return append(list, str)
 }
 
 func splitEmail(email string) (user, domain string, err error) {
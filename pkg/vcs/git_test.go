@@ -119,6 +119,26 @@ v1.
 	}
 }
 
+func TestGitParseCommitRange(t *testing.T) {
+	input := `2075b16e32c26e4031b9fd3cbe26c54676a8fcb5 rbtree: include rcu.h
+1d4a6b8c9e0f2a3b4c5d6e7f8091a2b3c4d5e6f7 net: fix build with CONFIG_INET=n
+`
+	want := []*Commit{
+		{Hash: "2075b16e32c26e4031b9fd3cbe26c54676a8fcb5", Title: "rbtree: include rcu.h"},
+		{Hash: "1d4a6b8c9e0f2a3b4c5d6e7f8091a2b3c4d5e6f7", Title: "net: fix build with CONFIG_INET=n"},
+	}
+	got, err := gitParseCommitRange([]byte(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got bad commits\ngot:  %+v\nwant: %+v", got, want)
+	}
+	if _, err := gitParseCommitRange([]byte("foo bar\n")); err == nil {
+		t.Fatalf("parsed bad output")
+	}
+}
+
 func TestGitExtractFixTags(t *testing.T) {
 	commits, err := gitExtractFixTags(strings.NewReader(extractFixTagsInput), extractFixTagsEmail)
 	if err != nil {
@@ -164,3 +184,51 @@ Date:   Fri Dec 22 11:59:09 2017 +0100
     
     Reported-and-tested-by: syzbot+8e4090902540da8c6e8fa640a0fc325c29c3efcb@my.mail.com
 `
+
+func TestGitExtractFixInfo(t *testing.T) {
+	input := `
+commit 0c8c77cd6ad4df6fa35b1d6a1f7e1dd5c8b6f7a5
+Author: me <foo@bar.com>
+Date:   Fri Dec 22 19:59:56 2017 +0100
+
+    net: fix use-after-free in foo
+    
+    This fixes CVE-2018-1000001 and CVE-2018-12345.
+    Reported-by: syzbot+8e4090902540da8c6e8f@my.mail.com
+    CVE: CVE-2018-12345
+
+commit 26cd53f078db858a6ccca338e13e7f4d1d291c22
+Author: me <foo@bar.com>
+Date:   Fri Dec 22 13:42:27 2017 +0100
+
+    mm: fix a bug without a CVE
+    
+    Reported-by: syzbot+a640a0fc325c29c3efcb@my.mail.com
+
+commit 7b62abdb0abadbaf7b3f3a23ab4d78485fbf9059
+Author: me <foo@bar.com>
+Date:   Fri Dec 22 11:59:09 2017 +0100
+
+    fs: unrelated change for CVE-2017-0001
+`
+	want := []FixInfo{
+		{
+			Tag:   "8e4090902540da8c6e8f",
+			Hash:  "0c8c77cd6ad4df6fa35b1d6a1f7e1dd5c8b6f7a5",
+			Title: "net: fix use-after-free in foo",
+			CVEs:  []string{"CVE-2018-1000001", "CVE-2018-12345"},
+		},
+		{
+			Tag:   "a640a0fc325c29c3efcb",
+			Hash:  "26cd53f078db858a6ccca338e13e7f4d1d291c22",
+			Title: "mm: fix a bug without a CVE",
+		},
+	}
+	got, err := gitExtractFixInfo(strings.NewReader(input), extractFixTagsEmail)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got : %+v\nwant: %+v", got, want)
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package vcs
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/syzkaller/pkg/osutil"
+)
+
+// TestRepo is a local git repository for tests of packages that work with repositories.
+type TestRepo struct {
+	t       *testing.T
+	Dir     string
+	commits int
+}
+
+// MakeTestRepo creates an empty git repository in dir.
+func MakeTestRepo(t *testing.T, dir string) *TestRepo {
+	repo := &TestRepo{
+		t:   t,
+		Dir: dir,
+	}
+	repo.Git("init")
+	return repo
+}
+
+// Git runs git command with args in the repository and fails the test on errors.
+func (repo *TestRepo) Git(args ...string) []byte {
+	args = append([]string{"-c", "user.name=syzkaller", "-c", "user.email=test@syzkaller.com"}, args...)
+	output, err := osutil.RunCmd(time.Minute, repo.Dir, "git", args...)
+	if err != nil {
+		repo.t.Fatal(err)
+	}
+	return output
+}
+
+// CommitChange commits a change to a file in the repository with the given title
+// and returns hash of the new commit.
+func (repo *TestRepo) CommitChange(title string) string {
+	repo.commits++
+	file := filepath.Join(repo.Dir, "file")
+	if err := osutil.WriteFile(file, []byte(fmt.Sprintf("change %v", repo.commits))); err != nil {
+		repo.t.Fatal(err)
+	}
+	repo.Git("add", file)
+	repo.Git("commit", "-q", "-m", title)
+	return strings.TrimSpace(string(repo.Git("rev-parse", "HEAD")))
+}
@@ -36,11 +36,19 @@ type Repo interface {
 	// ListRecentCommits returns list of recent commit titles starting from baseCommit.
 	ListRecentCommits(baseCommit string) ([]string, error)
 
+	// ListCommitRange returns at most max commits that are reachable from head, but not from base
+	// (i.e. base..head range), most recent first. Only Hash and Title of the commits are filled in.
+	ListCommitRange(base, head string, max int) ([]*Commit, error)
+
 	// ExtractFixTagsFromCommits extracts fixing tags for bugs from git log.
 	// Given email = "user@domain.com", it searches for tags of the form "user+tag@domain.com"
 	// and return pairs {tag, commit title}.
 	ExtractFixTagsFromCommits(baseCommit, email string) ([]FixCommit, error)
 
+	// ExtractFixInfoFromCommits is similar to ExtractFixTagsFromCommits,
+	// but also returns commit hashes and CVE identifiers mentioned in commit messages.
+	ExtractFixInfoFromCommits(baseCommit, email string) ([]FixInfo, error)
+
 	// PreviousReleaseTags returns list of preceding release tags that are reachable from the given commit.
 	PreviousReleaseTags(commit string) ([]string, error)
 
@@ -65,6 +73,13 @@ type FixCommit struct {
 	Title string
 }
 
+type FixInfo struct {
+	Tag   string
+	Hash  string
+	Title string
+	CVEs  []string
+}
+
 type BisectResult int
 
 const (
@@ -187,3 +202,10 @@ var commitPrefixes = []string{
 	"FROMGIT:",
 	"net-backports:",
 }
+
+// CheckCVE checks that the string is a valid CVE identifier (e.g. CVE-2018-1000001).
+func CheckCVE(cve string) bool {
+	return cveRe.FindString(cve) == cve
+}
+
+var cveRe = regexp.MustCompile(`CVE-[0-9]{4}-[0-9]{4,}`)
@@ -5,6 +5,7 @@ package prog
 
 import (
 	"fmt"
+	"strings"
 )
 
 type CsumChunkKind int
@@ -83,11 +84,16 @@ func calcChecksumsCall(c *Call) map[Arg]CsumInfo {
 		if !ok {
 			return
 		}
-		// syz_csum_* structs are used in tests
-		switch groupArg.Type().Name() {
+		// syz_csum_* structs are used in tests.
+		// ipv6_packet_t is a template, strip arguments from the instance name.
+		name := groupArg.Type().Name()
+		if pos := strings.IndexByte(name, '['); pos != -1 {
+			name = name[:pos]
+		}
+		switch name {
 		case "ipv4_header", "syz_csum_ipv4_header":
 			ipSrcAddr, ipDstAddr = extractHeaderParams(groupArg, 4)
-		case "ipv6_packet", "syz_csum_ipv6_header":
+		case "ipv6_packet_t", "syz_csum_ipv6_header":
 			ipSrcAddr, ipDstAddr = extractHeaderParams(groupArg, 16)
 		}
 	})
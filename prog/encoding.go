@@ -9,6 +9,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"strconv"
+	"strings"
 )
 
 // String generates a very compact program description (mostly for debug output).
@@ -53,6 +54,28 @@ func (p *Prog) Serialize() []byte {
 	return buf.Bytes()
 }
 
+// Versioned program format.
+// SerializeVersioned prepends the program with a header that contains format version,
+// target and revision of descriptions the program was serialized with:
+//
+//	# syz-program v2 linux/amd64 REVISION
+//
+// The header is a comment, so older versions of syzkaller simply ignore it.
+// Programs with the header are deserialized in non-strict mode (see DeserializeNonStrict),
+// so that corpora survive description renames and removals. Programs without the header
+// are treated as version 1 and deserialized in strict mode.
+const (
+	ProgFormatVersion = 2
+	progHeaderPrefix  = "# syz-program v"
+)
+
+// SerializeVersioned serializes the program in the versioned format.
+func (p *Prog) SerializeVersioned() []byte {
+	header := fmt.Sprintf("%v%v %v/%v %v\n", progHeaderPrefix, ProgFormatVersion,
+		p.Target.OS, p.Target.Arch, p.Target.Revision)
+	return append([]byte(header), p.Serialize()...)
+}
+
 func (target *Target) serialize(arg Arg, buf *bytes.Buffer, vars map[*ResultArg]int, varSeq *int) {
 	if arg == nil {
 		fmt.Fprintf(buf, "nil")
@@ -150,7 +173,83 @@ func (target *Target) serialize(arg Arg, buf *bytes.Buffer, vars map[*ResultArg]
 	}
 }
 
-func (target *Target) Deserialize(data []byte) (prog *Prog, err error) {
+// Deserialize parses a program. Programs in the versioned format (see SerializeVersioned)
+// are parsed in non-strict mode, other programs are parsed in strict mode.
+func (target *Target) Deserialize(data []byte) (*Prog, error) {
+	prog, _, err := target.deserialize(data, false)
+	return prog, err
+}
+
+// DeserializeNonStrict parses a program in non-strict mode: calls that are not present
+// in the current descriptions are skipped. Returns human-readable warnings
+// about skipped calls and mismatching descriptions revision.
+// It is an error if no calls are left in the program.
+func (target *Target) DeserializeNonStrict(data []byte) (*Prog, []string, error) {
+	return target.deserialize(data, true)
+}
+
+func (target *Target) deserialize(data []byte, nonStrict bool) (prog *Prog, warnings []string, err error) {
+	version, targetName, revision, err := parseProgHeader(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	if version != 0 {
+		if version > ProgFormatVersion {
+			return nil, nil, fmt.Errorf("program format version %v is not supported (max %v)",
+				version, ProgFormatVersion)
+		}
+		if want := target.OS + "/" + target.Arch; targetName != want {
+			return nil, nil, fmt.Errorf("program is for target %v, want %v", targetName, want)
+		}
+		if revision != target.Revision {
+			warnings = append(warnings, fmt.Sprintf("program was serialized with descriptions revision %v,"+
+				" current revision %v", revision, target.Revision))
+		}
+		nonStrict = true
+	}
+	prog, skipped, err := target.deserializeCalls(data, nonStrict)
+	if err != nil {
+		return nil, nil, err
+	}
+	warnings = append(warnings, skipped...)
+	if len(skipped) != 0 && len(prog.Calls) == 0 {
+		return nil, nil, fmt.Errorf("program does not contain any known calls")
+	}
+	return prog, warnings, nil
+}
+
+// parseProgHeader parses header of the versioned program format.
+// The header can be preceded by other comments (e.g. repro options).
+// Returns 0 version if the program does not have the header.
+func parseProgHeader(data []byte) (version int, target, revision string, err error) {
+	s := bufio.NewScanner(bytes.NewReader(data))
+	s.Buffer(nil, maxLineLen)
+	for line := 1; s.Scan(); line++ {
+		ln := strings.TrimSpace(s.Text())
+		if ln == "" {
+			continue
+		}
+		if ln[0] != '#' {
+			return 0, "", "", nil
+		}
+		if !strings.HasPrefix(ln, progHeaderPrefix) {
+			continue
+		}
+		parts := strings.Fields(ln[len(progHeaderPrefix):])
+		version := 0
+		if len(parts) == 3 {
+			version, _ = strconv.Atoi(parts[0])
+		}
+		if version < 2 {
+			return 0, "", "", &ParseError{Line: line, Col: 1, Text: s.Text(),
+				Msg: fmt.Sprintf("bad program header %q", ln)}
+		}
+		return version, parts[1], parts[2], nil
+	}
+	return 0, "", "", s.Err()
+}
+
+func (target *Target) deserializeCalls(data []byte, nonStrict bool) (prog *Prog, skipped []string, err error) {
 	prog = &Prog{
 		Target: target,
 	}
@@ -160,17 +259,23 @@ func (target *Target) Deserialize(data []byte) (prog *Prog, err error) {
 		if p.EOF() || p.Char() == '#' {
 			continue
 		}
+		namePos := p.i
 		name := p.Ident()
 		r := ""
 		if p.Char() == '=' {
 			r = name
 			p.Parse('=')
+			namePos = p.i
 			name = p.Ident()
-
 		}
 		meta := target.SyscallMap[name]
 		if meta == nil {
-			return nil, fmt.Errorf("unknown syscall %v", name)
+			if nonStrict && p.Err() == nil {
+				// References to the result of the skipped call are replaced with default values.
+				skipped = append(skipped, fmt.Sprintf("skipping unknown call %v (line #%v)", name, p.l))
+				continue
+			}
+			return nil, nil, p.errorf(namePos, "unknown syscall %v", name)
 		}
 		c := &Call{
 			Meta: meta,
@@ -185,11 +290,11 @@ func (target *Target) Deserialize(data []byte) (prog *Prog, err error) {
 			}
 			typ := meta.Args[i]
 			if IsPad(typ) {
-				return nil, fmt.Errorf("padding in syscall %v arguments", name)
+				return nil, nil, p.errorf(p.i, "padding in syscall %v arguments", name)
 			}
 			arg, err := target.parseArg(typ, p, vars)
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 			c.Args = append(c.Args, arg)
 			if p.Char() != ')' {
@@ -198,26 +303,26 @@ func (target *Target) Deserialize(data []byte) (prog *Prog, err error) {
 		}
 		p.Parse(')')
 		if !p.EOF() {
-			return nil, fmt.Errorf("tailing data (line #%v)", p.l)
+			return nil, nil, p.errorf(p.i, "tailing data")
 		}
 		for i := len(c.Args); i < len(meta.Args); i++ {
 			c.Args = append(c.Args, target.defaultArg(meta.Args[i]))
 		}
 		if len(c.Args) != len(meta.Args) {
-			return nil, fmt.Errorf("wrong call arg count: %v, want %v", len(c.Args), len(meta.Args))
+			return nil, nil, p.errorf(namePos, "wrong call arg count: %v, want %v", len(c.Args), len(meta.Args))
 		}
 		if r != "" && c.Ret != nil {
 			vars[r] = c.Ret
 		}
 	}
 	if err := p.Err(); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	// This validation is done even in non-debug mode because deserialization
 	// procedure does not catch all bugs (e.g. mismatched types).
 	// And we can receive bad programs from corpus and hub.
 	if err := prog.validate(); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	for _, c := range prog.Calls {
 		target.SanitizeCall(c)
@@ -226,6 +331,7 @@ func (target *Target) Deserialize(data []byte) (prog *Prog, err error) {
 }
 
 func (target *Target) parseArg(typ Type, p *parser, vars map[string]*ResultArg) (Arg, error) {
+	pos := p.i
 	r := ""
 	if p.Char() == '<' {
 		p.Parse('<')
@@ -235,13 +341,13 @@ func (target *Target) parseArg(typ Type, p *parser, vars map[string]*ResultArg)
 	}
 	arg, err := target.parseArgImpl(typ, p, vars)
 	if err != nil {
-		return nil, err
+		return nil, p.wrapError(pos, err)
 	}
 	if arg == nil {
 		if typ != nil {
 			arg = target.defaultArg(typ)
 		} else if r != "" {
-			return nil, fmt.Errorf("named nil argument")
+			return nil, p.errorf(pos, "named nil argument")
 		}
 	}
 	if r != "" {
@@ -275,8 +381,7 @@ func (target *Target) parseArgImpl(typ Type, p *parser, vars map[string]*ResultA
 		return nil, nil
 
 	default:
-		return nil, fmt.Errorf("failed to parse argument at %v (line #%v/%v: %v)",
-			int(p.Char()), p.l, p.i, p.s)
+		return nil, fmt.Errorf("failed to parse argument at %q", p.Char())
 	}
 }
 
@@ -828,7 +933,43 @@ func (p *parser) Ident() string {
 }
 
 func (p *parser) failf(msg string, args ...interface{}) {
-	p.e = fmt.Errorf("%v\nline #%v: %v", fmt.Sprintf(msg, args...), p.l, p.s)
+	p.e = p.errorf(p.i, msg, args...)
+}
+
+// errorf returns a ParseError for position pos of the current line.
+func (p *parser) errorf(pos int, msg string, args ...interface{}) error {
+	return &ParseError{
+		Line: p.l,
+		Col:  pos + 1,
+		Text: p.s,
+		Msg:  fmt.Sprintf(msg, args...),
+	}
+}
+
+// wrapError attaches position pos of the current line to err,
+// unless err already has a more precise position.
+func (p *parser) wrapError(pos int, err error) error {
+	if p.e != nil {
+		// Parser errors are usually the root cause.
+		return p.e
+	}
+	if _, ok := err.(*ParseError); ok {
+		return err
+	}
+	return p.errorf(pos, "%v", err)
+}
+
+// ParseError is returned by Deserialize for malformed programs,
+// it contains position of the error in the program text.
+type ParseError struct {
+	Line int    // 1-based line number
+	Col  int    // 1-based column number
+	Text string // contents of the line
+	Msg  string
+}
+
+func (err *ParseError) Error() string {
+	return fmt.Sprintf("%v\nline #%v:%v: %v", err.Msg, err.Line, err.Col, err.Text)
 }
 
 // CallSet returns a set of all calls in the program.
@@ -261,6 +261,123 @@ func TestSerializeDeserialize(t *testing.T) {
 	}
 }
 
+func TestDeserializeVersioned(t *testing.T) {
+	target := initTargetTest(t, "test", "64")
+	header := fmt.Sprintf("# syz-program v2 test/64 %v\n", target.Revision)
+	tests := []struct {
+		input    string
+		output   string
+		warnings int
+		err      string
+	}{
+		{
+			input:  header + "r0 = syz_test$res0()\nsyz_test$res1(r0)\n",
+			output: "r0 = syz_test$res0()\nsyz_test$res1(r0)\n",
+		},
+		{
+			input:    header + "r0 = syz_test$renamed()\nsyz_test$res1(r0)\n",
+			output:   "syz_test$res1(0xffff)\n",
+			warnings: 1,
+		},
+		{
+			input:    "# syz-program v2 test/64 oldrevision\nsyz_test$res1(0x0)\n",
+			output:   "syz_test$res1(0x0)\n",
+			warnings: 1,
+		},
+		{
+			input:  "# {Threaded:true}\n\n" + header + "syz_test$res1(0x0)\n",
+			output: "syz_test$res1(0x0)\n",
+		},
+		{
+			input: header + "syz_test$renamed()\n",
+			err:   "program does not contain any known calls",
+		},
+		{
+			input: "# syz-program v3 test/64 rev\nsyz_test$res1(0x0)\n",
+			err:   "program format version 3 is not supported",
+		},
+		{
+			input: "# syz-program v2 test/32 rev\nsyz_test$res1(0x0)\n",
+			err:   "program is for target test/32, want test/64",
+		},
+		{
+			input: "# syz-program v2\nsyz_test$res1(0x0)\n",
+			err:   "bad program header",
+		},
+		{
+			input: "syz_test$renamed()\n",
+			err:   "unknown syscall syz_test$renamed",
+		},
+	}
+	for i, test := range tests {
+		p, warnings, err := target.deserialize([]byte(test.input), false)
+		if test.err != "" {
+			if err == nil || !strings.Contains(err.Error(), test.err) {
+				t.Errorf("#%v: got error %v, want %q", i, err, test.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("#%v: deserialization failed: %v", i, err)
+			continue
+		}
+		if len(warnings) != test.warnings {
+			t.Errorf("#%v: got warnings %q, want %v", i, warnings, test.warnings)
+		}
+		if output := string(p.Serialize()); output != test.output {
+			t.Errorf("#%v: got:\n%s\nwant:\n%s", i, output, test.output)
+		}
+		data := p.SerializeVersioned()
+		if !bytes.HasPrefix(data, []byte(header)) {
+			t.Errorf("#%v: bad versioned serialization:\n%s", i, data)
+		}
+		p1, warnings, err := target.deserialize(data, false)
+		if err != nil || len(warnings) != 0 {
+			t.Errorf("#%v: failed to deserialize versioned program: %v %q", i, err, warnings)
+			continue
+		}
+		if output := string(p1.Serialize()); output != test.output {
+			t.Errorf("#%v: got:\n%s\nwant:\n%s", i, output, test.output)
+		}
+	}
+	if _, warnings, err := target.DeserializeNonStrict([]byte("syz_test$renamed()\nsyz_test$res1(0x0)\n")); err != nil ||
+		len(warnings) != 1 {
+		t.Errorf("non-strict deserialization failed: %v %q", err, warnings)
+	}
+}
+
+func TestDeserializeErrorPos(t *testing.T) {
+	target := initTargetTest(t, "test", "64")
+	tests := []struct {
+		input string
+		line  int
+		col   int
+		msg   string
+	}{
+		{"syz_test$renamed()\n", 1, 1, "unknown syscall syz_test$renamed"},
+		{"syz_test()\nr0 = syz_test$renamed()\n", 2, 6, "unknown syscall syz_test$renamed"},
+		{"syz_test$int(0x0, 0x1, 0xz)\n", 1, 24, "wrong arg value '0xz'"},
+		{"syz_test$int(0x0, 0x1 0x2)\n", 1, 23, "want ',', got '0'"},
+		{"\nsyz_test$int(0x0, %)\n", 2, 19, "failed to parse argument at '%'"},
+		{"syz_test$int(0x0) foo\n", 1, 19, "tailing data"},
+		{"syz_test$opt1(&(0x7f0000000000)=0x0\n", 1, 36, "unexpected eof"},
+		{"syz_test$opt1(&(0xz)=0x0)\n", 1, 15, "failed to parse addr"},
+		{"# foo\n# syz-program v2\nsyz_test()\n", 2, 1, "bad program header"},
+	}
+	for i, test := range tests {
+		_, err := target.Deserialize([]byte(test.input))
+		perr, ok := err.(*ParseError)
+		if !ok {
+			t.Errorf("#%v: got error %v, want ParseError", i, err)
+			continue
+		}
+		if perr.Line != test.line || perr.Col != test.col || !strings.Contains(perr.Msg, test.msg) {
+			t.Errorf("#%v: got error at %v:%v %q, want at %v:%v %q",
+				i, perr.Line, perr.Col, perr.Msg, test.line, test.col, test.msg)
+		}
+	}
+}
+
 func TestSerializeDeserializeRandom(t *testing.T) {
 	testEachTargetRandom(t, func(t *testing.T, target *Target, rs rand.Source, iters int) {
 		data0 := make([]byte, ExecBufferSize)
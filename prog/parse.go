@@ -17,6 +17,7 @@ type LogEntry struct {
 	Fault     bool // program was executed with fault injection in FaultCall/FaultNth
 	FaultCall int
 	FaultNth  int
+	Creds     string // credential context the program was executed in (see pkg/creds), "" for root
 }
 
 func (target *Target) ParseLog(data []byte) []*LogEntry {
@@ -48,6 +49,7 @@ func (target *Target) ParseLog(data []byte) []*LogEntry {
 				ent.FaultCall = faultCall
 				ent.FaultNth, _ = extractInt(line, "fault-nth:")
 			}
+			ent.Creds = extractString(line, "creds:")
 			cur = nil
 			continue
 		}
@@ -82,3 +84,16 @@ func extractInt(line []byte, prefix string) (int, bool) {
 	v, _ := strconv.Atoi(string(line[pos:end]))
 	return v, true
 }
+
+func extractString(line []byte, prefix string) string {
+	pos := bytes.Index(line, []byte(prefix))
+	if pos == -1 {
+		return ""
+	}
+	pos += len(prefix)
+	end := pos
+	for end != len(line) && line[end] != ' ' && line[end] != ')' && line[end] != '\n' {
+		end++
+	}
+	return string(line[pos:end])
+}
@@ -33,6 +33,9 @@ gettid()
 	if ent.Fault || ent.FaultCall != 0 || ent.FaultNth != 0 {
 		t.Fatalf("fault injection enabled")
 	}
+	if ent.Creds != "" {
+		t.Fatalf("credential context %q", ent.Creds)
+	}
 	want := "getpid-gettid"
 	got := ent.P.String()
 	if got != want {
@@ -138,3 +141,26 @@ getpid()
 		t.Fatalf("bad program: %s, want %s", got, want)
 	}
 }
+
+func TestParseCreds(t *testing.T) {
+	t.Parallel()
+	target, err := GetTarget("linux", "amd64")
+	if err != nil {
+		t.Fatal(err)
+	}
+	const execLog = `2015/12/21 12:18:05 executing program 1 (creds:uid=65534/nnp):
+gettid()
+2015/12/21 12:18:05 executing program 2 (fault-call:0 fault-nth:3 creds:caps=net_admin):
+getpid()
+`
+	entries := target.ParseLog([]byte(execLog))
+	if len(entries) != 2 {
+		t.Fatalf("got %v programs, want 2", len(entries))
+	}
+	if ent := entries[0]; ent.Creds != "uid=65534/nnp" || ent.Fault {
+		t.Fatalf("bad entry 0: creds %q, fault %v", ent.Creds, ent.Fault)
+	}
+	if ent := entries[1]; ent.Creds != "caps=net_admin" || !ent.Fault || ent.FaultNth != 3 {
+		t.Fatalf("bad entry 1: creds %q, fault %v/%v", ent.Creds, ent.Fault, ent.FaultNth)
+	}
+}
@@ -195,6 +195,51 @@ type ChoiceTable struct {
 	enabled      map[*Syscall]bool
 }
 
+// CallBudget bounds the share of a group of syscalls (e.g. a less common protocol family)
+// among calls chosen by the generator, the group stays enabled but gets a limited share of executions.
+type CallBudget struct {
+	Calls []int // syscall IDs
+	Share float64
+}
+
+// ApplyCallBudgets scales priorities in place so that after any enabled call
+// a call from each budget group is chosen with probability at most Share.
+// Groups are processed in order and are not expected to overlap.
+func (target *Target) ApplyCallBudgets(prios [][]float32, enabled map[*Syscall]bool, budgets []CallBudget) {
+	for _, budget := range budgets {
+		group := make(map[int]bool)
+		for _, id := range budget.Calls {
+			if enabled[target.Syscalls[id]] {
+				group[id] = true
+			}
+		}
+		for i, row := range prios {
+			if !enabled[target.Syscalls[i]] {
+				continue
+			}
+			var in, out float64
+			for j, w := range row {
+				if !enabled[target.Syscalls[j]] {
+					continue
+				}
+				if group[j] {
+					in += float64(w)
+				} else {
+					out += float64(w)
+				}
+			}
+			if in == 0 || out == 0 || in <= budget.Share*(in+out) {
+				continue
+			}
+			// Solve in*scale / (in*scale + out) == Share.
+			scale := float32(budget.Share * out / ((1 - budget.Share) * in))
+			for j := range group {
+				row[j] *= scale
+			}
+		}
+	}
+}
+
 func (target *Target) BuildChoiceTable(prios [][]float32, enabled map[*Syscall]bool) *ChoiceTable {
 	if enabled == nil {
 		enabled = make(map[*Syscall]bool)
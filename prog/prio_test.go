@@ -0,0 +1,44 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package prog
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCallBudgets(t *testing.T) {
+	testEachTarget(t, func(t *testing.T, target *Target) {
+		enabled := make(map[*Syscall]bool)
+		budget := CallBudget{Share: 0.05}
+		for _, c := range target.Syscalls {
+			enabled[c] = true
+			if strings.HasPrefix(c.Name, "socket") {
+				budget.Calls = append(budget.Calls, c.ID)
+			}
+		}
+		if len(budget.Calls) == 0 {
+			t.Skip("no socket calls")
+		}
+		group := make(map[int]bool)
+		for _, id := range budget.Calls {
+			group[id] = true
+		}
+		prios := target.CalculatePriorities(nil)
+		target.ApplyCallBudgets(prios, enabled, []CallBudget{budget})
+		for i, row := range prios {
+			var in, total float64
+			for j, w := range row {
+				total += float64(w)
+				if group[j] {
+					in += float64(w)
+				}
+			}
+			if in > (budget.Share+1e-3)*total {
+				t.Fatalf("call %v: group share %v, want at most %v",
+					target.Syscalls[i].Name, in/total, budget.Share)
+			}
+		}
+	})
+}
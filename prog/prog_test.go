@@ -19,6 +19,16 @@ func TestGeneration(t *testing.T) {
 	}
 }
 
+func TestGenerateCallProg(t *testing.T) {
+	target, rs, _ := initTest(t)
+	for _, meta := range target.Syscalls {
+		p := target.GenerateCallProg(rs, meta)
+		if len(p.Calls) == 0 || p.Calls[len(p.Calls)-1].Meta != meta {
+			t.Fatalf("program for %v does not end with the call:\n%s", meta.Name, p.Serialize())
+		}
+	}
+}
+
 func TestDefault(t *testing.T) {
 	target, _, _ := initTest(t)
 	for _, meta := range target.Syscalls {
@@ -387,6 +387,11 @@ func (r *randGen) nOutOf(n, outOf int) bool {
 }
 
 func (r *randGen) generateCall(s *state, p *Prog) []*Call {
+	if len(r.target.recipes) != 0 && r.oneOf(recipeChance) {
+		if calls := r.generateRecipe(s); calls != nil {
+			return calls
+		}
+	}
 	idx := 0
 	if s.ct == nil {
 		idx = r.Intn(len(r.target.Syscalls))
@@ -440,6 +445,24 @@ func (target *Target) GenerateAllSyzProg(rs rand.Source) *Prog {
 	return p
 }
 
+// GenerateCallProg generates a program that ends with a call to meta
+// preceded by calls that create resources required by the call.
+func (target *Target) GenerateCallProg(rs rand.Source, meta *Syscall) *Prog {
+	p := &Prog{
+		Target: target,
+	}
+	r := newRand(target, rs)
+	s := newState(target, nil)
+	for _, c := range r.generateParticularCall(s, meta) {
+		s.analyze(c)
+		p.Calls = append(p.Calls, c)
+	}
+	if err := p.validate(); err != nil {
+		panic(err)
+	}
+	return p
+}
+
 // GenerateSimpleProg generates the simplest non-empty program for testing
 // (e.g. containing a single mmap).
 func (target *Target) GenerateSimpleProg() *Prog {
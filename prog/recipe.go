@@ -0,0 +1,79 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package prog
+
+import (
+	"fmt"
+)
+
+// Recipe is a named sequence of calls that sets up some state which is hard to reach
+// with random generation (e.g. create a network device and bring it up).
+// Generator occasionally inserts recipes as a whole, and then the calls are mutated as usual.
+type Recipe struct {
+	Name string
+	// Text is a program in the text format.
+	// Values of const arguments are ignored and sizes are recalculated,
+	// so the same text can be used for all arches. Data addresses are chosen on insertion.
+	Text string
+}
+
+// recipeChance is 1/probability of inserting a recipe instead of a single random call.
+const recipeChance = 50
+
+func (target *Target) initRecipes() {
+	for _, recipe := range target.Recipes {
+		p, err := target.Deserialize([]byte(recipe.Text))
+		if err != nil {
+			panic(fmt.Sprintf("%v/%v: failed to parse recipe %q: %v",
+				target.OS, target.Arch, recipe.Name, err))
+		}
+		for _, c := range p.Calls {
+			ForeachArg(c, func(arg Arg, _ *ArgCtx) {
+				if a, ok := arg.(*ConstArg); ok {
+					if typ, ok := a.Type().(*ConstType); ok {
+						a.Val = typ.Val
+					}
+				}
+			})
+			target.assignSizesCall(c)
+		}
+		target.recipes = append(target.recipes, p)
+	}
+}
+
+// generateRecipe returns calls of a random recipe with all calls enabled, or nil.
+func (r *randGen) generateRecipe(s *state) []*Call {
+	var candidates []*Prog
+	for _, p := range r.target.recipes {
+		enabled := true
+		for _, c := range p.Calls {
+			if s.ct != nil && !s.ct.enabled[c.Meta] {
+				enabled = false
+				break
+			}
+		}
+		if enabled {
+			candidates = append(candidates, p)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+	p := candidates[r.Intn(len(candidates))].Clone()
+	for _, c := range p.Calls {
+		ForeachArg(c, func(arg Arg, _ *ArgCtx) {
+			a, ok := arg.(*PointerArg)
+			if !ok || a.IsNull() {
+				return
+			}
+			if a.Res != nil {
+				a.Address = s.ma.alloc(r, a.Res.Size())
+			} else {
+				a.Address = s.va.alloc(r, a.VmaSize/r.target.PageSize) * r.target.PageSize
+			}
+		})
+		r.target.SanitizeCall(c)
+	}
+	return p.Calls
+}
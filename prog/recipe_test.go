@@ -0,0 +1,45 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package prog
+
+import (
+	"testing"
+)
+
+func TestRecipes(t *testing.T) {
+	testEachTarget(t, func(t *testing.T, target *Target) {
+		rs := randSource(t)
+		for i, recipe := range target.recipes {
+			enabled := make(map[*Syscall]bool)
+			for _, c := range recipe.Calls {
+				enabled[c.Meta] = true
+			}
+			ct := target.BuildChoiceTable(nil, enabled)
+			r := newRand(target, rs)
+			calls := r.generateRecipe(newState(target, ct))
+			if len(calls) != len(recipe.Calls) {
+				t.Fatalf("recipe %q: got %v calls, want %v",
+					target.Recipes[i].Name, len(calls), len(recipe.Calls))
+			}
+			for j, c := range calls {
+				if c.Meta != recipe.Calls[j].Meta {
+					t.Fatalf("recipe %q: call %v is %v, want %v",
+						target.Recipes[i].Name, j, c.Meta.Name, recipe.Calls[j].Meta.Name)
+				}
+			}
+			p := &Prog{Target: target, Calls: calls}
+			if err := p.validate(); err != nil {
+				t.Fatalf("recipe %q: %v", target.Recipes[i].Name, err)
+			}
+			disabled := recipe.Calls[0].Meta
+			delete(enabled, disabled)
+			ct = target.BuildChoiceTable(nil, enabled)
+			for _, c := range r.generateRecipe(newState(target, ct)) {
+				if c.Meta == disabled {
+					t.Fatalf("recipe %q with disabled call was generated", target.Recipes[i].Name)
+				}
+			}
+		}
+	})
+}
@@ -44,6 +44,9 @@ type Target struct {
 	// Used as fallback when string type does not have own dictionary.
 	StringDictionary []string
 
+	// Multi-call building blocks that generator can insert as a whole, see Recipe.
+	Recipes []Recipe
+
 	// Filled by prog package:
 	init        sync.Once
 	initArch    func(target *Target)
@@ -53,6 +56,7 @@ type Target struct {
 	// Maps resource name to a list of calls that can create the resource.
 	resourceCtors map[string][]*Syscall
 	any           anyTypes
+	recipes       []*Prog
 }
 
 var targets = make(map[string]*Target)
@@ -100,6 +104,7 @@ func (target *Target) lazyInit() {
 	target.SanitizeCall = func(c *Call) {}
 	target.initTarget()
 	target.initArch(target)
+	target.initRecipes()
 	target.ConstMap = nil // currently used only by initArch
 }
 
@@ -0,0 +1,325 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package prog
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Translate converts program p of another target with the same OS (e.g. a linux/amd64 corpus program)
+// to the target (e.g. linux/arm64). Calls are matched by name, calls that are not present
+// on the target or not enabled (if enabled is not nil) are replaced with equivalents
+// (e.g. open with openat, see callRewrites) or dropped.
+// Values of const and flags arguments are re-computed for the target, other argument values
+// are preserved where the argument types match and get default values otherwise.
+// Returns human-readable warnings about dropped calls.
+// It is an error if no calls are left in the program.
+func (target *Target) Translate(p *Prog, enabled map[*Syscall]bool) (*Prog, []string, error) {
+	src := p.Target
+	if src.OS != target.OS {
+		return nil, nil, fmt.Errorf("can't translate %v/%v program to %v/%v",
+			src.OS, src.Arch, target.OS, target.Arch)
+	}
+	tr := &translator{
+		target:  target,
+		enabled: enabled,
+		results: make(map[*ResultArg]*ResultArg),
+	}
+	p1 := &Prog{
+		Target: target,
+	}
+	var warnings []string
+	for _, c := range p.Calls {
+		c1 := tr.translateCall(c)
+		if c1 == nil {
+			warnings = append(warnings, fmt.Sprintf("dropping call %v: not enabled on %v/%v",
+				c.Meta.Name, target.OS, target.Arch))
+			continue
+		}
+		p1.Calls = append(p1.Calls, c1)
+	}
+	if len(p1.Calls) == 0 {
+		return nil, nil, fmt.Errorf("program does not contain any calls enabled on %v/%v",
+			target.OS, target.Arch)
+	}
+	if err := p1.validate(); err != nil {
+		return nil, nil, err
+	}
+	return p1, warnings, nil
+}
+
+// callRewrite describes replacement of a call that is not present on some architectures
+// (e.g. legacy syscalls that are missing on arm64) with an equivalent call.
+type callRewrite struct {
+	call string
+	// Arguments of the new call: index of argument of the old call ("0", "1", ...),
+	// constant expression ("AT_FDCWD", "O_CREAT|O_WRONLY") or "" for the default value.
+	args []string
+}
+
+var callRewrites = map[string]callRewrite{
+	"open":         {"openat", []string{"AT_FDCWD", "0", "1", "2"}},
+	"creat":        {"openat", []string{"AT_FDCWD", "0", "O_CREAT|O_WRONLY|O_TRUNC", "1"}},
+	"mkdir":        {"mkdirat", []string{"AT_FDCWD", "0", "1"}},
+	"mknod":        {"mknodat", []string{"AT_FDCWD", "0", "1", "2"}},
+	"chmod":        {"fchmodat", []string{"AT_FDCWD", "0", "1"}},
+	"chown":        {"fchownat", []string{"AT_FDCWD", "0", "1", "2", ""}},
+	"lchown":       {"fchownat", []string{"AT_FDCWD", "0", "1", "2", "AT_SYMLINK_NOFOLLOW"}},
+	"link":         {"linkat", []string{"AT_FDCWD", "0", "AT_FDCWD", "1", ""}},
+	"symlink":      {"symlinkat", []string{"0", "AT_FDCWD", "1"}},
+	"unlink":       {"unlinkat", []string{"AT_FDCWD", "0", ""}},
+	"rmdir":        {"unlinkat", []string{"AT_FDCWD", "0", "AT_REMOVEDIR"}},
+	"rename":       {"renameat", []string{"AT_FDCWD", "0", "AT_FDCWD", "1"}},
+	"readlink":     {"readlinkat", []string{"AT_FDCWD", "0", "1", "2"}},
+	"utimes":       {"futimesat", []string{"AT_FDCWD", "0", "1"}},
+	"dup2":         {"dup3", []string{"0", "1", ""}},
+	"pipe":         {"pipe2", []string{"0", ""}},
+	"eventfd":      {"eventfd2", []string{"0", ""}},
+	"signalfd":     {"signalfd4", []string{"0", "1", "2", ""}},
+	"inotify_init": {"inotify_init1", []string{""}},
+	"epoll_create": {"epoll_create1", []string{""}},
+	"epoll_wait":   {"epoll_pwait", []string{"0", "1", "2", "3", "", ""}},
+	"poll":         {"ppoll", []string{"0", "1", "", "", ""}},
+	"select":       {"pselect6", []string{"0", "1", "2", "3", "", ""}},
+	"getdents":     {"getdents64", []string{"0", "1", "2"}},
+}
+
+type translator struct {
+	target  *Target
+	enabled map[*Syscall]bool         // nil if all calls are enabled
+	results map[*ResultArg]*ResultArg // resources of the original program -> translated resources
+}
+
+func (tr *translator) lookupCall(name string) *Syscall {
+	meta := tr.target.SyscallMap[name]
+	if meta == nil || tr.enabled != nil && !tr.enabled[meta] {
+		return nil
+	}
+	return meta
+}
+
+func (tr *translator) translateCall(c *Call) *Call {
+	var rewrite []string
+	meta := tr.lookupCall(c.Meta.Name)
+	if meta == nil {
+		rw, ok := callRewrites[c.Meta.CallName]
+		if !ok {
+			return nil
+		}
+		name := rw.call
+		if pos := strings.IndexByte(c.Meta.Name, '$'); pos != -1 &&
+			tr.lookupCall(name+c.Meta.Name[pos:]) != nil {
+			name += c.Meta.Name[pos:]
+		}
+		meta = tr.lookupCall(name)
+		if meta == nil || len(meta.Args) != len(rw.args) {
+			return nil
+		}
+		rewrite = rw.args
+	}
+	c1 := &Call{
+		Meta: meta,
+		Ret:  MakeReturnArg(meta.Ret),
+	}
+	for i, typ := range meta.Args {
+		var arg Arg
+		if rewrite != nil {
+			arg = tr.rewriteArg(c, rewrite[i], typ)
+		} else if i < len(c.Args) {
+			arg = tr.translateArg(c.Args[i], typ)
+		} else {
+			arg = tr.target.defaultArg(typ)
+		}
+		c1.Args = append(c1.Args, arg)
+	}
+	if c.Ret != nil && c1.Ret != nil {
+		tr.results[c.Ret] = c1.Ret
+	}
+	tr.target.assignSizesCall(c1)
+	tr.target.SanitizeCall(c1)
+	return c1
+}
+
+func (tr *translator) rewriteArg(c *Call, spec string, typ Type) Arg {
+	if spec == "" {
+		return tr.target.defaultArg(typ)
+	}
+	if idx, err := strconv.Atoi(spec); err == nil {
+		return tr.translateArg(c.Args[idx], typ)
+	}
+	var val uint64
+	for _, name := range strings.Split(spec, "|") {
+		v, ok := tr.constValue(name)
+		if !ok {
+			return tr.target.defaultArg(typ)
+		}
+		val |= v
+	}
+	switch typ.(type) {
+	case *ResourceType:
+		return MakeResultArg(typ, nil, val)
+	case *IntType, *FlagsType:
+		return MakeConstArg(typ, val)
+	}
+	return tr.target.defaultArg(typ)
+}
+
+func (tr *translator) constValue(name string) (uint64, bool) {
+	for _, c := range tr.target.Consts {
+		if c.Name == name {
+			return c.Value, true
+		}
+	}
+	return 0, false
+}
+
+func (tr *translator) translateArg(arg Arg, typ Type) Arg {
+	switch a := arg.(type) {
+	case *ConstArg:
+		if _, isLen := typ.(*LenType); typ.Dir() == DirOut && !isLen {
+			break
+		}
+		switch t := typ.(type) {
+		case *ConstType:
+			// Special types (e.g. iptables hooks) can store non-default values in consts.
+			if src, ok := a.Type().(*ConstType); ok && a.Val != src.Val {
+				return MakeConstArg(t, a.Val)
+			}
+			return MakeConstArg(t, t.Val)
+		case *FlagsType:
+			return MakeConstArg(t, translateFlags(a, t))
+		case *IntType, *LenType, *ProcType, *CsumType:
+			return MakeConstArg(t, a.Val)
+		}
+	case *ResultArg:
+		t, ok := typ.(*ResourceType)
+		if !ok {
+			break
+		}
+		var res *ResultArg
+		if r := tr.results[a.Res]; a.Res != nil && r != nil {
+			res = MakeResultArg(t, r, 0)
+			res.OpDiv = a.OpDiv
+			res.OpAdd = a.OpAdd
+		} else if a.Res == nil && t.Dir() != DirOut {
+			res = MakeResultArg(t, nil, a.Val)
+		} else {
+			// References to results of dropped calls get default values.
+			res = tr.target.defaultArg(t).(*ResultArg)
+		}
+		tr.results[a] = res
+		return res
+	case *PointerArg:
+		switch t := typ.(type) {
+		case *PtrType:
+			if a.IsNull() && t.Optional() {
+				return MakeNullPointerArg(t)
+			}
+			if a.Res == nil {
+				break
+			}
+			return MakePointerArg(t, a.Address, tr.translateArg(a.Res, t.Type))
+		case *VmaType:
+			if a.IsNull() && t.Optional() {
+				return MakeNullPointerArg(t)
+			}
+			if a.VmaSize == 0 {
+				break
+			}
+			return MakeVmaPointerArg(t, a.Address, a.VmaSize)
+		}
+	case *DataArg:
+		t, ok := typ.(*BufferType)
+		if !ok {
+			break
+		}
+		if src := a.Type().(*BufferType); (src.Kind == BufferText) != (t.Kind == BufferText) ||
+			src.Kind == BufferText && src.Text != t.Text {
+			// Machine code for another architecture is useless.
+			break
+		}
+		if t.Dir() == DirOut {
+			size := a.Size()
+			if !t.Varlen() {
+				size = t.Size()
+			}
+			return MakeOutDataArg(t, size)
+		}
+		data := append([]byte{}, a.Data()...)
+		if !t.Varlen() {
+			if size := int(t.Size()); len(data) < size {
+				data = append(data, make([]byte, size-len(data))...)
+			} else {
+				data = data[:size]
+			}
+		}
+		return MakeDataArg(t, data)
+	case *GroupArg:
+		switch t := typ.(type) {
+		case *StructType:
+			// Layout of structs can differ between architectures (e.g. struct stat),
+			// only structs with the same fields are translated.
+			if len(t.Fields) != len(a.Inner) {
+				break
+			}
+			var inner []Arg
+			for i, field := range t.Fields {
+				if a.Inner[i].Type().FieldName() != field.FieldName() {
+					return tr.target.defaultArg(typ)
+				}
+				inner = append(inner, tr.translateArg(a.Inner[i], field))
+			}
+			return MakeGroupArg(t, inner)
+		case *ArrayType:
+			if t.Kind == ArrayRangeLen && (uint64(len(a.Inner)) < t.RangeBegin ||
+				uint64(len(a.Inner)) > t.RangeEnd) {
+				break
+			}
+			var inner []Arg
+			for _, elem := range a.Inner {
+				inner = append(inner, tr.translateArg(elem, t.Type))
+			}
+			return MakeGroupArg(t, inner)
+		}
+	case *UnionArg:
+		t, ok := typ.(*UnionType)
+		if !ok {
+			break
+		}
+		for _, opt := range t.Fields {
+			if opt.FieldName() == a.Option.Type().FieldName() {
+				return MakeUnionArg(t, tr.translateArg(a.Option, opt))
+			}
+		}
+	}
+	return tr.target.defaultArg(typ)
+}
+
+// translateFlags maps flag values of the original program to values of the same flags on the target.
+// Flags that are not supported on some architectures are dropped from flag sets by the compiler,
+// so flag sets of different lengths can't be matched and their values are preserved as is.
+func translateFlags(arg *ConstArg, typ *FlagsType) uint64 {
+	src, ok := arg.Type().(*FlagsType)
+	if !ok || len(src.Vals) != len(typ.Vals) {
+		return arg.Val
+	}
+	if !typ.BitMask {
+		for i, v := range src.Vals {
+			if arg.Val == v {
+				return typ.Vals[i]
+			}
+		}
+		return arg.Val
+	}
+	res, rest := uint64(0), arg.Val
+	for i, v := range src.Vals {
+		if v != 0 && arg.Val&v == v {
+			res |= typ.Vals[i]
+			rest &^= v
+		}
+	}
+	return res | rest
+}
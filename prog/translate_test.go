@@ -0,0 +1,135 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package prog
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestTranslate(t *testing.T) {
+	amd64 := initTargetTest(t, "linux", "amd64")
+	arm64, err := GetTarget("linux", "arm64")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		in   string
+		out  string
+		warn int
+	}{
+		{
+			in: `
+r0 = open(&(0x7f0000000000)='./file0\x00', 0x10000, 0x0)
+dup2(r0, r0)
+r1 = openat$kvm(0xffffffffffffff9c, &(0x7f0000000040)='/dev/kvm\x00', 0x0, 0x0)
+ioctl$KVM_CREATE_VM(r1, 0xae01, 0x0)
+close(r0)
+`,
+			out: `
+r0 = openat(0xffffffffffffff9c, &(0x7f0000000000)='./file0\x00', 0x4000, 0x0)
+dup3(r0, r0, 0x0)
+r1 = openat$kvm(0xffffffffffffff9c, &(0x7f0000000040)='/dev/kvm\x00', 0x0, 0x0)
+ioctl$KVM_CREATE_VM(r1, 0xae01, 0x0)
+close(r0)
+`,
+		},
+		{
+			in: `
+r0 = creat(&(0x7f0000000000)='./file0\x00', 0x0)
+rmdir(&(0x7f0000000040)='./file1\x00')
+iopl(0x3)
+write(r0, &(0x7f0000000080)="01020304", 0x4)
+`,
+			out: `
+r0 = openat(0xffffffffffffff9c, &(0x7f0000000000)='./file0\x00', 0x241, 0x0)
+unlinkat(0xffffffffffffff9c, &(0x7f0000000040)='./file1\x00', 0x200)
+write(r0, &(0x7f0000000080)="01020304", 0x4)
+`,
+			warn: 1,
+		},
+	}
+	for i, test := range tests {
+		p, err := amd64.Deserialize([]byte(strings.TrimSpace(test.in)))
+		if err != nil {
+			t.Fatalf("test #%v: failed to deserialize: %v", i, err)
+		}
+		p1, warnings, err := arm64.Translate(p, nil)
+		if err != nil {
+			t.Fatalf("test #%v: failed to translate: %v", i, err)
+		}
+		if len(warnings) != test.warn {
+			t.Errorf("test #%v: got %v warnings, want %v: %q", i, len(warnings), test.warn, warnings)
+		}
+		got := strings.TrimSpace(string(p1.Serialize()))
+		if want := strings.TrimSpace(test.out); got != want {
+			t.Errorf("test #%v: bad program:\ngot:\n%v\nwant:\n%v", i, got, want)
+		}
+	}
+}
+
+func TestTranslateRandom(t *testing.T) {
+	target, rs, iters := initTest(t)
+	arm64, err := GetTarget("linux", "arm64")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < iters; i++ {
+		p := target.Generate(rs, 10, nil)
+		data := p.Serialize()
+		// Translation to the same target must not change the program.
+		p1, warnings, err := target.Translate(p, nil)
+		if err != nil || len(warnings) != 0 {
+			t.Fatalf("failed to translate program: %v %v\n%s", err, warnings, data)
+		}
+		if data1 := p1.Serialize(); !bytes.Equal(data, data1) {
+			t.Fatalf("program changed after translation:\n%s\n%s", data, data1)
+		}
+		p2, _, err := arm64.Translate(p, nil)
+		if err != nil {
+			if strings.Contains(err.Error(), "does not contain any calls") {
+				continue
+			}
+			t.Fatalf("failed to translate program to arm64: %v\n%s", err, data)
+		}
+		if _, err := arm64.Deserialize(p2.Serialize()); err != nil {
+			t.Fatalf("failed to deserialize translated program: %v\n%s\n%s", err, data, p2.Serialize())
+		}
+	}
+}
+
+func TestTranslateEnabled(t *testing.T) {
+	target := initTargetTest(t, "linux", "amd64")
+	enabled := make(map[*Syscall]bool)
+	for _, name := range []string{"openat", "write", "close"} {
+		enabled[target.SyscallMap[name]] = true
+	}
+	p, err := target.Deserialize([]byte(`
+r0 = open(&(0x7f0000000000)='./file0\x00', 0x2, 0x0)
+r1 = socket$inet_tcp(0x2, 0x1, 0x0)
+write(r1, &(0x7f0000000040)="01", 0x1)
+close(r0)
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	p1, warnings, err := target.Translate(p, enabled)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(warnings) != 1 {
+		t.Errorf("got %v warnings, want 1: %q", len(warnings), warnings)
+	}
+	want := `r0 = openat(0xffffffffffffff9c, &(0x7f0000000000)='./file0\x00', 0x2, 0x0)
+write(0xffffffffffffffff, &(0x7f0000000040)="01", 0x1)
+close(r0)
+`
+	if got := string(p1.Serialize()); got != want {
+		t.Errorf("bad program:\ngot:\n%v\nwant:\n%v", got, want)
+	}
+	if _, _, err := target.Translate(p, map[*Syscall]bool{}); err == nil {
+		t.Errorf("translation with no enabled calls succeeded")
+	}
+}
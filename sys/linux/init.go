@@ -54,6 +54,7 @@ func initTarget(target *prog.Target) {
 		"ebt_replace":        arch.generateEbtables,
 	}
 	target.StringDictionary = stringDictionary
+	target.Recipes = recipes
 
 	if target.Arch == runtime.GOARCH {
 		KCOV_INIT_TRACE = uintptr(target.ConstMap["KCOV_INIT_TRACE"])
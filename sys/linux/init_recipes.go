@@ -0,0 +1,45 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package linux
+
+import (
+	"github.com/google/syzkaller/prog"
+)
+
+// Setups that require several calls with matching arguments,
+// random generation assembles them only by chance. See prog.Recipe.
+var recipes = []prog.Recipe{
+	{
+		// Create a tap device and bring it up.
+		Name: "tap",
+		Text: `
+r0 = syz_open_dev$tun(&(0x7f0000000000)='/dev/net/tun\x00', 0x0, 0x2)
+ioctl$TUNSETIFF(r0, 0x400454ca, &(0x7f0000000040)={'syzkaller1\x00', 0x1002})
+r1 = socket$inet_udp(0x2, 0x2, 0x0)
+ioctl$sock_inet_SIOCSIFFLAGS(r1, 0x8914, &(0x7f0000000080)={'syzkaller1\x00', 0x1})
+`,
+	},
+	{
+		// Attach a memfd-backed file to a loop device.
+		Name: "loop",
+		Text: `
+r0 = memfd_create(&(0x7f0000000000)='syz\x00', 0x0)
+ftruncate(r0, 0x100000)
+r1 = syz_open_dev$loop(&(0x7f0000000040)='/dev/loop#\x00', 0x0, 0x2)
+ioctl$LOOP_SET_FD(r1, 0x4c00, r0)
+`,
+	},
+	{
+		// Establish a TCP connection over loopback.
+		Name: "tcp",
+		Text: `
+r0 = socket$inet_tcp(0x2, 0x1, 0x0)
+bind$inet(r0, &(0x7f0000000000)={0x2, 0x0, @loopback}, 0x10)
+listen(r0, 0x8)
+r1 = socket$inet_tcp(0x2, 0x1, 0x0)
+connect$inet(r1, &(0x7f0000000040)={0x2, 0x0, @loopback}, 0x10)
+r2 = accept4$inet(r0, 0x0, 0x0, 0x0)
+`,
+	},
+}
@@ -0,0 +1,230 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package main
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/google/syzkaller/pkg/gcs"
+	"github.com/google/syzkaller/pkg/osutil"
+)
+
+// buildCache keeps tested kernel builds keyed by BuildInfo.Tag (manager name, kernel commit,
+// compiler identity and config), so that the kernel is not rebuilt when the manager returns
+// to an already built revision (e.g. after a revert or a restart with a fresh disk).
+// Builds are kept in a local dir (up to size most recently used builds)
+// and optionally in GCS (never deleted by syz-ci, use bucket lifecycle rules for that).
+type buildCache struct {
+	dir     string
+	size    int
+	gcsPath string // bucket/path, optional
+}
+
+func newBuildCache(dir string, size int, gcsPath string) (*buildCache, error) {
+	if err := osutil.MkdirAll(dir); err != nil {
+		return nil, fmt.Errorf("failed to create build cache dir: %v", err)
+	}
+	return &buildCache{
+		dir:     dir,
+		size:    size,
+		gcsPath: gcsPath,
+	}, nil
+}
+
+// get restores the build with the given tag into dstDir, if it's in the cache.
+// All other files in dstDir are removed.
+func (cache *buildCache) get(tag, dstDir string) (bool, error) {
+	localDir := filepath.Join(cache.dir, tag)
+	if osutil.FilesExist(localDir, imageFiles) {
+		// Mark the build as recently used.
+		now := time.Now()
+		if err := os.Chtimes(localDir, now, now); err != nil {
+			return false, err
+		}
+		if err := osutil.CopyFiles(localDir, dstDir, imageFiles); err != nil {
+			return false, fmt.Errorf("failed to copy cached build: %v", err)
+		}
+		return true, nil
+	}
+	if cache.gcsPath == "" {
+		return false, nil
+	}
+	GCS, err := gcs.NewClient()
+	if err != nil {
+		return false, fmt.Errorf("failed to create GCS client: %v", err)
+	}
+	defer GCS.Close()
+	gcsFile := path.Join(cache.gcsPath, tag+".tar.gz")
+	file, err := GCS.Read(gcsFile)
+	if err != nil {
+		// Most likely the build is just not there.
+		return false, nil
+	}
+	r, err := file.Reader()
+	if err != nil {
+		return false, fmt.Errorf("failed to read %v: %v", gcsFile, err)
+	}
+	defer r.Close()
+	if err := os.RemoveAll(dstDir); err != nil {
+		return false, err
+	}
+	if err := untarBuild(r, dstDir); err != nil {
+		return false, fmt.Errorf("failed to unpack %v: %v", gcsFile, err)
+	}
+	if !osutil.FilesExist(dstDir, imageFiles) {
+		return false, fmt.Errorf("cached build %v misses required files", gcsFile)
+	}
+	return true, cache.putLocal(tag, dstDir)
+}
+
+// put adds the build in srcDir to the cache.
+func (cache *buildCache) put(tag, srcDir string) error {
+	if err := cache.putLocal(tag, srcDir); err != nil {
+		return err
+	}
+	if cache.gcsPath == "" {
+		return nil
+	}
+	GCS, err := gcs.NewClient()
+	if err != nil {
+		return fmt.Errorf("failed to create GCS client: %v", err)
+	}
+	defer GCS.Close()
+	gcsFile := path.Join(cache.gcsPath, tag+".tar.gz")
+	w, err := GCS.FileWriter(gcsFile)
+	if err != nil {
+		return fmt.Errorf("failed to upload %v: %v", gcsFile, err)
+	}
+	defer w.Close()
+	if err := tarBuild(srcDir, w); err != nil {
+		return fmt.Errorf("failed to upload %v: %v", gcsFile, err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("failed to upload %v: %v", gcsFile, err)
+	}
+	return nil
+}
+
+func (cache *buildCache) putLocal(tag, srcDir string) error {
+	// Builds are never modified in place, so we can use hard links instead of copying.
+	localDir := filepath.Join(cache.dir, tag)
+	if err := osutil.LinkFiles(srcDir, localDir, imageFiles); err != nil {
+		os.RemoveAll(localDir)
+		return fmt.Errorf("failed to cache build: %v", err)
+	}
+	return cache.evict()
+}
+
+// evict removes least recently used builds in excess of cache size.
+func (cache *buildCache) evict() error {
+	entries, err := osutil.ListDir(cache.dir)
+	if err != nil {
+		return err
+	}
+	type build struct {
+		dir  string
+		used time.Time
+	}
+	var builds []build
+	for _, entry := range entries {
+		dir := filepath.Join(cache.dir, entry)
+		st, err := os.Stat(dir)
+		if err != nil {
+			return err
+		}
+		builds = append(builds, build{dir, st.ModTime()})
+	}
+	sort.Slice(builds, func(i, j int) bool {
+		return builds[i].used.After(builds[j].used)
+	})
+	for i := cache.size; i < len(builds); i++ {
+		if err := os.RemoveAll(builds[i].dir); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func tarBuild(srcDir string, w io.Writer) error {
+	gzipWriter := gzip.NewWriter(w)
+	tarWriter := tar.NewWriter(gzipWriter)
+	for f, required := range imageFiles {
+		src := filepath.Join(srcDir, filepath.FromSlash(f))
+		if !required && !osutil.IsExist(src) {
+			continue
+		}
+		if err := tarFile(tarWriter, src, f); err != nil {
+			return err
+		}
+	}
+	if err := tarWriter.Close(); err != nil {
+		return err
+	}
+	return gzipWriter.Close()
+}
+
+func tarFile(tarWriter *tar.Writer, src, name string) error {
+	file, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	st, err := file.Stat()
+	if err != nil {
+		return err
+	}
+	hdr := &tar.Header{
+		Name:     name,
+		Typeflag: tar.TypeReg,
+		Mode:     int64(st.Mode().Perm()),
+		Size:     st.Size(),
+		ModTime:  st.ModTime(),
+	}
+	if err := tarWriter.WriteHeader(hdr); err != nil {
+		return err
+	}
+	_, err = io.Copy(tarWriter, file)
+	return err
+}
+
+func untarBuild(r io.Reader, dstDir string) error {
+	gzipReader, err := gzip.NewReader(r)
+	if err != nil {
+		return err
+	}
+	tarReader := tar.NewReader(gzipReader)
+	for {
+		hdr, err := tarReader.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if _, ok := imageFiles[hdr.Name]; !ok || hdr.Typeflag != tar.TypeReg {
+			return fmt.Errorf("unexpected file %v", hdr.Name)
+		}
+		dst := filepath.Join(dstDir, filepath.FromSlash(hdr.Name))
+		if err := osutil.MkdirAll(filepath.Dir(dst)); err != nil {
+			return err
+		}
+		file, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_EXCL, os.FileMode(hdr.Mode).Perm())
+		if err != nil {
+			return err
+		}
+		_, err = io.Copy(file, tarReader)
+		file.Close()
+		if err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/syzkaller/pkg/osutil"
+)
+
+func TestBuildCache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "syz-ci-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	cache, err := newBuildCache(filepath.Join(dir, "cache"), 2, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	writeBuild := func(buildDir, tag string) {
+		if err := osutil.MkdirAll(filepath.Join(buildDir, "obj")); err != nil {
+			t.Fatal(err)
+		}
+		for _, f := range []string{"tag", "image", "obj/vmlinux"} {
+			if err := osutil.WriteFile(filepath.Join(buildDir, f), []byte(tag+f)); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	for i, tag := range []string{"a", "b", "c"} {
+		src := filepath.Join(dir, "src")
+		writeBuild(src, tag)
+		if err := cache.put(tag, src); err != nil {
+			t.Fatal(err)
+		}
+		os.RemoveAll(src)
+		// Make sure that use times differ.
+		used := time.Now().Add(time.Duration(i-10) * time.Minute)
+		if err := os.Chtimes(filepath.Join(cache.dir, tag), used, used); err != nil {
+			t.Fatal(err)
+		}
+		if i == 1 {
+			// Use "a", so that "b" is evicted instead.
+			if cached, err := cache.get("a", filepath.Join(dir, "dst")); err != nil || !cached {
+				t.Fatalf("build a is not cached: %v", err)
+			}
+		}
+	}
+	dst := filepath.Join(dir, "dst")
+	if cached, err := cache.get("b", dst); err != nil || cached {
+		t.Fatalf("build b is not evicted: %v", err)
+	}
+	for _, tag := range []string{"a", "c"} {
+		if cached, err := cache.get(tag, dst); err != nil || !cached {
+			t.Fatalf("build %v is not cached: %v", tag, err)
+		}
+		data, err := ioutil.ReadFile(filepath.Join(dst, "obj", "vmlinux"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := tag + "obj/vmlinux"; string(data) != want {
+			t.Fatalf("bad cached file contents %q, want %q", data, want)
+		}
+	}
+}
+
+func TestBuildCacheTar(t *testing.T) {
+	dir, err := ioutil.TempDir("", "syz-ci-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	src := filepath.Join(dir, "src")
+	if err := osutil.MkdirAll(filepath.Join(src, "obj")); err != nil {
+		t.Fatal(err)
+	}
+	files := []string{"tag", "image", "key", "obj/vmlinux"}
+	for _, f := range files {
+		if err := osutil.WriteFile(filepath.Join(src, f), []byte(f)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	buf := new(bytes.Buffer)
+	if err := tarBuild(src, buf); err != nil {
+		t.Fatal(err)
+	}
+	dst := filepath.Join(dir, "dst")
+	if err := untarBuild(buf, dst); err != nil {
+		t.Fatal(err)
+	}
+	for _, f := range files {
+		data, err := ioutil.ReadFile(filepath.Join(dst, filepath.FromSlash(f)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != f {
+			t.Fatalf("bad file %v contents %q", f, data)
+		}
+	}
+	if osutil.IsExist(filepath.Join(dst, "kernel")) {
+		t.Fatalf("missing optional file is unpacked")
+	}
+}
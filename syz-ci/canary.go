@@ -0,0 +1,232 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/google/syzkaller/pkg/config"
+	"github.com/google/syzkaller/pkg/log"
+	"github.com/google/syzkaller/pkg/osutil"
+)
+
+// CanaryConfig enables blue/green manager updates: before a running manager is restarted
+// on a new kernel build, the build is tested by a second (canary) manager on a few VMs
+// while the old manager keeps fuzzing. The old manager is restarted on the new build
+// only if the canary was healthy for the whole canary period: its VMs were fuzzing
+// (rather than boot looping) and exec speed per VM was close to the speed of the old manager.
+// Otherwise the build is rejected and the old manager keeps running on the old build.
+// Requires in_process, the canary corpus is discarded after the test.
+type CanaryConfig struct {
+	// Number of VMs for the canary manager, they are used in addition to the VMs of the running manager.
+	VMs int `json:"vms"`
+	// VM-type-specific config of the canary manager (optional). By default the canary uses vm config
+	// of the running manager with count set to vms, which works only for VM types that create VMs
+	// on demand. VM types with a fixed set of machines (see fixedVMTypes) require a separate config
+	// with other machines, vms must match the number of machines in it.
+	VM json.RawMessage `json:"vm"`
+	// HTTP address of the canary manager (optional).
+	HTTP string `json:"http"`
+	// Duration of the test in minutes (default 60).
+	Period int `json:"period"`
+	// Minimal exec speed per VM of the canary manager in percents of the running manager speed (default 50).
+	MinExecSpeed int `json:"min_exec_speed"`
+}
+
+// Canary VMs must be fuzzing at least this fraction of the canary period.
+const canaryMinFuzzing = 0.5
+
+// fixedVMTypes are VM types that use a fixed set of machines listed in the vm config
+// rather than create VMs on demand. With the running manager vm config the canary
+// would use the same machines as the running manager.
+var fixedVMTypes = map[string]bool{
+	"isolated": true,
+	"adb":      true,
+	"odroid":   true,
+}
+
+func (cfg *CanaryConfig) validate(vmType string) error {
+	if cfg.VMs <= 0 {
+		return fmt.Errorf("vms must be positive")
+	}
+	if fixedVMTypes[vmType] && len(cfg.VM) == 0 {
+		return fmt.Errorf("vm type %v uses fixed machines, canary requires a separate vm config", vmType)
+	}
+	if cfg.Period < 0 || cfg.MinExecSpeed < 0 || cfg.MinExecSpeed > 100 {
+		return fmt.Errorf("bad period or min_exec_speed")
+	}
+	if cfg.Period == 0 {
+		cfg.Period = 60
+	}
+	if cfg.MinExecSpeed == 0 {
+		cfg.MinExecSpeed = 50
+	}
+	return nil
+}
+
+// canary is a canary manager that tests a pending update.
+type canary struct {
+	update   *managerUpdate
+	dir      string
+	runner   *ManagerInProcess
+	started  time.Time
+	baseline map[string]uint64 // stats of the running manager when the canary was started
+}
+
+type canaryState int
+
+const (
+	canaryRunning canaryState = iota
+	canaryPassed
+	canaryFailed
+)
+
+// testUpdate tests the update on the canary manager. It starts the canary on the first call
+// and returns canaryRunning until the canary period is over.
+func (mgr *Manager) testUpdate(update *managerUpdate) canaryState {
+	cfg := mgr.mgrcfg.Canary
+	if mgr.canary != nil && mgr.canary.update != update {
+		log.Logf(0, "%v: stopping canary for an outdated build", mgr.name)
+		mgr.stopCanary()
+	}
+	if mgr.canary == nil {
+		if err := mgr.startCanary(update); err != nil {
+			mgr.Errorf("failed to start canary: %v", err)
+			mgr.stopCanary()
+			return canaryFailed
+		}
+		return canaryRunning
+	}
+	if time.Since(mgr.canary.started) < time.Duration(cfg.Period)*time.Minute {
+		return canaryRunning
+	}
+	var current map[string]uint64
+	if running, ok := mgr.cmd.(*ManagerInProcess); ok {
+		current = running.Stats()
+	}
+	err := checkCanary(cfg, time.Since(mgr.canary.started), mgr.canary.baseline,
+		current, mgr.canary.runner.Stats())
+	mgr.stopCanary()
+	if err != nil {
+		mgr.Errorf("canary failed on %v, not updating: %v", update.info.KernelCommit, err)
+		mgr.notifyCanaryFailure(update, err)
+		return canaryFailed
+	}
+	log.Logf(0, "%v: canary passed on %v", mgr.name, update.info.KernelCommit)
+	return canaryPassed
+}
+
+// checkCanary checks health of the canary manager given stats of the running manager
+// at the canary start (baseline) and at the end of the canary period (current).
+func checkCanary(cfg *CanaryConfig, elapsed time.Duration, baseline, current, canary map[string]uint64) error {
+	// "fuzzing" is total fuzzing time of all VMs in seconds.
+	fuzzing := float64(canary["fuzzing"])
+	if fuzzing < elapsed.Seconds()*float64(cfg.VMs)*canaryMinFuzzing {
+		return fmt.Errorf("VMs were fuzzing only %.0f%% of time (boot loop?)",
+			fuzzing*100/(elapsed.Seconds()*float64(cfg.VMs)))
+	}
+	speed := float64(canary["exec total"]) / fuzzing
+	if current["fuzzing"] <= baseline["fuzzing"] || current["exec total"] < baseline["exec total"] {
+		// The old manager was not fuzzing or was restarted, nothing to compare with.
+		return nil
+	}
+	oldSpeed := float64(current["exec total"]-baseline["exec total"]) /
+		float64(current["fuzzing"]-baseline["fuzzing"])
+	if speed*100 < oldSpeed*float64(cfg.MinExecSpeed) {
+		return fmt.Errorf("exec speed is %.1f/sec per VM, running manager has %.1f/sec", speed, oldSpeed)
+	}
+	return nil
+}
+
+func (mgr *Manager) startCanary(update *managerUpdate) error {
+	running, ok := mgr.cmd.(*ManagerInProcess)
+	if !ok {
+		return fmt.Errorf("canary requires in_process manager")
+	}
+	dir := filepath.Join(filepath.Dir(mgr.workDir), "canary")
+	mgr.canary = &canary{
+		update: update,
+		dir:    dir,
+	}
+	if err := os.RemoveAll(dir); err != nil {
+		return err
+	}
+	workDir := filepath.Join(dir, "workdir")
+	if err := osutil.MkdirAll(workDir); err != nil {
+		return err
+	}
+	if err := osutil.LinkFiles(mgr.latestDir, dir, imageFiles); err != nil {
+		return err
+	}
+	// Start with the current corpus, so that the canary exercises the kernel as the main manager does.
+	if err := running.Snapshot(filepath.Join(workDir, "corpus.db")); err != nil {
+		return fmt.Errorf("failed to snapshot corpus: %v", err)
+	}
+	cfgData, err := mgr.createCanaryConfig(update.buildTag, dir, workDir)
+	if err != nil {
+		return err
+	}
+	cfgFile := filepath.Join(dir, "manager.cfg")
+	if err := osutil.WriteFile(cfgFile, cfgData); err != nil {
+		return err
+	}
+	log.Logf(0, "%v: starting canary on %v", mgr.name, update.info.KernelCommit)
+	mgr.canary.runner = NewManagerInProcess(mgr.name+"-canary", cfgFile, mgr.Errorf)
+	mgr.canary.started = time.Now()
+	mgr.canary.baseline = running.Stats()
+	return nil
+}
+
+// createCanaryConfig creates config of the canary manager: the same as the main manager config,
+// but with fewer VMs (or the canary vm config), own workdir and without dashboard and hub.
+func (mgr *Manager) createCanaryConfig(buildTag, imageDir, workDir string) ([]byte, error) {
+	mgrcfg, err := mgr.makeConfig(buildTag, imageDir, workDir)
+	if err != nil {
+		return nil, err
+	}
+	mgrcfg.Name += "-canary"
+	mgrcfg.HTTP = mgr.mgrcfg.Canary.HTTP
+	mgrcfg.RPC = ":0"
+	mgrcfg.DashboardClient, mgrcfg.DashboardAddr, mgrcfg.DashboardKey = "", "", ""
+	mgrcfg.HubClient, mgrcfg.HubAddr, mgrcfg.HubKey = "", "", ""
+	if len(mgr.mgrcfg.Canary.VM) != 0 {
+		mgrcfg.VM = mgr.mgrcfg.Canary.VM
+		return config.SaveData(mgrcfg)
+	}
+	vm := make(map[string]interface{})
+	if err := json.Unmarshal(mgrcfg.VM, &vm); err != nil {
+		return nil, fmt.Errorf("failed to parse vm config: %v", err)
+	}
+	vm["count"] = mgr.mgrcfg.Canary.VMs
+	if mgrcfg.VM, err = json.Marshal(vm); err != nil {
+		return nil, err
+	}
+	return config.SaveData(mgrcfg)
+}
+
+func (mgr *Manager) stopCanary() {
+	if mgr.canary == nil {
+		return
+	}
+	if mgr.canary.runner != nil {
+		mgr.canary.runner.Close()
+	}
+	os.RemoveAll(mgr.canary.dir)
+	mgr.canary = nil
+}
+
+func (mgr *Manager) notifyCanaryFailure(update *managerUpdate, err error) {
+	if mgr.cfg.Notifications == nil {
+		return
+	}
+	subject := fmt.Sprintf("syz-ci %v: canary failed on %v %v", mgr.name,
+		mgr.mgrcfg.Branch, update.info.KernelCommit)
+	if err := mgr.cfg.Notifications.notify(subject, []byte(err.Error())); err != nil {
+		mgr.Errorf("failed to send notification: %v", err)
+	}
+}
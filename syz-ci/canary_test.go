@@ -0,0 +1,44 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCheckCanary(t *testing.T) {
+	cfg := &CanaryConfig{VMs: 2}
+	if err := cfg.validate("qemu"); err != nil {
+		t.Fatal(err)
+	}
+	baseline := map[string]uint64{"fuzzing": 1000, "exec total": 10000}
+	current := map[string]uint64{"fuzzing": 1000 + 8*3600, "exec total": 10000 + 8*3600*100}
+	tests := []struct {
+		canary  map[string]uint64
+		healthy bool
+	}{
+		{map[string]uint64{"fuzzing": 7000, "exec total": 7000 * 90}, true},
+		{map[string]uint64{"fuzzing": 3700, "exec total": 3700 * 51}, true},
+		// Boot loop: VMs are fuzzing only 25% of time.
+		{map[string]uint64{"fuzzing": 1800, "exec total": 1800 * 100}, false},
+		{map[string]uint64{}, false},
+		// Slow.
+		{map[string]uint64{"fuzzing": 7000, "exec total": 7000 * 40}, false},
+	}
+	for i, test := range tests {
+		err := checkCanary(cfg, time.Hour, baseline, current, test.canary)
+		if healthy := err == nil; healthy != test.healthy {
+			t.Errorf("test #%v: healthy=%v, want %v (%v)", i, healthy, test.healthy, err)
+		}
+	}
+	// The running manager was restarted during the canary period, only boot loops are detected.
+	if err := checkCanary(cfg, time.Hour, current, baseline,
+		map[string]uint64{"fuzzing": 7000, "exec total": 1}); err != nil {
+		t.Errorf("canary failed without baseline: %v", err)
+	}
+	if err := checkCanary(cfg, time.Hour, current, nil, map[string]uint64{"fuzzing": 100}); err == nil {
+		t.Errorf("boot loop is not detected without baseline")
+	}
+}
@@ -0,0 +1,368 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/google/syzkaller/dashboard/dashapi"
+	"github.com/google/syzkaller/pkg/build"
+	"github.com/google/syzkaller/pkg/hash"
+	"github.com/google/syzkaller/pkg/osutil"
+	"github.com/google/syzkaller/pkg/vcs"
+	"github.com/google/syzkaller/syz-manager/mgrconfig"
+)
+
+var flagChaosDuration = flag.Duration("chaos", 3*time.Second, "duration of TestChaos run")
+
+// TestChaos runs managers against fake kernel repos, image builds, dashboard and syz-manager
+// processes that randomly fail (poll/build/upload errors, crashing managers, signal storms,
+// pause/resume storms) and checks invariants of the orchestration logic:
+// there are never 2 syz-manager processes for the same manager, builds in latest/current dirs
+// are consistent and no syz-manager processes are left after shutdown.
+// Use -chaos=1h for a long run.
+func TestChaos(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skipf("needs /proc")
+	}
+	if reexecInTempDir(t, "TestChaos", fmt.Sprintf("-chaos=%v", *flagChaosDuration)) {
+		return
+	}
+	setupChaosSyzkaller(t)
+
+	defer func(retry, rebuild, restart, interrupt time.Duration, image func(*build.Params) error) {
+		buildRetryPeriod, kernelRebuildPeriod = retry, rebuild
+		managerRestartPeriod, managerInterruptTimeout = restart, interrupt
+		buildImage = image
+	}(buildRetryPeriod, kernelRebuildPeriod, managerRestartPeriod, managerInterruptTimeout, buildImage)
+	buildRetryPeriod = 50 * time.Millisecond
+	kernelRebuildPeriod = 300 * time.Millisecond
+	managerRestartPeriod = 20 * time.Millisecond
+	managerInterruptTimeout = 200 * time.Millisecond
+
+	seed := time.Now().UnixNano()
+	t.Logf("seed: %v", seed)
+	chaos := &chaosSource{rnd: rand.New(rand.NewSource(seed))}
+	buildImage = chaos.buildImage
+
+	cfg := &Config{Name: "chaos"}
+	stop := make(chan struct{})
+	var managers []*Manager
+	for i := 0; i < 3; i++ {
+		mgrcfg := &ManagerConfig{
+			Name: fmt.Sprintf("mgr%v", i),
+			Repo: "git://chaos/linux.git",
+			ManagerConfig: []byte(fmt.Sprintf(`{"target": "linux/amd64", "http": ":%v",
+				"type": "isolated", "procs": 1, "sandbox": "none"}`, 10000+i)),
+		}
+		cfg.Managers = append(cfg.Managers, mgrcfg)
+		mgr := createManager(cfg, mgrcfg, stop)
+		mgr.repo = &chaosRepo{chaos: chaos}
+		mgr.dash = dashapi.NewCustom(mgrcfg.Name, "http://dashboard.chaos", "key",
+			http.NewRequest, chaos.dashboard, nil, nil)
+		managers = append(managers, mgr)
+	}
+
+	var wg sync.WaitGroup
+	for _, mgr := range managers {
+		mgr := mgr
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			mgr.loop()
+		}()
+	}
+	done := make(chan struct{})
+	var stormWG sync.WaitGroup
+	stormWG.Add(1)
+	go func() {
+		defer stormWG.Done()
+		maxRunning := 0
+		for {
+			select {
+			case <-done:
+				t.Logf("max running managers: %v", maxRunning)
+				return
+			case <-time.After(2 * time.Millisecond):
+			}
+			procs := runningChaosManagers(t)
+			for config, pids := range procs {
+				if len(pids) > 1 {
+					t.Errorf("%v syz-manager processes for %v", len(pids), config)
+				}
+			}
+			if len(procs) > maxRunning {
+				maxRunning = len(procs)
+			}
+			if !chaos.chance(20) {
+				continue
+			}
+			for _, pids := range procs {
+				sig := []syscall.Signal{syscall.SIGINT, syscall.SIGTERM, syscall.SIGKILL, syscall.SIGHUP}[chaos.intn(4)]
+				syscall.Kill(pids[0], sig)
+			}
+			if chaos.chance(10) {
+				mgr := managers[chaos.intn(len(managers))]
+				mgr.setPaused(!mgr.paused())
+			}
+		}
+	}()
+
+	time.Sleep(*flagChaosDuration)
+	close(stop)
+	stopped := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-time.After(time.Minute):
+		t.Fatalf("managers did not stop")
+	}
+	close(done)
+	stormWG.Wait()
+
+	if procs := runningChaosManagers(t); len(procs) != 0 {
+		t.Errorf("leaked syz-manager processes: %v", procs)
+	}
+	for _, mgr := range managers {
+		checkChaosBuild(t, mgr, mgr.latestDir)
+		checkChaosBuild(t, mgr, mgr.currentDir)
+	}
+	t.Logf("builds: %v, failed builds: %v, manager starts: %v",
+		chaos.builds, chaos.failedBuilds, countChaosStarts(t))
+	if chaos.builds == 0 {
+		t.Errorf("no successful builds")
+	}
+}
+
+// reexecInTempDir re-executes the test in a temp dir, since syz-ci works in the current dir
+// and osutil does not allow to change it. Returns true in the parent process,
+// which should return right away, and false in the re-executed test.
+func reexecInTempDir(t *testing.T, test string, args ...string) bool {
+	if os.Getenv("SYZ_CI_TEST_REEXEC") != "" {
+		return false
+	}
+	dir, err := ioutil.TempDir("", "syz-ci-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	cmd := osutil.Command(os.Args[0], append([]string{"-test.run=^" + test + "$", "-test.v"}, args...)...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "SYZ_CI_TEST_REEXEC=1")
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("%v run failed: %v\n%s", test, err, output)
+	}
+	return true
+}
+
+// setupChaosSyzkaller creates syzkaller/current with a fake syz-manager binary.
+// The fake manager records own pid in procs/ dir (with manager config file as contents),
+// removes the record on graceful shutdown (SIGINT/SIGTERM) and runs until killed.
+func setupChaosSyzkaller(t *testing.T) {
+	const manager = `#!/bin/sh
+echo "$2" > procs/$$
+echo "$2" >> starts
+trap 'rm -f procs/$$; exit 0' INT TERM
+while true; do sleep 0.01; done
+`
+	files := map[string]string{
+		"syzkaller/current/tag":                          "syzkaller-commit",
+		"syzkaller/current/bin/linux_amd64/syz-fuzzer":   "",
+		"syzkaller/current/bin/linux_amd64/syz-execprog": "",
+		"syzkaller/current/bin/linux_amd64/syz-executor": "",
+		"syzkaller/current/bin/syz-manager":              manager,
+		"procs/.keep":                                    "",
+		"starts":                                         "",
+	}
+	for file, data := range files {
+		if err := osutil.MkdirAll(filepath.Dir(file)); err != nil {
+			t.Fatal(err)
+		}
+		if err := osutil.WriteExecFile(file, []byte(data)); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// runningChaosManagers returns pids of running fake syz-manager processes
+// grouped by manager config file.
+func runningChaosManagers(t *testing.T) map[string][]int {
+	files, err := ioutil.ReadDir("procs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	procs := make(map[string][]int)
+	for _, file := range files {
+		pid, err := strconv.Atoi(file.Name())
+		if err != nil {
+			continue
+		}
+		config, err := ioutil.ReadFile(filepath.Join("procs", file.Name()))
+		if err != nil || len(config) == 0 {
+			continue
+		}
+		if !chaosManagerRunning(pid) {
+			continue
+		}
+		procs[string(config)] = append(procs[string(config)], pid)
+	}
+	return procs
+}
+
+func chaosManagerRunning(pid int) bool {
+	cmdline, err := ioutil.ReadFile(fmt.Sprintf("/proc/%v/cmdline", pid))
+	if err != nil || !bytes.Contains(cmdline, []byte("syz-manager")) {
+		return false
+	}
+	stat, err := ioutil.ReadFile(fmt.Sprintf("/proc/%v/stat", pid))
+	if err != nil {
+		return false
+	}
+	// Zombies are killed processes that are not yet waited by ManagerCmd.
+	fields := bytes.Fields(stat[bytes.LastIndexByte(stat, ')')+1:])
+	return len(fields) != 0 && string(fields[0]) != "Z"
+}
+
+func countChaosStarts(t *testing.T) int {
+	data, err := ioutil.ReadFile("starts")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return bytes.Count(data, []byte{'\n'})
+}
+
+// checkChaosBuild checks that build in dir (if any) is complete and consistent:
+// image is built from the commit in the tag, tag hash matches build info
+// and manager config in current dir uses the dashboard build for the tag.
+func checkChaosBuild(t *testing.T, mgr *Manager, dir string) {
+	if !osutil.IsExist(dir) {
+		return
+	}
+	if !osutil.FilesExist(dir, imageFiles) {
+		t.Errorf("%v: incomplete build", dir)
+		return
+	}
+	info, err := loadBuildInfo(dir)
+	if err != nil {
+		t.Errorf("%v: %v", dir, err)
+		return
+	}
+	image, err := ioutil.ReadFile(filepath.Join(dir, "image"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(image) != info.KernelCommit {
+		t.Errorf("%v: image is built on %q, but tag says %q", dir, image, info.KernelCommit)
+	}
+	tag := hash.String([]byte(mgr.name + info.KernelCommit + mgr.compilerID + mgr.configTag))
+	if info.Tag != tag {
+		t.Errorf("%v: build tag %v, want %v", dir, info.Tag, tag)
+	}
+	cfgFile := filepath.Join(dir, "manager.cfg")
+	if dir != mgr.currentDir || !osutil.IsExist(cfgFile) {
+		return
+	}
+	cfg, err := mgrconfig.LoadPartialFile(cfgFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	build, err := mgr.createDashboardBuild(info, dir, "normal")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Tag != build.ID {
+		t.Errorf("%v: manager uses build %v, want %v", dir, cfg.Tag, build.ID)
+	}
+}
+
+// chaosSource is the source of randomness and failures for TestChaos.
+type chaosSource struct {
+	mu           sync.Mutex
+	rnd          *rand.Rand
+	builds       int
+	failedBuilds int
+}
+
+func (chaos *chaosSource) intn(n int) int {
+	chaos.mu.Lock()
+	defer chaos.mu.Unlock()
+	return chaos.rnd.Intn(n)
+}
+
+// chance returns true with the probability of 1/n.
+func (chaos *chaosSource) chance(n int) bool {
+	return chaos.intn(n) == 0
+}
+
+// buildImage fakes build.Image: the image contains the kernel commit from the tag file.
+func (chaos *chaosSource) buildImage(params *build.Params) error {
+	time.Sleep(time.Duration(chaos.intn(20)) * time.Millisecond)
+	if chaos.chance(3) {
+		chaos.mu.Lock()
+		chaos.failedBuilds++
+		chaos.mu.Unlock()
+		return fmt.Errorf("chaos build failure")
+	}
+	info, err := loadBuildInfo(params.OutputDir)
+	if err != nil {
+		return err
+	}
+	if err := osutil.WriteFile(filepath.Join(params.OutputDir, "image"), []byte(info.KernelCommit)); err != nil {
+		return err
+	}
+	chaos.mu.Lock()
+	chaos.builds++
+	chaos.mu.Unlock()
+	return nil
+}
+
+// dashboard fakes dashboard (and GCS uploads behind it) that fails 1/4 of requests.
+func (chaos *chaosSource) dashboard(req *http.Request) (*http.Response, error) {
+	time.Sleep(time.Duration(chaos.intn(5)) * time.Millisecond)
+	switch chaos.intn(8) {
+	case 0:
+		return nil, fmt.Errorf("chaos network error")
+	case 1:
+		return &http.Response{
+			StatusCode: http.StatusInternalServerError,
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte("chaos server error"))),
+		}, nil
+	default:
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte("{}"))),
+		}, nil
+	}
+}
+
+// chaosRepo is a kernel repo that randomly fails to poll and moves between a few commits.
+type chaosRepo struct {
+	vcs.Repo
+	chaos *chaosSource
+}
+
+func (repo *chaosRepo) Poll(repoURL, branch string) (*vcs.Commit, error) {
+	if repo.chaos.chance(4) {
+		return nil, fmt.Errorf("chaos poll failure")
+	}
+	commit := fmt.Sprintf("commit%v", repo.chaos.intn(3))
+	return &vcs.Commit{Hash: commit, Title: commit, Date: time.Now()}, nil
+}
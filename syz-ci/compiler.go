@@ -0,0 +1,125 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package main
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/syzkaller/pkg/gcs"
+	"github.com/google/syzkaller/pkg/log"
+	"github.com/google/syzkaller/pkg/osutil"
+)
+
+// fetchCompiler downloads compiler archive (.tar.gz in GCS, bucket/path) and extracts it into dir.
+// This allows to build kernels with specific compilers (e.g. clang versions required
+// for KMSAN/KCSAN) without installing them on the machine.
+// The archive is downloaded again only if it has changed since the previous download.
+// If the download fails, but a previously extracted compiler exists, it is used.
+func fetchCompiler(archive, dir string) error {
+	tagFile := filepath.Join(dir, ".archive")
+	oldTag, _ := readTag(tagFile)
+	err := func() error {
+		GCS, err := gcs.NewClient()
+		if err != nil {
+			return fmt.Errorf("failed to create GCS client: %v", err)
+		}
+		defer GCS.Close()
+		file, err := GCS.Read(archive)
+		if err != nil {
+			return err
+		}
+		tag := archive + " " + file.Updated.UTC().Format(time.RFC3339Nano)
+		if tag == oldTag {
+			return nil
+		}
+		log.Logf(0, "downloading compiler %v", archive)
+		r, err := file.Reader()
+		if err != nil {
+			return fmt.Errorf("failed to read %v: %v", archive, err)
+		}
+		defer r.Close()
+		// Extract into a tmp dir first, so that we don't end up with a half-extracted compiler.
+		tmpDir := dir + ".tmp"
+		if err := os.RemoveAll(tmpDir); err != nil {
+			return err
+		}
+		if err := untar(r, tmpDir); err != nil {
+			return fmt.Errorf("failed to extract %v: %v", archive, err)
+		}
+		if err := osutil.WriteFile(filepath.Join(tmpDir, ".archive"), []byte(tag)); err != nil {
+			return err
+		}
+		if err := os.RemoveAll(dir); err != nil {
+			return err
+		}
+		return os.Rename(tmpDir, dir)
+	}()
+	if err != nil && oldTag != "" {
+		log.Logf(0, "failed to update compiler, using the old one: %v", err)
+		return nil
+	}
+	return err
+}
+
+// untar extracts gzipped tar archive into dir.
+func untar(r io.Reader, dir string) error {
+	gzipReader, err := gzip.NewReader(r)
+	if err != nil {
+		return err
+	}
+	if err := osutil.MkdirAll(dir); err != nil {
+		return err
+	}
+	tarReader := tar.NewReader(gzipReader)
+	for {
+		hdr, err := tarReader.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		dst := filepath.Join(dir, filepath.FromSlash(hdr.Name))
+		if !strings.HasPrefix(dst, filepath.Clean(dir)+string(filepath.Separator)) {
+			return fmt.Errorf("bad file name %v", hdr.Name)
+		}
+		if err := osutil.MkdirAll(filepath.Dir(dst)); err != nil {
+			return err
+		}
+		mode := os.FileMode(hdr.Mode).Perm()
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(dst, mode|0700); err != nil {
+				return err
+			}
+		case tar.TypeReg, tar.TypeRegA:
+			file, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, mode)
+			if err != nil {
+				return err
+			}
+			_, err = io.Copy(file, tarReader)
+			file.Close()
+			if err != nil {
+				return err
+			}
+		case tar.TypeSymlink:
+			if err := os.Symlink(hdr.Linkname, dst); err != nil {
+				return err
+			}
+		case tar.TypeLink:
+			if err := os.Link(filepath.Join(dir, filepath.FromSlash(hdr.Linkname)), dst); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("unsupported file type %v of %v", hdr.Typeflag, hdr.Name)
+		}
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package main
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestUntar(t *testing.T) {
+	dir, err := ioutil.TempDir("", "syz-ci-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	archive := func(files ...*tar.Header) *bytes.Buffer {
+		buf := new(bytes.Buffer)
+		gzipWriter := gzip.NewWriter(buf)
+		tarWriter := tar.NewWriter(gzipWriter)
+		for _, hdr := range files {
+			data := []byte(hdr.Name)
+			if hdr.Typeflag == tar.TypeReg {
+				hdr.Size = int64(len(data))
+			}
+			if err := tarWriter.WriteHeader(hdr); err != nil {
+				t.Fatal(err)
+			}
+			if hdr.Typeflag == tar.TypeReg {
+				if _, err := tarWriter.Write(data); err != nil {
+					t.Fatal(err)
+				}
+			}
+		}
+		if err := tarWriter.Close(); err != nil {
+			t.Fatal(err)
+		}
+		if err := gzipWriter.Close(); err != nil {
+			t.Fatal(err)
+		}
+		return buf
+	}
+	good := archive(
+		&tar.Header{Name: "bin/", Typeflag: tar.TypeDir, Mode: 0755},
+		&tar.Header{Name: "bin/clang-8", Typeflag: tar.TypeReg, Mode: 0755},
+		&tar.Header{Name: "bin/clang", Typeflag: tar.TypeSymlink, Linkname: "clang-8"},
+		&tar.Header{Name: "bin/clang++", Typeflag: tar.TypeLink, Linkname: "bin/clang-8"},
+		&tar.Header{Name: "lib/clang/8.0.0/include/stddef.h", Typeflag: tar.TypeReg, Mode: 0644},
+	)
+	compilerDir := filepath.Join(dir, "compiler")
+	if err := untar(good, compilerDir); err != nil {
+		t.Fatal(err)
+	}
+	for _, f := range []string{"bin/clang", "bin/clang++"} {
+		data, err := ioutil.ReadFile(filepath.Join(compilerDir, filepath.FromSlash(f)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != "bin/clang-8" {
+			t.Fatalf("bad %v contents: %q", f, data)
+		}
+	}
+	st, err := os.Stat(filepath.Join(compilerDir, "bin", "clang"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if st.Mode()&0100 == 0 {
+		t.Fatalf("compiler is not executable: %v", st.Mode())
+	}
+	bad := archive(&tar.Header{Name: "../evil", Typeflag: tar.TypeReg, Mode: 0644})
+	if err := untar(bad, filepath.Join(dir, "bad")); err == nil {
+		t.Fatalf("extracted file outside of the dir")
+	}
+}
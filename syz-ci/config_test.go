@@ -4,7 +4,11 @@
 package main
 
 import (
+	"io/ioutil"
+	"os"
 	"testing"
+
+	"github.com/google/syzkaller/pkg/osutil"
 )
 
 func TestLoadConfig(t *testing.T) {
@@ -12,3 +16,73 @@ func TestLoadConfig(t *testing.T) {
 		t.Fatalf("failed to load: %v", err)
 	}
 }
+
+func TestLoadConfigDuplicates(t *testing.T) {
+	configs := []string{
+		`{"name": "ci", "http": ":80", "managers": [
+			{"name": "foo", "manager_config": {"http": ":10000"}},
+			{"name": "foo", "manager_config": {"http": ":10001"}}
+		]}`,
+		`{"name": "ci", "http": ":80", "managers": [
+			{"name": "foo", "manager_config": {"http": ":10000"}},
+			{"name": "bar", "manager_config": {"http": ":10000"}}
+		]}`,
+		`{"name": "ci", "http": ":80", "managers": [
+			{"name": "foo", "compiler_archive": "bucket/clang.tar.gz", "compiler": "/usr/bin/clang"}
+		]}`,
+		`{"name": "ci", "http": ":80", "managers": [
+			{"name": "foo", "sanitizers": ["kasan", "kmsan"]}
+		]}`,
+		`{"name": "ci", "http": ":80", "maintenance_windows": ["sun 25:00-26:00"], "managers": [
+			{"name": "foo"}
+		]}`,
+		`{"name": "ci", "http": ":80", "managers": [
+			{"name": "foo", "canary": {"vms": 2}}
+		]}`,
+		`{"name": "ci", "http": ":80", "managers": [
+			{"name": "foo", "in_process": true, "canary": {"vms": 0}}
+		]}`,
+		`{"name": "ci", "http": ":80", "managers": [
+			{"name": "foo", "in_process": true, "canary": {"vms": 1},
+			"manager_config": {"type": "isolated", "vm": {"targets": ["host1", "host2"]}}}
+		]}`,
+		`{"name": "ci", "http": ":80", "managers": [
+			{"name": "foo", "kernel_lockdown": true}
+		]}`,
+		`{"name": "ci", "http": ":80", "notifications": {"emails": ["a@b.c"]}, "managers": [
+			{"name": "foo"}
+		]}`,
+		`{"name": "ci", "http": ":80", "managers": [
+			{"name": "foo", "root_storage": "lvm"}
+		]}`,
+		`{"name": "ci", "http": ":80", "managers": [
+			{"name": "foo", "image_backend": "buildroot", "root_storage": "verity"}
+		]}`,
+		`{"name": "ci", "http": ":80", "managers": [
+			{"name": "foo", "lsm": "smack"}
+		]}`,
+		`{"name": "ci", "http": ":80", "managers": [
+			{"name": "foo", "deep_dive": {"windows": ["weekend 00:00-24:00"]}}
+		]}`,
+		`{"name": "ci", "http": ":80", "poll_period": -1, "managers": [
+			{"name": "foo"}
+		]}`,
+		`{"name": "ci", "http": ":80", "poll_period": 30, "error_backoff": 60, "managers": [
+			{"name": "foo"}
+		]}`,
+	}
+	f, err := ioutil.TempFile("", "syz-ci-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+	defer os.Remove(f.Name())
+	for i, cfg := range configs {
+		if err := osutil.WriteFile(f.Name(), []byte(cfg)); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := loadConfig(f.Name()); err == nil {
+			t.Errorf("config #%v is loaded", i)
+		}
+	}
+}
@@ -0,0 +1,223 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package main
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/syzkaller/pkg/health"
+	"github.com/google/syzkaller/pkg/log"
+	"github.com/google/syzkaller/pkg/osutil"
+)
+
+// Control API used by fleet controller (tools/syz-fleet) to manage many syz-ci instances.
+// It is served on the http address:
+//	GET  /                - combined status page of all managers (see status.go)
+//	GET  /status          - current status of the instance in JSON (dashapi.CIHeartbeat):
+//	                         commits, uptimes, build errors and times of the last repo polls
+//	GET  /api/status      - the same as /status
+//	GET  /events          - stream of manager phases as Server-Sent Events (see events.go)
+//	GET  /metrics         - metrics in Prometheus text format (see metrics.go)
+//	POST /control/pause   - pause all managers (stop syz-manager processes and kernel builds)
+//	POST /control/resume  - resume all managers
+//	POST /control/update  - poll and rebuild syzkaller right away (restarts on new build)
+//	POST /control/config  - replace config file with the request body and restart
+//	POST /control/confirm - confirm pending update of the manager given in the manager form value
+//	                         (all managers if not set), see confirm_updates
+//	POST /control/snapshot - save corpus of all running in-process managers (see in_process)
+//	                         into managers/<manager>/corpus-snapshot.db
+//	POST /control/syscalls - replace enabled syscalls of the in-process manager given in the manager
+//	                         form value, the body is JSON {"enable_syscalls": [...], "disable_syscalls": [...]}
+//	GET  /healthz, /readyz - liveness/readiness (see pkg/health), the instance is ready
+//	                         when all managers that are not paused are running
+// Control requests need to pass control_key in the key form value,
+// control requests are disabled if control_key is not set.
+// Note: the paused state is not persisted across restarts.
+
+type controller struct {
+	cfg         *Config
+	configFile  string
+	managers    []*Manager
+	updater     *SyzUpdater
+	start       time.Time
+	restart     chan struct{}
+	restartOnce sync.Once
+}
+
+func serveControl(ctl *controller) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", ctl.httpSummary)
+	mux.HandleFunc("/status", ctl.httpStatus)
+	mux.HandleFunc("/api/status", ctl.httpStatus)
+	mux.HandleFunc("/events", ctl.httpEvents)
+	mux.HandleFunc("/metrics", ctl.httpMetrics)
+	mux.HandleFunc("/control/pause", ctl.control(ctl.httpPause))
+	mux.HandleFunc("/control/resume", ctl.control(ctl.httpResume))
+	mux.HandleFunc("/control/update", ctl.control(ctl.httpUpdate))
+	mux.HandleFunc("/control/config", ctl.control(ctl.httpConfig))
+	mux.HandleFunc("/control/confirm", ctl.control(ctl.httpConfirm))
+	mux.HandleFunc("/control/snapshot", ctl.control(ctl.httpSnapshot))
+	mux.HandleFunc("/control/syscalls", ctl.control(ctl.httpSyscalls))
+	checker := health.NewChecker()
+	checker.Add("storage", false, health.CheckDir("."))
+	checker.Add("managers", false, ctl.checkManagers)
+	checker.Register(mux)
+	log.Logf(0, "serving control http on %v", ctl.cfg.HTTP)
+	if err := http.ListenAndServe(ctl.cfg.HTTP, mux); err != nil {
+		log.Logf(0, "failed to serve control http: %v", err)
+	}
+}
+
+func (ctl *controller) control(fn func(w http.ResponseWriter, r *http.Request) error) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			http.Error(w, "only POST is supported", http.StatusMethodNotAllowed)
+			return
+		}
+		key := r.FormValue("key")
+		if ctl.cfg.ControlKey == "" ||
+			subtle.ConstantTimeCompare([]byte(key), []byte(ctl.cfg.ControlKey)) != 1 {
+			http.Error(w, "access denied", http.StatusForbidden)
+			return
+		}
+		if err := fn(w, r); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	}
+}
+
+func (ctl *controller) httpStatus(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(collectStatus(ctl.cfg, ctl.managers, ctl.updater, ctl.start))
+}
+
+func (ctl *controller) checkManagers() error {
+	var down []string
+	for _, mgr := range ctl.managers {
+		status := mgr.heartbeatStatus()
+		if !status.Paused && status.UpTime == 0 {
+			down = append(down, mgr.name)
+		}
+	}
+	if len(down) != 0 {
+		return fmt.Errorf("managers are not running: %v", strings.Join(down, ", "))
+	}
+	return nil
+}
+
+func (ctl *controller) httpPause(w http.ResponseWriter, r *http.Request) error {
+	log.Logf(0, "pausing managers")
+	for _, mgr := range ctl.managers {
+		mgr.setPaused(true)
+	}
+	return nil
+}
+
+func (ctl *controller) httpResume(w http.ResponseWriter, r *http.Request) error {
+	log.Logf(0, "resuming managers")
+	for _, mgr := range ctl.managers {
+		mgr.setPaused(false)
+	}
+	return nil
+}
+
+func (ctl *controller) httpUpdate(w http.ResponseWriter, r *http.Request) error {
+	log.Logf(0, "syzkaller update requested")
+	ctl.updater.TriggerUpdate()
+	return nil
+}
+
+func (ctl *controller) httpConfig(w http.ResponseWriter, r *http.Request) error {
+	if ctl.configFile == configFromMetadata || !osutil.IsExist(ctl.configFile) {
+		return fmt.Errorf("config is not loaded from a local file")
+	}
+	data, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read config: %v", err)
+	}
+	tmpFile := ctl.configFile + ".tmp"
+	if err := osutil.WriteFile(tmpFile, data); err != nil {
+		return err
+	}
+	if _, err := loadConfig(tmpFile); err != nil {
+		os.Remove(tmpFile)
+		return fmt.Errorf("bad config: %v", err)
+	}
+	if err := os.Rename(tmpFile, ctl.configFile); err != nil {
+		return err
+	}
+	log.Logf(0, "config is updated, restarting")
+	ctl.restartOnce.Do(func() {
+		close(ctl.restart)
+	})
+	return nil
+}
+
+func (ctl *controller) httpConfirm(w http.ResponseWriter, r *http.Request) error {
+	name := r.FormValue("manager")
+	confirmed := false
+	for _, mgr := range ctl.managers {
+		if (name == "" || mgr.name == name) && mgr.confirmPendingUpdate() {
+			log.Logf(0, "%v: manager update is confirmed", mgr.name)
+			confirmed = true
+		}
+	}
+	if !confirmed {
+		return fmt.Errorf("no pending updates")
+	}
+	return nil
+}
+
+func (ctl *controller) httpSnapshot(w http.ResponseWriter, r *http.Request) error {
+	var errs []string
+	for _, mgr := range ctl.managers {
+		mp := mgr.inProcess()
+		if mp == nil {
+			continue
+		}
+		file := filepath.Join("managers", mgr.name, "corpus-snapshot.db")
+		if err := mp.Snapshot(file); err != nil {
+			errs = append(errs, fmt.Sprintf("%v: %v", mgr.name, err))
+			continue
+		}
+		log.Logf(0, "%v: saved corpus snapshot to %v", mgr.name, file)
+	}
+	if len(errs) != 0 {
+		return fmt.Errorf("failed to snapshot corpus: %v", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func (ctl *controller) httpSyscalls(w http.ResponseWriter, r *http.Request) error {
+	name := r.FormValue("manager")
+	var mp *ManagerInProcess
+	for _, mgr := range ctl.managers {
+		if mgr.name == name {
+			mp = mgr.inProcess()
+			if mp == nil {
+				return fmt.Errorf("manager %v is not running in-process", name)
+			}
+		}
+	}
+	if mp == nil {
+		return fmt.Errorf("unknown manager %q", name)
+	}
+	req := new(struct {
+		EnableSyscalls  []string `json:"enable_syscalls"`
+		DisableSyscalls []string `json:"disable_syscalls"`
+	})
+	if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+		return fmt.Errorf("failed to parse request: %v", err)
+	}
+	return mp.ReloadSyscalls(req.EnableSyscalls, req.DisableSyscalls)
+}
@@ -0,0 +1,277 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"time"
+
+	"github.com/google/syzkaller/pkg/crypt"
+	"github.com/google/syzkaller/pkg/db"
+	"github.com/google/syzkaller/pkg/gcs"
+	"github.com/google/syzkaller/pkg/log"
+	"github.com/google/syzkaller/pkg/osutil"
+	"github.com/google/syzkaller/syz-manager/manager"
+)
+
+// Corpus persistence in GCS (see corpus_gcs_path config parameter).
+// Manager workdirs survive manager restarts, but are lost if the syz-ci instance is recreated.
+// So syz-ci periodically uploads corpus.db of every manager into <corpus_gcs_path>/<manager>/corpus.db
+// along with corpus.db.meta (corpusMeta) and restores it before the first manager start
+// if the manager does not have a local corpus.
+// The uploaded database is a compacted copy that is verified with the meta on restore.
+
+// corpusUploadPeriod is how often corpus is uploaded to GCS.
+const corpusUploadPeriod = time.Hour
+
+type corpusMeta struct {
+	Time    time.Time `json:"time"`
+	Version uint64    `json:"version"` // corpus.db user version (manager.CorpusDBVersion)
+	Records int       `json:"records"`
+	SHA256  string    `json:"sha256"`
+}
+
+// copyCorpus writes a compacted copy of the corpus database src into dst
+// and returns description of the copy.
+func copyCorpus(src, dst string, key *crypt.Key) (*corpusMeta, error) {
+	// Read a copy, the manager can write to the database concurrently
+	// and opening the database may compact it.
+	tmpFile := dst + ".tmp"
+	defer os.Remove(tmpFile)
+	if err := osutil.CopyFile(src, tmpFile); err != nil {
+		return nil, err
+	}
+	srcDB, err := db.OpenEncrypted(tmpFile, key)
+	if err != nil {
+		return nil, err
+	}
+	os.Remove(dst)
+	dstDB, err := db.OpenEncrypted(dst, key)
+	if err != nil {
+		return nil, err
+	}
+	for key, rec := range srcDB.Records {
+		dstDB.Save(key, rec.Val, rec.Seq)
+	}
+	if err := dstDB.BumpVersion(srcDB.Version); err != nil {
+		return nil, err
+	}
+	sum, err := fileSHA256(dst)
+	if err != nil {
+		return nil, err
+	}
+	meta := &corpusMeta{
+		Time:    time.Now(),
+		Version: srcDB.Version,
+		Records: len(srcDB.Records),
+		SHA256:  sum,
+	}
+	return meta, nil
+}
+
+// verifyCorpus checks that the corpus database file matches meta.
+func verifyCorpus(file string, key *crypt.Key, meta *corpusMeta) error {
+	sum, err := fileSHA256(file)
+	if err != nil {
+		return err
+	}
+	if sum != meta.SHA256 {
+		return fmt.Errorf("corpus checksum mismatch: %v, want %v", sum, meta.SHA256)
+	}
+	if meta.Version > manager.CorpusDBVersion {
+		return fmt.Errorf("corpus version %v is newer than supported %v",
+			meta.Version, manager.CorpusDBVersion)
+	}
+	// Open a copy, because open may modify the database.
+	tmpFile := file + ".tmp"
+	defer os.Remove(tmpFile)
+	if err := osutil.CopyFile(file, tmpFile); err != nil {
+		return err
+	}
+	corpusDB, err := db.OpenEncrypted(tmpFile, key)
+	if err != nil {
+		return err
+	}
+	if len(corpusDB.Records) != meta.Records || corpusDB.Version != meta.Version {
+		return fmt.Errorf("corpus has %v records version %v, want %v records version %v",
+			len(corpusDB.Records), corpusDB.Version, meta.Records, meta.Version)
+	}
+	return nil
+}
+
+func fileSHA256(file string) (string, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func (mgr *Manager) corpusKey() (*crypt.Key, error) {
+	return crypt.LoadKey(mgr.managercfg.WorkdirKey, mgr.managercfg.WorkdirKeyCommand)
+}
+
+func (mgr *Manager) corpusGCSPath() string {
+	return path.Join(mgr.cfg.CorpusGCSPath, mgr.name, "corpus.db")
+}
+
+// uploadCorpus uploads the manager corpus to GCS.
+func (mgr *Manager) uploadCorpus() error {
+	localFile := filepath.Join(mgr.workDir, "corpus.db")
+	if !osutil.IsExist(localFile) {
+		return nil
+	}
+	key, err := mgr.corpusKey()
+	if err != nil {
+		return err
+	}
+	copyFile := filepath.Join(filepath.Dir(mgr.workDir), "corpus-upload.db")
+	defer os.Remove(copyFile)
+	meta, err := copyCorpus(localFile, copyFile, key)
+	if err != nil {
+		return fmt.Errorf("failed to copy corpus: %v", err)
+	}
+	GCS, err := gcs.NewClient()
+	if err != nil {
+		return fmt.Errorf("failed to create GCS client: %v", err)
+	}
+	defer GCS.Close()
+	gcsFile := mgr.corpusGCSPath()
+	if oldMeta, err := readCorpusMeta(GCS, gcsFile+".meta"); err == nil && meta.Records < oldMeta.Records/2 {
+		// Most likely the local corpus was lost and the restore failed,
+		// don't overwrite the good corpus in GCS.
+		return fmt.Errorf("local corpus has %v records, but corpus in GCS has %v records, not uploading",
+			meta.Records, oldMeta.Records)
+	}
+	if err := uploadFile(GCS, copyFile, gcsFile); err != nil {
+		return err
+	}
+	metaData, err := json.Marshal(meta)
+	if err != nil {
+		return err
+	}
+	w, err := GCS.FileWriter(gcsFile + ".meta")
+	if err != nil {
+		return err
+	}
+	defer w.Close()
+	if _, err := w.Write(metaData); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	log.Logf(0, "%v: uploaded corpus (%v records) to %v", mgr.name, meta.Records, gcsFile)
+	return nil
+}
+
+// restoreCorpus downloads the manager corpus from GCS, if there is no local corpus.
+func (mgr *Manager) restoreCorpus() error {
+	localFile := filepath.Join(mgr.workDir, "corpus.db")
+	if osutil.IsExist(localFile) {
+		return nil
+	}
+	key, err := mgr.corpusKey()
+	if err != nil {
+		return err
+	}
+	GCS, err := gcs.NewClient()
+	if err != nil {
+		return fmt.Errorf("failed to create GCS client: %v", err)
+	}
+	defer GCS.Close()
+	gcsFile := mgr.corpusGCSPath()
+	meta, err := readCorpusMeta(GCS, gcsFile+".meta")
+	if err != nil {
+		// Most likely there is no corpus yet.
+		log.Logf(0, "%v: no corpus to restore: %v", mgr.name, err)
+		return nil
+	}
+	file, err := GCS.Read(gcsFile)
+	if err != nil {
+		return err
+	}
+	r, err := file.Reader()
+	if err != nil {
+		return fmt.Errorf("failed to read %v: %v", gcsFile, err)
+	}
+	defer r.Close()
+	if err := osutil.MkdirAll(mgr.workDir); err != nil {
+		return err
+	}
+	tmpFile := localFile + ".tmp"
+	defer os.Remove(tmpFile)
+	f, err := os.Create(tmpFile)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(f, r)
+	f.Close()
+	if err != nil {
+		return fmt.Errorf("failed to read %v: %v", gcsFile, err)
+	}
+	if err := verifyCorpus(tmpFile, key, meta); err != nil {
+		return fmt.Errorf("corpus %v is broken: %v", gcsFile, err)
+	}
+	if err := os.Rename(tmpFile, localFile); err != nil {
+		return err
+	}
+	log.Logf(0, "%v: restored corpus (%v records from %v) from %v",
+		mgr.name, meta.Records, meta.Time.Format(time.RFC3339), gcsFile)
+	return nil
+}
+
+func readCorpusMeta(GCS *gcs.Client, gcsFile string) (*corpusMeta, error) {
+	file, err := GCS.Read(gcsFile)
+	if err != nil {
+		return nil, err
+	}
+	r, err := file.Reader()
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	meta := new(corpusMeta)
+	if err := json.Unmarshal(data, meta); err != nil {
+		return nil, fmt.Errorf("failed to parse %v: %v", gcsFile, err)
+	}
+	return meta, nil
+}
+
+func uploadFile(GCS *gcs.Client, localFile, gcsFile string) error {
+	f, err := os.Open(localFile)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	w, err := GCS.FileWriter(gcsFile)
+	if err != nil {
+		return err
+	}
+	defer w.Close()
+	if _, err := io.Copy(w, f); err != nil {
+		return fmt.Errorf("failed to upload %v: %v", gcsFile, err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("failed to upload %v: %v", gcsFile, err)
+	}
+	return nil
+}
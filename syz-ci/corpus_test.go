@@ -0,0 +1,76 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/syzkaller/pkg/db"
+	"github.com/google/syzkaller/pkg/osutil"
+	"github.com/google/syzkaller/syz-manager/manager"
+)
+
+func TestCopyCorpus(t *testing.T) {
+	dir, err := ioutil.TempDir("", "syz-ci-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	src := filepath.Join(dir, "corpus.db")
+	corpusDB, err := db.Open(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 10; i++ {
+		corpusDB.Save(fmt.Sprint(i), []byte(fmt.Sprintf("prog%v", i)), 0)
+	}
+	corpusDB.Delete("0")
+	if err := corpusDB.BumpVersion(manager.CorpusDBVersion); err != nil {
+		t.Fatal(err)
+	}
+	dst := filepath.Join(dir, "copy.db")
+	meta, err := copyCorpus(src, dst, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if meta.Records != 9 || meta.Version != manager.CorpusDBVersion {
+		t.Fatalf("bad meta: %+v", meta)
+	}
+	if err := verifyCorpus(dst, nil, meta); err != nil {
+		t.Fatal(err)
+	}
+	copyDB, err := db.Open(dst)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(copyDB.Records) != 9 || string(copyDB.Records["5"].Val) != "prog5" {
+		t.Fatalf("bad copied records: %+v", copyDB.Records)
+	}
+
+	// Corrupted database must not pass verification.
+	data, err := ioutil.ReadFile(dst)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data[len(data)/2] ^= 0xff
+	if err := osutil.WriteFile(dst, data); err != nil {
+		t.Fatal(err)
+	}
+	if err := verifyCorpus(dst, nil, meta); err == nil {
+		t.Fatalf("corrupted corpus passed verification")
+	}
+	// As well as a database from a future version.
+	meta1, err := copyCorpus(src, dst, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	meta1.Version++
+	if err := verifyCorpus(dst, nil, meta1); err == nil {
+		t.Fatalf("corpus with newer version passed verification")
+	}
+}
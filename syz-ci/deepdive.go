@@ -0,0 +1,91 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	"github.com/google/syzkaller/pkg/build"
+	"github.com/google/syzkaller/pkg/hash"
+)
+
+// DeepDiveConfig describes periodic "deep dive" runs of a manager (deep_dive manager parameter).
+// During the windows the kernel is rebuilt with expensive debugging options enabled on top
+// of kernel_config (DEBUG_PAGEALLOC, KASAN stack instrumentation, lockdep, etc) and the manager
+// is restarted on it with the same workdir, so the existing corpus is run through the debug kernel.
+// When the window ends, the kernel is rebuilt with the normal config.
+// Mode switches are kernel rebuilds, so they are delayed until maintenance windows (if configured).
+type DeepDiveConfig struct {
+	// Weekly time windows of deep dive runs in maintenance_windows format
+	// (e.g. "sat-sun 00:00-24:00"), see maintenanceWindow.
+	Windows []string `json:"windows"`
+	// File with kernel config options enabled on top of kernel_config during deep dives
+	// (optional, see build.DeepDiveConfig for the default options).
+	Config string `json:"config"`
+
+	windows []*maintenanceWindow
+}
+
+func (cfg *DeepDiveConfig) validate() error {
+	if len(cfg.Windows) == 0 {
+		return fmt.Errorf("no windows")
+	}
+	cfg.windows = nil
+	for _, str := range cfg.Windows {
+		w, err := parseMaintenanceWindow(str)
+		if err != nil {
+			return err
+		}
+		cfg.windows = append(cfg.windows, w)
+	}
+	return nil
+}
+
+// active returns true if deep dive runs are scheduled at the given time.
+func (cfg *DeepDiveConfig) active(now time.Time) bool {
+	for _, w := range cfg.windows {
+		if w.contains(now) {
+			return true
+		}
+	}
+	return false
+}
+
+// loadDeepDiveConfig returns kernel config for deep dive runs on top of the normal kernel config.
+func loadDeepDiveConfig(cfg *DeepDiveConfig, configData []byte) ([]byte, error) {
+	if cfg.Config == "" {
+		return build.DeepDiveConfig(configData), nil
+	}
+	extra, err := ioutil.ReadFile(cfg.Config)
+	if err != nil {
+		return nil, err
+	}
+	res := append([]byte{}, configData...)
+	if len(res) != 0 && res[len(res)-1] != '\n' {
+		res = append(res, '\n')
+	}
+	return append(res, extra...), nil
+}
+
+// switchDeepDive switches kernel config of the manager to the deep dive or the normal one
+// according to the schedule, returns true if the config has changed and the kernel needs a rebuild.
+func (mgr *Manager) switchDeepDive(now time.Time) bool {
+	if mgr.mgrcfg.DeepDive == nil {
+		return false
+	}
+	deepDive := mgr.mgrcfg.DeepDive.active(now)
+	if deepDive == mgr.deepDive {
+		return false
+	}
+	mgr.deepDive = deepDive
+	if deepDive {
+		mgr.configData = mgr.deepDiveConfig
+	} else {
+		mgr.configData = mgr.normalConfig
+	}
+	mgr.configTag = hash.String(mgr.configData)
+	return true
+}
@@ -0,0 +1,52 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/google/syzkaller/pkg/hash"
+)
+
+func TestDeepDive(t *testing.T) {
+	if err := new(DeepDiveConfig).validate(); err == nil {
+		t.Fatalf("deep dive without windows is accepted")
+	}
+	cfg := &DeepDiveConfig{Windows: []string{"sat-sun 00:00-24:00"}}
+	if err := cfg.validate(); err != nil {
+		t.Fatal(err)
+	}
+	normal := []byte("CONFIG_KASAN=y\n")
+	deepDive, err := loadDeepDiveConfig(cfg, normal)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.HasPrefix(deepDive, normal) || !bytes.Contains(deepDive, []byte("\nCONFIG_DEBUG_PAGEALLOC=y\n")) {
+		t.Fatalf("bad deep dive config:\n%s", deepDive)
+	}
+	mgr := &Manager{
+		mgrcfg:         &ManagerConfig{DeepDive: cfg},
+		configData:     normal,
+		configTag:      hash.String(normal),
+		normalConfig:   normal,
+		deepDiveConfig: deepDive,
+	}
+	// 2018-06-04 is Monday.
+	monday := time.Date(2018, time.June, 4, 12, 0, 0, 0, time.UTC)
+	saturday := time.Date(2018, time.June, 9, 12, 0, 0, 0, time.UTC)
+	if mgr.switchDeepDive(monday) {
+		t.Fatalf("switched to deep dive on monday")
+	}
+	if !mgr.switchDeepDive(saturday) || !mgr.deepDive || mgr.configTag != hash.String(deepDive) {
+		t.Fatalf("did not switch to deep dive on saturday")
+	}
+	if mgr.switchDeepDive(saturday.Add(time.Hour)) {
+		t.Fatalf("switched twice")
+	}
+	if !mgr.switchDeepDive(monday.Add(7*24*time.Hour)) || mgr.deepDive || mgr.configTag != hash.String(normal) {
+		t.Fatalf("did not switch back to normal config")
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Phases of the manager loop, exposed on the status page and streamed by /events,
+// so that it's possible to see what syz-ci is doing without looking at the logs.
+const (
+	phaseStarting   = "starting"
+	phasePolling    = "polling"
+	phaseQueued     = "queued for build" // waiting for other managers to finish kernel builds
+	phaseBuilding   = "building kernel"
+	phaseTesting    = "testing image"
+	phaseWaiting    = "waiting for build" // no good build to run the manager on
+	phaseRunning    = "running"
+	phaseConfirming = "waiting for update confirmation" // see managerUpdate
+	phaseDeferred   = "waiting for maintenance window"  // see maintenanceWindow
+	phaseUptime     = "waiting for min manager uptime"  // see minManagerUptime
+	phaseCanary     = "testing update on canary"        // see CanaryConfig
+	phasePaused     = "paused"
+	phaseStopped    = "stopped"
+)
+
+// ManagerPhase describes what the manager loop is doing right now.
+type ManagerPhase struct {
+	Name  string    `json:"name"`
+	Phase string    `json:"phase"`
+	Since time.Time `json:"since"`
+	// Estimated progress of the phase in percents (0 if unknown).
+	// Progress of kernel builds is estimated from duration of the previous build.
+	Progress int `json:"progress,omitempty"`
+}
+
+func (phase ManagerPhase) String() string {
+	if phase.Progress == 0 {
+		return phase.Phase
+	}
+	return fmt.Sprintf("%v %v%%", phase.Phase, phase.Progress)
+}
+
+// eventsPeriod is how often /events checks for phase changes.
+var eventsPeriod = time.Second
+
+// setPhase switches the manager to the new phase. estimate is the expected duration
+// of the phase, if known. Setting the current phase again does nothing.
+func (mgr *Manager) setPhase(phase string, estimate time.Duration) {
+	mgr.status.mu.Lock()
+	defer mgr.status.mu.Unlock()
+	if mgr.status.phase == phase {
+		return
+	}
+	mgr.status.phase = phase
+	mgr.status.phaseStart = time.Now()
+	mgr.status.phaseEstimate = estimate
+}
+
+func (mgr *Manager) currentPhase() ManagerPhase {
+	mgr.status.mu.Lock()
+	defer mgr.status.mu.Unlock()
+	res := ManagerPhase{
+		Name:  mgr.name,
+		Phase: mgr.status.phase,
+		Since: mgr.status.phaseStart,
+	}
+	if res.Phase == "" {
+		res.Phase = phaseStarting
+	}
+	if estimate := mgr.status.phaseEstimate; estimate != 0 {
+		res.Progress = phaseProgress(time.Since(res.Since), estimate)
+	}
+	return res
+}
+
+// phaseProgress returns progress in percents of a phase that runs for elapsed
+// and is expected to take estimate. It never reaches 100%, since the estimate
+// is not precise and the phase is still running.
+func phaseProgress(elapsed, estimate time.Duration) int {
+	progress := int(elapsed * 100 / estimate)
+	if progress > 99 {
+		progress = 99
+	}
+	if progress < 1 {
+		progress = 1
+	}
+	return progress
+}
+
+// httpEvents streams phases of all managers as Server-Sent Events
+// (https://html.spec.whatwg.org/multipage/server-sent-events.html).
+// Every event contains JSON array of ManagerPhase and is sent when any of them changes.
+func (ctl *controller) httpEvents(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming is not supported", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	ticker := time.NewTicker(eventsPeriod)
+	defer ticker.Stop()
+	var last []byte
+	for {
+		var phases []ManagerPhase
+		for _, mgr := range ctl.managers {
+			phases = append(phases, mgr.currentPhase())
+		}
+		data, err := json.Marshal(phases)
+		if err != nil {
+			panic(err)
+		}
+		if !bytes.Equal(data, last) {
+			last = data
+			if _, err := fmt.Fprintf(w, "data: %s\n\n", data); err != nil {
+				return
+			}
+			flusher.Flush()
+		}
+		select {
+		case <-ticker.C:
+		case <-r.Context().Done():
+			return
+		}
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestPhaseProgress(t *testing.T) {
+	tests := []struct {
+		elapsed  time.Duration
+		estimate time.Duration
+		progress int
+	}{
+		{0, time.Hour, 1},
+		{15 * time.Minute, time.Hour, 25},
+		{59 * time.Minute, time.Hour, 98},
+		{2 * time.Hour, time.Hour, 99},
+	}
+	for _, test := range tests {
+		if got := phaseProgress(test.elapsed, test.estimate); got != test.progress {
+			t.Errorf("phaseProgress(%v, %v) = %v, want %v", test.elapsed, test.estimate, got, test.progress)
+		}
+	}
+}
+
+func TestEvents(t *testing.T) {
+	defer func(period time.Duration) {
+		eventsPeriod = period
+	}(eventsPeriod)
+	eventsPeriod = 10 * time.Millisecond
+	managers := []*Manager{{name: "foo"}, {name: "bar"}}
+	ctl := &controller{managers: managers}
+	srv := httptest.NewServer(http.HandlerFunc(ctl.httpEvents))
+	defer srv.Close()
+	resp, err := http.Get(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if ct := resp.Header.Get("Content-Type"); ct != "text/event-stream" {
+		t.Fatalf("bad content type %q", ct)
+	}
+	events := bufio.NewReader(resp.Body)
+	next := func() []ManagerPhase {
+		for {
+			line, err := events.ReadString('\n')
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !strings.HasPrefix(line, "data: ") {
+				continue
+			}
+			var phases []ManagerPhase
+			if err := json.Unmarshal([]byte(line[len("data: "):]), &phases); err != nil {
+				t.Fatal(err)
+			}
+			return phases
+		}
+	}
+	phases := next()
+	if len(phases) != 2 || phases[0].Name != "foo" || phases[0].Phase != phaseStarting ||
+		phases[1].Name != "bar" || phases[1].Phase != phaseStarting {
+		t.Fatalf("bad initial phases: %+v", phases)
+	}
+	managers[1].setPhase(phaseBuilding, time.Hour)
+	phases = next()
+	if phases[0].Phase != phaseStarting || phases[1].Phase != phaseBuilding || phases[1].Progress != 1 {
+		t.Fatalf("bad phases after build start: %+v", phases)
+	}
+	if got, want := phases[1].String(), "building kernel 1%"; got != want {
+		t.Fatalf("bad phase string %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package main
+
+import (
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/google/syzkaller/dashboard/dashapi"
+	"github.com/google/syzkaller/pkg/log"
+)
+
+// syz-ci periodically reports own status (current builds, build failures, manager uptimes)
+// to dashboard, so that it's possible to see which of the instances are healthy, stuck or down.
+const heartbeatPeriod = 10 * time.Minute
+
+type managerStatus struct {
+	mu     sync.Mutex
+	status dashapi.CIManagerStatus
+	cmd    managerRunner
+	// Update that is waiting for confirmation and diff of the last applied update (see update.go).
+	update     *managerUpdate
+	lastUpdate string
+	// Current phase of the manager loop (see events.go).
+	phase         string
+	phaseStart    time.Time
+	phaseEstimate time.Duration
+}
+
+func (mgr *Manager) updateStatus(fn func(status *dashapi.CIManagerStatus)) {
+	mgr.status.mu.Lock()
+	defer mgr.status.mu.Unlock()
+	fn(&mgr.status.status)
+}
+
+func (mgr *Manager) setStatusCmd(cmd managerRunner) {
+	mgr.status.mu.Lock()
+	defer mgr.status.mu.Unlock()
+	mgr.status.cmd = cmd
+}
+
+// inProcess returns the running in-process manager, or nil if the manager
+// is not running or runs as a separate process.
+func (mgr *Manager) inProcess() *ManagerInProcess {
+	mgr.status.mu.Lock()
+	defer mgr.status.mu.Unlock()
+	mp, _ := mgr.status.cmd.(*ManagerInProcess)
+	return mp
+}
+
+func (mgr *Manager) paused() bool {
+	mgr.status.mu.Lock()
+	defer mgr.status.mu.Unlock()
+	return mgr.status.status.Paused
+}
+
+// setPaused pauses (stops the manager process and kernel builds) or resumes the manager.
+func (mgr *Manager) setPaused(paused bool) {
+	mgr.updateStatus(func(status *dashapi.CIManagerStatus) {
+		status.Paused = paused
+	})
+	select {
+	case mgr.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (mgr *Manager) heartbeatStatus() dashapi.CIManagerStatus {
+	mgr.status.mu.Lock()
+	defer mgr.status.mu.Unlock()
+	status := mgr.status.status
+	status.Name = mgr.name
+	if mgr.status.cmd != nil {
+		status.UpTime = mgr.status.cmd.UpTime()
+	}
+	return status
+}
+
+// collectStatus returns the current status of the instance (sent in heartbeats and served by /status).
+func collectStatus(cfg *Config, managers []*Manager, updater *SyzUpdater, start time.Time) *dashapi.CIHeartbeat {
+	syzkallerCommit, _ := readTag(filepath.FromSlash("syzkaller/current/tag"))
+	status := &dashapi.CIHeartbeat{
+		Name:            cfg.Name,
+		SyzkallerCommit: syzkallerCommit,
+		UpTime:          time.Since(start),
+	}
+	status.LastSyzkallerPoll, status.LastSyzkallerBuildError = updater.Status()
+	for _, mgr := range managers {
+		status.Managers = append(status.Managers, mgr.heartbeatStatus())
+	}
+	return status
+}
+
+func heartbeatLoop(cfg *Config, managers []*Manager, updater *SyzUpdater, start time.Time, stop chan struct{}) {
+	dash := dashapi.New(cfg.DashboardClient, cfg.DashboardAddr, cfg.DashboardKey)
+	ticker := time.NewTicker(heartbeatPeriod)
+	defer ticker.Stop()
+	for {
+		if err := dash.UploadCIHeartbeat(collectStatus(cfg, managers, updater, start)); err != nil {
+			log.Logf(0, "failed to upload heartbeat: %v", err)
+		}
+		select {
+		case <-ticker.C:
+		case <-stop:
+			return
+		}
+	}
+}
@@ -0,0 +1,145 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/syzkaller/pkg/gce"
+	"github.com/google/syzkaller/pkg/log"
+)
+
+// syz-ci periodically deletes GCE instances, disks and images that were created for managers
+// of this syz-ci instance, but are not used anymore (e.g. after crashes of syz-ci or syz-manager,
+// removal/renaming of managers or reduction of VM count), because they cost money.
+const janitorPeriod = time.Hour
+
+type janitor struct {
+	// All resources created for managers of this syz-ci instance start with prefix.
+	prefix string
+	// Resources of the current managers are named <manager name> (images)
+	// and <manager name>-<suffix> (instances and disks), these are never deleted.
+	managers []string
+	// Other resources that must not be deleted (e.g. pre-created images).
+	keep   map[string]bool
+	maxAge time.Duration
+}
+
+func newJanitor(cfg *Config) (*janitor, error) {
+	jan := &janitor{
+		prefix: cfg.Name + "-",
+		keep:   make(map[string]bool),
+		maxAge: time.Duration(cfg.GCEJanitorAge) * time.Hour,
+	}
+	for _, mgr := range cfg.Managers {
+		jan.managers = append(jan.managers, cfg.Name+"-"+mgr.Name)
+		mgrcfg := new(struct {
+			Type string          `json:"type"`
+			VM   json.RawMessage `json:"vm"`
+		})
+		if err := json.Unmarshal(mgr.ManagerConfig, mgrcfg); err != nil {
+			return nil, fmt.Errorf("manager %v: failed to parse manager config: %v", mgr.Name, err)
+		}
+		if mgrcfg.Type != "gce" {
+			continue
+		}
+		vm := new(struct {
+			GCEImage string `json:"gce_image"`
+		})
+		if err := json.Unmarshal(mgrcfg.VM, vm); err != nil {
+			return nil, fmt.Errorf("manager %v: failed to parse vm config: %v", mgr.Name, err)
+		}
+		if vm.GCEImage != "" {
+			jan.keep[vm.GCEImage] = true
+		}
+	}
+	return jan, nil
+}
+
+func janitorLoop(cfg *Config, stop chan struct{}) {
+	jan, err := newJanitor(cfg)
+	if err != nil {
+		log.Logf(0, "janitor: %v", err)
+		return
+	}
+	GCE, err := gce.NewContext()
+	if err != nil {
+		log.Logf(0, "janitor: failed to init gce: %v", err)
+		return
+	}
+	// Don't kill ourselves.
+	jan.keep[GCE.Instance] = true
+	ticker := time.NewTicker(janitorPeriod)
+	defer ticker.Stop()
+	for {
+		jan.clean(GCE, stop)
+		select {
+		case <-ticker.C:
+		case <-stop:
+			return
+		}
+	}
+}
+
+func (jan *janitor) clean(GCE *gce.Context, stop chan struct{}) {
+	// Instances go first, since their disks are deleted together with them.
+	kinds := []struct {
+		name   string
+		list   func() ([]*gce.Resource, error)
+		delete func(name string) error
+	}{
+		{"instance", GCE.ListInstances, func(name string) error { return GCE.DeleteInstance(name, true) }},
+		{"disk", GCE.ListDisks, GCE.DeleteDisk},
+		{"image", GCE.ListImages, GCE.DeleteImage},
+	}
+	for _, kind := range kinds {
+		resources, err := kind.list()
+		if err != nil {
+			log.Logf(0, "janitor: %v", err)
+			continue
+		}
+		for _, name := range jan.leaked(resources, kind.name == "instance", time.Now()) {
+			select {
+			case <-stop:
+				return
+			default:
+			}
+			log.Logf(0, "janitor: deleting leaked %v %v", kind.name, name)
+			if err := kind.delete(name); err != nil {
+				log.Logf(0, "janitor: %v", err)
+			}
+		}
+	}
+}
+
+// leaked returns names of resources that belong to this syz-ci instance,
+// are not used by the current managers and are older than maxAge.
+// Instances are additionally checked to be syzkaller workers.
+func (jan *janitor) leaked(resources []*gce.Resource, instances bool, now time.Time) []string {
+	var res []string
+	for _, r := range resources {
+		if !strings.HasPrefix(r.Name, jan.prefix) || jan.used(r.Name) ||
+			instances && r.Description != gce.WorkerDescription ||
+			r.Created.IsZero() || now.Sub(r.Created) < jan.maxAge {
+			continue
+		}
+		res = append(res, r.Name)
+	}
+	return res
+}
+
+func (jan *janitor) used(name string) bool {
+	if jan.keep[name] {
+		return true
+	}
+	for _, mgr := range jan.managers {
+		if name == mgr || strings.HasPrefix(name, mgr+"-") {
+			return true
+		}
+	}
+	return false
+}
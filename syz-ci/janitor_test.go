@@ -0,0 +1,65 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/syzkaller/pkg/gce"
+)
+
+func TestJanitorLeaked(t *testing.T) {
+	cfg := &Config{
+		Name:          "ci",
+		GCEJanitorAge: 24,
+		Managers: []*ManagerConfig{
+			{
+				Name:          "upstream",
+				ManagerConfig: json.RawMessage(`{"type": "gce", "vm": {"count": 2}}`),
+			},
+			{
+				Name:          "next",
+				ManagerConfig: json.RawMessage(`{"type": "gce", "vm": {"gce_image": "ci-base-image"}}`),
+			},
+			{
+				Name:          "local",
+				ManagerConfig: json.RawMessage(`{"type": "qemu", "vm": {"count": 2}}`),
+			},
+		},
+	}
+	jan, err := newJanitor(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Date(2018, 6, 1, 12, 0, 0, 0, time.UTC)
+	old := now.Add(-48 * time.Hour)
+	fresh := now.Add(-time.Hour)
+	instances := []*gce.Resource{
+		{Name: "ci-upstream-0", Description: gce.WorkerDescription, Created: old},
+		{Name: "ci-upstream-job-1", Description: gce.WorkerDescription, Created: old},
+		{Name: "ci-next-0", Description: gce.WorkerDescription, Created: old},
+		{Name: "ci-removed-0", Description: gce.WorkerDescription, Created: old},
+		{Name: "ci-removed-1", Description: gce.WorkerDescription, Created: fresh},
+		{Name: "ci-removed-2", Description: gce.WorkerDescription},
+		{Name: "ci-syz-ci", Description: "", Created: old},
+		{Name: "other-upstream-0", Description: gce.WorkerDescription, Created: old},
+	}
+	if got, want := jan.leaked(instances, true, now), []string{"ci-removed-0"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("leaked instances: got %v, want %v", got, want)
+	}
+	images := []*gce.Resource{
+		{Name: "ci-upstream", Created: old},
+		{Name: "ci-next", Created: old},
+		{Name: "ci-base-image", Created: old},
+		{Name: "ci-removed", Created: old},
+		{Name: "ci-removed2", Created: fresh},
+		{Name: "other-removed", Created: old},
+	}
+	if got, want := jan.leaked(images, false, now), []string{"ci-removed"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("leaked images: got %v, want %v", got, want)
+	}
+}
@@ -4,13 +4,16 @@
 package main
 
 import (
+	"bytes"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"os"
 	"path/filepath"
 	"time"
 
 	"github.com/google/syzkaller/dashboard/dashapi"
+	"github.com/google/syzkaller/pkg/bisect"
 	"github.com/google/syzkaller/pkg/build"
 	"github.com/google/syzkaller/pkg/instance"
 	"github.com/google/syzkaller/pkg/log"
@@ -25,6 +28,7 @@ type JobProcessor struct {
 	dash            *dashapi.Dashboard
 	syzkallerRepo   string
 	syzkallerBranch string
+	bisectBinDir    string
 }
 
 func newJobProcessor(cfg *Config, managers []*Manager) *JobProcessor {
@@ -33,6 +37,7 @@ func newJobProcessor(cfg *Config, managers []*Manager) *JobProcessor {
 		managers:        managers,
 		syzkallerRepo:   cfg.SyzkallerRepo,
 		syzkallerBranch: cfg.SyzkallerBranch,
+		bisectBinDir:    cfg.BisectBinDir,
 	}
 	if cfg.DashboardAddr != "" && cfg.DashboardClient != "" {
 		jp.dash = dashapi.New(cfg.DashboardClient, cfg.DashboardAddr, cfg.DashboardKey)
@@ -58,11 +63,20 @@ func (jp *JobProcessor) loop(stop chan struct{}) {
 }
 
 func (jp *JobProcessor) poll() {
-	var names []string
+	var names, minimizeNames []string
 	for _, mgr := range jp.managers {
 		names = append(names, mgr.name)
+		if len(mgr.baselineConfig) != 0 {
+			minimizeNames = append(minimizeNames, mgr.name)
+		}
 	}
-	req, err := jp.dash.JobPoll(names)
+	pollStart := time.Now()
+	req, err := jp.dash.JobPoll(&dashapi.JobPollReq{
+		Managers:               names,
+		BisectCause:            jp.bisectBinDir != "",
+		MinimizeConfigManagers: minimizeNames,
+	})
+	ciMetrics.pollFinished(pollStart, err, "action", "jobs")
 	if err != nil {
 		jp.Errorf("failed to poll jobs: %v", err)
 		return
@@ -85,8 +99,8 @@ func (jp *JobProcessor) poll() {
 		req: req,
 		mgr: mgr,
 	}
-	log.Logf(0, "starting job %v for manager %v on %v/%v",
-		req.ID, req.Manager, req.KernelRepo, req.KernelBranch)
+	log.Logf(0, "starting job %v (type %v) for manager %v on %v/%v",
+		req.ID, req.Type, req.Manager, req.KernelRepo, req.KernelBranch)
 	resp := jp.process(job)
 	log.Logf(0, "done job %v: commit %v, crash %q, error: %s",
 		resp.ID, resp.Build.KernelCommit, resp.CrashTitle, resp.Error)
@@ -130,6 +144,7 @@ func (jp *JobProcessor) process(job *Job) *dashapi.JobDoneReq {
 		{"syzkaller commit", req.SyzkallerCommit != ""},
 		{"reproducer options", len(req.ReproOpts) != 0},
 		{"reproducer program", len(req.ReproSyz) != 0},
+		{"kernel commit", req.Type == dashapi.JobTestPatch || req.KernelCommit != ""},
 	}
 	for _, req := range required {
 		if !req.ok {
@@ -149,26 +164,43 @@ func (jp *JobProcessor) process(job *Job) *dashapi.JobDoneReq {
 		jp.Errorf("%s", job.resp.Error)
 		return job.resp
 	}
-	if err := jp.test(job); err != nil {
+	var err error
+	switch req.Type {
+	case dashapi.JobTestPatch:
+		err = jp.test(job)
+	case dashapi.JobBisectCause:
+		err = jp.bisect(job)
+	case dashapi.JobMinimizeConfig:
+		err = jp.minimizeConfig(job)
+	default:
+		err = fmt.Errorf("unknown job type %v", req.Type)
+	}
+	if err != nil {
 		job.resp.Error = []byte(err.Error())
 	}
 	return job.resp
 }
 
-func (jp *JobProcessor) test(job *Job) error {
-	kernelBuildSem <- struct{}{}
-	defer func() { <-kernelBuildSem }()
-	req, resp, mgr := job.req, job.resp, job.mgr
-
+// createJobConfig creates config for the manager used for testing,
+// kernel and syzkaller are checked out into a separate dir.
+func (jp *JobProcessor) createJobConfig(mgr *Manager) *mgrconfig.Config {
 	dir := osutil.Abs(filepath.Join("jobs", mgr.managercfg.TargetOS))
-	kernelDir := filepath.Join(dir, "kernel")
-
 	mgrcfg := new(mgrconfig.Config)
 	*mgrcfg = *mgr.managercfg
 	mgrcfg.Name += "-job"
 	mgrcfg.Workdir = filepath.Join(dir, "workdir")
-	mgrcfg.KernelSrc = kernelDir
+	mgrcfg.KernelSrc = filepath.Join(dir, "kernel")
 	mgrcfg.Syzkaller = filepath.Join(dir, "gopath", "src", "github.com", "google", "syzkaller")
+	return mgrcfg
+}
+
+func (jp *JobProcessor) test(job *Job) error {
+	kernelBuildSem <- struct{}{}
+	defer func() { <-kernelBuildSem }()
+	req, resp, mgr := job.req, job.resp, job.mgr
+
+	mgrcfg := jp.createJobConfig(mgr)
+	kernelDir := mgrcfg.KernelSrc
 
 	os.RemoveAll(mgrcfg.Workdir)
 	defer os.RemoveAll(mgrcfg.Workdir)
@@ -217,8 +249,16 @@ func (jp *JobProcessor) test(job *Job) error {
 	}
 
 	log.Logf(0, "job: building kernel...")
-	if err := env.BuildKernel(mgr.mgrcfg.Compiler, mgr.mgrcfg.Userspace, mgr.mgrcfg.KernelCmdline,
-		mgr.mgrcfg.KernelSysctl, req.KernelConfig); err != nil {
+	if err := env.BuildKernel(&build.Params{
+		Compiler:     mgr.mgrcfg.Compiler,
+		UserspaceDir: mgr.mgrcfg.Userspace,
+		ImageBackend: mgr.mgrcfg.ImageBackend,
+		RootStorage:  mgr.mgrcfg.RootStorage,
+		LSM:          mgr.mgrcfg.LSM,
+		CmdlineFile:  mgr.mgrcfg.KernelCmdline,
+		SysctlFile:   mgr.mgrcfg.KernelSysctl,
+		Config:       req.KernelConfig,
+	}); err != nil {
 		return err
 	}
 	resp.Build.KernelConfig, err = ioutil.ReadFile(filepath.Join(mgrcfg.KernelSrc, ".config"))
@@ -269,6 +309,101 @@ func (jp *JobProcessor) test(job *Job) error {
 	return anyErr
 }
 
+// bisect searches for the commit that introduced the crash, see pkg/bisect.
+// Kernels are tested with the manager VMs (e.g. new GCE instances are created for every test).
+func (jp *JobProcessor) bisect(job *Job) error {
+	kernelBuildSem <- struct{}{}
+	defer func() { <-kernelBuildSem }()
+	req, resp, mgr := job.req, job.resp, job.mgr
+	resp.Build.KernelCommit = req.KernelCommit
+	resp.Build.KernelConfig = req.KernelConfig
+	resp.Build.SyzkallerCommit = req.SyzkallerCommit
+
+	mgrcfg := jp.createJobConfig(mgr)
+	os.RemoveAll(mgrcfg.Workdir)
+	defer os.RemoveAll(mgrcfg.Workdir)
+
+	trace := new(bytes.Buffer)
+	cfg := jp.bisectConfig(job, mgrcfg, trace)
+	log.Logf(0, "job: bisecting crash on %v...", req.KernelCommit)
+	commit, err := bisect.Run(cfg)
+	resp.Log = trace.Bytes()
+	if err != nil {
+		return err
+	}
+	if commit != nil {
+		resp.Commits = []dashapi.Commit{{
+			Hash:   commit.Hash,
+			Title:  commit.Title,
+			Author: commit.Author,
+			CC:     commit.CC,
+			Date:   commit.Date,
+		}}
+	}
+	return nil
+}
+
+// minimizeConfig searches for a small kernel config that still reproduces the crash,
+// starting from the manager baseline config, see bisect.MinimizeConfig.
+func (jp *JobProcessor) minimizeConfig(job *Job) error {
+	kernelBuildSem <- struct{}{}
+	defer func() { <-kernelBuildSem }()
+	req, resp, mgr := job.req, job.resp, job.mgr
+	resp.Build.KernelCommit = req.KernelCommit
+	resp.Build.KernelConfig = req.KernelConfig
+	resp.Build.SyzkallerCommit = req.SyzkallerCommit
+	if len(mgr.baselineConfig) == 0 {
+		return fmt.Errorf("manager %v has no kernel_baseline_config", mgr.name)
+	}
+
+	mgrcfg := jp.createJobConfig(mgr)
+	os.RemoveAll(mgrcfg.Workdir)
+	defer os.RemoveAll(mgrcfg.Workdir)
+
+	trace := new(bytes.Buffer)
+	cfg := jp.bisectConfig(job, mgrcfg, trace)
+	cfg.Kernel.Compiler = mgr.mgrcfg.Compiler
+	cfg.Kernel.BaselineConfig = mgr.baselineConfig
+	log.Logf(0, "job: minimizing config for crash on %v...", req.KernelCommit)
+	config, err := bisect.MinimizeConfig(cfg)
+	resp.Log = trace.Bytes()
+	if err != nil {
+		return err
+	}
+	resp.MinimizedConfig = config
+	return nil
+}
+
+func (jp *JobProcessor) bisectConfig(job *Job, mgrcfg *mgrconfig.Config, trace io.Writer) *bisect.Config {
+	req, mgr := job.req, job.mgr
+	return &bisect.Config{
+		Trace:  trace,
+		BinDir: jp.bisectBinDir,
+		Kernel: bisect.KernelConfig{
+			Repo:         req.KernelRepo,
+			Branch:       req.KernelBranch,
+			Commit:       req.KernelCommit,
+			Cmdline:      mgr.mgrcfg.KernelCmdline,
+			Sysctl:       mgr.mgrcfg.KernelSysctl,
+			Config:       req.KernelConfig,
+			Userspace:    mgr.mgrcfg.Userspace,
+			ImageBackend: mgr.mgrcfg.ImageBackend,
+			RootStorage:  mgr.mgrcfg.RootStorage,
+			LSM:          mgr.mgrcfg.LSM,
+		},
+		Syzkaller: bisect.SyzkallerConfig{
+			Repo:   jp.syzkallerRepo,
+			Commit: req.SyzkallerCommit,
+		},
+		Repro: bisect.ReproConfig{
+			Opts: req.ReproOpts,
+			Syz:  req.ReproSyz,
+			C:    req.ReproC,
+		},
+		Manager: *mgrcfg,
+	}
+}
+
 // Errorf logs non-fatal error and sends it to dashboard.
 func (jp *JobProcessor) Errorf(msg string, args ...interface{}) {
 	log.Logf(0, "job: "+msg, args...)
@@ -0,0 +1,135 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maintenanceWindow is a weekly time window when disruptive updates are allowed
+// (see maintenance_windows config parameter). The format is "days HH:MM-HH:MM [timezone]",
+// where days is "*" or a comma-separated list of days and day ranges (e.g. "mon-fri,sun")
+// and timezone is an IANA time zone name (e.g. "America/Los_Angeles", UTC by default).
+// A window that ends before it starts spans midnight, days refer to the start of the window.
+type maintenanceWindow struct {
+	days  [7]bool // indexed by time.Weekday
+	start int     // minutes since midnight
+	end   int
+	loc   *time.Location
+}
+
+var weekdays = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+func parseMaintenanceWindow(str string) (*maintenanceWindow, error) {
+	fields := strings.Fields(str)
+	if len(fields) != 2 && len(fields) != 3 {
+		return nil, fmt.Errorf("bad maintenance window %q: want \"days HH:MM-HH:MM [timezone]\"", str)
+	}
+	w := &maintenanceWindow{loc: time.UTC}
+	if err := w.parseDays(fields[0]); err != nil {
+		return nil, fmt.Errorf("bad maintenance window %q: %v", str, err)
+	}
+	times := strings.Split(fields[1], "-")
+	if len(times) != 2 {
+		return nil, fmt.Errorf("bad maintenance window %q: bad time range %q", str, fields[1])
+	}
+	var err error
+	if w.start, err = parseTimeOfDay(times[0]); err != nil {
+		return nil, fmt.Errorf("bad maintenance window %q: %v", str, err)
+	}
+	if w.end, err = parseTimeOfDay(times[1]); err != nil {
+		return nil, fmt.Errorf("bad maintenance window %q: %v", str, err)
+	}
+	if w.start == w.end {
+		return nil, fmt.Errorf("bad maintenance window %q: empty time range", str)
+	}
+	if len(fields) == 3 {
+		if w.loc, err = time.LoadLocation(fields[2]); err != nil {
+			return nil, fmt.Errorf("bad maintenance window %q: %v", str, err)
+		}
+	}
+	return w, nil
+}
+
+func (w *maintenanceWindow) parseDays(str string) error {
+	if str == "*" {
+		for i := range w.days {
+			w.days[i] = true
+		}
+		return nil
+	}
+	for _, part := range strings.Split(strings.ToLower(str), ",") {
+		bounds := strings.Split(part, "-")
+		if len(bounds) > 2 {
+			return fmt.Errorf("bad days %q", part)
+		}
+		first, ok := weekdays[bounds[0]]
+		if !ok {
+			return fmt.Errorf("bad day %q", bounds[0])
+		}
+		last, ok := weekdays[bounds[len(bounds)-1]]
+		if !ok {
+			return fmt.Errorf("bad day %q", bounds[len(bounds)-1])
+		}
+		for day := first; ; day = (day + 1) % 7 {
+			w.days[day] = true
+			if day == last {
+				break
+			}
+		}
+	}
+	return nil
+}
+
+func parseTimeOfDay(str string) (int, error) {
+	parts := strings.Split(str, ":")
+	if len(parts) != 2 {
+		return 0, fmt.Errorf("bad time %q", str)
+	}
+	hour, err1 := strconv.Atoi(parts[0])
+	min, err2 := strconv.Atoi(parts[1])
+	if err1 != nil || err2 != nil || hour < 0 || hour > 24 || min < 0 || min > 59 || hour == 24 && min != 0 {
+		return 0, fmt.Errorf("bad time %q", str)
+	}
+	return hour*60 + min, nil
+}
+
+func (w *maintenanceWindow) contains(now time.Time) bool {
+	now = now.In(w.loc)
+	min := now.Hour()*60 + now.Minute()
+	if w.start < w.end {
+		return w.days[now.Weekday()] && min >= w.start && min < w.end
+	}
+	// The window spans midnight.
+	if min >= w.start {
+		return w.days[now.Weekday()]
+	}
+	return min < w.end && w.days[(now.Weekday()+6)%7]
+}
+
+// maintenanceAllowed returns true if disruptive updates (kernel rebuilds, manager
+// and syz-ci restarts) are allowed at the given time.
+// If no maintenance windows are configured, updates are allowed at any time.
+func (cfg *Config) maintenanceAllowed(now time.Time) bool {
+	if len(cfg.maintenanceWindows) == 0 {
+		return true
+	}
+	for _, w := range cfg.maintenanceWindows {
+		if w.contains(now) {
+			return true
+		}
+	}
+	return false
+}
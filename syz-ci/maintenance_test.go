@@ -0,0 +1,84 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMaintenanceWindow(t *testing.T) {
+	for _, str := range []string{
+		"",
+		"mon",
+		"foo 01:00-02:00",
+		"mon-fri-sat 01:00-02:00",
+		"mon 01:00",
+		"mon 01:00-01:00",
+		"mon 25:00-26:00",
+		"mon 01:60-02:00",
+		"mon 01:00-02:00 Mars/Olympus",
+		"mon 01:00-02:00 UTC extra",
+	} {
+		if _, err := parseMaintenanceWindow(str); err == nil {
+			t.Errorf("window %q is accepted", str)
+		}
+	}
+	// 2018-06-04 is Monday.
+	at := func(day, hour, min int) time.Time {
+		return time.Date(2018, time.June, 3+day, hour, min, 0, 0, time.UTC)
+	}
+	type test struct {
+		now    time.Time
+		inside bool
+	}
+	tests := []struct {
+		window string
+		tests  []test
+	}{
+		{"* 02:00-04:00", []test{
+			{at(1, 1, 59), false},
+			{at(1, 2, 0), true},
+			{at(3, 3, 59), true},
+			{at(0, 4, 0), false},
+		}},
+		{"mon-wed,sat 02:00-04:00", []test{
+			{at(1, 3, 0), true},
+			{at(3, 3, 0), true},
+			{at(4, 3, 0), false},
+			{at(6, 3, 0), true},
+			{at(0, 3, 0), false},
+		}},
+		{"fri-mon 22:00-02:00", []test{
+			{at(5, 21, 59), false},
+			{at(5, 22, 0), true},
+			{at(6, 1, 0), true},
+			{at(1, 23, 0), true},
+			{at(2, 1, 59), true},
+			{at(2, 2, 0), false},
+			{at(2, 23, 0), false},
+			{at(5, 1, 0), false},
+		}},
+		{"mon 02:00-04:00 Asia/Tokyo", []test{
+			{at(0, 17, 0), true}, // Monday 02:00 in Tokyo
+			{at(1, 3, 0), false},
+		}},
+	}
+	for _, test := range tests {
+		w, err := parseMaintenanceWindow(test.window)
+		if err != nil {
+			t.Errorf("failed to parse %q: %v", test.window, err)
+			continue
+		}
+		for _, tt := range test.tests {
+			if got := w.contains(tt.now); got != tt.inside {
+				t.Errorf("window %q: contains(%v)=%v, want %v", test.window, tt.now, got, tt.inside)
+			}
+		}
+	}
+	cfg := new(Config)
+	if !cfg.maintenanceAllowed(time.Now()) {
+		t.Errorf("maintenance is not allowed without windows")
+	}
+}
@@ -4,6 +4,7 @@
 package main
 
 import (
+	"bytes"
 	"fmt"
 	"io/ioutil"
 	"os"
@@ -27,7 +28,15 @@ import (
 // during that period (or around that period), we can rebuild kernel, restart
 // manager and then instantly shutdown everything for syzkaller update.
 // Instead we rebuild syzkaller, restart and then rebuild kernel.
-const kernelRebuildPeriod = syzkallerRebuildPeriod + time.Hour
+var kernelRebuildPeriod = syzkallerRebuildPeriod + time.Hour
+
+// A new kernel build does not restart a running manager until it runs for this long
+// (min_manager_uptime config parameter). This gives managers time to make progress
+// when builds are frequent.
+var minManagerUptime time.Duration
+
+// buildImage builds kernel and image for a manager, it's replaced with a fake in tests.
+var buildImage = build.Image
 
 // List of required files in kernel build (contents of latest/current dirs).
 var imageFiles = map[string]bool{
@@ -36,6 +45,9 @@ var imageFiles = map[string]bool{
 	"image":          true,  // kernel image
 	"kernel":         false,
 	"initrd":         false,
+	"dtb":            false, // device tree blob
+	"firmware":       false, // firmware/bootloader to boot with (e.g. UEFI firmware)
+	"modules.tar":    false, // kernel modules
 	"key":            false, // root ssh key for the image
 	"obj/vmlinux":    false, // Linux object file with debug info
 	"obj/zircon.elf": false, // Zircon object file with debug info
@@ -55,14 +67,22 @@ type Manager struct {
 	compilerID      string
 	syzkallerCommit string
 	configTag       string
-	configData      []byte
+	configData      []byte // config of the next kernel build, normalConfig or deepDiveConfig
+	normalConfig    []byte
+	deepDiveConfig  []byte // nil if deep dives are not configured, see DeepDiveConfig
+	deepDive        bool   // configData is deepDiveConfig
+	baselineConfig  []byte // used for config minimization jobs, see ManagerConfig.KernelBaselineConfig
 	cfg             *Config
 	repo            vcs.Repo
 	mgrcfg          *ManagerConfig
 	managercfg      *mgrconfig.Config
-	cmd             *ManagerCmd
+	cmd             managerRunner
 	dash            *dashapi.Dashboard
 	stop            chan struct{}
+	status          managerStatus // reported in heartbeats
+	wake            chan struct{} // wakes up the loop after pause/resume
+	cache           *buildCache   // nil if build caching is disabled
+	canary          *canary       // canary manager testing an update, if any (see CanaryConfig)
 }
 
 func createManager(cfg *Config, mgrcfg *ManagerConfig, stop chan struct{}) *Manager {
@@ -79,14 +99,31 @@ func createManager(cfg *Config, mgrcfg *ManagerConfig, stop chan struct{}) *Mana
 		dash = dashapi.New(mgrcfg.DashboardClient, cfg.DashboardAddr, mgrcfg.DashboardKey)
 	}
 
+	if mgrcfg.CompilerArchive != "" {
+		compilerDir := filepath.Join(dir, "compiler")
+		if err := fetchCompiler(mgrcfg.CompilerArchive, compilerDir); err != nil {
+			log.Fatalf("failed to fetch compiler for %v: %v", mgrcfg.Name, err)
+		}
+		mgrcfg.Compiler = filepath.Join(compilerDir, filepath.FromSlash(mgrcfg.Compiler))
+	}
 	// Assume compiler and config don't change underneath us.
 	compilerID, err := build.CompilerIdentity(mgrcfg.Compiler)
 	if err != nil {
 		log.Fatal(err)
 	}
-	var configData []byte
-	if mgrcfg.KernelConfig != "" {
-		if configData, err = ioutil.ReadFile(mgrcfg.KernelConfig); err != nil {
+	configData, err := loadKernelConfig(mgrcfg.KernelConfig, mgrcfg)
+	if err != nil {
+		log.Fatal(err)
+	}
+	var deepDiveConfig []byte
+	if mgrcfg.DeepDive != nil {
+		if deepDiveConfig, err = loadDeepDiveConfig(mgrcfg.DeepDive, configData); err != nil {
+			log.Fatal(err)
+		}
+	}
+	var baselineConfig []byte
+	if mgrcfg.KernelBaselineConfig != "" {
+		if baselineConfig, err = loadKernelConfig(mgrcfg.KernelBaselineConfig, mgrcfg); err != nil {
 			log.Fatal(err)
 		}
 	}
@@ -95,13 +132,14 @@ func createManager(cfg *Config, mgrcfg *ManagerConfig, stop chan struct{}) *Mana
 		log.Fatalf("no tag in syzkaller/current/tag")
 	}
 
-	// Prepare manager config skeleton (other fields are filled in writeConfig).
+	// Prepare manager config skeleton (other fields are filled in createConfig).
 	managercfg, err := mgrconfig.LoadPartialData(mgrcfg.ManagerConfig)
 	if err != nil {
 		log.Fatalf("failed to load manager %v config: %v", mgrcfg.Name, err)
 	}
 	managercfg.Name = cfg.Name + "-" + mgrcfg.Name
 	managercfg.Syzkaller = filepath.FromSlash("syzkaller/current")
+	managercfg.LSM = mgrcfg.LSM
 
 	kernelDir := filepath.Join(dir, "kernel")
 	repo, err := vcs.NewRepo(managercfg.TargetOS, managercfg.Type, kernelDir)
@@ -119,17 +157,61 @@ func createManager(cfg *Config, mgrcfg *ManagerConfig, stop chan struct{}) *Mana
 		syzkallerCommit: syzkallerCommit,
 		configTag:       hash.String(configData),
 		configData:      configData,
+		normalConfig:    configData,
+		deepDiveConfig:  deepDiveConfig,
+		baselineConfig:  baselineConfig,
 		cfg:             cfg,
 		repo:            repo,
 		mgrcfg:          mgrcfg,
 		managercfg:      managercfg,
 		dash:            dash,
 		stop:            stop,
+		wake:            make(chan struct{}, 1),
+	}
+	if cfg.BuildCacheSize != 0 {
+		if mgr.cache, err = newBuildCache(filepath.Join(dir, "cache"), cfg.BuildCacheSize,
+			cfg.BuildCacheGCSPath); err != nil {
+			log.Fatal(err)
+		}
+	}
+	if mgr.switchDeepDive(time.Now()) {
+		log.Logf(0, "%v: deep dive run is scheduled, using deep dive kernel config", mgr.name)
 	}
 	os.RemoveAll(mgr.currentDir)
 	return mgr
 }
 
+// loadKernelConfig reads kernel config file (if file is not empty)
+// and enables lockdown, sanitizers, root storage and LSM options on top of it according to mgrcfg.
+func loadKernelConfig(file string, mgrcfg *ManagerConfig) ([]byte, error) {
+	var data []byte
+	if file != "" {
+		var err error
+		if data, err = ioutil.ReadFile(file); err != nil {
+			return nil, err
+		}
+	}
+	if mgrcfg.KernelLockdown {
+		data = build.LockdownConfig(data)
+	}
+	if len(mgrcfg.Sanitizers) != 0 {
+		var err error
+		if data, err = build.SanitizerConfig(data, mgrcfg.Sanitizers); err != nil {
+			return nil, err
+		}
+	}
+	if mgrcfg.RootStorage != build.RootStoragePlain {
+		var err error
+		if data, err = build.RootStorageConfig(data, mgrcfg.RootStorage); err != nil {
+			return nil, err
+		}
+	}
+	if mgrcfg.LSM != build.LSMNone {
+		return build.LSMConfig(data, mgrcfg.LSM)
+	}
+	return data, nil
+}
+
 // Gates kernel builds.
 // Kernel builds take whole machine, so we don't run more than one at a time.
 // Also current image build script uses some global resources (/dev/nbd0) and can't run in parallel.
@@ -139,6 +221,15 @@ func (mgr *Manager) loop() {
 	lastCommit := ""
 	nextBuildTime := time.Now()
 	var managerRestartTime time.Time
+	var lastBuildDuration time.Duration // used to estimate build progress
+	var lastCorpusUpload time.Time
+	pollFailures, buildFailures := 0, 0 // consecutive failures, used for error backoff
+	if mgr.cfg.CorpusGCSPath != "" {
+		if err := mgr.restoreCorpus(); err != nil {
+			mgr.Errorf("failed to restore corpus: %v", err)
+		}
+		lastCorpusUpload = time.Now()
+	}
 	latestInfo := mgr.checkLatest()
 	if latestInfo != nil && time.Since(latestInfo.Time) < kernelRebuildPeriod/2 {
 		// If we have a reasonably fresh build,
@@ -147,6 +238,16 @@ func (mgr *Manager) loop() {
 		managerRestartTime = latestInfo.Time
 		nextBuildTime = time.Now().Add(kernelRebuildPeriod)
 		mgr.restartManager()
+	} else if latestInfo != nil && latestInfo.CompilerID == mgr.compilerID &&
+		latestInfo.KernelConfigTag == mgr.configTag {
+		// The build is old, but was done with the current compiler and config
+		// (e.g. syz-ci was restarted to update syzkaller binaries). Start manager on it
+		// straight away and rebuild kernel in background, then restart the manager
+		// on the new build. This avoids fuzzing downtime for the duration of the kernel build.
+		log.Logf(0, "%v: using latest image built on %v until a new one is built",
+			mgr.name, latestInfo.KernelCommit)
+		managerRestartTime = latestInfo.Time
+		mgr.restartManager()
 	} else if latestInfo != nil {
 		log.Logf(0, "%v: latest image is on %v", mgr.name, latestInfo.KernelCommit)
 	}
@@ -156,26 +257,72 @@ func (mgr *Manager) loop() {
 
 loop:
 	for {
-		if time.Since(nextBuildTime) >= 0 {
-			rebuildAfter := buildRetryPeriod
+		if mgr.switchDeepDive(time.Now()) {
+			// Rebuild the kernel with the new config straight away (or as soon as maintenance
+			// windows allow), the manager is restarted on the new build with the same corpus.
+			mode := "normal"
+			if mgr.deepDive {
+				mode = "deep dive"
+			}
+			log.Logf(0, "%v: switching to %v kernel config", mgr.name, mode)
+			lastCommit = ""
+			nextBuildTime = time.Now()
+		}
+		paused := mgr.paused()
+		// Outside of maintenance windows a running manager is not disrupted by rebuilds and restarts.
+		deferred := mgr.cmd != nil && !mgr.cfg.maintenanceAllowed(time.Now())
+		// A manager that was started recently is not restarted on new builds (see minManagerUptime).
+		young := false
+		if mgr.cmd != nil {
+			upTime := mgr.cmd.UpTime()
+			young = upTime != 0 && upTime < minManagerUptime
+		}
+		if !paused && !deferred && time.Since(nextBuildTime) >= 0 {
+			var rebuildAfter time.Duration
+			mgr.setPhase(phasePolling, 0)
+			pollStart := time.Now()
 			commit, err := mgr.repo.Poll(mgr.mgrcfg.Repo, mgr.mgrcfg.Branch)
+			ciMetrics.pollFinished(pollStart, err, "action", "kernel", "manager", mgr.name)
 			if err != nil {
 				mgr.Errorf("failed to poll: %v", err)
+				pollFailures++
 			} else {
+				pollFailures = 0
 				log.Logf(0, "%v: poll: %v", mgr.name, commit.Hash)
+				mgr.updateStatus(func(status *dashapi.CIManagerStatus) {
+					status.LastKernelPoll = time.Now()
+				})
 				if commit.Hash != lastCommit &&
 					(latestInfo == nil ||
 						commit.Hash != latestInfo.KernelCommit ||
 						mgr.compilerID != latestInfo.CompilerID ||
 						mgr.configTag != latestInfo.KernelConfigTag) {
 					lastCommit = commit.Hash
+					mgr.setPhase(phaseQueued, 0)
 					select {
 					case kernelBuildSem <- struct{}{}:
 						log.Logf(0, "%v: building kernel...", mgr.name)
-						if err := mgr.build(commit); err != nil {
+						mgr.setPhase(phaseBuilding, lastBuildDuration)
+						buildStart := time.Now()
+						err := mgr.build(commit)
+						ciMetrics.add("syzci_kernel_builds_total", 1, "manager", mgr.name)
+						ciMetrics.observe("syzci_kernel_build_duration_seconds", time.Since(buildStart),
+							"manager", mgr.name)
+						if err != nil {
+							ciMetrics.add("syzci_kernel_build_failures_total", 1, "manager", mgr.name)
+							buildFailures++
 							log.Logf(0, "%v: %v", mgr.name, err)
+							mgr.updateStatus(func(status *dashapi.CIManagerStatus) {
+								status.LastBuildError = err.Error()
+								status.LastBuildFailure = time.Now()
+							})
 						} else {
+							mgr.updateStatus(func(status *dashapi.CIManagerStatus) {
+								status.LastBuildError = ""
+							})
 							log.Logf(0, "%v: build successful, [re]starting manager", mgr.name)
+							lastBuildDuration = time.Since(buildStart)
+							buildFailures = 0
 							rebuildAfter = kernelRebuildPeriod
 							latestInfo = mgr.checkLatest()
 							if latestInfo == nil {
@@ -188,6 +335,9 @@ loop:
 					}
 				}
 			}
+			if rebuildAfter == 0 {
+				rebuildAfter = retryPeriod(pollFailures + buildFailures)
+			}
 			nextBuildTime = time.Now().Add(rebuildAfter)
 		}
 
@@ -197,22 +347,61 @@ loop:
 		default:
 		}
 
-		if latestInfo != nil && (latestInfo.Time != managerRestartTime || mgr.cmd == nil) {
-			managerRestartTime = latestInfo.Time
-			mgr.restartManager()
+		if paused {
+			if mgr.cmd != nil {
+				log.Logf(0, "%v: paused, stopping manager", mgr.name)
+				mgr.stopCanary()
+				mgr.cmd.Close()
+				mgr.cmd = nil
+			}
+		} else if latestInfo != nil && (latestInfo.Time != managerRestartTime || mgr.cmd == nil) &&
+			!deferred && !young {
+			if mgr.restartManager() {
+				managerRestartTime = latestInfo.Time
+			}
+		}
+		if mgr.cfg.CorpusGCSPath != "" && mgr.cmd != nil && time.Since(lastCorpusUpload) > corpusUploadPeriod {
+			lastCorpusUpload = time.Now()
+			if err := mgr.uploadCorpus(); err != nil {
+				mgr.Errorf("failed to upload corpus: %v", err)
+			}
+		}
+		switch {
+		case paused:
+			mgr.setPhase(phasePaused, 0)
+		case deferred && latestInfo != nil && latestInfo.Time != managerRestartTime:
+			mgr.setPhase(phaseDeferred, 0)
+		case young && latestInfo != nil && latestInfo.Time != managerRestartTime:
+			mgr.setPhase(phaseUptime, 0)
+		case mgr.pendingUpdate() != nil:
+			mgr.setPhase(phaseConfirming, 0)
+		case mgr.canary != nil:
+			mgr.setPhase(phaseCanary, time.Duration(mgr.mgrcfg.Canary.Period)*time.Minute)
+		case mgr.cmd != nil:
+			mgr.setPhase(phaseRunning, 0)
+		default:
+			mgr.setPhase(phaseWaiting, 0)
 		}
 
 		select {
 		case <-ticker.C:
+		case <-mgr.wake:
 		case <-mgr.stop:
 			break loop
 		}
 	}
 
+	mgr.stopCanary()
 	if mgr.cmd != nil {
 		mgr.cmd.Close()
 		mgr.cmd = nil
+		if mgr.cfg.CorpusGCSPath != "" {
+			if err := mgr.uploadCorpus(); err != nil {
+				mgr.Errorf("failed to upload corpus: %v", err)
+			}
+		}
 	}
+	mgr.setPhase(phaseStopped, 0)
 	log.Logf(0, "%v: stopped", mgr.name)
 }
 
@@ -248,11 +437,16 @@ func (mgr *Manager) checkLatest() *BuildInfo {
 }
 
 func (mgr *Manager) build(kernelCommit *vcs.Commit) error {
+	patches, patchesTag, err := mgr.fetchPatches()
+	if err != nil {
+		return err
+	}
 	var tagData []byte
 	tagData = append(tagData, mgr.name...)
 	tagData = append(tagData, kernelCommit.Hash...)
 	tagData = append(tagData, mgr.compilerID...)
 	tagData = append(tagData, mgr.configTag...)
+	tagData = append(tagData, patchesTag...)
 	info := &BuildInfo{
 		Time:              time.Now(),
 		Tag:               hash.String(tagData),
@@ -276,25 +470,84 @@ func (mgr *Manager) build(kernelCommit *vcs.Commit) error {
 	if err := config.SaveFile(filepath.Join(tmpDir, "tag"), info); err != nil {
 		return fmt.Errorf("failed to write tag file: %v", err)
 	}
-	if err := build.Image(mgr.managercfg.TargetOS, mgr.managercfg.TargetVMArch, mgr.managercfg.Type,
-		mgr.kernelDir, tmpDir, mgr.mgrcfg.Compiler, mgr.mgrcfg.Userspace,
-		mgr.mgrcfg.KernelCmdline, mgr.mgrcfg.KernelSysctl, mgr.configData); err != nil {
-		if _, ok := err.(build.KernelBuildError); ok {
+	if mgr.cache != nil {
+		cached, err := mgr.cache.get(info.Tag, tmpDir)
+		if err != nil {
+			mgr.Errorf("failed to get build from cache: %v", err)
+		}
+		if cached {
+			log.Logf(0, "%v: using cached build of %v", mgr.name, kernelCommit.Hash)
+			// The cached tag file may be hard linked into the cache, so we don't overwrite it in place.
+			tagFile := filepath.Join(tmpDir, "tag")
+			if err := os.Remove(tagFile); err != nil {
+				return fmt.Errorf("failed to remove tag file: %v", err)
+			}
+			if err := config.SaveFile(tagFile, info); err != nil {
+				return fmt.Errorf("failed to write tag file: %v", err)
+			}
+			return mgr.replaceLatest(tmpDir)
+		}
+	}
+	if err := mgr.applyPatches(patches); err != nil {
+		return err
+	}
+	if len(patches) != 0 {
+		log.Logf(0, "%v: applied %v patches", mgr.name, len(patches))
+	}
+	if err := buildImage(&build.Params{
+		TargetOS:     mgr.managercfg.TargetOS,
+		TargetArch:   mgr.managercfg.TargetVMArch,
+		VMType:       mgr.managercfg.Type,
+		KernelDir:    mgr.kernelDir,
+		OutputDir:    tmpDir,
+		Compiler:     mgr.mgrcfg.Compiler,
+		UserspaceDir: mgr.mgrcfg.Userspace,
+		ImageBackend: mgr.mgrcfg.ImageBackend,
+		RootStorage:  mgr.mgrcfg.RootStorage,
+		LSM:          mgr.mgrcfg.LSM,
+		CmdlineFile:  mgr.mgrcfg.KernelCmdline,
+		SysctlFile:   mgr.mgrcfg.KernelSysctl,
+		Config:       mgr.configData,
+	}); err != nil {
+		if kernelErr, ok := err.(build.KernelBuildError); ok {
 			rep := &report.Report{
 				Title:  fmt.Sprintf("%v build error", mgr.mgrcfg.RepoAlias),
+				Report: kernelErr.Excerpt(),
 				Output: []byte(err.Error()),
 			}
+			// The build did not get to saving the config, but it's needed to reproduce the error.
+			if configFile := filepath.Join(tmpDir, "kernel.config"); !osutil.IsExist(configFile) {
+				if err := osutil.WriteFile(configFile, mgr.configData); err != nil {
+					mgr.Errorf("failed to write kernel config: %v", err)
+				}
+			}
 			if err := mgr.reportBuildError(rep, info, tmpDir); err != nil {
 				mgr.Errorf("failed to report image error: %v", err)
 			}
+			if mgr.cfg.Notifications != nil {
+				subject := fmt.Sprintf("syz-ci %v: %v on %v %v", mgr.cfg.Name, rep.Title,
+					mgr.mgrcfg.Branch, kernelCommit.Hash)
+				if err := mgr.cfg.Notifications.notify(subject, rep.Output); err != nil {
+					mgr.Errorf("failed to notify about build error: %v", err)
+				}
+			}
 		}
 		return fmt.Errorf("kernel build failed: %v", err)
 	}
 
+	mgr.setPhase(phaseTesting, 0)
 	if err := mgr.testImage(tmpDir, info); err != nil {
 		return err
 	}
+	if mgr.cache != nil {
+		if err := mgr.cache.put(info.Tag, tmpDir); err != nil {
+			mgr.Errorf("failed to cache build: %v", err)
+		}
+	}
+	return mgr.replaceLatest(tmpDir)
+}
 
+func (mgr *Manager) replaceLatest(tmpDir string) error {
 	// Now try to replace latest with our tmp dir as atomically as we can get on Linux.
 	if err := os.RemoveAll(mgr.latestDir); err != nil {
 		return fmt.Errorf("failed to remove latest dir: %v", err)
@@ -302,10 +555,29 @@ func (mgr *Manager) build(kernelCommit *vcs.Commit) error {
 	return os.Rename(tmpDir, mgr.latestDir)
 }
 
-func (mgr *Manager) restartManager() {
+// restartManager restarts the manager on the latest build. Returns false if the restart
+// is postponed until the update is confirmed (see managerUpdate).
+func (mgr *Manager) restartManager() bool {
 	if !osutil.FilesExist(mgr.latestDir, imageFiles) {
 		mgr.Errorf("can't start manager, image files missing")
-		return
+		return true
+	}
+	update, err := mgr.prepareUpdate()
+	if err != nil {
+		mgr.Errorf("%v", err)
+		return true
+	}
+	if !mgr.confirmUpdate(update) {
+		return false
+	}
+	if mgr.cmd != nil && mgr.mgrcfg.Canary != nil {
+		switch mgr.testUpdate(update) {
+		case canaryRunning:
+			return false
+		case canaryFailed:
+			// Keep the old manager running until the next build.
+			return true
+		}
 	}
 	if mgr.cmd != nil {
 		mgr.cmd.Close()
@@ -313,26 +585,26 @@ func (mgr *Manager) restartManager() {
 	}
 	if err := osutil.LinkFiles(mgr.latestDir, mgr.currentDir, imageFiles); err != nil {
 		mgr.Errorf("failed to create current image dir: %v", err)
-		return
-	}
-	info, err := loadBuildInfo(mgr.currentDir)
-	if err != nil {
-		mgr.Errorf("failed to load build info: %v", err)
-		return
-	}
-	buildTag, err := mgr.uploadBuild(info, mgr.currentDir)
-	if err != nil {
-		mgr.Errorf("failed to upload build: %v", err)
-		return
-	}
-	cfgFile, err := mgr.writeConfig(buildTag)
-	if err != nil {
-		mgr.Errorf("failed to create manager config: %v", err)
-		return
-	}
-	bin := filepath.FromSlash("syzkaller/current/bin/syz-manager")
-	logFile := filepath.Join(mgr.currentDir, "manager.log")
-	mgr.cmd = NewManagerCmd(mgr.name, logFile, mgr.Errorf, bin, "-config", cfgFile)
+		return true
+	}
+	cfgFile := filepath.Join(mgr.currentDir, "manager.cfg")
+	if err := osutil.WriteFile(cfgFile, update.config); err != nil {
+		mgr.Errorf("failed to write manager config: %v", err)
+		return true
+	}
+	if mgr.mgrcfg.InProcess {
+		mgr.cmd = NewManagerInProcess(mgr.name, cfgFile, mgr.Errorf)
+	} else {
+		bin := filepath.FromSlash("syzkaller/current/bin/syz-manager")
+		logFile := filepath.Join(mgr.currentDir, "manager.log")
+		mgr.cmd = NewManagerCmd(mgr.name, logFile, mgr.Errorf, bin, "-config", cfgFile)
+	}
+	mgr.updateStatus(func(status *dashapi.CIManagerStatus) {
+		status.KernelCommit = update.info.KernelCommit
+		status.KernelBuildTime = update.info.Time
+	})
+	mgr.setStatusCmd(mgr.cmd)
+	return true
 }
 
 func (mgr *Manager) testImage(imageDir string, info *BuildInfo) error {
@@ -396,7 +668,19 @@ func (mgr *Manager) testImage(imageDir string, info *BuildInfo) error {
 	return nil
 }
 
+// maxReportCommits limits the number of commits since the last successful build in build error reports.
+const maxReportCommits = 100
+
+// reportBuildError reports kernel build/boot/test error to the dashboard.
+// The report is amended with the list of commits since the last successful build.
 func (mgr *Manager) reportBuildError(rep *report.Report, info *BuildInfo, imageDir string) error {
+	if commits := mgr.commitsSinceLastBuild(info); len(commits) != 0 {
+		var text []byte
+		if len(rep.Report) != 0 {
+			text = append(append(text, rep.Report...), '\n')
+		}
+		rep.Report = append(text, commits...)
+	}
 	if mgr.dash == nil {
 		log.Logf(0, "%v: image testing failed: %v\n\n%s\n\n%s\n",
 			mgr.name, rep.Title, rep.Report, rep.Output)
@@ -410,6 +694,7 @@ func (mgr *Manager) reportBuildError(rep *report.Report, info *BuildInfo, imageD
 		Build: *build,
 		Crash: dashapi.Crash{
 			Title:       rep.Title,
+			AltTitles:   rep.AltTitles,
 			Corrupted:   false, // Otherwise they get merged with other corrupted reports.
 			Maintainers: rep.Maintainers,
 			Log:         rep.Output,
@@ -419,6 +704,33 @@ func (mgr *Manager) reportBuildError(rep *report.Report, info *BuildInfo, imageD
 	return mgr.dash.ReportBuildError(req)
 }
 
+// commitsSinceLastBuild describes kernel commits between the last successful build and the given build.
+func (mgr *Manager) commitsSinceLastBuild(info *BuildInfo) []byte {
+	latest := mgr.checkLatest()
+	if latest == nil || latest.KernelCommit == "" || latest.KernelCommit == info.KernelCommit {
+		return nil
+	}
+	commits, err := mgr.repo.ListCommitRange(latest.KernelCommit, info.KernelCommit, maxReportCommits+1)
+	if err != nil {
+		log.Logf(0, "%v: failed to list commits since the last build: %v", mgr.name, err)
+		return nil
+	}
+	if len(commits) == 0 {
+		return nil
+	}
+	buf := new(bytes.Buffer)
+	fmt.Fprintf(buf, "Commits since the last successful build on %v (%v):\n",
+		latest.KernelCommit, latest.KernelCommitTitle)
+	for i, commit := range commits {
+		if i == maxReportCommits {
+			fmt.Fprintf(buf, "...\n")
+			break
+		}
+		fmt.Fprintf(buf, "%.12v %v\n", commit.Hash, commit.Title)
+	}
+	return buf.Bytes()
+}
+
 func (mgr *Manager) createTestConfig(imageDir string, info *BuildInfo) (*mgrconfig.Config, error) {
 	mgrcfg := new(mgrconfig.Config)
 	*mgrcfg = *mgr.managercfg
@@ -435,7 +747,17 @@ func (mgr *Manager) createTestConfig(imageDir string, info *BuildInfo) (*mgrconf
 	return mgrcfg, nil
 }
 
-func (mgr *Manager) writeConfig(buildTag string) (string, error) {
+// createConfig returns the manager config for the build in currentDir.
+func (mgr *Manager) createConfig(buildTag string) ([]byte, error) {
+	mgrcfg, err := mgr.makeConfig(buildTag, mgr.currentDir, mgr.workDir)
+	if err != nil {
+		return nil, err
+	}
+	return config.SaveData(mgrcfg)
+}
+
+// makeConfig creates manager config for the image in imageDir and the given workdir.
+func (mgr *Manager) makeConfig(buildTag, imageDir, workDir string) (*mgrconfig.Config, error) {
 	mgrcfg := new(mgrconfig.Config)
 	*mgrcfg = *mgr.managercfg
 
@@ -450,22 +772,18 @@ func (mgr *Manager) writeConfig(buildTag string) (string, error) {
 		mgrcfg.HubKey = mgr.cfg.HubKey
 	}
 	mgrcfg.Tag = buildTag
-	mgrcfg.Workdir = mgr.workDir
-	if err := instance.SetConfigImage(mgrcfg, mgr.currentDir); err != nil {
-		return "", err
+	mgrcfg.Workdir = workDir
+	if err := instance.SetConfigImage(mgrcfg, imageDir); err != nil {
+		return nil, err
 	}
 	// Strictly saying this is somewhat racy as builder can concurrently
 	// update the source, or even delete and re-clone. If this causes
 	// problems, we need to make a copy of sources after build.
 	mgrcfg.KernelSrc = mgr.kernelDir
 	if err := mgrconfig.Complete(mgrcfg); err != nil {
-		return "", fmt.Errorf("bad manager config: %v", err)
-	}
-	configFile := filepath.Join(mgr.currentDir, "manager.cfg")
-	if err := config.SaveFile(configFile, mgrcfg); err != nil {
-		return "", err
+		return nil, fmt.Errorf("bad manager config: %v", err)
 	}
-	return configFile, nil
+	return mgrcfg, nil
 }
 
 func (mgr *Manager) uploadBuild(info *BuildInfo, imageDir string) (string, error) {
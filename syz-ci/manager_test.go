@@ -0,0 +1,130 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/google/syzkaller/dashboard/dashapi"
+	"github.com/google/syzkaller/pkg/build"
+	"github.com/google/syzkaller/pkg/config"
+	"github.com/google/syzkaller/pkg/osutil"
+	"github.com/google/syzkaller/pkg/vcs"
+)
+
+// TestStartOnOldBuild checks that after restart (e.g. for syzkaller update) manager is started
+// on an old build right away and keeps running while a new kernel is built.
+func TestStartOnOldBuild(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skipf("needs /proc")
+	}
+	if reexecInTempDir(t, "TestStartOnOldBuild") {
+		return
+	}
+	setupChaosSyzkaller(t)
+
+	buildStarted := make(chan struct{})
+	buildDone := make(chan struct{})
+	defer func(retry time.Duration, image func(*build.Params) error) {
+		buildRetryPeriod = retry
+		buildImage = image
+	}(buildRetryPeriod, buildImage)
+	buildRetryPeriod = 10 * time.Millisecond
+	buildImage = func(params *build.Params) error {
+		close(buildStarted)
+		<-buildDone
+		return osutil.WriteFile(filepath.Join(params.OutputDir, "image"), []byte("new"))
+	}
+
+	cfg := &Config{Name: "test"}
+	mgrcfg := &ManagerConfig{
+		Name: "mgr",
+		Repo: "git://test/linux.git",
+		ManagerConfig: []byte(`{"target": "linux/amd64", "http": ":10000",
+			"type": "isolated", "procs": 1, "sandbox": "none"}`),
+	}
+	cfg.Managers = append(cfg.Managers, mgrcfg)
+	stop := make(chan struct{})
+	mgr := createManager(cfg, mgrcfg, stop)
+	mgr.repo = &newCommitRepo{}
+	mgr.dash = dashapi.NewCustom(mgrcfg.Name, "http://dashboard.test", "key", http.NewRequest,
+		func(req *http.Request) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       ioutil.NopCloser(bytes.NewReader([]byte("{}"))),
+			}, nil
+		}, nil, nil)
+
+	// The old build was done a day ago with the same compiler and config.
+	info := &BuildInfo{
+		Time:            time.Now().Add(-24 * time.Hour),
+		Tag:             "old",
+		CompilerID:      mgr.compilerID,
+		KernelCommit:    "old-commit",
+		KernelConfigTag: mgr.configTag,
+	}
+	if err := osutil.MkdirAll(mgr.latestDir); err != nil {
+		t.Fatal(err)
+	}
+	if err := config.SaveFile(filepath.Join(mgr.latestDir, "tag"), info); err != nil {
+		t.Fatal(err)
+	}
+	if err := osutil.WriteFile(filepath.Join(mgr.latestDir, "image"), []byte("old")); err != nil {
+		t.Fatal(err)
+	}
+
+	stopped := make(chan struct{})
+	go func() {
+		mgr.loop()
+		close(stopped)
+	}()
+	defer func() {
+		select {
+		case <-buildDone:
+		default:
+			close(buildDone)
+		}
+		close(stop)
+		<-stopped
+		if procs := runningChaosManagers(t); len(procs) != 0 {
+			t.Errorf("leaked syz-manager processes: %v", procs)
+		}
+	}()
+	select {
+	case <-buildStarted:
+	case <-time.After(time.Minute):
+		t.Fatalf("kernel build did not start")
+	}
+	waitForStarts(t, 1)
+	if len(runningChaosManagers(t)) != 1 {
+		t.Fatalf("manager is not running during kernel build")
+	}
+	close(buildDone)
+	// The manager is restarted on the new build.
+	waitForStarts(t, 2)
+}
+
+func waitForStarts(t *testing.T, starts int) {
+	for i := 0; countChaosStarts(t) < starts; i++ {
+		if i == 1000 {
+			t.Fatalf("manager was not started %v times", starts)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// newCommitRepo is a kernel repo that always has a new commit.
+type newCommitRepo struct {
+	vcs.Repo
+}
+
+func (repo *newCommitRepo) Poll(repoURL, branch string) (*vcs.Commit, error) {
+	return &vcs.Commit{Hash: "new-commit", Title: "new commit", Date: time.Now()}, nil
+}
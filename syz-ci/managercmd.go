@@ -6,6 +6,7 @@ package main
 import (
 	"os"
 	"os/exec"
+	"sync"
 	"syscall"
 	"time"
 
@@ -23,8 +24,17 @@ type ManagerCmd struct {
 	bin     string
 	args    []string
 	closing chan bool
+
+	mu      sync.Mutex
+	running time.Time // when the current process was started, zero if it is not running
 }
 
+// These are vars to allow shorter periods in tests.
+var (
+	managerRestartPeriod    = 10 * time.Minute // don't restart crashing manager more frequently than that
+	managerInterruptTimeout = time.Minute      // give manager that much time to react to SIGINT
+)
+
 type Errorf func(msg string, args ...interface{})
 
 // NewManagerCmd starts new syz-manager process.
@@ -44,6 +54,22 @@ func NewManagerCmd(name, log string, errorf Errorf, bin string, args ...string)
 	return mc
 }
 
+// UpTime returns uptime of the manager process, 0 if it is not running.
+func (mc *ManagerCmd) UpTime() time.Duration {
+	mc.mu.Lock()
+	defer mc.mu.Unlock()
+	if mc.running.IsZero() {
+		return 0
+	}
+	return time.Since(mc.running)
+}
+
+func (mc *ManagerCmd) setRunning(running time.Time) {
+	mc.mu.Lock()
+	mc.running = running
+	mc.mu.Unlock()
+}
+
 // Close gracefully shutdowns the process and waits for its termination.
 func (mc *ManagerCmd) Close() {
 	mc.closing <- true
@@ -51,18 +77,14 @@ func (mc *ManagerCmd) Close() {
 }
 
 func (mc *ManagerCmd) loop() {
-	const (
-		restartPeriod    = 10 * time.Minute // don't restart crashing manager more frequently than that
-		interruptTimeout = time.Minute      // give manager that much time to react to SIGINT
-	)
 	var (
 		cmd         *exec.Cmd
 		started     time.Time
 		interrupted time.Time
 		stopped     = make(chan error, 1)
 		closing     = mc.closing
-		ticker1     = time.NewTicker(restartPeriod)
-		ticker2     = time.NewTicker(interruptTimeout)
+		ticker1     = time.NewTicker(managerRestartPeriod)
+		ticker2     = time.NewTicker(managerInterruptTimeout)
 	)
 	defer func() {
 		ticker1.Stop()
@@ -72,7 +94,7 @@ func (mc *ManagerCmd) loop() {
 		if cmd == nil {
 			// cmd is not running
 			// don't restart too frequently (in case it instantly exits with an error)
-			if time.Since(started) > restartPeriod {
+			if time.Since(started) > managerRestartPeriod {
 				started = time.Now()
 				os.Rename(mc.log, mc.log+".old")
 				logfile, err := os.Create(mc.log)
@@ -89,6 +111,8 @@ func (mc *ManagerCmd) loop() {
 						cmd = nil
 					} else {
 						log.Logf(1, "%v: started manager", mc.name)
+						ciMetrics.add("syzci_manager_restarts_total", 1, "manager", mc.name)
+						mc.setRunning(started)
 						go func() {
 							stopped <- cmd.Wait()
 						}()
@@ -97,7 +121,7 @@ func (mc *ManagerCmd) loop() {
 			}
 		} else {
 			// cmd is running
-			if closing == nil && time.Since(interrupted) > interruptTimeout {
+			if closing == nil && time.Since(interrupted) > managerInterruptTimeout {
 				log.Logf(1, "%v: killing manager", mc.name)
 				cmd.Process.Kill()
 				interrupted = time.Now()
@@ -120,6 +144,7 @@ func (mc *ManagerCmd) loop() {
 				mc.errorf("manager exited unexpectedly: %v", err)
 			}
 			cmd = nil
+			mc.setRunning(time.Time{})
 			log.Logf(1, "%v: manager exited with %v", mc.name, err)
 		case <-ticker1.C:
 		case <-ticker2.C:
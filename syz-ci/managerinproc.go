@@ -0,0 +1,193 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/syzkaller/pkg/log"
+	"github.com/google/syzkaller/prog"
+	"github.com/google/syzkaller/syz-manager/manager"
+	"github.com/google/syzkaller/syz-manager/mgrconfig"
+)
+
+// managerRunner is a running syz-manager: either a separate process (ManagerCmd),
+// or the manager package running inside of syz-ci (ManagerInProcess).
+type managerRunner interface {
+	// UpTime returns uptime of the manager, 0 if it is not running.
+	UpTime() time.Duration
+	// Close gracefully stops the manager and waits for its termination.
+	Close()
+}
+
+// ManagerInProcess runs syz-manager inside of syz-ci process (see in_process manager config parameter).
+// Unlike ManagerCmd it does not need to kill the process and wait for the ports to be released
+// to stop the manager: Close drains the manager (stops VMs, finishes reproductions)
+// and releases all resources. It also allows to snapshot corpus and reload enabled syscalls
+// of a running manager.
+// Note: the manager logs into syz-ci log, fatal errors of the manager stop only this manager
+// and it's restarted (see manager.Manager.Run).
+type ManagerInProcess struct {
+	name    string
+	errorf  Errorf
+	cfgFile string
+	closing chan bool
+
+	mu      sync.Mutex
+	mgr     *manager.Manager // nil if the manager is not running
+	running time.Time
+}
+
+// NewManagerInProcess starts new in-process manager with the given manager config file.
+// If the manager fails to start, it is retried every managerRestartPeriod.
+func NewManagerInProcess(name, cfgFile string, errorf Errorf) *ManagerInProcess {
+	mp := &ManagerInProcess{
+		name:    name,
+		errorf:  errorf,
+		cfgFile: cfgFile,
+		closing: make(chan bool),
+	}
+	go mp.loop()
+	return mp
+}
+
+func (mp *ManagerInProcess) UpTime() time.Duration {
+	mp.mu.Lock()
+	defer mp.mu.Unlock()
+	if mp.running.IsZero() {
+		return 0
+	}
+	return time.Since(mp.running)
+}
+
+func (mp *ManagerInProcess) Close() {
+	mp.closing <- true
+	<-mp.closing
+}
+
+// Snapshot saves a copy of the manager corpus into file (see manager.Manager.Snapshot).
+func (mp *ManagerInProcess) Snapshot(file string) error {
+	mp.mu.Lock()
+	mgr := mp.mgr
+	mp.mu.Unlock()
+	if mgr == nil {
+		return fmt.Errorf("manager is not running")
+	}
+	return mgr.Snapshot(file)
+}
+
+// Stats returns stats of the running manager (see manager.Manager.Stats), nil if it is not running.
+func (mp *ManagerInProcess) Stats() map[string]uint64 {
+	mp.mu.Lock()
+	mgr := mp.mgr
+	mp.mu.Unlock()
+	if mgr == nil {
+		return nil
+	}
+	return mgr.Stats()
+}
+
+// ReloadSyscalls replaces enabled syscalls of the running manager
+// (the same as enable_syscalls/disable_syscalls manager config parameters).
+// The change is not persisted, the manager uses syscalls from the config after restart.
+func (mp *ManagerInProcess) ReloadSyscalls(enabled, disabled []string) error {
+	mp.mu.Lock()
+	mgr := mp.mgr
+	mp.mu.Unlock()
+	if mgr == nil {
+		return fmt.Errorf("manager is not running")
+	}
+	_, target, err := mp.loadConfig()
+	if err != nil {
+		return err
+	}
+	syscalls, err := mgrconfig.ParseEnabledSyscalls(target, enabled, disabled)
+	if err != nil {
+		return err
+	}
+	log.Logf(0, "%v: reloading syscalls", mp.name)
+	mgr.ReloadSyscalls(syscalls)
+	return nil
+}
+
+func (mp *ManagerInProcess) loadConfig() (*mgrconfig.Config, *prog.Target, error) {
+	cfg, err := mgrconfig.LoadFile(mp.cfgFile)
+	if err != nil {
+		return nil, nil, err
+	}
+	target, err := prog.GetTarget(cfg.TargetOS, cfg.TargetArch)
+	if err != nil {
+		return nil, nil, err
+	}
+	return cfg, target, nil
+}
+
+func (mp *ManagerInProcess) start() (*manager.Manager, error) {
+	cfg, target, err := mp.loadConfig()
+	if err != nil {
+		return nil, err
+	}
+	syscalls, err := mgrconfig.ParseEnabledSyscalls(target, cfg.EnabledSyscalls, cfg.DisabledSyscalls)
+	if err != nil {
+		return nil, err
+	}
+	return manager.New(cfg, target, syscalls, manager.Options{})
+}
+
+func (mp *ManagerInProcess) loop() {
+	var (
+		mgr     *manager.Manager
+		started time.Time
+		stopped = make(chan error)
+		closing = mp.closing
+		ticker  = time.NewTicker(managerRestartPeriod)
+	)
+	defer ticker.Stop()
+	for closing != nil || mgr != nil {
+		if mgr == nil && closing != nil && time.Since(started) > managerRestartPeriod {
+			// Don't restart too frequently (in case it instantly fails).
+			started = time.Now()
+			var err error
+			if mgr, err = mp.start(); err != nil {
+				mp.errorf("failed to start manager: %v", err)
+			} else {
+				log.Logf(1, "%v: started manager", mp.name)
+				ciMetrics.add("syzci_manager_restarts_total", 1, "manager", mp.name)
+				mp.mu.Lock()
+				mp.mgr = mgr
+				mp.running = started
+				mp.mu.Unlock()
+				go func(mgr *manager.Manager) {
+					stopped <- mgr.Run()
+				}(mgr)
+			}
+		}
+
+		select {
+		case <-closing:
+			closing = nil
+			if mgr != nil {
+				log.Logf(1, "%v: stopping manager", mp.name)
+				mgr.Drain()
+			}
+		case err := <-stopped:
+			if err != nil {
+				mp.errorf("manager failed: %v", err)
+			} else if closing != nil {
+				mp.errorf("manager exited unexpectedly")
+			}
+			mgr.Close()
+			mgr = nil
+			mp.mu.Lock()
+			mp.mgr = nil
+			mp.running = time.Time{}
+			mp.mu.Unlock()
+			log.Logf(1, "%v: manager stopped", mp.name)
+		case <-ticker.C:
+		}
+	}
+	close(mp.closing)
+}
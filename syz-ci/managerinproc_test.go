@@ -0,0 +1,65 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/syzkaller/pkg/osutil"
+)
+
+func TestManagerInProcess(t *testing.T) {
+	dir, err := ioutil.TempDir("", "syz-ci-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	binDir := filepath.Join(dir, "syzkaller", "bin", "linux_amd64")
+	if err := osutil.MkdirAll(binDir); err != nil {
+		t.Fatal(err)
+	}
+	for _, bin := range []string{"syz-fuzzer", "syz-execprog", "syz-executor"} {
+		if err := osutil.WriteFile(filepath.Join(binDir, bin), nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	cfgFile := filepath.Join(dir, "manager.cfg")
+	cfg := fmt.Sprintf(`{"target": "linux/amd64", "type": "none", "http": "127.0.0.1:0",
+		"rpc": "127.0.0.1:0", "workdir": %q, "syzkaller": %q, "procs": 1, "sandbox": "none"}`,
+		filepath.Join(dir, "workdir"), filepath.Join(dir, "syzkaller"))
+	if err := osutil.WriteFile(cfgFile, []byte(cfg)); err != nil {
+		t.Fatal(err)
+	}
+	mp := NewManagerInProcess("test", cfgFile, t.Errorf)
+	for start := time.Now(); mp.UpTime() == 0; time.Sleep(10 * time.Millisecond) {
+		if time.Since(start) > time.Minute {
+			t.Fatalf("manager is not started")
+		}
+	}
+	snapshot := filepath.Join(dir, "corpus-snapshot.db")
+	if err := mp.Snapshot(snapshot); err != nil {
+		t.Fatal(err)
+	}
+	if !osutil.IsExist(snapshot) {
+		t.Fatalf("snapshot is not saved")
+	}
+	if err := mp.ReloadSyscalls([]string{"open", "close"}, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := mp.ReloadSyscalls([]string{"no_such_syscall"}, nil); err == nil {
+		t.Fatalf("reloaded unknown syscall")
+	}
+	mp.Close()
+	if mp.UpTime() != 0 {
+		t.Fatalf("manager is running after close")
+	}
+	if err := mp.Snapshot(snapshot); err == nil {
+		t.Fatalf("snapshot of closed manager succeeded")
+	}
+}
@@ -0,0 +1,144 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Metrics of the instance are served on /metrics in Prometheus text exposition format,
+// so that it's possible to alert on e.g. a stuck update loop (syzci_kernel_update_age_seconds)
+// or constantly failing builds (syzci_kernel_build_failures_total).
+// Summaries are exported only as _sum and _count (without quantiles).
+
+type metricDesc struct {
+	name string
+	typ  string
+	help string
+}
+
+var metricDescs = []metricDesc{
+	{"syzci_uptime_seconds", "gauge", "Uptime of the syz-ci process."},
+	{"syzci_kernel_builds_total", "counter", "Number of kernel builds."},
+	{"syzci_kernel_build_failures_total", "counter", "Number of failed kernel builds."},
+	{"syzci_kernel_build_duration_seconds", "summary", "Duration of kernel builds."},
+	{"syzci_kernel_update_age_seconds", "gauge", "Time since the kernel build the manager runs on was built."},
+	{"syzci_manager_restarts_total", "counter", "Number of syz-manager starts (including restarts after crashes)."},
+	{"syzci_manager_up", "gauge", "Whether syz-manager is running."},
+	{"syzci_poll_duration_seconds", "summary", "Latency of polls per action (kernel, syzkaller, jobs)."},
+	{"syzci_poll_errors_total", "counter", "Number of failed polls per action."},
+}
+
+type metricsRegistry struct {
+	mu     sync.Mutex
+	values map[string]map[string]float64 // metric name -> formatted labels -> value
+}
+
+var ciMetrics = newMetricsRegistry()
+
+func newMetricsRegistry() *metricsRegistry {
+	return &metricsRegistry{
+		values: make(map[string]map[string]float64),
+	}
+}
+
+// add adds v to the metric with the given labels (pairs of label names and values).
+func (r *metricsRegistry) add(name string, v float64, labels ...string) {
+	r.update(name, labels, func(old float64) float64 { return old + v })
+}
+
+func (r *metricsRegistry) set(name string, v float64, labels ...string) {
+	r.update(name, labels, func(float64) float64 { return v })
+}
+
+// observe records a sample of a summary metric.
+func (r *metricsRegistry) observe(name string, d time.Duration, labels ...string) {
+	r.add(name+"_sum", d.Seconds(), labels...)
+	r.add(name+"_count", 1, labels...)
+}
+
+// pollFinished records latency of a poll started at start (and the error, if any).
+func (r *metricsRegistry) pollFinished(start time.Time, err error, labels ...string) {
+	r.observe("syzci_poll_duration_seconds", time.Since(start), labels...)
+	if err != nil {
+		r.add("syzci_poll_errors_total", 1, labels...)
+	}
+}
+
+func (r *metricsRegistry) update(name string, labels []string, fn func(float64) float64) {
+	if len(labels)%2 != 0 {
+		panic(fmt.Sprintf("metric %v: odd number of labels", name))
+	}
+	buf := new(bytes.Buffer)
+	for i := 0; i < len(labels); i += 2 {
+		if buf.Len() != 0 {
+			buf.WriteByte(',')
+		}
+		fmt.Fprintf(buf, "%v=%q", labels[i], labels[i+1])
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	m := r.values[name]
+	if m == nil {
+		m = make(map[string]float64)
+		r.values[name] = m
+	}
+	m[buf.String()] = fn(m[buf.String()])
+}
+
+func (r *metricsRegistry) write(w io.Writer) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, desc := range metricDescs {
+		var names []string
+		for name := range r.values {
+			if name == desc.name || desc.typ == "summary" &&
+				(name == desc.name+"_sum" || name == desc.name+"_count") {
+				names = append(names, name)
+			}
+		}
+		if len(names) == 0 {
+			continue
+		}
+		sort.Strings(names)
+		fmt.Fprintf(w, "# HELP %v %v\n# TYPE %v %v\n", desc.name, desc.help, desc.name, desc.typ)
+		for _, name := range names {
+			var lines []string
+			for labels, v := range r.values[name] {
+				if labels != "" {
+					labels = "{" + labels + "}"
+				}
+				lines = append(lines, fmt.Sprintf("%v%v %v\n", name, labels, v))
+			}
+			sort.Strings(lines)
+			io.WriteString(w, strings.Join(lines, ""))
+		}
+	}
+}
+
+// httpMetrics serves metrics of the instance in Prometheus text format.
+func (ctl *controller) httpMetrics(w http.ResponseWriter, r *http.Request) {
+	ciMetrics.set("syzci_uptime_seconds", time.Since(ctl.start).Seconds())
+	for _, mgr := range ctl.managers {
+		status := mgr.heartbeatStatus()
+		up := 0.0
+		if status.UpTime != 0 {
+			up = 1
+		}
+		ciMetrics.set("syzci_manager_up", up, "manager", mgr.name)
+		if !status.KernelBuildTime.IsZero() {
+			ciMetrics.set("syzci_kernel_update_age_seconds", time.Since(status.KernelBuildTime).Seconds(),
+				"manager", mgr.name)
+		}
+	}
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+	ciMetrics.write(w)
+}
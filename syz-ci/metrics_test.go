@@ -0,0 +1,38 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+func TestMetricsWrite(t *testing.T) {
+	r := newMetricsRegistry()
+	r.add("syzci_kernel_builds_total", 1, "manager", "foo")
+	r.add("syzci_kernel_builds_total", 1, "manager", "foo")
+	r.add("syzci_kernel_builds_total", 1, "manager", "bar")
+	r.observe("syzci_poll_duration_seconds", 2*time.Second, "action", "kernel", "manager", "foo")
+	r.observe("syzci_poll_duration_seconds", 3*time.Second, "action", "kernel", "manager", "foo")
+	r.set("syzci_uptime_seconds", 10)
+	r.set("syzci_uptime_seconds", 20)
+	buf := new(bytes.Buffer)
+	r.write(buf)
+	want := `# HELP syzci_uptime_seconds Uptime of the syz-ci process.
+# TYPE syzci_uptime_seconds gauge
+syzci_uptime_seconds 20
+# HELP syzci_kernel_builds_total Number of kernel builds.
+# TYPE syzci_kernel_builds_total counter
+syzci_kernel_builds_total{manager="bar"} 1
+syzci_kernel_builds_total{manager="foo"} 2
+# HELP syzci_poll_duration_seconds Latency of polls per action (kernel, syzkaller, jobs).
+# TYPE syzci_poll_duration_seconds summary
+syzci_poll_duration_seconds_count{action="kernel",manager="foo"} 2
+syzci_poll_duration_seconds_sum{action="kernel",manager="foo"} 5
+`
+	if got := buf.String(); got != want {
+		t.Fatalf("got metrics:\n%s\nwant:\n%s", got, want)
+	}
+}
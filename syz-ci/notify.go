@@ -0,0 +1,112 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/smtp"
+	"strings"
+	"time"
+)
+
+// NotificationsConfig describes where to send notifications about kernel build failures,
+// so that build breakage (e.g. linux-next does not compile) is noticed by a human
+// even without the dashboard.
+type NotificationsConfig struct {
+	// SMTP server address (host:port) used to send emails.
+	SMTPAddr string `json:"smtp_addr"`
+	// SMTP credentials (optional, PLAIN auth is used if set).
+	SMTPUser     string `json:"smtp_user"`
+	SMTPPassword string `json:"smtp_password"`
+	// Sender address of the emails.
+	From string `json:"from"`
+	// Recipients of the emails.
+	Emails []string `json:"emails"`
+	// URL that receives POST requests with JSON {"text": "..."},
+	// compatible with Slack incoming webhooks.
+	Webhook string `json:"webhook"`
+}
+
+// maxNotificationOutput limits size of the build output attached to notifications.
+const maxNotificationOutput = 64 << 10
+
+var (
+	notifySendMail = smtp.SendMail
+	notifyClient   = &http.Client{Timeout: time.Minute}
+)
+
+func (cfg *NotificationsConfig) validate() error {
+	if len(cfg.Emails) == 0 && cfg.Webhook == "" {
+		return fmt.Errorf("no emails or webhook specified")
+	}
+	if len(cfg.Emails) != 0 {
+		if cfg.SMTPAddr == "" || cfg.From == "" {
+			return fmt.Errorf("emails require smtp_addr and from")
+		}
+		if _, _, err := net.SplitHostPort(cfg.SMTPAddr); err != nil {
+			return fmt.Errorf("bad smtp_addr %q: %v", cfg.SMTPAddr, err)
+		}
+	}
+	return nil
+}
+
+// notify sends a notification with the given subject and output to all configured targets.
+// Failure to deliver to one target does not prevent delivery to others.
+func (cfg *NotificationsConfig) notify(subject string, output []byte) error {
+	if len(output) > maxNotificationOutput {
+		output = append(output[:maxNotificationOutput:maxNotificationOutput], "\n<truncated>"...)
+	}
+	var errs []string
+	if len(cfg.Emails) != 0 {
+		if err := cfg.sendEmail(subject, output); err != nil {
+			errs = append(errs, fmt.Sprintf("failed to send email: %v", err))
+		}
+	}
+	if cfg.Webhook != "" {
+		if err := cfg.sendWebhook(subject, output); err != nil {
+			errs = append(errs, fmt.Sprintf("failed to call webhook: %v", err))
+		}
+	}
+	if len(errs) != 0 {
+		return fmt.Errorf("%v", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func (cfg *NotificationsConfig) sendEmail(subject string, output []byte) error {
+	var auth smtp.Auth
+	if cfg.SMTPUser != "" {
+		host, _, _ := net.SplitHostPort(cfg.SMTPAddr)
+		auth = smtp.PlainAuth("", cfg.SMTPUser, cfg.SMTPPassword, host)
+	}
+	msg := new(bytes.Buffer)
+	fmt.Fprintf(msg, "From: %v\r\n", cfg.From)
+	fmt.Fprintf(msg, "To: %v\r\n", strings.Join(cfg.Emails, ", "))
+	fmt.Fprintf(msg, "Subject: %v\r\n", subject)
+	fmt.Fprintf(msg, "Content-Type: text/plain; charset=UTF-8\r\n\r\n")
+	msg.Write(bytes.Replace(output, []byte("\n"), []byte("\r\n"), -1))
+	return notifySendMail(cfg.SMTPAddr, auth, cfg.From, cfg.Emails, msg.Bytes())
+}
+
+func (cfg *NotificationsConfig) sendWebhook(subject string, output []byte) error {
+	data, err := json.Marshal(map[string]string{
+		"text": fmt.Sprintf("%v\n```\n%s\n```", subject, output),
+	})
+	if err != nil {
+		return err
+	}
+	resp, err := notifyClient.Post(cfg.Webhook, "application/json", bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("webhook returned %v", resp.Status)
+	}
+	return nil
+}
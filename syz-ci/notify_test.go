@@ -0,0 +1,71 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/smtp"
+	"strings"
+	"testing"
+)
+
+func TestNotify(t *testing.T) {
+	var webhookText string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var msg struct {
+			Text string `json:"text"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&msg); err != nil {
+			t.Errorf("bad webhook request: %v", err)
+		}
+		webhookText = msg.Text
+	}))
+	defer server.Close()
+	var mailTo []string
+	var mailMsg []byte
+	defer func(old func(string, smtp.Auth, string, []string, []byte) error) { notifySendMail = old }(notifySendMail)
+	notifySendMail = func(addr string, a smtp.Auth, from string, to []string, msg []byte) error {
+		if addr != "smtp.example.com:25" || from != "ci@example.com" {
+			t.Errorf("bad addr/from: %v/%v", addr, from)
+		}
+		mailTo, mailMsg = to, msg
+		return nil
+	}
+	cfg := &NotificationsConfig{
+		SMTPAddr: "smtp.example.com:25",
+		From:     "ci@example.com",
+		Emails:   []string{"a@example.com", "b@example.com"},
+		Webhook:  server.URL,
+	}
+	if err := cfg.validate(); err != nil {
+		t.Fatal(err)
+	}
+	output := bytes.Repeat([]byte("error: foo\n"), maxNotificationOutput)
+	if err := cfg.notify("linux-next build error", output); err != nil {
+		t.Fatal(err)
+	}
+	if len(mailTo) != 2 || !bytes.Contains(mailMsg, []byte("Subject: linux-next build error\r\n")) ||
+		!bytes.Contains(mailMsg, []byte("error: foo\r\n")) ||
+		!bytes.HasSuffix(mailMsg, []byte("<truncated>")) || len(mailMsg) > 2*maxNotificationOutput {
+		t.Fatalf("bad email to %v:\n%.1000s", mailTo, mailMsg)
+	}
+	if !strings.HasPrefix(webhookText, "linux-next build error\n```\nerror: foo\n") ||
+		!strings.Contains(webhookText, "<truncated>") {
+		t.Fatalf("bad webhook text:\n%.1000s", webhookText)
+	}
+	notifySendMail = func(addr string, a smtp.Auth, from string, to []string, msg []byte) error {
+		return fmt.Errorf("smtp is down")
+	}
+	webhookText = ""
+	if err := cfg.notify("subject", []byte("output")); err == nil || !strings.Contains(err.Error(), "smtp is down") {
+		t.Fatalf("bad error: %v", err)
+	}
+	if webhookText == "" {
+		t.Fatalf("webhook is not called after email failure")
+	}
+}
@@ -0,0 +1,192 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/syzkaller/pkg/hash"
+	"github.com/google/syzkaller/pkg/vcs"
+)
+
+// PatchSource is a source of out-of-tree kernel patches that are applied on top of the kernel
+// branch before every build (see patches manager parameter).
+// Exactly one of dir, repo or urls must be set.
+type PatchSource struct {
+	// Name of the source used in errors and logs (optional).
+	Name string `json:"name"`
+	// Disabled sources are not applied (allows to temporary disable a source without removing it).
+	Disabled bool `json:"disabled"`
+	// Local directory with patch files. If the directory contains quilt series file,
+	// patches are applied in the series order. Otherwise all *.patch and *.diff files
+	// are applied in file name order.
+	Dir string `json:"dir"`
+	// Git repository and branch with the patches, the commits of the branch that are not
+	// in the kernel branch are cherry-picked (see vcs.GitBranchPatches).
+	Repo   string `json:"repo"`
+	Branch string `json:"branch"`
+	// URLs of patch files, applied in the given order.
+	URLs []string `json:"urls"`
+}
+
+type kernelPatch struct {
+	name string
+	data []byte
+}
+
+var patchClient = &http.Client{Timeout: time.Minute}
+
+func (src *PatchSource) String() string {
+	switch {
+	case src.Name != "":
+		return src.Name
+	case src.Dir != "":
+		return src.Dir
+	case src.Repo != "":
+		return src.Repo + " " + src.Branch
+	default:
+		return strings.Join(src.URLs, " ")
+	}
+}
+
+func (src *PatchSource) validate() error {
+	kinds := 0
+	if src.Dir != "" {
+		kinds++
+	}
+	if src.Repo != "" || src.Branch != "" {
+		kinds++
+		if !vcs.CheckRepoAddress(src.Repo) {
+			return fmt.Errorf("bad repo %q", src.Repo)
+		}
+		if !vcs.CheckBranch(src.Branch) {
+			return fmt.Errorf("bad branch %q", src.Branch)
+		}
+	}
+	if len(src.URLs) != 0 {
+		kinds++
+	}
+	if kinds != 1 {
+		return fmt.Errorf("exactly one of dir, repo or urls must be set")
+	}
+	return nil
+}
+
+// fetch returns patches of the source in the order they need to be applied.
+// kernelDir is the kernel git checkout the patches will be applied to.
+func (src *PatchSource) fetch(kernelDir string) ([]kernelPatch, error) {
+	switch {
+	case src.Dir != "":
+		return fetchDirPatches(src.Dir)
+	case src.Repo != "":
+		commits, err := vcs.GitBranchPatches(kernelDir, src.Repo, src.Branch)
+		if err != nil {
+			return nil, err
+		}
+		var patches []kernelPatch
+		for i, data := range commits {
+			patches = append(patches, kernelPatch{fmt.Sprintf("%v/%v", src.Branch, i), data})
+		}
+		return patches, nil
+	default:
+		var patches []kernelPatch
+		for _, url := range src.URLs {
+			data, err := fetchURLPatch(url)
+			if err != nil {
+				return nil, err
+			}
+			patches = append(patches, kernelPatch{url, data})
+		}
+		return patches, nil
+	}
+}
+
+func fetchDirPatches(dir string) ([]kernelPatch, error) {
+	var files []string
+	if series, err := ioutil.ReadFile(filepath.Join(dir, "series")); err == nil {
+		for _, line := range strings.Split(string(series), "\n") {
+			// Series lines can have comments and patch options (e.g. -p1) after the file name.
+			if fields := strings.Fields(line); len(fields) != 0 && !strings.HasPrefix(fields[0], "#") {
+				files = append(files, fields[0])
+			}
+		}
+	} else {
+		infos, err := ioutil.ReadDir(dir)
+		if err != nil {
+			return nil, err
+		}
+		for _, info := range infos {
+			if !info.IsDir() && (strings.HasSuffix(info.Name(), ".patch") ||
+				strings.HasSuffix(info.Name(), ".diff")) {
+				files = append(files, info.Name())
+			}
+		}
+		sort.Strings(files)
+	}
+	var patches []kernelPatch
+	for _, file := range files {
+		data, err := ioutil.ReadFile(filepath.Join(dir, filepath.FromSlash(file)))
+		if err != nil {
+			return nil, err
+		}
+		patches = append(patches, kernelPatch{file, data})
+	}
+	return patches, nil
+}
+
+func fetchURLPatch(url string) ([]byte, error) {
+	resp, err := patchClient.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch %v: %v", url, resp.Status)
+	}
+	return ioutil.ReadAll(resp.Body)
+}
+
+// fetchPatches fetches patches from all enabled sources of the manager.
+// Returns the patches and a tag that identifies them (empty if there are no patches).
+func (mgr *Manager) fetchPatches() ([]kernelPatch, string, error) {
+	var patches []kernelPatch
+	for _, src := range mgr.mgrcfg.Patches {
+		if src.Disabled {
+			continue
+		}
+		srcPatches, err := src.fetch(mgr.kernelDir)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to fetch patches from %v: %v", src, err)
+		}
+		for i := range srcPatches {
+			srcPatches[i].name = fmt.Sprintf("%v: %v", src, srcPatches[i].name)
+		}
+		patches = append(patches, srcPatches...)
+	}
+	if len(patches) == 0 {
+		return nil, "", nil
+	}
+	var tagData [][]byte
+	for _, patch := range patches {
+		tagData = append(tagData, patch.data)
+	}
+	return patches, hash.String(bytes.Join(tagData, []byte{0})), nil
+}
+
+// applyPatches applies the patches to the kernel checkout.
+func (mgr *Manager) applyPatches(patches []kernelPatch) error {
+	for _, patch := range patches {
+		if err := vcs.Patch(mgr.kernelDir, patch.data); err != nil {
+			return fmt.Errorf("failed to apply %v: %v", patch.name, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/google/syzkaller/pkg/osutil"
+)
+
+func TestPatchSources(t *testing.T) {
+	dir, err := ioutil.TempDir("", "syz-ci-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	quiltDir := filepath.Join(dir, "quilt")
+	plainDir := filepath.Join(dir, "plain")
+	files := map[string]string{
+		"quilt/series":       "# comment\nb.patch -p1\n\na.patch\n",
+		"quilt/a.patch":      "a",
+		"quilt/b.patch":      "b",
+		"quilt/c.patch":      "c",
+		"plain/2-foo.patch":  "2",
+		"plain/1-bar.diff":   "1",
+		"plain/README":       "readme",
+		"plain/3.patch/file": "dir",
+	}
+	for file, data := range files {
+		file = filepath.Join(dir, filepath.FromSlash(file))
+		if err := osutil.MkdirAll(filepath.Dir(file)); err != nil {
+			t.Fatal(err)
+		}
+		if err := osutil.WriteFile(file, []byte(data)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/missing.patch" {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprintf(w, "url%v", r.URL.Path)
+	}))
+	defer srv.Close()
+
+	mgr := &Manager{
+		mgrcfg: &ManagerConfig{
+			Patches: []*PatchSource{
+				{Dir: quiltDir},
+				{Name: "plain", Dir: plainDir},
+				{Name: "disabled", Disabled: true, Dir: "/nonexistent"},
+				{Name: "urls", URLs: []string{srv.URL + "/x.patch", srv.URL + "/y.patch"}},
+			},
+		},
+	}
+	for _, src := range mgr.mgrcfg.Patches {
+		if err := src.validate(); err != nil {
+			t.Fatalf("%v: %v", src, err)
+		}
+	}
+	patches, tag, err := mgr.fetchPatches()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, patch := range patches {
+		got = append(got, fmt.Sprintf("%v=%s", patch.name, patch.data))
+	}
+	want := []string{
+		quiltDir + ": b.patch=b",
+		quiltDir + ": a.patch=a",
+		"plain: 1-bar.diff=1",
+		"plain: 2-foo.patch=2",
+		"urls: " + srv.URL + "/x.patch=url/x.patch",
+		"urls: " + srv.URL + "/y.patch=url/y.patch",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got patches:\n%q\nwant:\n%q", got, want)
+	}
+
+	// The tag must change when the patches change and must be empty without patches.
+	mgr.mgrcfg.Patches[0].Disabled = true
+	_, tag1, err := mgr.fetchPatches()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tag1 == "" || tag1 == tag {
+		t.Fatalf("bad tags %q/%q", tag, tag1)
+	}
+	mgr.mgrcfg.Patches = mgr.mgrcfg.Patches[2:3]
+	if patches, tag, err := mgr.fetchPatches(); err != nil || len(patches) != 0 || tag != "" {
+		t.Fatalf("disabled source is used: %v %q %v", len(patches), tag, err)
+	}
+	mgr.mgrcfg.Patches = []*PatchSource{{URLs: []string{srv.URL + "/missing.patch"}}}
+	if _, _, err := mgr.fetchPatches(); err == nil {
+		t.Fatalf("missing patch is not detected")
+	}
+
+	for _, src := range []*PatchSource{
+		{},
+		{Dir: quiltDir, URLs: []string{srv.URL}},
+		{Repo: "foo", Branch: "master"},
+		{Repo: "https://github.com/google/syzkaller.git"},
+	} {
+		if err := src.validate(); err == nil {
+			t.Errorf("bad source %+v is accepted", src)
+		}
+	}
+}
@@ -0,0 +1,155 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"html/template"
+	"net"
+	"net/http"
+	"time"
+)
+
+// httpSummary serves combined status page of all managers of the instance
+// with links to web UIs of the managers.
+func (ctl *controller) httpSummary(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/" {
+		http.NotFound(w, r)
+		return
+	}
+	status := collectStatus(ctl.cfg, ctl.managers, ctl.updater, ctl.start)
+	data := &UIStatus{
+		Name:            status.Name,
+		SyzkallerCommit: status.SyzkallerCommit,
+		UpTime:          status.UpTime.Truncate(time.Second),
+	}
+	for i, mgr := range ctl.managers {
+		mgrStatus := status.Managers[i]
+		ui := &UIManager{
+			Name:           mgr.name,
+			Repo:           mgr.mgrcfg.RepoAlias,
+			Branch:         mgr.mgrcfg.Branch,
+			KernelCommit:   mgrStatus.KernelCommit,
+			LastBuildError: mgrStatus.LastBuildError,
+			UpTime:         mgrStatus.UpTime.Truncate(time.Second),
+			Paused:         mgrStatus.Paused,
+			Phase:          mgr.currentPhase().String(),
+			Link:           managerLink(r.Host, mgr.managercfg.HTTP),
+		}
+		mgr.status.mu.Lock()
+		if mgr.status.update != nil {
+			ui.PendingUpdate = mgr.status.update.diff
+		}
+		ui.LastUpdate = mgr.status.lastUpdate
+		mgr.status.mu.Unlock()
+		if !mgrStatus.KernelBuildTime.IsZero() {
+			ui.KernelBuildTime = mgrStatus.KernelBuildTime.Format("2006/01/02 15:04")
+		}
+		data.Managers = append(data.Managers, ui)
+	}
+	if err := statusTemplate.Execute(w, data); err != nil {
+		http.Error(w, fmt.Sprintf("failed to execute template: %v", err),
+			http.StatusInternalServerError)
+	}
+}
+
+// managerLink returns link to the manager web UI listening on addr
+// for a user that accesses syz-ci at reqHost.
+func managerLink(reqHost, addr string) string {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil || port == "" {
+		return ""
+	}
+	if host == "" || host == "0.0.0.0" || host == "::" {
+		host = reqHost
+		if h, _, err := net.SplitHostPort(reqHost); err == nil {
+			host = h
+		}
+	}
+	return "http://" + net.JoinHostPort(host, port)
+}
+
+type UIStatus struct {
+	Name            string
+	SyzkallerCommit string
+	UpTime          time.Duration
+	Managers        []*UIManager
+}
+
+type UIManager struct {
+	Name            string
+	Repo            string
+	Branch          string
+	KernelCommit    string
+	KernelBuildTime string
+	LastBuildError  string
+	UpTime          time.Duration
+	Paused          bool
+	Phase           string
+	Link            string
+	PendingUpdate   string // config diff of the update waiting for confirmation
+	LastUpdate      string // config diff of the last applied update
+}
+
+var statusTemplate = template.Must(template.New("").Parse(`
+<!doctype html>
+<html>
+<head>
+	<title>{{.Name}} syz-ci</title>
+	<style>
+		body { font-family: Arial, Helvetica, sans-serif; font-size: 80%; }
+		table { border-collapse: collapse; }
+		th, td { border: 1px solid #ccc; padding: 4px 8px; text-align: left; }
+		.error { color: #c00; }
+	</style>
+</head>
+<body>
+<b>{{.Name}}</b>: syzkaller {{.SyzkallerCommit}}, uptime {{.UpTime}}
+<br><br>
+<table>
+	<tr>
+		<th>Manager</th>
+		<th>Repo</th>
+		<th>Branch</th>
+		<th>Kernel commit</th>
+		<th>Kernel build</th>
+		<th>Status</th>
+		<th>Phase</th>
+		<th>Last build error</th>
+	</tr>
+	{{range $mgr := $.Managers}}
+	<tr>
+		<td>{{if $mgr.Link}}<a href="{{$mgr.Link}}">{{$mgr.Name}}</a>{{else}}{{$mgr.Name}}{{end}}</td>
+		<td>{{$mgr.Repo}}</td>
+		<td>{{$mgr.Branch}}</td>
+		<td>{{$mgr.KernelCommit}}</td>
+		<td>{{$mgr.KernelBuildTime}}</td>
+		<td>{{if $mgr.Paused}}paused{{else if $mgr.UpTime}}up {{$mgr.UpTime}}{{else}}down{{end}}</td>
+		<td id="phase-{{$mgr.Name}}">{{$mgr.Phase}}</td>
+		<td class="error">{{$mgr.LastBuildError}}</td>
+	</tr>
+	{{end}}
+</table>
+{{range $mgr := $.Managers}}
+	{{if $mgr.PendingUpdate}}
+		<br><b>{{$mgr.Name}}</b>: update is waiting for confirmation (/control/confirm?manager={{$mgr.Name}}):
+		<pre>{{$mgr.PendingUpdate}}</pre>
+	{{else if $mgr.LastUpdate}}
+		<br><b>{{$mgr.Name}}</b>: last update:
+		<pre>{{$mgr.LastUpdate}}</pre>
+	{{end}}
+{{end}}
+<script>
+	// Phases are updated live from /events (see events.go).
+	new EventSource("/events").onmessage = function(e) {
+		JSON.parse(e.data).forEach(function(phase) {
+			var td = document.getElementById("phase-" + phase.name);
+			if (td) {
+				td.textContent = phase.phase + (phase.progress ? " " + phase.progress + "%" : "");
+			}
+		});
+	};
+</script>
+</body></html>
+`))
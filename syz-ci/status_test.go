@@ -0,0 +1,67 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/syzkaller/dashboard/dashapi"
+)
+
+func TestManagerLink(t *testing.T) {
+	tests := []struct {
+		reqHost string
+		addr    string
+		link    string
+	}{
+		{"ci.example.com", ":10000", "http://ci.example.com:10000"},
+		{"ci.example.com:8080", ":10000", "http://ci.example.com:10000"},
+		{"ci.example.com", "0.0.0.0:10000", "http://ci.example.com:10000"},
+		{"ci.example.com", "10.0.0.1:10000", "http://10.0.0.1:10000"},
+		{"ci.example.com", "", ""},
+	}
+	for _, test := range tests {
+		if link := managerLink(test.reqHost, test.addr); link != test.link {
+			t.Errorf("managerLink(%q, %q) = %q, want %q", test.reqHost, test.addr, link, test.link)
+		}
+	}
+}
+
+func TestAPIStatus(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	mgr := &Manager{name: "ci-foo"}
+	mgr.updateStatus(func(status *dashapi.CIManagerStatus) {
+		status.KernelCommit = "kernel-commit"
+		status.LastKernelPoll = now
+		status.LastBuildError = "kernel build failed"
+	})
+	updater := &SyzUpdater{lastPoll: now.Add(-time.Minute), lastBuildError: "syzkaller build failed"}
+	ctl := &controller{
+		cfg:      &Config{Name: "ci"},
+		managers: []*Manager{mgr},
+		updater:  updater,
+		start:    time.Now(),
+	}
+	w := httptest.NewRecorder()
+	ctl.httpStatus(w, httptest.NewRequest("GET", "/api/status", nil))
+	status := new(dashapi.CIHeartbeat)
+	if err := json.Unmarshal(w.Body.Bytes(), status); err != nil {
+		t.Fatalf("failed to parse status: %v\n%s", err, w.Body.Bytes())
+	}
+	if status.Name != "ci" || !status.LastSyzkallerPoll.Equal(now.Add(-time.Minute)) ||
+		status.LastSyzkallerBuildError != "syzkaller build failed" {
+		t.Errorf("bad instance status: %+v", status)
+	}
+	if len(status.Managers) != 1 {
+		t.Fatalf("want 1 manager, got %+v", status.Managers)
+	}
+	got := status.Managers[0]
+	if got.Name != "ci-foo" || got.KernelCommit != "kernel-commit" || !got.LastKernelPoll.Equal(now) ||
+		got.LastBuildError != "kernel build failed" {
+		t.Errorf("bad manager status: %+v", got)
+	}
+}
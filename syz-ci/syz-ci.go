@@ -41,6 +41,8 @@ package main
 //		workdir/	: manager workdir (never deleted)
 //		latest/		: latest good kernel image build
 //		current/	: kernel image currently in use
+//		cache/		: cached kernel builds (optional, see build_cache_size)
+//		compiler/	: compiler extracted from compiler_archive (optional)
 // jobs/
 //	linux/			: one dir per target OS
 //		kernel/		: kernel checkout
@@ -56,16 +58,36 @@ import (
 	"encoding/json"
 	"flag"
 	"fmt"
+	"io/ioutil"
 	"os"
+	"path/filepath"
+	"strings"
 	"sync"
+	"time"
 
+	"github.com/google/syzkaller/pkg/build"
 	"github.com/google/syzkaller/pkg/config"
+	"github.com/google/syzkaller/pkg/gce"
+	"github.com/google/syzkaller/pkg/gcs"
 	"github.com/google/syzkaller/pkg/log"
 	"github.com/google/syzkaller/pkg/osutil"
 	"github.com/google/syzkaller/syz-manager/mgrconfig"
 )
 
-var flagConfig = flag.String("config", "", "config file")
+var (
+	flagConfig        = flag.String("config", "", "config file, gs://bucket/file or "+configFromMetadata)
+	flagEmitTerraform = flag.Bool("emit-terraform", false, "print terraform descriptors for the config and exit")
+)
+
+// configFromMetadata can be specified as the config file to read the config from
+// configMetadataAttribute of the current GCE instance. The attribute contains either
+// the config itself, or a gs://bucket/file path of the config. This allows to create
+// syz-ci instances from an instance template without provisioning any files.
+const (
+	configFromMetadata      = "gce-metadata"
+	configMetadataAttribute = "syz-ci-config"
+	gcsPrefix               = "gs://"
+)
 
 type Config struct {
 	Name            string `json:"name"`
@@ -81,8 +103,49 @@ type Config struct {
 	// Dir with additional syscall descriptions (.txt and .const files).
 	SyzkallerDescriptions string `json:"syzkaller_descriptions"`
 	// Enable patch testing jobs.
-	EnableJobs bool             `json:"enable_jobs"`
+	EnableJobs bool `json:"enable_jobs"`
+	// Dir with compilers for bisection (see pkg/bisect), if set and jobs are enabled,
+	// syz-ci also does bisection of crashes with reproducers (optional).
+	BisectBinDir string `json:"bisect_bin_dir"`
+	// If set, syz-ci periodically deletes GCE instances, disks and images created for its managers
+	// that are not used by the current managers and are older than this many hours (optional).
+	GCEJanitorAge int `json:"gce_janitor_age"`
+	// Number of tested kernel builds to keep per manager in a local cache (optional).
+	// If a manager needs to build a kernel commit that is in the cache
+	// (with the same compiler and config), the cached build is used instead.
+	BuildCacheSize int `json:"build_cache_size"`
+	// GCS path (bucket/path) to additionally store cached builds in, shared
+	// across syz-ci restarts and instance recreations (optional, requires build_cache_size).
+	BuildCacheGCSPath string `json:"build_cache_gcs_path"`
+	// Restart running managers on new builds/configs only after the update is confirmed
+	// with /control/confirm request, the pending config diff is shown on the status page (optional).
+	ConfirmUpdates bool `json:"confirm_updates"`
+	// GCS path (bucket/path) to periodically upload manager corpuses to (optional).
+	// A manager without a local corpus (e.g. on a freshly created syz-ci instance)
+	// restores the corpus from there on start.
+	CorpusGCSPath string `json:"corpus_gcs_path"`
+	// Weekly time windows when disruptive updates are allowed, e.g. "mon-fri 02:00-06:00 Europe/Berlin"
+	// (see maintenanceWindow for the format). Outside of the windows kernel rebuilds,
+	// restarts of running managers on new builds and syz-ci self-updates are deferred,
+	// managers keep fuzzing on the old builds (optional, updates are allowed at any time if empty).
+	MaintenanceWindows []string `json:"maintenance_windows"`
+	// How often syzkaller and kernels are polled and rebuilt after successful builds, in minutes
+	// (optional, 12 hours by default). Kernels are polled 1/12 of the period later than syzkaller.
+	PollPeriod int `json:"poll_period"`
+	// Delay before retrying failed polls and builds and between polls when there are
+	// no new commits, in minutes (optional, 10 by default).
+	ErrorBackoff int `json:"error_backoff"`
+	// Double error_backoff after every consecutive failure, up to poll_period (optional).
+	ErrorBackoffExponential bool `json:"error_backoff_exponential"`
+	// New kernel builds don't restart a running manager until it runs for this many minutes (optional).
+	MinManagerUptime int `json:"min_manager_uptime"`
+	// Where to send notifications about kernel build failures (optional, see NotificationsConfig).
+	Notifications *NotificationsConfig `json:"notifications"`
+	// Key for the control API served on http (used by tools/syz-fleet), optional.
+	ControlKey string           `json:"control_key"`
 	Managers   []*ManagerConfig `json:"managers"`
+
+	maintenanceWindows []*maintenanceWindow
 }
 
 type ManagerConfig struct {
@@ -91,15 +154,55 @@ type ManagerConfig struct {
 	DashboardKey    string `json:"dashboard_key"`
 	Repo            string `json:"repo"`
 	// Short name of the repo (e.g. "linux-next"), used only for reporting.
-	RepoAlias    string `json:"repo_alias"`
-	Branch       string `json:"branch"`
-	Compiler     string `json:"compiler"`
-	Userspace    string `json:"userspace"`
+	RepoAlias string `json:"repo_alias"`
+	Branch    string `json:"branch"`
+	Compiler  string `json:"compiler"`
+	// GCS path (bucket/path) of a .tar.gz archive with the compiler toolchain (optional).
+	// If set, the archive is downloaded and extracted and compiler is a path inside of the archive
+	// (e.g. "bin/clang"). This allows to use specific compilers without installing them on the machine.
+	CompilerArchive string `json:"compiler_archive"`
+	Userspace       string `json:"userspace"`
+	// How to create image from userspace: "debootstrap" (default), "buildroot"
+	// or absolute path to a custom script, see build.CheckImageBackend (optional).
+	ImageBackend string `json:"image_backend"`
+	// Put the image root filesystem on dm-crypt ("crypt") or dm-verity ("verity") device
+	// to fuzz the storage stack used in such configurations, see build.RootStorageCrypt
+	// and build.RootStorageVerity (optional, requires a bootable image).
+	// The required options are enabled on top of kernel_config.
+	RootStorage string `json:"root_storage"`
+	// Security module profile: "selinux-permissive", "selinux-enforcing", "apparmor-permissive",
+	// "apparmor-enforcing" or "landlock", see build.CheckLSM (optional).
+	// The module is enabled on top of kernel_config, the image is provisioned with the policy
+	// and crashes are labeled with the profile (manager config lsm parameter).
+	LSM          string `json:"lsm"`
 	KernelConfig string `json:"kernel_config"`
+	// Small kernel config (e.g. defconfig with debugging options required to detect crashes)
+	// used as the starting point for minimization of kernel configs of crashes with reproducers
+	// (optional, enables config minimization jobs for the manager, see bisect.MinimizeConfig).
+	// Lockdown and sanitizers are enabled on top of it the same way as for kernel_config.
+	KernelBaselineConfig string `json:"kernel_baseline_config"`
+	// Build kernel with module signing (with keys generated for every build) and lockdown enabled
+	// on top of kernel_config, see build.LockdownConfig (optional).
+	// Lockdown breaks kcov, so it requires "cover": false in manager_config.
+	KernelLockdown bool `json:"kernel_lockdown"`
+	// Sanitizers to enable on top of kernel_config: "kasan", "kmsan", "kcsan", "ubsan",
+	// see build.SanitizerConfig (optional).
+	Sanitizers []string `json:"sanitizers"`
 	// File with kernel cmdline values (optional).
 	KernelCmdline string `json:"kernel_cmdline"`
 	// File with sysctl values (e.g. output of sysctl -a, optional).
-	KernelSysctl  string          `json:"kernel_sysctl"`
+	KernelSysctl string `json:"kernel_sysctl"`
+	// Run syz-manager inside of syz-ci process instead of syzkaller/current/bin/syz-manager
+	// (see ManagerInProcess), this enables snapshot and syscalls control requests (optional).
+	InProcess bool `json:"in_process"`
+	// Out-of-tree patches applied on top of the kernel branch before every build (optional, see PatchSource).
+	Patches []*PatchSource `json:"patches"`
+	// Test new kernel builds on a canary manager before restarting the running manager
+	// (optional, requires in_process, see CanaryConfig).
+	Canary *CanaryConfig `json:"canary"`
+	// Periodically rebuild the kernel with expensive debugging options and run the existing corpus
+	// on it (optional, see DeepDiveConfig).
+	DeepDive      *DeepDiveConfig `json:"deep_dive"`
 	ManagerConfig json.RawMessage `json:"manager_config"`
 }
 
@@ -110,7 +213,17 @@ func main() {
 	if err != nil {
 		log.Fatalf("failed to load config: %v", err)
 	}
+	if *flagEmitTerraform {
+		data, err := emitTerraform(cfg, *flagConfig)
+		if err != nil {
+			log.Fatalf("failed to generate terraform descriptors: %v", err)
+		}
+		os.Stdout.Write(data)
+		return
+	}
 
+	applyPollPolicy(cfg)
+	start := time.Now()
 	shutdownPending := make(chan struct{})
 	osutil.HandleInterrupts(shutdownPending)
 
@@ -119,16 +232,21 @@ func main() {
 	updatePending := make(chan struct{})
 	go func() {
 		updater.WaitForUpdate()
+		for !cfg.maintenanceAllowed(time.Now()) {
+			time.Sleep(time.Minute)
+		}
 		close(updatePending)
 	}()
 
 	var wg sync.WaitGroup
 	wg.Add(1)
 	stop := make(chan struct{})
+	restartPending := make(chan struct{})
 	go func() {
 		select {
 		case <-shutdownPending:
 		case <-updatePending:
+		case <-restartPending:
 		}
 		close(stop)
 		wg.Done()
@@ -146,6 +264,28 @@ func main() {
 			mgr.loop()
 		}()
 	}
+	if cfg.DashboardAddr != "" && cfg.DashboardClient != "" {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			heartbeatLoop(cfg, managers, updater, start, stop)
+		}()
+	}
+	go serveControl(&controller{
+		cfg:        cfg,
+		configFile: *flagConfig,
+		managers:   managers,
+		updater:    updater,
+		start:      start,
+		restart:    restartPending,
+	})
+	if cfg.GCEJanitorAge != 0 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			janitorLoop(cfg, stop)
+		}()
+	}
 	if cfg.EnableJobs {
 		jp := newJobProcessor(cfg, managers)
 		wg.Add(1)
@@ -161,7 +301,22 @@ func main() {
 	case <-shutdownPending:
 	case <-updatePending:
 		updater.UpdateAndRestart()
+	case <-restartPending:
+		updater.Restart()
+	}
+}
+
+// applyPollPolicy overrides the default poll periods and error backoff with values from the config.
+func applyPollPolicy(cfg *Config) {
+	if cfg.PollPeriod != 0 {
+		syzkallerRebuildPeriod = time.Duration(cfg.PollPeriod) * time.Minute
+		kernelRebuildPeriod = syzkallerRebuildPeriod + syzkallerRebuildPeriod/12
 	}
+	if cfg.ErrorBackoff != 0 {
+		buildRetryPeriod = time.Duration(cfg.ErrorBackoff) * time.Minute
+	}
+	errorBackoffExponential = cfg.ErrorBackoffExponential
+	minManagerUptime = time.Duration(cfg.MinManagerUptime) * time.Minute
 }
 
 func loadConfig(filename string) (*Config, error) {
@@ -170,7 +325,15 @@ func loadConfig(filename string) (*Config, error) {
 		SyzkallerBranch: "master",
 		Goroot:          os.Getenv("GOROOT"),
 	}
-	if err := config.LoadFile(filename, cfg); err != nil {
+	if filename == configFromMetadata || strings.HasPrefix(filename, gcsPrefix) {
+		data, err := readRemoteConfig(filename)
+		if err != nil {
+			return nil, err
+		}
+		if err := config.LoadData(data, cfg); err != nil {
+			return nil, err
+		}
+	} else if err := config.LoadFile(filename, cfg); err != nil {
 		return nil, err
 	}
 	if cfg.Name == "" {
@@ -182,14 +345,137 @@ func loadConfig(filename string) (*Config, error) {
 	if len(cfg.Managers) == 0 {
 		return nil, fmt.Errorf("no managers specified")
 	}
+	if cfg.GCEJanitorAge < 0 {
+		return nil, fmt.Errorf("param 'gce_janitor_age' is negative")
+	}
+	if cfg.BuildCacheSize < 0 {
+		return nil, fmt.Errorf("param 'build_cache_size' is negative")
+	}
+	if cfg.PollPeriod < 0 || cfg.ErrorBackoff < 0 || cfg.MinManagerUptime < 0 {
+		return nil, fmt.Errorf("params 'poll_period', 'error_backoff' and 'min_manager_uptime' can't be negative")
+	}
+	if cfg.ErrorBackoff != 0 && cfg.PollPeriod != 0 && cfg.ErrorBackoff > cfg.PollPeriod {
+		return nil, fmt.Errorf("param 'error_backoff' is larger than 'poll_period'")
+	}
+	if cfg.BuildCacheGCSPath != "" && cfg.BuildCacheSize == 0 {
+		return nil, fmt.Errorf("param 'build_cache_gcs_path' requires 'build_cache_size'")
+	}
+	for _, str := range cfg.MaintenanceWindows {
+		w, err := parseMaintenanceWindow(str)
+		if err != nil {
+			return nil, fmt.Errorf("param 'maintenance_windows': %v", err)
+		}
+		cfg.maintenanceWindows = append(cfg.maintenanceWindows, w)
+	}
+	if cfg.Notifications != nil {
+		if err := cfg.Notifications.validate(); err != nil {
+			return nil, fmt.Errorf("param 'notifications': %v", err)
+		}
+	}
+	// Managers run in parallel, so they need distinct names (used for workdirs and images)
+	// and distinct http ports.
+	names := make(map[string]bool)
+	addrs := make(map[string]string)
 	for i, mgr := range cfg.Managers {
 		if mgr.Name == "" {
 			return nil, fmt.Errorf("param 'managers[%v].name' is empty", i)
 		}
-		mgrcfg := new(mgrconfig.Config)
+		if names[mgr.Name] {
+			return nil, fmt.Errorf("duplicate manager name %v", mgr.Name)
+		}
+		names[mgr.Name] = true
+		mgrcfg := &mgrconfig.Config{Cover: true} // cover is enabled by default
 		if err := config.LoadData(mgr.ManagerConfig, mgrcfg); err != nil {
 			return nil, fmt.Errorf("manager %v: %v", mgr.Name, err)
 		}
+		if mgr.KernelLockdown && mgrcfg.Cover {
+			return nil, fmt.Errorf("manager %v: kernel_lockdown restricts debugfs and breaks kcov,"+
+				" it requires \"cover\": false in manager_config", mgr.Name)
+		}
+		if mgrcfg.HTTP != "" {
+			if other := addrs[mgrcfg.HTTP]; other != "" {
+				return nil, fmt.Errorf("managers %v and %v use the same http address %v",
+					other, mgr.Name, mgrcfg.HTTP)
+			}
+			addrs[mgrcfg.HTTP] = mgr.Name
+		}
+		if err := build.CheckImageBackend(mgr.ImageBackend); err != nil {
+			return nil, fmt.Errorf("manager %v: %v", mgr.Name, err)
+		}
+		if err := build.CheckSanitizers(mgr.Sanitizers); err != nil {
+			return nil, fmt.Errorf("manager %v: %v", mgr.Name, err)
+		}
+		if err := build.CheckRootStorage(mgr.RootStorage); err != nil {
+			return nil, fmt.Errorf("manager %v: %v", mgr.Name, err)
+		}
+		if err := build.CheckLSM(mgr.LSM); err != nil {
+			return nil, fmt.Errorf("manager %v: %v", mgr.Name, err)
+		}
+		if mgr.RootStorage != build.RootStoragePlain && mgr.ImageBackend == build.ImageBackendBuildroot {
+			return nil, fmt.Errorf("manager %v: param 'root_storage' is not supported for buildroot images",
+				mgr.Name)
+		}
+		for i, src := range mgr.Patches {
+			if err := src.validate(); err != nil {
+				return nil, fmt.Errorf("manager %v: param 'patches[%v]': %v", mgr.Name, i, err)
+			}
+		}
+		if mgr.Canary != nil {
+			if !mgr.InProcess {
+				return nil, fmt.Errorf("manager %v: canary requires in_process", mgr.Name)
+			}
+			if err := mgr.Canary.validate(mgrcfg.Type); err != nil {
+				return nil, fmt.Errorf("manager %v: param 'canary': %v", mgr.Name, err)
+			}
+			if mgr.Canary.HTTP != "" {
+				if other := addrs[mgr.Canary.HTTP]; other != "" {
+					return nil, fmt.Errorf("managers %v and %v use the same http address %v",
+						other, mgr.Name, mgr.Canary.HTTP)
+				}
+				addrs[mgr.Canary.HTTP] = mgr.Name
+			}
+		}
+		if mgr.DeepDive != nil {
+			if err := mgr.DeepDive.validate(); err != nil {
+				return nil, fmt.Errorf("manager %v: param 'deep_dive': %v", mgr.Name, err)
+			}
+		}
+		if mgr.CompilerArchive != "" && (mgr.Compiler == "" || filepath.IsAbs(mgr.Compiler)) {
+			return nil, fmt.Errorf("manager %v: compiler must be a relative path inside of compiler_archive",
+				mgr.Name)
+		}
 	}
 	return cfg, nil
 }
+
+func readRemoteConfig(filename string) ([]byte, error) {
+	if filename == configFromMetadata {
+		attr, err := gce.GetInstanceAttribute(configMetadataAttribute)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %v instance attribute: %v", configMetadataAttribute, err)
+		}
+		if !strings.HasPrefix(strings.TrimSpace(attr), gcsPrefix) {
+			return []byte(attr), nil
+		}
+		filename = strings.TrimSpace(attr)
+	}
+	GCS, err := gcs.NewClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GCS client: %v", err)
+	}
+	defer GCS.Close()
+	file, err := GCS.Read(strings.TrimPrefix(filename, gcsPrefix))
+	if err != nil {
+		return nil, err
+	}
+	r, err := file.Reader()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %v: %v", filename, err)
+	}
+	defer r.Close()
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %v: %v", filename, err)
+	}
+	return data, nil
+}
@@ -9,6 +9,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 	"syscall"
 	"time"
 
@@ -18,11 +19,31 @@ import (
 	"github.com/google/syzkaller/syz-manager/mgrconfig"
 )
 
-const (
-	syzkallerRebuildPeriod = 12 * time.Hour
-	buildRetryPeriod       = 10 * time.Minute // used for both syzkaller and kernel
+// These are vars to allow shorter periods in tests, they can be also changed in the config
+// (poll_period, error_backoff, error_backoff_exponential), see applyPollPolicy.
+var (
+	syzkallerRebuildPeriod  = 12 * time.Hour
+	buildRetryPeriod        = 10 * time.Minute // used for both syzkaller and kernel
+	errorBackoffExponential = false            // double buildRetryPeriod after every consecutive failure
 )
 
+// retryPeriod returns delay before the next poll after the given number of consecutive failures.
+// With exponential backoff the delay is doubled after every failure, but it does not exceed
+// syzkallerRebuildPeriod (polls are not done less frequently than after successful builds).
+func retryPeriod(failures int) time.Duration {
+	period := buildRetryPeriod
+	if !errorBackoffExponential {
+		return period
+	}
+	for i := 1; i < failures && period < syzkallerRebuildPeriod; i++ {
+		period *= 2
+	}
+	if period > syzkallerRebuildPeriod {
+		period = syzkallerRebuildPeriod
+	}
+	return period
+}
+
 // SyzUpdater handles everything related to syzkaller updates.
 // As kernel builder, it maintains 2 builds:
 //  - latest: latest known good syzkaller build
@@ -41,6 +62,14 @@ type SyzUpdater struct {
 	currentDir   string
 	syzFiles     map[string]bool
 	targets      map[string]bool
+	updateNow    chan struct{}
+	// Number of consecutive failed polls and builds, used only by the update loop.
+	pollFailures  int
+	buildFailures int
+
+	mu             sync.Mutex
+	lastPoll       time.Time
+	lastBuildError string
 }
 
 func NewSyzUpdater(cfg *Config) *SyzUpdater {
@@ -99,6 +128,7 @@ func NewSyzUpdater(cfg *Config) *SyzUpdater {
 		currentDir:   filepath.Join("syzkaller", "current"),
 		syzFiles:     syzFiles,
 		targets:      targets,
+		updateNow:    make(chan struct{}, 1),
 	}
 }
 
@@ -143,9 +173,10 @@ func (upd *SyzUpdater) UpdateOnStart(shutdown chan struct{}) {
 		}
 
 		// No good build at all, try again later.
-		log.Logf(0, "retrying in %v", buildRetryPeriod)
+		retry := retryPeriod(upd.pollFailures + upd.buildFailures)
+		log.Logf(0, "retrying in %v", retry)
 		select {
-		case <-time.After(buildRetryPeriod):
+		case <-time.After(retry):
 		case <-shutdown:
 			os.Exit(0)
 		}
@@ -155,7 +186,7 @@ func (upd *SyzUpdater) UpdateOnStart(shutdown chan struct{}) {
 // WaitForUpdate polls and rebuilds syzkaller.
 // Returns when we have a new good build in latest.
 func (upd *SyzUpdater) WaitForUpdate() {
-	time.Sleep(syzkallerRebuildPeriod)
+	upd.sleep(syzkallerRebuildPeriod)
 	latestTag := upd.checkLatest()
 	lastCommit := latestTag
 	for {
@@ -163,11 +194,26 @@ func (upd *SyzUpdater) WaitForUpdate() {
 		if latestTag != upd.checkLatest() {
 			break
 		}
-		time.Sleep(buildRetryPeriod)
+		upd.sleep(retryPeriod(upd.pollFailures + upd.buildFailures))
 	}
 	log.Logf(0, "syzkaller: update available, restarting")
 }
 
+// TriggerUpdate makes WaitForUpdate poll and rebuild syzkaller right away.
+func (upd *SyzUpdater) TriggerUpdate() {
+	select {
+	case upd.updateNow <- struct{}{}:
+	default:
+	}
+}
+
+func (upd *SyzUpdater) sleep(d time.Duration) {
+	select {
+	case <-time.After(d):
+	case <-upd.updateNow:
+	}
+}
+
 // UpdateAndRestart updates and restarts the current executable.
 // Does not return.
 func (upd *SyzUpdater) UpdateAndRestart() {
@@ -186,23 +232,58 @@ func (upd *SyzUpdater) UpdateAndRestart() {
 	log.Fatalf("not reachable")
 }
 
+// Restart restarts the current executable without update (e.g. to pick up config changes).
+// Does not return.
+func (upd *SyzUpdater) Restart() {
+	log.Logf(0, "restarting executable")
+	if err := syscall.Exec(upd.exe, os.Args, os.Environ()); err != nil {
+		log.Fatal(err)
+	}
+	log.Fatalf("not reachable")
+}
+
 func (upd *SyzUpdater) pollAndBuild(lastCommit string) string {
+	pollStart := time.Now()
 	commit, err := upd.repo.Poll(upd.repoAddress, upd.branch)
+	ciMetrics.pollFinished(pollStart, err, "action", "syzkaller")
 	if err != nil {
 		log.Logf(0, "syzkaller: failed to poll: %v", err)
+		upd.pollFailures++
 		return lastCommit
 	}
+	upd.pollFailures = 0
 	log.Logf(0, "syzkaller: poll: %v (%v)", commit.Hash, commit.Title)
+	upd.mu.Lock()
+	upd.lastPoll = time.Now()
+	upd.mu.Unlock()
 	if lastCommit != commit.Hash {
 		log.Logf(0, "syzkaller: building ...")
 		lastCommit = commit.Hash
-		if err := upd.build(commit); err != nil {
+		err := upd.build(commit)
+		if err != nil {
 			log.Logf(0, "syzkaller: %v", err)
+			upd.buildFailures++
+		} else {
+			upd.buildFailures = 0
+		}
+		upd.mu.Lock()
+		upd.lastBuildError = ""
+		if err != nil {
+			upd.lastBuildError = err.Error()
 		}
+		upd.mu.Unlock()
 	}
 	return lastCommit
 }
 
+// Status returns time of the last successful poll of syzkaller repo
+// and error of the last syzkaller build (empty if it succeeded).
+func (upd *SyzUpdater) Status() (lastPoll time.Time, lastBuildError string) {
+	upd.mu.Lock()
+	defer upd.mu.Unlock()
+	return upd.lastPoll, upd.lastBuildError
+}
+
 func (upd *SyzUpdater) build(commit *vcs.Commit) error {
 	if upd.descriptions != "" {
 		files, err := ioutil.ReadDir(upd.descriptions)
@@ -0,0 +1,38 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRetryPeriod(t *testing.T) {
+	defer func(retry, rebuild time.Duration, exponential bool) {
+		buildRetryPeriod, syzkallerRebuildPeriod, errorBackoffExponential = retry, rebuild, exponential
+	}(buildRetryPeriod, syzkallerRebuildPeriod, errorBackoffExponential)
+	buildRetryPeriod = 10 * time.Minute
+	syzkallerRebuildPeriod = time.Hour
+	tests := []struct {
+		exponential bool
+		failures    int
+		period      time.Duration
+	}{
+		{false, 0, 10 * time.Minute},
+		{false, 5, 10 * time.Minute},
+		{true, 0, 10 * time.Minute},
+		{true, 1, 10 * time.Minute},
+		{true, 2, 20 * time.Minute},
+		{true, 3, 40 * time.Minute},
+		{true, 4, time.Hour},
+		{true, 100, time.Hour},
+	}
+	for _, test := range tests {
+		errorBackoffExponential = test.exponential
+		if got := retryPeriod(test.failures); got != test.period {
+			t.Errorf("retryPeriod(%v), exponential=%v: got %v, want %v",
+				test.failures, test.exponential, got, test.period)
+		}
+	}
+}
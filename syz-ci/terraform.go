@@ -0,0 +1,209 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+// Generation of terraform descriptors for the infrastructure implied by the config:
+// the syz-ci VM itself, its service account, GCS buckets used by GCE managers
+// and firewall rules for web UIs. Things that are not implied by the config
+// (project, zone, network, etc) are left as terraform variables.
+
+type terraformDesc struct {
+	ID         string // terraform resource identifier
+	Name       string // GCE resource name
+	ConfigFile string
+	Ports      []string
+	Buckets    []terraformBucket
+	// Projects other than the current one where the service account needs access to images.
+	ImageProjects []terraformProject
+}
+
+type terraformBucket struct {
+	ID   string
+	Name string
+}
+
+type terraformProject struct {
+	ID   string
+	Name string
+}
+
+func emitTerraform(cfg *Config, configFile string) ([]byte, error) {
+	desc := &terraformDesc{
+		ID:         terraformID(cfg.Name),
+		Name:       cfg.Name,
+		ConfigFile: configFile,
+	}
+	ports := make(map[string]bool)
+	buckets := make(map[string]bool)
+	projects := make(map[string]bool)
+	addPort := func(addr string) error {
+		_, port, err := net.SplitHostPort(addr)
+		if err != nil {
+			return fmt.Errorf("bad http address %q: %v", addr, err)
+		}
+		ports[port] = true
+		return nil
+	}
+	if err := addPort(cfg.HTTP); err != nil {
+		return nil, err
+	}
+	for _, mgr := range cfg.Managers {
+		// Only few fields are needed, and the config is already validated by loadConfig.
+		mgrcfg := new(struct {
+			HTTP string          `json:"http"`
+			Type string          `json:"type"`
+			VM   json.RawMessage `json:"vm"`
+		})
+		if err := json.Unmarshal(mgr.ManagerConfig, mgrcfg); err != nil {
+			return nil, fmt.Errorf("manager %v: failed to parse manager config: %v", mgr.Name, err)
+		}
+		if mgrcfg.HTTP != "" {
+			if err := addPort(mgrcfg.HTTP); err != nil {
+				return nil, fmt.Errorf("manager %v: %v", mgr.Name, err)
+			}
+		}
+		if mgrcfg.Type != "gce" {
+			continue
+		}
+		vm := new(struct {
+			GCSPath      string `json:"gcs_path"`
+			ImageProject string `json:"image_project"`
+		})
+		if err := json.Unmarshal(mgrcfg.VM, vm); err != nil {
+			return nil, fmt.Errorf("manager %v: failed to parse vm config: %v", mgr.Name, err)
+		}
+		if vm.GCSPath != "" {
+			buckets[strings.SplitN(vm.GCSPath, "/", 2)[0]] = true
+		}
+		if vm.ImageProject != "" {
+			projects[vm.ImageProject] = true
+		}
+	}
+	desc.Ports = sortedKeys(ports)
+	for _, bucket := range sortedKeys(buckets) {
+		desc.Buckets = append(desc.Buckets, terraformBucket{terraformID(bucket), bucket})
+	}
+	for _, project := range sortedKeys(projects) {
+		desc.ImageProjects = append(desc.ImageProjects, terraformProject{terraformID(project), project})
+	}
+	buf := new(bytes.Buffer)
+	if err := terraformTemplate.Execute(buf, desc); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func sortedKeys(m map[string]bool) []string {
+	var keys []string
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+var terraformIDRe = regexp.MustCompile("[^a-zA-Z0-9_]")
+
+func terraformID(name string) string {
+	return terraformIDRe.ReplaceAllString(name, "_")
+}
+
+var terraformTemplate = template.Must(template.New("").Parse(`# Generated by syz-ci -emit-terraform from {{.ConfigFile}}.
+
+variable "project" {}
+variable "zone" {}
+variable "network" { default = "default" }
+variable "machine_type" { default = "n1-standard-8" }
+variable "image" { default = "ubuntu-os-cloud/ubuntu-1804-lts" }
+variable "disk_size" { default = 500 }
+variable "http_source_ranges" { default = ["0.0.0.0/0"] }
+
+provider "google" {
+	project = "${var.project}"
+	zone    = "${var.zone}"
+}
+
+resource "google_service_account" "{{.ID}}" {
+	account_id   = "{{.Name}}-syz-ci"
+	display_name = "syz-ci {{.Name}}"
+}
+
+# syz-ci creates and deletes VMs and images for managers.
+resource "google_project_iam_member" "{{.ID}}_compute" {
+	role   = "roles/compute.instanceAdmin.v1"
+	member = "serviceAccount:${google_service_account.{{.ID}}.email}"
+}
+
+resource "google_project_iam_member" "{{.ID}}_images" {
+	role   = "roles/compute.storageAdmin"
+	member = "serviceAccount:${google_service_account.{{.ID}}.email}"
+}
+
+resource "google_project_iam_member" "{{.ID}}_sa_user" {
+	role   = "roles/iam.serviceAccountUser"
+	member = "serviceAccount:${google_service_account.{{.ID}}.email}"
+}
+{{range $project := .ImageProjects}}
+resource "google_project_iam_member" "{{$.ID}}_images_{{$project.ID}}" {
+	project = "{{$project.Name}}"
+	role    = "roles/compute.storageAdmin"
+	member  = "serviceAccount:${google_service_account.{{$.ID}}.email}"
+}
+{{end}}{{range $bucket := .Buckets}}
+resource "google_storage_bucket" "{{$bucket.ID}}" {
+	name = "{{$bucket.Name}}"
+}
+
+resource "google_storage_bucket_iam_member" "{{$.ID}}_{{$bucket.ID}}" {
+	bucket = "${google_storage_bucket.{{$bucket.ID}}.name}"
+	role   = "roles/storage.objectAdmin"
+	member = "serviceAccount:${google_service_account.{{$.ID}}.email}"
+}
+{{end}}
+resource "google_compute_firewall" "{{.ID}}_http" {
+	name          = "{{.Name}}-http"
+	network       = "${var.network}"
+	source_ranges = "${var.http_source_ranges}"
+	target_tags   = ["{{.Name}}"]
+	allow {
+		protocol = "tcp"
+		ports    = [{{range $i, $port := .Ports}}{{if $i}}, {{end}}"{{$port}}"{{end}}]
+	}
+}
+
+resource "google_compute_instance" "{{.ID}}" {
+	name         = "{{.Name}}"
+	machine_type = "${var.machine_type}"
+	tags         = ["{{.Name}}"]
+	boot_disk {
+		initialize_params {
+			image = "${var.image}"
+			size  = "${var.disk_size}"
+		}
+	}
+	network_interface {
+		network = "${var.network}"
+		access_config {}
+	}
+	service_account {
+		email  = "${google_service_account.{{.ID}}.email}"
+		scopes = ["cloud-platform"]
+	}
+	# syz-ci -config=gce-metadata reads the config from this attribute.
+	metadata {
+		syz-ci-config = "${file("{{.ConfigFile}}")}"
+	}
+}
+`))
@@ -0,0 +1,47 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestEmitTerraform(t *testing.T) {
+	cfg := &Config{
+		Name: "ci-upstream",
+		HTTP: ":80",
+		Managers: []*ManagerConfig{
+			{
+				Name: "upstream-kasan",
+				ManagerConfig: []byte(`{"http": ":10000", "type": "gce",
+					"vm": {"gcs_path": "syz-images/upstream", "image_project": "syz-images-project"}}`),
+			},
+			{
+				Name:          "upstream-qemu",
+				ManagerConfig: []byte(`{"http": ":10001", "type": "qemu", "vm": {"count": 4}}`),
+			},
+		},
+	}
+	data, err := emitTerraform(cfg, "ci.cfg")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		`resource "google_compute_instance" "ci_upstream"`,
+		`resource "google_storage_bucket" "syz_images"`,
+		`name = "syz-images"`,
+		`project = "syz-images-project"`,
+		`ports    = ["10000", "10001", "80"]`,
+		`syz-ci-config = "${file("ci.cfg")}"`,
+	} {
+		if !strings.Contains(string(data), want) {
+			t.Errorf("output does not contain %q:\n%s", want, data)
+		}
+	}
+	cfg.HTTP = "80"
+	if _, err := emitTerraform(cfg, "ci.cfg"); err == nil {
+		t.Errorf("bad http address is not detected")
+	}
+}
@@ -0,0 +1,180 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+
+	"github.com/google/syzkaller/pkg/log"
+)
+
+// managerUpdate is a manager restart on a new build and/or with a new config.
+// Before the restart syz-ci shows diff of the new manager config and kernel commit
+// against the running ones (in the log and on the status page). If confirm_updates is set,
+// running managers are restarted only after the update is confirmed with /control/confirm.
+type managerUpdate struct {
+	info      *BuildInfo
+	buildTag  string
+	config    []byte // new manager config
+	diff      string // see configDiff, empty if the manager is not running yet
+	confirmed bool
+}
+
+// prepareUpdate prepares restart of the manager on the latest build.
+// If the same update is already pending, it is returned.
+func (mgr *Manager) prepareUpdate() (*managerUpdate, error) {
+	info, err := loadBuildInfo(mgr.latestDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load build info: %v", err)
+	}
+	if update := mgr.pendingUpdate(); update != nil && update.info.Tag == info.Tag &&
+		update.info.Time.Equal(info.Time) {
+		return update, nil
+	}
+	if mgr.canary != nil && mgr.canary.update.info.Tag == info.Tag && mgr.canary.update.info.Time.Equal(info.Time) {
+		return mgr.canary.update, nil
+	}
+	buildTag, err := mgr.uploadBuild(info, mgr.latestDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to upload build: %v", err)
+	}
+	config, err := mgr.createConfig(buildTag)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create manager config: %v", err)
+	}
+	update := &managerUpdate{
+		info:     info,
+		buildTag: buildTag,
+		config:   config,
+	}
+	if mgr.cmd == nil {
+		return update, nil
+	}
+	oldConfig, err := ioutil.ReadFile(filepath.Join(mgr.currentDir, "manager.cfg"))
+	if err != nil {
+		return update, nil
+	}
+	diff, err := configDiff(oldConfig, config)
+	if err != nil {
+		return nil, err
+	}
+	if oldInfo, err := loadBuildInfo(mgr.currentDir); err == nil && oldInfo.KernelCommit != info.KernelCommit {
+		diff = fmt.Sprintf("- kernel: %v %v\n+ kernel: %v %v\n%v",
+			oldInfo.KernelCommit, oldInfo.KernelCommitTitle, info.KernelCommit, info.KernelCommitTitle, diff)
+	}
+	update.diff = diff
+	if diff != "" {
+		log.Logf(0, "%v: manager update:\n%v", mgr.name, diff)
+	}
+	return update, nil
+}
+
+// confirmUpdate returns true if the update can be applied right away.
+// Otherwise the update becomes pending until it is confirmed.
+func (mgr *Manager) confirmUpdate(update *managerUpdate) bool {
+	mgr.status.mu.Lock()
+	defer mgr.status.mu.Unlock()
+	if !mgr.cfg.ConfirmUpdates || update.diff == "" || update.confirmed {
+		mgr.status.update = nil
+		mgr.status.lastUpdate = update.diff
+		return true
+	}
+	if mgr.status.update != update {
+		log.Logf(0, "%v: manager update is waiting for confirmation", mgr.name)
+		mgr.status.update = update
+	}
+	return false
+}
+
+func (mgr *Manager) pendingUpdate() *managerUpdate {
+	mgr.status.mu.Lock()
+	defer mgr.status.mu.Unlock()
+	return mgr.status.update
+}
+
+// confirmPendingUpdate confirms the pending update, returns false if there is no pending update.
+func (mgr *Manager) confirmPendingUpdate() bool {
+	mgr.status.mu.Lock()
+	update := mgr.status.update
+	if update != nil {
+		update.confirmed = true
+	}
+	mgr.status.mu.Unlock()
+	if update == nil {
+		return false
+	}
+	select {
+	case mgr.wake <- struct{}{}:
+	default:
+	}
+	return true
+}
+
+// configDiff returns human-readable diff of two JSON configs, one line per changed parameter:
+// "- param: old value" and/or "+ param: new value". Nested parameters are named as "vm.count".
+// Returns an empty string if the configs are equal.
+func configDiff(oldData, newData []byte) (string, error) {
+	oldParams, err := flattenConfig(oldData)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse old config: %v", err)
+	}
+	newParams, err := flattenConfig(newData)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse new config: %v", err)
+	}
+	var names []string
+	for name := range oldParams {
+		names = append(names, name)
+	}
+	for name := range newParams {
+		if _, ok := oldParams[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	diff := new(bytes.Buffer)
+	for _, name := range names {
+		oldVal, oldOk := oldParams[name]
+		newVal, newOk := newParams[name]
+		if oldOk && newOk && oldVal == newVal {
+			continue
+		}
+		if oldOk {
+			fmt.Fprintf(diff, "- %v: %v\n", name, oldVal)
+		}
+		if newOk {
+			fmt.Fprintf(diff, "+ %v: %v\n", name, newVal)
+		}
+	}
+	return diff.String(), nil
+}
+
+func flattenConfig(data []byte) (map[string]string, error) {
+	var cfg interface{}
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return nil, err
+	}
+	params := make(map[string]string)
+	var flatten func(name string, v interface{})
+	flatten = func(name string, v interface{}) {
+		if obj, ok := v.(map[string]interface{}); ok && len(obj) != 0 {
+			for key, val := range obj {
+				if name != "" {
+					key = name + "." + key
+				}
+				flatten(key, val)
+			}
+			return
+		}
+		data, _ := json.Marshal(v)
+		params[name] = string(data)
+	}
+	flatten("", cfg)
+	return params, nil
+}
@@ -0,0 +1,73 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+)
+
+func TestConfigDiff(t *testing.T) {
+	tests := []struct {
+		old  string
+		new  string
+		diff string
+	}{
+		{
+			old:  `{"name": "foo", "vm": {"count": 2}}`,
+			new:  `{"vm": {"count": 2}, "name": "foo"}`,
+			diff: "",
+		},
+		{
+			old: `{"name": "foo", "tag": "a", "vm": {"count": 2, "cpu": 1}, "enable_syscalls": ["open"]}`,
+			new: `{"name": "foo", "tag": "b", "vm": {"count": 4, "mem": 1024}, "enable_syscalls": ["open", "read"]}`,
+			diff: "- enable_syscalls: [\"open\"]\n" +
+				"+ enable_syscalls: [\"open\",\"read\"]\n" +
+				"- tag: \"a\"\n" +
+				"+ tag: \"b\"\n" +
+				"- vm.count: 2\n" +
+				"+ vm.count: 4\n" +
+				"- vm.cpu: 1\n" +
+				"+ vm.mem: 1024\n",
+		},
+	}
+	for i, test := range tests {
+		diff, err := configDiff([]byte(test.old), []byte(test.new))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if diff != test.diff {
+			t.Errorf("test #%v: got diff:\n%v\nwant:\n%v", i, diff, test.diff)
+		}
+	}
+	if _, err := configDiff([]byte("{"), []byte("{}")); err == nil {
+		t.Fatalf("diffed broken config")
+	}
+}
+
+func TestConfirmUpdate(t *testing.T) {
+	mgr := &Manager{
+		cfg:  &Config{ConfirmUpdates: true},
+		wake: make(chan struct{}, 1),
+	}
+	if !mgr.confirmUpdate(&managerUpdate{}) {
+		t.Fatalf("update without diff is not applied")
+	}
+	update := &managerUpdate{diff: "- tag: a\n+ tag: b\n"}
+	if mgr.confirmUpdate(update) || mgr.pendingUpdate() != update {
+		t.Fatalf("update is not pending")
+	}
+	if !mgr.confirmPendingUpdate() {
+		t.Fatalf("failed to confirm update")
+	}
+	if !mgr.confirmUpdate(update) || mgr.pendingUpdate() != nil || mgr.status.lastUpdate != update.diff {
+		t.Fatalf("confirmed update is not applied")
+	}
+	if mgr.confirmPendingUpdate() {
+		t.Fatalf("confirmed non-existent update")
+	}
+	mgr.cfg.ConfirmUpdates = false
+	if !mgr.confirmUpdate(&managerUpdate{diff: "- tag: b\n+ tag: c\n"}) {
+		t.Fatalf("update is not applied without confirm_updates")
+	}
+}
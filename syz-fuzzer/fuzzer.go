@@ -11,14 +11,20 @@ import (
 	"os"
 	"runtime"
 	"runtime/debug"
+	"sort"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
 
+	"github.com/google/syzkaller/pkg/callstats"
+	"github.com/google/syzkaller/pkg/creds"
+	"github.com/google/syzkaller/pkg/experiment"
 	"github.com/google/syzkaller/pkg/hash"
 	"github.com/google/syzkaller/pkg/host"
 	"github.com/google/syzkaller/pkg/ipc"
 	"github.com/google/syzkaller/pkg/log"
+	"github.com/google/syzkaller/pkg/mutator"
 	"github.com/google/syzkaller/pkg/osutil"
 	"github.com/google/syzkaller/pkg/rpctype"
 	"github.com/google/syzkaller/pkg/signal"
@@ -36,9 +42,12 @@ type Fuzzer struct {
 	workQueue   *WorkQueue
 	needPoll    chan struct{}
 	choiceTable *prog.ChoiceTable
+	mutators    *mutator.Set
 	stats       [StatCount]uint64
 	manager     *rpctype.RPCClient
 	target      *prog.Target
+	crashTrail  int           // size of the coverage trail of every proc (see pcTrail), 0 if disabled
+	creds       []creds.Creds // credential contexts for fuzzing programs, empty to always run as root
 
 	faultInjectionEnabled    bool
 	comparisonTracingEnabled bool
@@ -52,6 +61,9 @@ type Fuzzer struct {
 	maxSignal    signal.Signal // max signal ever observed including flakes
 	newSignal    signal.Signal // diff of maxSignal since last sync with master
 
+	callStatsMu sync.Mutex
+	callStats   callstats.Set // sampled stats of executed calls since last sync with master
+
 	logMu sync.Mutex
 }
 
@@ -80,6 +92,9 @@ var statNames = [StatCount]string{
 	StatSeed:      "exec seeds",
 }
 
+// Priority multiplier for calls that fail with permission errors (see probeCalls).
+const downweightedCallPrio = 0.1
+
 type OutputType int
 
 const (
@@ -168,7 +183,12 @@ func main() {
 	if err != nil {
 		log.Fatalf("failed to connect to manager: %v ", err)
 	}
-	a := &rpctype.ConnectArgs{Name: *flagName}
+	// Modules are reported on every connect, because they can differ between VMs and boots.
+	modules, err := host.KernelModules()
+	if err != nil {
+		log.Logf(0, "failed to read loaded kernel modules: %v", err)
+	}
+	a := &rpctype.ConnectArgs{Name: *flagName, Modules: modules}
 	r := &rpctype.ConnectRes{}
 	if err := manager.Call("Manager.Connect", a, r); err != nil {
 		log.Fatalf("failed to connect to manager: %v ", err)
@@ -206,6 +226,39 @@ func main() {
 		config.Flags |= ipc.FlagEnableFault
 	}
 
+	if len(r.FeatureFlags) != 0 {
+		log.Logf(0, "feature flags: %v", r.FeatureFlags)
+	}
+	mutatorNames := flagMutators(r.Mutators, r.FeatureFlags)
+	if len(mutatorNames) == 0 {
+		log.Fatalf("feature flags disabled all mutators")
+	}
+	mutators, err := mutator.New(target, mutatorNames)
+	if err != nil {
+		log.Fatalf("%v", err)
+	}
+
+	if r.CrashTrail != 0 && config.Flags&ipc.FlagSignal != 0 {
+		// The trail needs coverage of all executed programs, not just signal.
+		execOpts.Flags |= ipc.FlagCollectCover
+	} else {
+		r.CrashTrail = 0
+	}
+
+	var fuzzCreds []creds.Creds
+	for _, c := range r.Creds {
+		parsed, err := creds.Parse(c)
+		if err != nil {
+			log.Fatalf("%v", err)
+		}
+		fuzzCreds = append(fuzzCreds, parsed)
+	}
+
+	faultInjection := r.CheckResult.Features[host.FeatureFaultInjection].Enabled &&
+		featureFlag(r.FeatureFlags, experiment.FlagFaultInjection)
+	comparisonTracing := r.CheckResult.Features[host.FeatureComparisons].Enabled &&
+		featureFlag(r.FeatureFlags, experiment.FlagComparisons)
+
 	needPoll := make(chan struct{}, 1)
 	needPoll <- struct{}{}
 	fuzzer := &Fuzzer{
@@ -218,8 +271,11 @@ func main() {
 		needPoll:                 needPoll,
 		manager:                  manager,
 		target:                   target,
-		faultInjectionEnabled:    r.CheckResult.Features[host.FeatureFaultInjection].Enabled,
-		comparisonTracingEnabled: r.CheckResult.Features[host.FeatureComparisons].Enabled,
+		mutators:                 mutators,
+		crashTrail:               r.CrashTrail,
+		creds:                    fuzzCreds,
+		faultInjectionEnabled:    faultInjection,
+		comparisonTracingEnabled: comparisonTracing,
 		corpusHashes:             make(map[hash.Sig]struct{}),
 	}
 	for i := 0; fuzzer.poll(i == 0, nil); i++ {
@@ -229,6 +285,17 @@ func main() {
 		calls[target.Syscalls[id]] = true
 	}
 	prios := target.CalculatePriorities(fuzzer.corpus)
+	for _, dc := range r.CheckResult.DownweightedCalls {
+		for _, row := range prios {
+			row[dc.ID] *= downweightedCallPrio
+		}
+	}
+	for id, w := range r.CallWeights {
+		for _, row := range prios {
+			row[id] *= float32(w)
+		}
+	}
+	target.ApplyCallBudgets(prios, calls, r.CallBudgets)
 	fuzzer.choiceTable = target.BuildChoiceTable(prios, calls)
 
 	for pid := 0; pid < *flagProcs; pid++ {
@@ -243,6 +310,39 @@ func main() {
 	fuzzer.pollLoop()
 }
 
+// featureFlag says if the experimental behavior is enabled on this VM,
+// behaviors that are not controlled by feature flags are enabled on all VMs.
+func featureFlag(flags map[string]bool, name string) bool {
+	enabled, ok := flags[name]
+	return !ok || enabled
+}
+
+// flagMutators returns mutation strategies enabled on this VM: mutators from the manager config
+// (all registered strategies if empty) without strategies that are flagged off on this VM
+// and with strategies that are flagged on.
+func flagMutators(names []string, flags map[string]bool) []string {
+	if len(names) == 0 {
+		names = mutator.Names()
+	}
+	var res []string
+	have := make(map[string]bool)
+	for _, name := range names {
+		if featureFlag(flags, experiment.FlagMutator+name) {
+			res = append(res, name)
+			have[name] = true
+		}
+	}
+	var flagged []string
+	for flag, enabled := range flags {
+		name := strings.TrimPrefix(flag, experiment.FlagMutator)
+		if enabled && name != flag && !have[name] {
+			flagged = append(flagged, name)
+		}
+	}
+	sort.Strings(flagged)
+	return append(res, flagged...)
+}
+
 func (fuzzer *Fuzzer) pollLoop() {
 	var execTotal uint64
 	var lastPoll time.Time
@@ -260,6 +360,9 @@ func (fuzzer *Fuzzer) pollLoop() {
 			log.Logf(0, "alive, executed %v", execTotal)
 			lastPrint = time.Now()
 		}
+		if fuzzer.crashTrail != 0 && !poll {
+			fuzzer.sendTrail()
+		}
 		if poll || time.Since(lastPoll) > 10*time.Second {
 			needCandidates := fuzzer.workQueue.wantCandidates()
 			if poll && !needCandidates {
@@ -275,6 +378,9 @@ func (fuzzer *Fuzzer) pollLoop() {
 				stats[statNames[stat]] = v
 				execTotal += v
 			}
+			for k, v := range fuzzer.mutators.Stats() {
+				stats[k] = v
+			}
 			if !fuzzer.poll(needCandidates, stats) {
 				lastPoll = time.Now()
 			}
@@ -288,6 +394,7 @@ func (fuzzer *Fuzzer) poll(needCandidates bool, stats map[string]uint64) bool {
 		NeedCandidates: needCandidates,
 		MaxSignal:      fuzzer.grabNewSignal().Serialize(),
 		Stats:          stats,
+		CallStats:      fuzzer.grabCallStats(),
 	}
 	r := &rpctype.PollRes{}
 	if err := fuzzer.manager.Call("Manager.Poll", a, r); err != nil {
@@ -312,17 +419,23 @@ func (fuzzer *Fuzzer) poll(needCandidates bool, stats map[string]uint64) bool {
 		if candidate.Smashed {
 			flags |= ProgSmashed
 		}
+		if candidate.Reminimize {
+			flags |= ProgReminimize
+		}
 		fuzzer.workQueue.enqueue(&WorkCandidate{
-			p:     p,
-			flags: flags,
+			p:        p,
+			flags:    flags,
+			call:     candidate.Call,
+			replaces: hash.String(candidate.Prog),
 		})
 	}
 	return len(r.NewInputs) != 0 || len(r.Candidates) != 0 || maxSignal.Len() != 0
 }
 
-func (fuzzer *Fuzzer) sendInputToManager(inp rpctype.RPCInput) {
+func (fuzzer *Fuzzer) sendInputToManager(inp rpctype.RPCInput, replaces string) {
 	a := &rpctype.NewInputArgs{
 		Name:     fuzzer.name,
+		Replaces: replaces,
 		RPCInput: inp,
 	}
 	if err := fuzzer.manager.Call("Manager.NewInput", a, nil); err != nil {
@@ -371,6 +484,33 @@ func (fuzzer *Fuzzer) addMaxSignal(sign signal.Signal) {
 	fuzzer.maxSignal.Merge(sign)
 }
 
+// callStatsSampling is how often (1 out of callStatsSampling executed programs)
+// results of executed calls are added to call stats.
+const callStatsSampling = 16
+
+func (fuzzer *Fuzzer) addCallStats(p *prog.Prog, info []ipc.CallInfo) {
+	fuzzer.callStatsMu.Lock()
+	defer fuzzer.callStatsMu.Unlock()
+	if fuzzer.callStats == nil {
+		fuzzer.callStats = make(callstats.Set)
+	}
+	for i, inf := range info {
+		// Injected faults make calls fail artificially.
+		if !inf.Executed || inf.FaultInjected {
+			continue
+		}
+		fuzzer.callStats.Add(p.Calls[i].Meta.Name, inf.Errno, inf.Duration)
+	}
+}
+
+func (fuzzer *Fuzzer) grabCallStats() callstats.Set {
+	fuzzer.callStatsMu.Lock()
+	defer fuzzer.callStatsMu.Unlock()
+	stats := fuzzer.callStats
+	fuzzer.callStats = nil
+	return stats
+}
+
 func (fuzzer *Fuzzer) grabNewSignal() signal.Signal {
 	fuzzer.signalMu.Lock()
 	defer fuzzer.signalMu.Unlock()
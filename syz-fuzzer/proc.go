@@ -9,14 +9,17 @@ import (
 	"math/rand"
 	"os"
 	"runtime/debug"
+	"strings"
 	"sync/atomic"
 	"syscall"
 	"time"
 
 	"github.com/google/syzkaller/pkg/cover"
+	"github.com/google/syzkaller/pkg/creds"
 	"github.com/google/syzkaller/pkg/hash"
 	"github.com/google/syzkaller/pkg/ipc"
 	"github.com/google/syzkaller/pkg/log"
+	"github.com/google/syzkaller/pkg/mutator"
 	"github.com/google/syzkaller/pkg/rpctype"
 	"github.com/google/syzkaller/pkg/signal"
 	"github.com/google/syzkaller/prog"
@@ -36,6 +39,7 @@ type Proc struct {
 	execOptsCover     *ipc.ExecOpts
 	execOptsComps     *ipc.ExecOpts
 	execOptsNoCollide *ipc.ExecOpts
+	trail             *pcTrail
 }
 
 func newProc(fuzzer *Fuzzer, pid int) (*Proc, error) {
@@ -49,6 +53,7 @@ func newProc(fuzzer *Fuzzer, pid int) (*Proc, error) {
 	execOptsCover := execOptsNoCollide
 	execOptsCover.Flags |= ipc.FlagCollectCover
 	execOptsComps := execOptsNoCollide
+	execOptsComps.Flags &^= ipc.FlagCollectCover
 	execOptsComps.Flags |= ipc.FlagCollectComps
 	proc := &Proc{
 		fuzzer:            fuzzer,
@@ -59,6 +64,7 @@ func newProc(fuzzer *Fuzzer, pid int) (*Proc, error) {
 		execOptsCover:     &execOptsCover,
 		execOptsComps:     &execOptsComps,
 		execOptsNoCollide: &execOptsNoCollide,
+		trail:             newPCTrail(fuzzer.crashTrail),
 	}
 	return proc, nil
 }
@@ -77,7 +83,11 @@ func (proc *Proc) loop() {
 			case *WorkTriage:
 				proc.triageInput(item)
 			case *WorkCandidate:
-				proc.execute(proc.execOpts, item.p, item.flags, StatCandidate)
+				if item.flags&ProgReminimize != 0 {
+					proc.reminimizeCandidate(item)
+				} else {
+					proc.execute(proc.execOpts, item.p, item.flags, StatCandidate)
+				}
 			case *WorkSmash:
 				proc.smashInput(item)
 			default:
@@ -92,28 +102,52 @@ func (proc *Proc) loop() {
 			// Generate a new prog.
 			p := proc.fuzzer.target.Generate(proc.rnd, programLength, ct)
 			log.Logf(1, "#%v: generated", proc.pid)
-			proc.execute(proc.execOpts, p, ProgNormal, StatGenerate)
+			proc.execute(proc.fuzzOpts(), p, ProgNormal, StatGenerate)
 		} else {
 			// Mutate an existing prog.
 			p := corpus[proc.rnd.Intn(len(corpus))].Clone()
-			p.Mutate(proc.rnd, programLength, ct, corpus)
-			log.Logf(1, "#%v: mutated", proc.pid)
-			proc.execute(proc.execOpts, p, ProgNormal, StatFuzz)
+			proc.mutate(p, ct, corpus, proc.fuzzOpts(), StatFuzz)
 		}
 	}
 }
 
+// fuzzOpts returns execution options for a new fuzzing program
+// with a random credential context (if contexts are configured).
+func (proc *Proc) fuzzOpts() *ipc.ExecOpts {
+	if len(proc.fuzzer.creds) == 0 {
+		return proc.execOpts
+	}
+	return withCreds(proc.execOpts, proc.fuzzer.creds[proc.rnd.Intn(len(proc.fuzzer.creds))])
+}
+
+// withCreds returns opts with credential context c.
+// Programs that give new signal are triaged and smashed in the context they were executed in.
+func withCreds(opts *ipc.ExecOpts, c creds.Creds) *ipc.ExecOpts {
+	if opts.Creds == c {
+		return opts
+	}
+	opts1 := *opts
+	opts1.Creds = c
+	return &opts1
+}
+
 func (proc *Proc) triageInput(item *WorkTriage) {
 	log.Logf(1, "#%v: triaging type=%x", proc.pid, item.flags)
 
 	call := item.p.Calls[item.call]
 	inputSignal := signal.FromRaw(item.info.Signal, signalPrio(item.p.Target, call, &item.info))
 	newSignal := proc.fuzzer.corpusSignalDiff(inputSignal)
+	if item.replaces != "" {
+		// The input is already in corpus, the minimized program must preserve all of its signal.
+		newSignal = inputSignal
+	}
 	if newSignal.Empty() {
 		return
 	}
 	log.Logf(3, "triaging input for %v (new signal=%v)", call.Meta.CallName, newSignal.Len())
 	var inputCover cover.Cover
+	execOptsCover := withCreds(proc.execOptsCover, item.creds)
+	execOptsNoCollide := withCreds(proc.execOptsNoCollide, item.creds)
 	const (
 		signalRuns       = 3
 		minimizeAttempts = 3
@@ -121,7 +155,7 @@ func (proc *Proc) triageInput(item *WorkTriage) {
 	// Compute input coverage and non-flaky signal for minimization.
 	notexecuted := 0
 	for i := 0; i < signalRuns; i++ {
-		info := proc.executeRaw(proc.execOptsCover, item.p, StatTriage)
+		info := proc.executeRaw(execOptsCover, item.p, StatTriage)
 		if len(info) == 0 || len(info[item.call].Signal) == 0 ||
 			item.info.Errno == 0 && info[item.call].Errno != 0 {
 			// The call was not executed or failed.
@@ -145,7 +179,7 @@ func (proc *Proc) triageInput(item *WorkTriage) {
 		item.p, item.call = prog.Minimize(item.p, item.call, false,
 			func(p1 *prog.Prog, call1 int) bool {
 				for i := 0; i < minimizeAttempts; i++ {
-					info := proc.execute(proc.execOptsNoCollide, p1, ProgNormal, StatMinimize)
+					info := proc.execute(execOptsNoCollide, p1, ProgNormal, StatMinimize)
 					if len(info) == 0 || len(info[call1].Signal) == 0 {
 						continue // The call was not executed.
 					}
@@ -174,35 +208,82 @@ func (proc *Proc) triageInput(item *WorkTriage) {
 		Prog:   data,
 		Signal: inputSignal.Serialize(),
 		Cover:  inputCover.Serialize(),
-	})
+	}, item.replaces)
 
+	if item.replaces != "" {
+		// The original input is already in our corpus.
+		return
+	}
 	proc.fuzzer.addInputToCorpus(item.p, inputSignal, sig)
 
 	if item.flags&ProgSmashed == 0 {
-		proc.fuzzer.workQueue.enqueue(&WorkSmash{item.p, item.call})
+		proc.fuzzer.workQueue.enqueue(&WorkSmash{item.p, item.call, item.creds})
+	}
+}
+
+// reminimizeCandidate executes a corpus input sent by manager for re-minimization
+// and queues triage of the call the input was triaged for (the last call with the name).
+func (proc *Proc) reminimizeCandidate(item *WorkCandidate) {
+	callIndex := -1
+	for i, c := range item.p.Calls {
+		if c.Meta.CallName == item.call {
+			callIndex = i
+		}
+	}
+	if callIndex == -1 {
+		return
+	}
+	info := proc.executeRaw(proc.execOptsNoCollide, item.p, StatCandidate)
+	if len(info) <= callIndex || len(info[callIndex].Signal) == 0 {
+		// The call was not executed, manager keeps the original input.
+		return
 	}
+	inf := info[callIndex]
+	// info.Signal points to the output shmem region, detach it before queueing.
+	inf.Signal = append([]uint32{}, inf.Signal...)
+	inf.Cover = nil
+	proc.fuzzer.workQueue.enqueue(&WorkTriage{
+		p:        item.p,
+		call:     callIndex,
+		info:     inf,
+		flags:    item.flags,
+		replaces: item.replaces,
+	})
 }
 
 func (proc *Proc) smashInput(item *WorkSmash) {
 	if proc.fuzzer.faultInjectionEnabled {
-		proc.failCall(item.p, item.call)
+		proc.failCall(item.p, item.call, item.creds)
 	}
 	if proc.fuzzer.comparisonTracingEnabled {
-		proc.executeHintSeed(item.p, item.call)
+		proc.executeHintSeed(item.p, item.call, item.creds)
 	}
 	corpus := proc.fuzzer.corpusSnapshot()
+	execOpts := withCreds(proc.execOpts, item.creds)
 	for i := 0; i < 100; i++ {
 		p := item.p.Clone()
-		p.Mutate(proc.rnd, programLength, proc.fuzzer.choiceTable, corpus)
-		log.Logf(1, "#%v: smash mutated", proc.pid)
-		proc.execute(proc.execOpts, p, ProgNormal, StatSmash)
+		proc.mutate(p, proc.fuzzer.choiceTable, corpus, execOpts, StatSmash)
 	}
 }
 
-func (proc *Proc) failCall(p *prog.Prog, call int) {
+// mutate mutates p with one of the enabled mutation strategies, executes it
+// and gives feedback about new signal to the strategy.
+func (proc *Proc) mutate(p *prog.Prog, ct *prog.ChoiceTable, corpus []*prog.Prog, execOpts *ipc.ExecOpts,
+	stat Stat) {
+	feedback := proc.fuzzer.mutators.Mutate(p, proc.rnd, &mutator.Context{
+		NCalls:      programLength,
+		ChoiceTable: ct,
+		Corpus:      corpus,
+	})
+	log.Logf(1, "#%v: mutated", proc.pid)
+	_, newSignalCalls := proc.executeAndCheck(execOpts, p, ProgNormal, stat)
+	feedback(newSignalCalls)
+}
+
+func (proc *Proc) failCall(p *prog.Prog, call int, c creds.Creds) {
 	for nth := 0; nth < 100; nth++ {
 		log.Logf(1, "#%v: injecting fault into call %v/%v", proc.pid, call, nth)
-		opts := *proc.execOpts
+		opts := *withCreds(proc.execOpts, c)
 		opts.Flags |= ipc.FlagInjectFault
 		opts.FaultCall = call
 		opts.FaultNth = nth
@@ -213,10 +294,10 @@ func (proc *Proc) failCall(p *prog.Prog, call int) {
 	}
 }
 
-func (proc *Proc) executeHintSeed(p *prog.Prog, call int) {
+func (proc *Proc) executeHintSeed(p *prog.Prog, call int, c creds.Creds) {
 	log.Logf(1, "#%v: collecting comparisons", proc.pid)
 	// First execute the original program to dump comparisons from KCOV.
-	info := proc.execute(proc.execOptsComps, p, ProgNormal, StatSeed)
+	info := proc.execute(withCreds(proc.execOptsComps, c), p, ProgNormal, StatSeed)
 	if info == nil {
 		return
 	}
@@ -226,13 +307,21 @@ func (proc *Proc) executeHintSeed(p *prog.Prog, call int) {
 	// Execute each of such mutants to check if it gives new coverage.
 	p.MutateWithHints(call, info[call].Comps, func(p *prog.Prog) {
 		log.Logf(1, "#%v: executing comparison hint", proc.pid)
-		proc.execute(proc.execOpts, p, ProgNormal, StatHint)
+		proc.execute(withCreds(proc.execOpts, c), p, ProgNormal, StatHint)
 	})
 }
 
 func (proc *Proc) execute(execOpts *ipc.ExecOpts, p *prog.Prog, flags ProgTypes, stat Stat) []ipc.CallInfo {
+	info, _ := proc.executeAndCheck(execOpts, p, flags, stat)
+	return info
+}
+
+// executeAndCheck executes p, queues calls with new signal for triage and returns their indexes.
+func (proc *Proc) executeAndCheck(execOpts *ipc.ExecOpts, p *prog.Prog, flags ProgTypes,
+	stat Stat) ([]ipc.CallInfo, []int) {
 	info := proc.executeRaw(execOpts, p, stat)
-	for _, callIndex := range proc.fuzzer.checkNewSignal(p, info) {
+	newSignalCalls := proc.fuzzer.checkNewSignal(p, info)
+	for _, callIndex := range newSignalCalls {
 		info := info[callIndex]
 		// info.Signal points to the output shmem region, detach it before queueing.
 		info.Signal = append([]uint32{}, info.Signal...)
@@ -244,9 +333,10 @@ func (proc *Proc) execute(execOpts *ipc.ExecOpts, p *prog.Prog, flags ProgTypes,
 			call:  callIndex,
 			info:  info,
 			flags: flags,
+			creds: execOpts.Creds,
 		})
 	}
-	return info
+	return info, newSignalCalls
 }
 
 func (proc *Proc) executeRaw(opts *ipc.ExecOpts, p *prog.Prog, stat Stat) []ipc.CallInfo {
@@ -280,6 +370,10 @@ retry:
 		goto retry
 	}
 	log.Logf(2, "result failed=%v hanged=%v: %v\n", failed, hanged, string(output))
+	proc.trail.add(info)
+	if proc.rnd.Intn(callStatsSampling) == 0 {
+		proc.fuzzer.addCallStats(p, info)
+	}
 	return info
 }
 
@@ -289,9 +383,16 @@ func (proc *Proc) logProgram(opts *ipc.ExecOpts, p *prog.Prog) {
 	}
 
 	data := p.Serialize()
-	strOpts := ""
+	var strOptsList []string
 	if opts.Flags&ipc.FlagInjectFault != 0 {
-		strOpts = fmt.Sprintf(" (fault-call:%v fault-nth:%v)", opts.FaultCall, opts.FaultNth)
+		strOptsList = append(strOptsList, fmt.Sprintf("fault-call:%v fault-nth:%v", opts.FaultCall, opts.FaultNth))
+	}
+	if !opts.Creds.IsRoot() {
+		strOptsList = append(strOptsList, "creds:"+opts.Creds.String())
+	}
+	strOpts := ""
+	if len(strOptsList) != 0 {
+		strOpts = fmt.Sprintf(" (%v)", strings.Join(strOptsList, " "))
 	}
 
 	// The following output helps to understand what program crashed kernel.
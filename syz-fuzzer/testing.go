@@ -5,7 +5,10 @@ package main
 
 import (
 	"fmt"
+	"math/rand"
+	"sort"
 	"strings"
+	"syscall"
 	"time"
 
 	"github.com/google/syzkaller/pkg/host"
@@ -82,10 +85,21 @@ func checkMachine(args *checkArgs) (*rpctype.CheckArgs, error) {
 	if err != nil {
 		return nil, err
 	}
+	enabledCalls, probeDisabled, downweightedCalls, err := probeCalls(args, enabledCalls)
+	if err != nil {
+		return nil, err
+	}
+	disabledCalls = append(disabledCalls, probeDisabled...)
+	modules, err := host.KernelModules()
+	if err != nil {
+		return nil, err
+	}
 	res := &rpctype.CheckArgs{
-		EnabledCalls:  enabledCalls,
-		DisabledCalls: disabledCalls,
-		Features:      features,
+		EnabledCalls:      enabledCalls,
+		DisabledCalls:     disabledCalls,
+		DownweightedCalls: downweightedCalls,
+		Features:          features,
+		Modules:           modules,
 	}
 	return res, nil
 }
@@ -155,6 +169,115 @@ func checkSimpleProgram(args *checkArgs) error {
 	return nil
 }
 
+// probeCalls executes every enabled syscall variant in the guest several times with random arguments.
+// Syscalls that always fail with ENOSYS (in all variants) are not implemented by the kernel,
+// the result depends only on the syscall number, so all variants of the syscall (e.g. socket$*)
+// are disabled. Variants that always fail with EPERM/EACCES are down-weighted as they most likely
+// can't be used in the current sandbox. To make probing cheap, probes of several calls are batched
+// into a single program and only calls that failed are probed again. Arguments are generated
+// with a fixed seed, so the result does not change between fuzzer restarts.
+func probeCalls(args *checkArgs, enabled []int) (
+	supported []int, disabled, downweighted []rpctype.SyscallReason, err error) {
+	switch args.target.OS {
+	case "linux", "freebsd", "netbsd":
+	default:
+		return enabled, nil, nil, nil
+	}
+	// Pseudo syscalls don't return ENOSYS, they are checked by host.DetectSupportedSyscalls.
+	var probed []int
+	for _, id := range enabled {
+		if !strings.HasPrefix(args.target.Syscalls[id].CallName, "syz_") {
+			probed = append(probed, id)
+		}
+	}
+	sort.Ints(probed)
+	log.Logf(0, "probing %v syscalls...", len(probed))
+	env, err := ipc.MakeEnv(args.ipcConfig, 0)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to create ipc env: %v", err)
+	}
+	defer env.Close()
+	const attempts = 3
+	rs := rand.NewSource(0)
+	enosys := make(map[int]int)
+	eperm := make(map[int]int)
+	for attempt := 0; attempt < attempts; attempt++ {
+		// Calls that failed with ENOSYS or EPERM/EACCES in all previous attempts.
+		var failing []int
+		for _, id := range probed {
+			if enosys[id]+eperm[id] == attempt {
+				failing = append(failing, id)
+			}
+		}
+		for len(failing) != 0 {
+			// Probe of every call is generated with the resources it needs,
+			// the call itself is the last one.
+			p := &prog.Prog{Target: args.target}
+			var batch, last []int
+			for len(failing) != 0 && len(p.Calls) < programLength {
+				id := failing[0]
+				failing = failing[1:]
+				p1 := args.target.GenerateCallProg(rs, args.target.Syscalls[id])
+				p.Calls = append(p.Calls, p1.Calls...)
+				batch = append(batch, id)
+				last = append(last, len(p.Calls)-1)
+			}
+			_, info, _, _, err := env.Exec(args.ipcExecOpts, p)
+			if err != nil {
+				if _, ok := err.(ipc.ExecutorFailure); ok {
+					return nil, nil, nil, fmt.Errorf("executor failed while probing syscalls: %v", err)
+				}
+				// The result is unknown, the calls are considered supported.
+				continue
+			}
+			for i, id := range batch {
+				if last[i] >= len(info) || !info[last[i]].Executed {
+					continue
+				}
+				switch info[last[i]].Errno {
+				case int(syscall.ENOSYS):
+					enosys[id]++
+				case int(syscall.EPERM), int(syscall.EACCES):
+					eperm[id]++
+				}
+			}
+		}
+	}
+	// Variants of a syscall that always fail with ENOSYS.
+	variants := make(map[string]int)
+	notImplemented := make(map[string]int)
+	for _, id := range probed {
+		name := args.target.Syscalls[id].CallName
+		variants[name]++
+		if enosys[id] == attempts {
+			notImplemented[name]++
+		}
+	}
+	for _, id := range enabled {
+		c := args.target.Syscalls[id]
+		if n := variants[c.CallName]; n != 0 && notImplemented[c.CallName] == n {
+			log.Logf(1, "unsupported syscall: %v: ENOSYS", c.Name)
+			disabled = append(disabled, rpctype.SyscallReason{
+				ID:     id,
+				Reason: "not implemented by the kernel (ENOSYS)",
+			})
+			continue
+		}
+		if eperm[id] != 0 && enosys[id]+eperm[id] == attempts {
+			log.Logf(1, "down-weighting syscall: %v: EPERM", c.Name)
+			downweighted = append(downweighted, rpctype.SyscallReason{
+				ID:     id,
+				Reason: "not permitted (EPERM/EACCES)",
+			})
+		}
+		supported = append(supported, id)
+	}
+	if len(supported) == 0 {
+		return nil, nil, nil, fmt.Errorf("all system calls are disabled")
+	}
+	return supported, disabled, downweighted, nil
+}
+
 func buildCallList(target *prog.Target, enabledCalls []int, sandbox string) (
 	enabled []int, disabled []rpctype.SyscallReason, err error) {
 	calls := make(map[*prog.Syscall]bool)
@@ -0,0 +1,74 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package main
+
+import (
+	"sync"
+
+	"github.com/google/syzkaller/pkg/ipc"
+	"github.com/google/syzkaller/pkg/log"
+	"github.com/google/syzkaller/pkg/rpctype"
+)
+
+// pcTrail is a ring buffer of the last coverage PCs of a proc.
+// The fuzzer periodically sends trails of all procs to manager (Manager.Trail),
+// manager saves the trail of the crashed VM along with the crash as a root cause hint.
+// Note: executor deduplicates coverage, so PCs are ordered by calls,
+// but PCs of a single call are not in execution order.
+type pcTrail struct {
+	mu   sync.Mutex
+	pcs  []uint32
+	pos  int
+	full bool
+}
+
+func newPCTrail(size int) *pcTrail {
+	if size == 0 {
+		return nil
+	}
+	return &pcTrail{pcs: make([]uint32, size)}
+}
+
+func (trail *pcTrail) add(info []ipc.CallInfo) {
+	if trail == nil {
+		return
+	}
+	trail.mu.Lock()
+	defer trail.mu.Unlock()
+	for _, inf := range info {
+		for _, pc := range inf.Cover {
+			trail.pcs[trail.pos] = pc
+			trail.pos++
+			if trail.pos == len(trail.pcs) {
+				trail.pos = 0
+				trail.full = true
+			}
+		}
+	}
+}
+
+// get returns the PCs in the trail, oldest first.
+func (trail *pcTrail) get() []uint32 {
+	trail.mu.Lock()
+	defer trail.mu.Unlock()
+	if !trail.full {
+		return append([]uint32{}, trail.pcs[:trail.pos]...)
+	}
+	return append(append([]uint32{}, trail.pcs[trail.pos:]...), trail.pcs[:trail.pos]...)
+}
+
+func (fuzzer *Fuzzer) sendTrail() {
+	a := &rpctype.TrailArgs{
+		Name:  fuzzer.name,
+		Procs: make(map[int][]uint32),
+	}
+	for _, proc := range fuzzer.procs {
+		if pcs := proc.trail.get(); len(pcs) != 0 {
+			a.Procs[proc.pid] = pcs
+		}
+	}
+	if err := fuzzer.manager.Call("Manager.Trail", a, nil); err != nil {
+		log.Fatalf("Manager.Trail call failed: %v", err)
+	}
+}
@@ -6,6 +6,7 @@ package main
 import (
 	"sync"
 
+	"github.com/google/syzkaller/pkg/creds"
 	"github.com/google/syzkaller/pkg/ipc"
 	"github.com/google/syzkaller/prog"
 )
@@ -31,6 +32,8 @@ const (
 	ProgCandidate ProgTypes = 1 << iota
 	ProgMinimized
 	ProgSmashed
+	// ProgReminimize marks re-minimization of an existing corpus input requested by manager.
+	ProgReminimize
 	ProgNormal ProgTypes = 0
 )
 
@@ -39,10 +42,12 @@ const (
 // During triage we understand if these programs in fact give new coverage,
 // and if yes, minimize them and add to corpus.
 type WorkTriage struct {
-	p     *prog.Prog
-	call  int
-	info  ipc.CallInfo
-	flags ProgTypes
+	p        *prog.Prog
+	call     int
+	info     ipc.CallInfo
+	flags    ProgTypes
+	replaces string      // for ProgReminimize: hash of the original corpus input
+	creds    creds.Creds // credential context the program was executed in
 }
 
 // WorkCandidate are programs from hub.
@@ -51,14 +56,18 @@ type WorkTriage struct {
 type WorkCandidate struct {
 	p     *prog.Prog
 	flags ProgTypes
+	// For ProgReminimize: the call the input was triaged for and hash of the input.
+	call     string
+	replaces string
 }
 
 // WorkSmash are programs just added to corpus.
 // During smashing these programs receive a one-time special attention
 // (emit faults, collect comparison hints, etc).
 type WorkSmash struct {
-	p    *prog.Prog
-	call int
+	p     *prog.Prog
+	call  int
+	creds creds.Creds
 }
 
 func newWorkQueue(procs int, needCandidates chan struct{}) *WorkQueue {
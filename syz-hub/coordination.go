@@ -0,0 +1,90 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package main
+
+import (
+	"time"
+)
+
+// Coverage-complementary scheduling of managers that fuzz the same kernel.
+// Managers with the same coordination group (hub_coordination_group manager config parameter)
+// send coverage per syscall (total signal of corpus programs of the call) with every sync
+// and receive syscall weights back. The weights bias generation towards syscalls where
+// the other managers of the group have little coverage and away from syscalls that
+// the others already cover well, so that the fleet explores complementary parts of the kernel
+// instead of duplicating effort.
+
+const (
+	minCallWeight = 0.25
+	maxCallWeight = 4.0
+	// Coverage of managers that did not sync for this long is not taken into account
+	// (the manager was removed or is down).
+	coordinationExpiration = 24 * time.Hour
+)
+
+type coordinationGroup struct {
+	managers map[string]*managerCoverage
+}
+
+type managerCoverage struct {
+	calls   map[string]int // syscall name -> coverage
+	updated time.Time
+}
+
+// coordinate records call coverage of the manager (if not nil) and returns weights
+// of the manager's syscalls. Syscalls with weight 1 are omitted.
+func (hub *Hub) coordinate(group, manager string, coverage map[string]int, now time.Time) map[string]float64 {
+	if hub.groups == nil {
+		hub.groups = make(map[string]*coordinationGroup)
+	}
+	g := hub.groups[group]
+	if g == nil {
+		g = &coordinationGroup{managers: make(map[string]*managerCoverage)}
+		hub.groups[group] = g
+	}
+	if coverage != nil {
+		g.managers[manager] = &managerCoverage{
+			calls:   coverage,
+			updated: now,
+		}
+	}
+	own := g.managers[manager]
+	if own == nil || len(own.calls) == 0 {
+		return nil
+	}
+	others := make(map[string]int)
+	total := 0
+	for name, mgr := range g.managers {
+		if name == manager || now.Sub(mgr.updated) > coordinationExpiration {
+			continue
+		}
+		for call := range own.calls {
+			others[call] += mgr.calls[call]
+			total += mgr.calls[call]
+		}
+	}
+	if total == 0 {
+		return nil
+	}
+	// A syscall with average coverage of the other managers gets weight 1,
+	// the weight is inversely proportional to the coverage of the others.
+	avg := float64(total) / float64(len(own.calls))
+	weights := make(map[string]float64)
+	for call := range own.calls {
+		w := maxCallWeight
+		if others[call] != 0 {
+			w = avg / float64(others[call])
+		}
+		if w < minCallWeight {
+			w = minCallWeight
+		}
+		if w > maxCallWeight {
+			w = maxCallWeight
+		}
+		if w != 1 {
+			weights[call] = w
+		}
+	}
+	return weights
+}
@@ -0,0 +1,48 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package main
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCoordinate(t *testing.T) {
+	hub := new(Hub)
+	now := time.Now()
+	// The only manager in the group does not get any weights.
+	if w := hub.coordinate("linux", "mgr1", map[string]int{"open": 200, "read": 40}, now); w != nil {
+		t.Fatalf("got weights for a single manager: %v", w)
+	}
+	// Managers in other groups are not taken into account.
+	if w := hub.coordinate("freebsd", "mgr3", map[string]int{"open": 100, "read": 10}, now); w != nil {
+		t.Fatalf("got weights for a single manager: %v", w)
+	}
+	coverage := map[string]int{"open": 50, "read": 50, "write": 0, "mmap": 0, "close": 10, "dup": 0}
+	got := hub.coordinate("linux", "mgr2", coverage, now)
+	// Coverage of mgr1 on calls enabled in mgr2: total 240, avg 40.
+	// read has average coverage and gets weight 1.
+	want := map[string]float64{
+		"open":  minCallWeight,
+		"write": maxCallWeight,
+		"mmap":  maxCallWeight,
+		"close": maxCallWeight,
+		"dup":   maxCallWeight,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got weights %v, want %v", got, want)
+	}
+	// mgr1 gets weights based on the coverage of mgr2 on open/read: total 100, avg 50.
+	got = hub.coordinate("linux", "mgr1", nil, now)
+	want = map[string]float64{}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("got weights %v, want %v", got, want)
+	}
+	// Coverage of managers that did not sync for a long time is ignored.
+	now = now.Add(coordinationExpiration + time.Hour)
+	if w := hub.coordinate("linux", "mgr2", nil, now); w != nil {
+		t.Fatalf("got weights based on stale coverage: %v", w)
+	}
+}
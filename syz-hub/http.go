@@ -10,14 +10,20 @@ import (
 	"net/http"
 	"sort"
 	"strings"
+	"time"
 
+	"github.com/google/syzkaller/pkg/health"
 	"github.com/google/syzkaller/pkg/log"
 )
 
-func (hub *Hub) initHTTP(addr string) {
+func (hub *Hub) initHTTP(network, addr, workdir string) {
 	http.HandleFunc("/", hub.httpSummary)
+	checker := health.NewChecker()
+	checker.Add("rpc", true, hub.rpcServing.Check)
+	checker.Add("storage", false, health.CheckDir(workdir))
+	checker.Register(http.DefaultServeMux)
 
-	ln, err := net.Listen("tcp4", addr)
+	ln, err := net.Listen(network, addr)
 	if err != nil {
 		log.Fatalf("failed to listen on %v: %v", addr, err)
 	}
@@ -58,6 +64,21 @@ func (hub *Hub) httpSummary(w http.ResponseWriter, r *http.Request) {
 	}
 	sort.Sort(UIManagerArray(data.Managers))
 	data.Managers = append([]UIManager{total}, data.Managers...)
+	now := time.Now()
+	for client := range hub.keys {
+		q := hub.clientQuality(client, now)
+		data.Clients = append(data.Clients, UIClient{
+			Name:        client,
+			Reputation:  fmt.Sprintf("%.2f", q.reputation),
+			Quarantined: q.reputation < hub.qualityCfg.MinReputation,
+			Accepted:    q.Accepted,
+			Rejected:    q.Rejected,
+			Limited:     q.Limited,
+		})
+	}
+	sort.Slice(data.Clients, func(i, j int) bool {
+		return data.Clients[i].Name < data.Clients[j].Name
+	})
 	if err := summaryTemplate.Execute(w, data); err != nil {
 		log.Logf(0, "failed to execute template: %v", err)
 		http.Error(w, fmt.Sprintf("failed to execute template: %v", err), http.StatusInternalServerError)
@@ -71,9 +92,19 @@ func compileTemplate(html string) *template.Template {
 
 type UISummaryData struct {
 	Managers []UIManager
+	Clients  []UIClient
 	Log      string
 }
 
+type UIClient struct {
+	Name        string
+	Reputation  string
+	Quarantined bool
+	Accepted    int
+	Rejected    int
+	Limited     int
+}
+
 type UIManager struct {
 	Name       string
 	Corpus     int
@@ -129,6 +160,27 @@ var summaryTemplate = compileTemplate(`
 </table>
 <br><br>
 
+<table>
+	<caption>Clients:</caption>
+	<tr>
+		<th>Name</th>
+		<th>Reputation</th>
+		<th>Accepted</th>
+		<th>Rejected</th>
+		<th>Rate limited</th>
+	</tr>
+	{{range $c := $.Clients}}
+	<tr>
+		<td>{{$c.Name}}</td>
+		<td>{{$c.Reputation}}{{if $c.Quarantined}} (quarantined){{end}}</td>
+		<td>{{$c.Accepted}}</td>
+		<td>{{$c.Rejected}}</td>
+		<td>{{$c.Limited}}</td>
+	</tr>
+	{{end}}
+</table>
+<br><br>
+
 Log:
 <br>
 <textarea id="log_textarea" readonly rows="50">
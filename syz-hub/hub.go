@@ -8,8 +8,10 @@ import (
 	"fmt"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/google/syzkaller/pkg/config"
+	"github.com/google/syzkaller/pkg/health"
 	"github.com/google/syzkaller/pkg/log"
 	"github.com/google/syzkaller/pkg/rpctype"
 	"github.com/google/syzkaller/syz-hub/state"
@@ -20,27 +22,45 @@ var (
 )
 
 type Config struct {
-	HTTP    string
-	RPC     string
-	Workdir string
-	Clients []struct {
+	HTTP string
+	RPC  string
+	// Address family for HTTP/RPC listeners: "ipv4", "ipv6", "dual" (dual-stack)
+	// or empty (HTTP on IPv4, RPC dual-stack).
+	IPFamily string
+	Workdir  string
+	Clients  []struct {
 		Name string
 		Key  string
 	}
+	// Validation of programs received from clients, see QualityConfig.
+	Quality QualityConfig
 }
 
 type Hub struct {
-	mu   sync.Mutex
-	st   *state.State
-	keys map[string]string
+	mu         sync.Mutex
+	st         *state.State
+	keys       map[string]string
+	qualityCfg QualityConfig
+	quality    map[string]*clientQuality
+	groups     map[string]*coordinationGroup // see coordinate
+	rpcServing *health.Flag
 }
 
 func main() {
 	flag.Parse()
-	cfg := new(Config)
+	cfg := &Config{
+		Quality: QualityConfig{
+			MaxProgSize:   64 << 10,
+			MinReputation: 0.5,
+		},
+	}
 	if err := config.LoadFile(*flagConfig, cfg); err != nil {
 		log.Fatal(err)
 	}
+	if cfg.Quality.MaxProgSize <= 0 || cfg.Quality.RateLimit < 0 ||
+		cfg.Quality.MinReputation < 0 || cfg.Quality.MinReputation > 1 {
+		log.Fatalf("bad quality config: %+v", cfg.Quality)
+	}
 	log.EnableLogCaching(1000, 1<<20)
 
 	st, err := state.Make(cfg.Workdir)
@@ -48,20 +68,33 @@ func main() {
 		log.Fatalf("failed to load state: %v", err)
 	}
 	hub := &Hub{
-		st:   st,
-		keys: make(map[string]string),
+		st:         st,
+		keys:       make(map[string]string),
+		qualityCfg: cfg.Quality,
+		quality:    make(map[string]*clientQuality),
+		rpcServing: health.NewFlag(fmt.Errorf("rpc server is not started")),
 	}
 	for _, mgr := range cfg.Clients {
 		hub.keys[mgr.Name] = mgr.Key
 	}
 
-	hub.initHTTP(cfg.HTTP)
+	// HTTP is served on IPv4 and RPC on all addresses by default.
+	httpNetwork, err := rpctype.Network(cfg.IPFamily, "tcp4")
+	if err != nil {
+		log.Fatalf("%v", err)
+	}
+	rpcNetwork, err := rpctype.Network(cfg.IPFamily, "tcp")
+	if err != nil {
+		log.Fatalf("%v", err)
+	}
+	hub.initHTTP(httpNetwork, cfg.HTTP, cfg.Workdir)
 
-	s, err := rpctype.NewRPCServer(cfg.RPC, hub)
+	s, err := rpctype.NewRPCServer(rpcNetwork, cfg.RPC, hub)
 	if err != nil {
 		log.Fatalf("failed to create rpc server: %v", err)
 	}
 	log.Logf(0, "serving rpc on tcp://%v", s.Addr())
+	hub.rpcServing.Set()
 	s.Serve()
 }
 
@@ -73,9 +106,14 @@ func (hub *Hub) Connect(a *rpctype.HubConnectArgs, r *int) error {
 	hub.mu.Lock()
 	defer hub.mu.Unlock()
 
-	log.Logf(0, "connect from %v: fresh=%v calls=%v corpus=%v",
-		name, a.Fresh, len(a.Calls), len(a.Corpus))
-	if err := hub.st.Connect(name, a.Fresh, a.Calls, a.Corpus); err != nil {
+	log.Logf(0, "connect from %v: fresh=%v calls=%v pull calls=%v corpus=%v",
+		name, a.Fresh, len(a.Calls), len(a.PullCalls), len(a.Corpus))
+	calls := make(map[string]struct{})
+	for _, c := range a.Calls {
+		calls[c] = struct{}{}
+	}
+	corpus := hub.filterProgs(a.Client, name, calls, a.Corpus, false, time.Now())
+	if err := hub.st.Connect(name, a.Fresh, a.Calls, a.PullCalls, corpus); err != nil {
 		log.Logf(0, "connect error: %v", err)
 		return err
 	}
@@ -90,14 +128,23 @@ func (hub *Hub) Sync(a *rpctype.HubSyncArgs, r *rpctype.HubSyncRes) error {
 	hub.mu.Lock()
 	defer hub.mu.Unlock()
 
-	progs, more, err := hub.st.Sync(name, a.Add, a.Del)
+	add, repros := a.Add, a.Repros
+	if mgr := hub.st.Managers[name]; mgr != nil {
+		now := time.Now()
+		add = hub.filterProgs(a.Client, name, mgr.Calls, add, true, now)
+		repros = hub.filterProgs(a.Client, name, mgr.Calls, repros, true, now)
+	}
+	progs, more, err := hub.st.Sync(name, add, a.Del)
 	if err != nil {
 		log.Logf(0, "sync error: %v", err)
 		return err
 	}
 	r.Progs = progs
 	r.More = more
-	for _, repro := range a.Repros {
+	if a.CoordinationGroup != "" {
+		r.CallWeights = hub.coordinate(a.CoordinationGroup, name, a.CallCoverage, time.Now())
+	}
+	for _, repro := range repros {
 		if err := hub.st.AddRepro(name, repro); err != nil {
 			log.Logf(0, "add repro error: %v", err)
 		}
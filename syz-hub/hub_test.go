@@ -6,6 +6,7 @@ package main
 import (
 	"fmt"
 	"testing"
+	"time"
 )
 
 func TestAuth(t *testing.T) {
@@ -101,3 +102,66 @@ func TestAuth(t *testing.T) {
 		})
 	}
 }
+
+func TestQuality(t *testing.T) {
+	hub := &Hub{
+		qualityCfg: QualityConfig{
+			MaxProgSize:   100,
+			RateLimit:     10,
+			MinReputation: 0.9,
+		},
+		quality: make(map[string]*clientQuality),
+	}
+	calls := map[string]struct{}{"open": {}, "read": {}}
+	now := time.Now()
+	progs := [][]byte{
+		[]byte("open()\nread()"),
+		[]byte("close()"),
+		[]byte("not a program"),
+		make([]byte, 101),
+		[]byte("read()"),
+	}
+	res := hub.filterProgs("foo", "foo-mgr", calls, progs, true, now)
+	if len(res) != 2 || string(res[0]) != "open()\nread()" || string(res[1]) != "read()" {
+		t.Fatalf("bad filtered programs: %q", res)
+	}
+	q := hub.quality["foo"]
+	if q.Accepted != 2 || q.Rejected != 3 {
+		t.Fatalf("bad stats: accepted %v, rejected %v", q.Accepted, q.Rejected)
+	}
+	// Rate limit: 5 programs are already counted.
+	var good [][]byte
+	for i := 0; i < 10; i++ {
+		good = append(good, []byte("read()"))
+	}
+	if res := hub.filterProgs("foo", "foo-mgr", calls, good, true, now); len(res) != 5 {
+		t.Fatalf("rate limit: got %v programs, want 5", len(res))
+	}
+	// Initial corpus is not rate limited.
+	if res := hub.filterProgs("foo", "foo-mgr", calls, good, false, now); len(res) != 10 {
+		t.Fatalf("corpus: got %v programs, want 10", len(res))
+	}
+	// The limit recovers with time.
+	now = now.Add(time.Hour)
+	if res := hub.filterProgs("foo", "foo-mgr", calls, good, true, now); len(res) != 10 {
+		t.Fatalf("rate limit: got %v programs, want 10", len(res))
+	}
+	// Bad programs lead to quarantine of the client.
+	var bad [][]byte
+	for i := 0; i < 20; i++ {
+		bad = append(bad, []byte("close()"))
+	}
+	hub.filterProgs("foo", "foo-mgr", calls, bad, false, now)
+	if res := hub.filterProgs("foo", "foo-mgr", calls, good, false, now); len(res) != 0 {
+		t.Fatalf("quarantined client: got %v programs", len(res))
+	}
+	// Other clients are not affected.
+	if res := hub.filterProgs("bar", "bar-mgr", calls, good, false, now); len(res) != 10 {
+		t.Fatalf("other client: got %v programs, want 10", len(res))
+	}
+	// Reputation recovers with time.
+	now = now.Add(reputationRecovery)
+	if res := hub.filterProgs("foo", "foo-mgr", calls, good, false, now); len(res) != 10 {
+		t.Fatalf("recovered client: got %v programs, want 10", len(res))
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/syzkaller/pkg/log"
+	"github.com/google/syzkaller/prog"
+)
+
+// Protection of the shared corpus from buggy or malicious clients.
+// Programs and repros received from managers are validated before they are added
+// to the shared corpus: they must be syntactically valid, not too large and must
+// contain only calls that the manager declared as enabled on connect.
+// Each client has a rate limit on the number of programs it can send, and a reputation
+// score in [0, 1]: accepted programs increase it, rejected decrease it.
+// Programs of clients with reputation below MinReputation are rejected.
+// Reputation slowly recovers with time, so that a temporary bug in a client
+// does not exclude it forever.
+
+type QualityConfig struct {
+	// Max size of a single program in bytes (default: 64KB).
+	MaxProgSize int `json:"max_prog_size"`
+	// Max number of programs (including repros) a client can send per hour,
+	// initial corpus sent on connect is not counted (default: 0, no limit).
+	RateLimit int `json:"rate_limit"`
+	// Programs of clients with reputation below this value are rejected (default: 0.5).
+	MinReputation float64 `json:"min_reputation"`
+}
+
+const (
+	// Weight of a single program in the reputation score.
+	reputationStep = 0.01
+	// Time for reputation to recover from 0 to 1.
+	reputationRecovery = 24 * time.Hour
+)
+
+type clientQuality struct {
+	reputation float64
+	tokens     float64 // rate limit token bucket
+	lastUpdate time.Time
+	Accepted   int
+	Rejected   int
+	Limited    int
+}
+
+func (hub *Hub) clientQuality(client string, now time.Time) *clientQuality {
+	q := hub.quality[client]
+	if q == nil {
+		q = &clientQuality{
+			reputation: 1,
+			tokens:     float64(hub.qualityCfg.RateLimit),
+			lastUpdate: now,
+		}
+		hub.quality[client] = q
+	}
+	elapsed := now.Sub(q.lastUpdate)
+	if elapsed > 0 {
+		q.reputation += float64(elapsed) / float64(reputationRecovery)
+		if q.reputation > 1 {
+			q.reputation = 1
+		}
+		limit := float64(hub.qualityCfg.RateLimit)
+		q.tokens += limit * float64(elapsed) / float64(time.Hour)
+		if q.tokens > limit {
+			q.tokens = limit
+		}
+		q.lastUpdate = now
+	}
+	return q
+}
+
+// filterProgs returns programs that pass validation for the manager with the given enabled calls.
+// If rateLimit is set, the programs are counted against the client rate limit.
+func (hub *Hub) filterProgs(client, manager string, calls map[string]struct{}, progs [][]byte,
+	rateLimit bool, now time.Time) [][]byte {
+	if len(progs) == 0 {
+		return nil
+	}
+	q := hub.clientQuality(client, now)
+	if q.reputation < hub.qualityCfg.MinReputation {
+		q.Rejected += len(progs)
+		log.Logf(0, "rejecting %v programs from %v: reputation %.2f", len(progs), manager, q.reputation)
+		return nil
+	}
+	var res [][]byte
+	rejected := 0
+	for _, data := range progs {
+		if rateLimit && hub.qualityCfg.RateLimit != 0 {
+			if q.tokens < 1 {
+				q.Limited++
+				continue
+			}
+			q.tokens--
+		}
+		if err := validateProg(&hub.qualityCfg, calls, data); err != nil {
+			if rejected == 0 {
+				log.Logf(0, "rejecting program from %v: %v", manager, err)
+			}
+			rejected++
+			q.Rejected++
+			q.reputation -= q.reputation * reputationStep
+			continue
+		}
+		q.Accepted++
+		q.reputation += (1 - q.reputation) * reputationStep
+		res = append(res, data)
+	}
+	if rejected != 0 {
+		log.Logf(0, "rejected %v programs from %v, reputation %.2f", rejected, manager, q.reputation)
+	}
+	return res
+}
+
+func validateProg(cfg *QualityConfig, calls map[string]struct{}, data []byte) error {
+	if len(data) > cfg.MaxProgSize {
+		return fmt.Errorf("program is too large: %v bytes", len(data))
+	}
+	progCalls, err := prog.CallSet(data)
+	if err != nil {
+		return fmt.Errorf("bad program: %v", err)
+	}
+	for c := range progCalls {
+		if _, ok := calls[c]; !ok {
+			return fmt.Errorf("program contains call %v that is not enabled", c)
+		}
+	}
+	return nil
+}
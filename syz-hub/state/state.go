@@ -47,6 +47,7 @@ type Manager struct {
 	SentRepros    int
 	RecvRepros    int
 	Calls         map[string]struct{}
+	PullCalls     map[string]struct{} // if not empty, send only programs with at least one of these calls
 	Corpus        *db.DB
 }
 
@@ -141,7 +142,7 @@ func (st *State) createManager(name string) (*Manager, error) {
 	return mgr, nil
 }
 
-func (st *State) Connect(name string, fresh bool, calls []string, corpus [][]byte) error {
+func (st *State) Connect(name string, fresh bool, calls, pullCalls []string, corpus [][]byte) error {
 	mgr := st.Managers[name]
 	if mgr == nil {
 		var err error
@@ -162,6 +163,10 @@ func (st *State) Connect(name string, fresh bool, calls []string, corpus [][]byt
 	for _, c := range calls {
 		mgr.Calls[c] = struct{}{}
 	}
+	mgr.PullCalls = make(map[string]struct{})
+	for _, c := range pullCalls {
+		mgr.PullCalls[c] = struct{}{}
+	}
 
 	os.Remove(mgr.corpusFile)
 	var err error
@@ -281,7 +286,7 @@ func (st *State) pendingInputs(mgr *Manager) ([][]byte, int, error) {
 		if err != nil {
 			return nil, 0, fmt.Errorf("failed to extract call set: %v\nprogram: %s", err, rec.Val)
 		}
-		if !managerSupportsAllCalls(mgr.Calls, calls) {
+		if !managerSupportsAllCalls(mgr.Calls, calls) || !managerWantsCalls(mgr.PullCalls, calls) {
 			continue
 		}
 		records = append(records, rec)
@@ -301,7 +306,7 @@ func (st *State) pendingInputs(mgr *Manager) ([][]byte, int, error) {
 		more = len(records) - pos
 		records = records[:pos]
 	}
-	progs := make([][]byte, len(records))
+	progs := make([][]byte, 0, len(records))
 	for _, rec := range records {
 		progs = append(progs, rec.Val)
 	}
@@ -365,6 +370,18 @@ func managerSupportsAllCalls(mgr, prog map[string]struct{}) bool {
 	return true
 }
 
+func managerWantsCalls(mgr, prog map[string]struct{}) bool {
+	if len(mgr) == 0 {
+		return true
+	}
+	for c := range prog {
+		if _, ok := mgr[c]; ok {
+			return true
+		}
+	}
+	return false
+}
+
 func writeFile(name string, data []byte) {
 	if err := osutil.WriteFile(name, data); err != nil {
 		log.Logf(0, "failed to write file %v: %v", name, err)
@@ -28,7 +28,7 @@ func TestState(t *testing.T) {
 		t.Fatalf("synced with unconnected manager")
 	}
 	calls := []string{"read", "write"}
-	if err := st.Connect("foo", false, calls, nil); err != nil {
+	if err := st.Connect("foo", false, calls, nil, nil); err != nil {
 		t.Fatalf("Connect failed: %v", err)
 	}
 	_, _, err = st.Sync("foo", nil, nil)
@@ -49,10 +49,10 @@ func TestRepro(t *testing.T) {
 		t.Fatalf("failed to make state: %v", err)
 	}
 
-	if err := st.Connect("foo", false, []string{"open", "read", "write"}, nil); err != nil {
+	if err := st.Connect("foo", false, []string{"open", "read", "write"}, nil, nil); err != nil {
 		t.Fatalf("Connect failed: %v", err)
 	}
-	if err := st.Connect("bar", false, []string{"open", "read", "close"}, nil); err != nil {
+	if err := st.Connect("bar", false, []string{"open", "read", "close"}, nil, nil); err != nil {
 		t.Fatalf("Connect failed: %v", err)
 	}
 	checkPendingRepro(t, st, "foo", "")
@@ -86,10 +86,10 @@ func TestRepro(t *testing.T) {
 	if err != nil {
 		t.Fatalf("failed to make state: %v", err)
 	}
-	if err := st.Connect("foo", false, []string{"open", "read", "write"}, nil); err != nil {
+	if err := st.Connect("foo", false, []string{"open", "read", "write"}, nil, nil); err != nil {
 		t.Fatalf("Connect failed: %v", err)
 	}
-	if err := st.Connect("bar", false, []string{"open", "read", "close"}, nil); err != nil {
+	if err := st.Connect("bar", false, []string{"open", "read", "close"}, nil, nil); err != nil {
 		t.Fatalf("Connect failed: %v", err)
 	}
 	checkPendingRepro(t, st, "bar", "")
@@ -98,6 +98,47 @@ func TestRepro(t *testing.T) {
 	checkPendingRepro(t, st, "foo", "")
 }
 
+func TestPullCalls(t *testing.T) {
+	dir, err := ioutil.TempDir("", "syz-hub-state-test")
+	if err != nil {
+		t.Fatalf("failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	st, err := Make(dir)
+	if err != nil {
+		t.Fatalf("failed to make state: %v", err)
+	}
+	calls := []string{"open", "read", "write", "io_uring_setup"}
+	if err := st.Connect("foo", false, calls, nil, nil); err != nil {
+		t.Fatalf("Connect failed: %v", err)
+	}
+	if err := st.Connect("bar", false, calls, []string{"io_uring_setup"}, nil); err != nil {
+		t.Fatalf("Connect failed: %v", err)
+	}
+	progs := []string{"open()", "open()\nio_uring_setup()", "read()\nwrite()", "io_uring_setup()"}
+	var add [][]byte
+	for _, p := range progs {
+		add = append(add, []byte(p))
+	}
+	if _, _, err := st.Sync("foo", add, nil); err != nil {
+		t.Fatalf("Sync failed: %v", err)
+	}
+	got, _, err := st.Sync("bar", nil, nil)
+	if err != nil {
+		t.Fatalf("Sync failed: %v", err)
+	}
+	want := map[string]bool{"open()\nio_uring_setup()": true, "io_uring_setup()": true}
+	if len(got) != len(want) {
+		t.Fatalf("got %q, want %v", got, want)
+	}
+	for _, p := range got {
+		if !want[string(p)] {
+			t.Fatalf("got unwanted program %q", p)
+		}
+	}
+}
+
 func checkPendingRepro(t *testing.T, st *State, name, result string) {
 	repro, err := st.PendingRepro(name)
 	if err != nil {
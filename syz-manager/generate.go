@@ -0,0 +1,77 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/google/syzkaller/syz-manager/mgrconfig"
+)
+
+// generateConfig prints config for target generated from param=value args.
+// Values of params that are not specified in args are asked for if stdin is a terminal,
+// otherwise defaults are used.
+func generateConfig(target string, args []string) error {
+	params, err := mgrconfig.GenerateParams(target)
+	if err != nil {
+		return err
+	}
+	values := make(map[string]string)
+	for _, arg := range args {
+		eq := strings.IndexByte(arg, '=')
+		if eq == -1 {
+			return fmt.Errorf("bad argument %q, want param=value", arg)
+		}
+		values[arg[:eq]] = arg[eq+1:]
+	}
+	if isTerminal(os.Stdin) {
+		stdin := bufio.NewReader(os.Stdin)
+	loop:
+		for _, param := range params {
+			if _, ok := values[param.Name]; ok {
+				continue
+			}
+			for {
+				fmt.Fprintf(os.Stderr, "%v (%v) [%v]: ", param.Name, param.Description, param.Default)
+				ln, err := stdin.ReadString('\n')
+				if err == io.EOF {
+					// Use defaults for the rest of params.
+					fmt.Fprintf(os.Stderr, "\n")
+					break loop
+				}
+				if err != nil {
+					return fmt.Errorf("failed to read input: %v", err)
+				}
+				val := strings.TrimSpace(ln)
+				if val == "" {
+					val = param.Default
+				}
+				if val == "" && param.Required {
+					fmt.Fprintf(os.Stderr, "%v is required\n", param.Name)
+					continue
+				}
+				values[param.Name] = val
+				break
+			}
+		}
+	}
+	data, err := mgrconfig.Generate(target, values)
+	if err != nil {
+		return err
+	}
+	if _, err := mgrconfig.LoadData(data); err != nil {
+		fmt.Fprintf(os.Stderr, "WARNING: the generated config does not pass validation: %v\n", err)
+	}
+	_, err = os.Stdout.Write(data)
+	return err
+}
+
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
@@ -0,0 +1,64 @@
+// Copyright 2015 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+// syz-manager runs the fuzzing process, the implementation is in the manager package.
+package main
+
+import (
+	"flag"
+
+	"github.com/google/syzkaller/pkg/log"
+	"github.com/google/syzkaller/pkg/osutil"
+	"github.com/google/syzkaller/prog"
+	"github.com/google/syzkaller/sys"
+	"github.com/google/syzkaller/syz-manager/manager"
+	"github.com/google/syzkaller/syz-manager/mgrconfig"
+	"github.com/google/syzkaller/vm"
+)
+
+var (
+	flagConfig = flag.String("config", "", "configuration file")
+	flagDebug  = flag.Bool("debug", false, "dump all VM output to console")
+	flagBench  = flag.String("bench", "", "write execution statistics into this file periodically")
+
+	flagGenerateConfig = flag.Bool("generate-config", false, "generate config for -target and print it to stdout"+
+		" (params can be given as param=value args, the rest are asked for interactively)")
+	flagTarget = flag.String("target", "", "target for -generate-config (e.g. linux/amd64/qemu)")
+)
+
+func main() {
+	if sys.GitRevision == "" {
+		log.Fatalf("Bad syz-manager build. Build with make, run bin/syz-manager.")
+	}
+	flag.Parse()
+	if *flagGenerateConfig {
+		if err := generateConfig(*flagTarget, flag.Args()); err != nil {
+			log.Fatalf("%v", err)
+		}
+		return
+	}
+	log.EnableLogCaching(1000, 1<<20)
+	cfg, err := mgrconfig.LoadFile(*flagConfig)
+	if err != nil {
+		log.Fatalf("%v", err)
+	}
+	target, err := prog.GetTarget(cfg.TargetOS, cfg.TargetArch)
+	if err != nil {
+		log.Fatalf("%v", err)
+	}
+	syscalls, err := mgrconfig.ParseEnabledSyscalls(target, cfg.EnabledSyscalls, cfg.DisabledSyscalls)
+	if err != nil {
+		log.Fatalf("%v", err)
+	}
+	mgr, err := manager.New(cfg, target, syscalls, manager.Options{
+		Debug: *flagDebug,
+		Bench: *flagBench,
+	})
+	if err != nil {
+		log.Fatalf("%v", err)
+	}
+	osutil.HandleInterrupts(vm.Shutdown)
+	if err := mgr.Run(); err != nil {
+		log.Fatalf("%v", err)
+	}
+}
@@ -0,0 +1,191 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package manager
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/rpc"
+	"net/rpc/jsonrpc"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/syzkaller/pkg/log"
+	"github.com/google/syzkaller/pkg/rpctype"
+)
+
+// Control API for tooling built around managers (see rpctype.APIStatsArgs for the protocol).
+// It provides the same data and actions as the web UI in a stable machine-readable form.
+
+// API is the receiver of the control API methods.
+type API struct {
+	mgr *Manager
+}
+
+func (mgr *Manager) initAPI() {
+	mgr.apiServer = rpc.NewServer()
+	mgr.apiServer.Register(&API{mgr})
+}
+
+// httpAPI serves a single JSON-RPC request per HTTP POST request.
+func (mgr *Manager) httpAPI(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		http.Error(w, "use POST with a JSON-RPC request", http.StatusMethodNotAllowed)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	mgr.apiServer.ServeRequest(jsonrpc.NewServerCodec(&httpConn{r.Body, w}))
+}
+
+type httpConn struct {
+	io.Reader
+	io.Writer
+}
+
+func (conn *httpConn) Close() error {
+	return nil
+}
+
+func (api *API) Stats(a *rpctype.APIStatsArgs, r *rpctype.APIStatsRes) error {
+	mgr := api.mgr
+	mgr.mu.Lock()
+	defer mgr.mu.Unlock()
+	r.Uptime = int64(time.Since(mgr.startTime) / time.Second)
+	r.FuzzingTime = int64(mgr.fuzzingTime / time.Second)
+	r.Corpus = len(mgr.corpus)
+	r.TriageQueue = len(mgr.candidates)
+	r.Cover = len(mgr.corpusCover)
+	r.Signal = mgr.corpusSignal.Len()
+	r.FuzzingVMs = int(atomic.LoadUint32(&mgr.numFuzzing))
+	r.ReproJobs = int(atomic.LoadUint32(&mgr.numReproducing))
+	r.Paused = mgr.paused
+	r.Counters = make(map[string]uint64)
+	for k, v := range mgr.stats {
+		r.Counters[k] = v
+	}
+	return nil
+}
+
+func (api *API) Crashes(a *rpctype.APICrashesArgs, r *rpctype.APICrashesRes) error {
+	crashes, err := api.mgr.collectCrashes(api.mgr.cfg.Workdir)
+	if err != nil {
+		return fmt.Errorf("failed to collect crashes: %v", err)
+	}
+	for _, crash := range crashes {
+		r.Crashes = append(r.Crashes, &rpctype.APICrash{
+			ID:      crash.ID,
+			Title:   crash.Description,
+			Count:   crash.Count,
+			Triaged: crash.Triaged,
+		})
+	}
+	return nil
+}
+
+func (api *API) Crash(a *rpctype.APICrashArgs, r *rpctype.APICrashRes) error {
+	mgr := api.mgr
+	if strings.Trim(a.ID, "0123456789abcdef") != "" {
+		return fmt.Errorf("bad crash id %q", a.ID)
+	}
+	crash := readCrash(mgr.cfg.Workdir, a.ID, mgr.key, nil, true)
+	if crash == nil {
+		return fmt.Errorf("unknown crash %q", a.ID)
+	}
+	r.APICrash = rpctype.APICrash{
+		ID:      crash.ID,
+		Title:   crash.Description,
+		Count:   crash.Count,
+		Triaged: crash.Triaged,
+	}
+	read := func(file string) string {
+		data, _ := mgr.key.ReadFile(filepath.Join(mgr.cfg.Workdir, file))
+		return string(data)
+	}
+	for _, c := range crash.Crashes {
+		inst := &rpctype.APICrashInstance{
+			Index: c.Index,
+			Tag:   c.Tag,
+		}
+		if !c.Time.IsZero() {
+			inst.Time = c.Time.Unix()
+		}
+		if c.Report != "" {
+			inst.Report = read(c.Report)
+		}
+		if a.Logs {
+			inst.Log = read(c.Log)
+		}
+		r.Crashes = append(r.Crashes, inst)
+	}
+	dir := filepath.Join("crashes", crash.ID)
+	r.ReproSyz = read(filepath.Join(dir, "repro.prog"))
+	r.ReproC = read(filepath.Join(dir, "repro.cprog"))
+	r.ReproReport = read(filepath.Join(dir, "repro.report"))
+	return nil
+}
+
+// AddPrograms adds the programs to the triage queue, programs that give new signal end up in corpus.
+func (api *API) AddPrograms(a *rpctype.APIAddProgramsArgs, r *rpctype.APIAddProgramsRes) error {
+	mgr := api.mgr
+	mgr.mu.Lock()
+	defer mgr.mu.Unlock()
+	// Calls that are not enabled are rewritten or dropped, see salvageProg.
+	enabled := mgr.enabledCalls(mgr.enabledSyscalls)
+	stats := new(importStats)
+	for _, text := range a.Progs {
+		p := mgr.salvageProg([]byte(text), enabled, stats)
+		if p == nil {
+			continue
+		}
+		mgr.candidates = append(mgr.candidates, rpctype.RPCCandidate{
+			Prog:      p.Serialize(),
+			Minimized: false, // don't trust external programs
+			Smashed:   false,
+		})
+	}
+	r.Added = stats.added
+	r.Salvaged = stats.salvaged
+	r.DroppedCalls = stats.droppedCalls
+	r.Dropped = stats.dropped
+	mgr.stats["api add"] += uint64(r.Added)
+	mgr.stats["import salvaged"] += uint64(r.Salvaged)
+	mgr.stats["import dropped calls"] += uint64(r.DroppedCalls)
+	log.Logf(0, "api: added %v programs to triage queue (%v salvaged, %v calls dropped), %v dropped",
+		r.Added, r.Salvaged, r.DroppedCalls, r.Dropped)
+	return nil
+}
+
+// Pause stops (or resumes) fuzzing, fuzzing VMs are shut down while fuzzing is paused.
+// Reproduction of crashes and user-requested runs are not affected.
+func (api *API) Pause(a *rpctype.APIPauseArgs, r *rpctype.APIPauseRes) error {
+	mgr := api.mgr
+	mgr.mu.Lock()
+	mgr.paused = a.Paused
+	mgr.mu.Unlock()
+	log.Logf(0, "api: fuzzing paused=%v", a.Paused)
+	select {
+	case mgr.vmsChanged <- true:
+	default:
+	}
+	return nil
+}
+
+// PinPrograms pins corpus programs, so that they are never dropped from corpus (see pin.go).
+func (api *API) PinPrograms(a *rpctype.APIPinProgramsArgs, r *rpctype.APIPinProgramsRes) error {
+	n, err := api.mgr.pinPrograms(a.Sigs, !a.Unpin)
+	r.Changed = n
+	return err
+}
+
+func (api *API) MinimizeCorpus(a *rpctype.APIMinimizeCorpusArgs, r *rpctype.APIMinimizeCorpusRes) error {
+	n, err := api.mgr.reminimizeCorpus()
+	if err != nil {
+		return err
+	}
+	r.Inputs = n
+	return nil
+}
@@ -0,0 +1,175 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package manager
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/google/syzkaller/dashboard/dashapi"
+	"github.com/google/syzkaller/pkg/log"
+)
+
+// Upload of crash assets to dashboard (see mgrconfig.Config.DashboardAssets and dashapi.CrashAsset).
+// Crash reports reference assets by blob ID calculated locally, contents of the blobs are uploaded
+// in background by assetLoop, blobs that the dashboard already has are skipped. Build assets
+// (vmlinux, disk image) don't change during manager lifetime, so they are hashed once on start
+// and attached to crashes after that.
+
+const maxPendingAssets = 100
+
+type assetUploader struct {
+	mu       sync.Mutex
+	files    []dashapi.CrashAsset // hashed build assets
+	uploads  chan *assetUpload
+	uploaded map[string]bool // blob ID -> uploaded
+	console  bool
+	vmlinux  string
+	image    string
+}
+
+type assetUpload struct {
+	blob *dashapi.Blob
+	data []byte // contents if not file
+	file string
+}
+
+func (mgr *Manager) initAssets() {
+	if mgr.dash == nil || len(mgr.cfg.DashboardAssets) == 0 {
+		return
+	}
+	a := &assetUploader{
+		uploads:  make(chan *assetUpload, maxPendingAssets),
+		uploaded: make(map[string]bool),
+	}
+	for _, typ := range mgr.cfg.DashboardAssets {
+		switch typ {
+		case dashapi.AssetConsoleLog:
+			a.console = true
+		case dashapi.AssetVmlinux:
+			if mgr.cfg.KernelObj != "" {
+				a.vmlinux = filepath.Join(mgr.cfg.KernelObj, "vmlinux")
+			}
+		case dashapi.AssetDiskImage:
+			a.image = mgr.cfg.Image
+		}
+	}
+	if mgr.scrubber != nil && (a.vmlinux != "" || a.image != "") {
+		// Kernel binaries and images can't be scrubbed.
+		log.Logf(0, "repro_scrub is enabled, not uploading vmlinux and disk image to dashboard")
+		a.vmlinux, a.image = "", ""
+	}
+	mgr.assets = a
+}
+
+// crashAssets returns assets for a crash with the given console output and queues their upload.
+func (mgr *Manager) crashAssets(output []byte) []dashapi.CrashAsset {
+	a := mgr.assets
+	if a == nil {
+		return nil
+	}
+	a.mu.Lock()
+	res := append([]dashapi.CrashAsset{}, a.files...)
+	a.mu.Unlock()
+	if a.console && len(output) != 0 {
+		data := mgr.scrub(output)
+		blob, err := dashapi.HashBlob(bytes.NewReader(data), int64(len(data)))
+		if err != nil {
+			log.Logf(0, "failed to hash console log: %v", err)
+			return res
+		}
+		select {
+		case a.uploads <- &assetUpload{blob: blob, data: data}:
+			res = append(res, dashapi.CrashAsset{
+				Type: dashapi.AssetConsoleLog,
+				Name: "console.log",
+				Blob: blob.ID,
+				Size: blob.Size,
+			})
+		default:
+			log.Logf(0, "too many pending asset uploads, dropping console log")
+		}
+	}
+	return res
+}
+
+// assetLoop hashes build assets and uploads blobs until the manager is closed.
+func (mgr *Manager) assetLoop() {
+	a := mgr.assets
+	for _, f := range []struct {
+		typ  string
+		file string
+	}{
+		{dashapi.AssetVmlinux, a.vmlinux},
+		{dashapi.AssetDiskImage, a.image},
+	} {
+		if f.file == "" {
+			continue
+		}
+		blob, err := hashAssetFile(f.file)
+		if err != nil {
+			log.Logf(0, "failed to hash %v: %v", f.file, err)
+			continue
+		}
+		a.mu.Lock()
+		a.files = append(a.files, dashapi.CrashAsset{
+			Type: f.typ,
+			Name: filepath.Base(f.file),
+			Blob: blob.ID,
+			Size: blob.Size,
+		})
+		a.mu.Unlock()
+		if err := mgr.uploadAsset(&assetUpload{blob: blob, file: f.file}); err != nil {
+			log.Logf(0, "failed to upload %v: %v", f.file, err)
+			continue
+		}
+		a.uploaded[blob.ID] = true
+	}
+	for {
+		select {
+		case up := <-a.uploads:
+			if a.uploaded[up.blob.ID] {
+				continue
+			}
+			if err := mgr.uploadAsset(up); err != nil {
+				log.Logf(0, "failed to upload asset blob %v: %v", up.blob.ID, err)
+				continue
+			}
+			a.uploaded[up.blob.ID] = true
+		case <-mgr.closed:
+			return
+		}
+	}
+}
+
+func (mgr *Manager) uploadAsset(up *assetUpload) error {
+	var r io.ReaderAt
+	if up.file != "" {
+		f, err := os.Open(up.file)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		r = f
+	} else {
+		r = bytes.NewReader(up.data)
+	}
+	return mgr.dash.UploadBlob(up.blob, r)
+}
+
+func hashAssetFile(file string) (*dashapi.Blob, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	stat, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	return dashapi.HashBlob(f, stat.Size())
+}
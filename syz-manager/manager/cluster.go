@@ -0,0 +1,90 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package manager
+
+import (
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/google/syzkaller/pkg/crypt"
+	"github.com/google/syzkaller/pkg/osutil"
+	"github.com/google/syzkaller/pkg/report"
+)
+
+// clusterThreshold is the min stack similarity of crashes in a cluster, see report.ClusterStacks.
+const clusterThreshold = 0.5
+
+// httpClusters shows groups of crashes with different titles but similar stacks,
+// such crashes likely have the same root cause and can be triaged together.
+func (mgr *Manager) httpClusters(w http.ResponseWriter, r *http.Request) {
+	crashes, err := mgr.collectCrashes(mgr.cfg.Workdir)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to collect crashes: %v", err), http.StatusInternalServerError)
+		return
+	}
+	var stacks [][]string
+	for _, crash := range crashes {
+		stacks = append(stacks, readCrashStack(filepath.Join(mgr.cfg.Workdir, "crashes", crash.ID), mgr.key))
+	}
+	data := &UIClustersData{
+		Name: mgr.cfg.Name,
+	}
+	for _, group := range report.ClusterStacks(stacks, clusterThreshold) {
+		cluster := new(UICluster)
+		common := make(map[string]int)
+		for _, idx := range group {
+			crash := crashes[idx]
+			cluster.Crashes = append(cluster.Crashes, crash)
+			cluster.Count += crash.Count
+			seen := make(map[string]bool)
+			for _, frame := range stacks[idx] {
+				if !seen[frame] {
+					seen[frame] = true
+					common[frame]++
+				}
+			}
+		}
+		// Frames present in all stacks of the cluster hint at the root cause.
+		for _, frame := range stacks[group[0]] {
+			if common[frame] == len(group) {
+				cluster.Frames = append(cluster.Frames, frame)
+				common[frame] = 0
+			}
+		}
+		data.Clusters = append(data.Clusters, cluster)
+	}
+	sort.SliceStable(data.Clusters, func(i, j int) bool {
+		return data.Clusters[i].Count > data.Clusters[j].Count
+	})
+
+	if err := clustersTemplate.Execute(w, data); err != nil {
+		http.Error(w, fmt.Sprintf("failed to execute template: %v", err),
+			http.StatusInternalServerError)
+		return
+	}
+}
+
+// readCrashStack returns stack frames of the first parsable report of the crash.
+func readCrashStack(dir string, key *crypt.Key) []string {
+	files, err := osutil.ListDir(dir)
+	if err != nil {
+		return nil
+	}
+	for _, f := range files {
+		if !strings.HasPrefix(f, "report") {
+			continue
+		}
+		data, err := key.ReadFile(filepath.Join(dir, f))
+		if err != nil {
+			continue
+		}
+		if frames := report.StackFrames(data); len(frames) != 0 {
+			return frames
+		}
+	}
+	return nil
+}
@@ -0,0 +1,303 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package manager
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// The /console page lists running VM instances and streams console output of the selected instance live,
+// lines that contain crash markers (see report.Reporter.ContainsCrash) are highlighted.
+// Streaming uses a chunked HTTP response, so it works without any scripts in the page.
+
+const (
+	consoleTail    = 64 << 10 // output kept for new viewers
+	consoleBacklog = 256      // output chunks buffered per viewer before it's disconnected
+)
+
+// console is live console output of a running VM instance.
+type console struct {
+	start   time.Time
+	mu      sync.Mutex
+	tail    []byte
+	viewers map[chan []byte]bool
+	closed  bool
+}
+
+func newConsole() *console {
+	return &console{
+		start:   time.Now(),
+		viewers: make(map[chan []byte]bool),
+	}
+}
+
+func (con *console) write(out []byte) {
+	con.mu.Lock()
+	defer con.mu.Unlock()
+	con.tail = append(con.tail, out...)
+	if len(con.tail) > 2*consoleTail {
+		con.tail = append([]byte{}, con.tail[len(con.tail)-consoleTail:]...)
+	}
+	for ch := range con.viewers {
+		select {
+		case ch <- out:
+		default:
+			// The viewer does not keep up with the output, drop it.
+			delete(con.viewers, ch)
+			close(ch)
+		}
+	}
+}
+
+func (con *console) close() {
+	con.mu.Lock()
+	defer con.mu.Unlock()
+	con.closed = true
+	for ch := range con.viewers {
+		close(ch)
+	}
+	con.viewers = nil
+}
+
+// subscribe returns recent output and a channel that receives new output.
+// The channel is closed when the instance finishes or the viewer is too slow.
+func (con *console) subscribe() ([]byte, chan []byte) {
+	con.mu.Lock()
+	defer con.mu.Unlock()
+	tail := con.tail
+	if len(tail) > consoleTail {
+		tail = tail[len(tail)-consoleTail:]
+	}
+	tail = append([]byte{}, tail...)
+	ch := make(chan []byte, consoleBacklog)
+	if con.closed {
+		close(ch)
+	} else {
+		con.viewers[ch] = true
+	}
+	return tail, ch
+}
+
+func (con *console) unsubscribe(ch chan []byte) {
+	con.mu.Lock()
+	defer con.mu.Unlock()
+	if con.viewers[ch] {
+		delete(con.viewers, ch)
+		close(ch)
+	}
+}
+
+// watchConsole makes output of the VM instance available on the /console page and passes it through
+// to the returned channel. stop must be closed when the returned channel is not read anymore.
+func (mgr *Manager) watchConsole(index int, outc <-chan []byte, stop <-chan bool) <-chan []byte {
+	con := newConsole()
+	mgr.mu.Lock()
+	mgr.consoles[index] = con
+	mgr.mu.Unlock()
+	res := make(chan []byte, cap(outc))
+	go func() {
+		defer func() {
+			mgr.mu.Lock()
+			if mgr.consoles[index] == con {
+				delete(mgr.consoles, index)
+			}
+			mgr.mu.Unlock()
+			con.close()
+		}()
+		defer close(res)
+		for out := range outc {
+			con.write(out)
+			select {
+			case res <- out:
+			case <-stop:
+				return
+			}
+		}
+	}()
+	return res
+}
+
+func (mgr *Manager) httpConsole(w http.ResponseWriter, r *http.Request) {
+	if r.FormValue("vm") == "" {
+		mgr.httpConsoleList(w, r)
+		return
+	}
+	index, err := strconv.Atoi(r.FormValue("vm"))
+	if err != nil {
+		http.Error(w, fmt.Sprintf("bad vm index %q", r.FormValue("vm")), http.StatusBadRequest)
+		return
+	}
+	mgr.mu.Lock()
+	con := mgr.consoles[index]
+	mgr.mu.Unlock()
+	if con == nil {
+		http.Error(w, fmt.Sprintf("vm-%v is not running", index), http.StatusNotFound)
+		return
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming is not supported", http.StatusInternalServerError)
+		return
+	}
+	tail, ch := con.subscribe()
+	defer con.unsubscribe(ch)
+	// Start from a line boundary, the first line of the tail is most likely partial.
+	if len(tail) == consoleTail {
+		if pos := bytes.IndexByte(tail, '\n'); pos != -1 {
+			tail = tail[pos+1:]
+		}
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	data := &UIConsoleData{
+		Name:  mgr.cfg.Name,
+		VM:    index,
+		Start: con.start.Format("Jan 02 15:04:05"),
+	}
+	if err := consoleHeaderTemplate.Execute(w, data); err != nil {
+		return
+	}
+	var line []byte
+	writeLines := func(out []byte) error {
+		line = append(line, out...)
+		for {
+			pos := bytes.IndexByte(line, '\n')
+			if pos == -1 {
+				return nil
+			}
+			if err := mgr.writeConsoleLine(w, line[:pos+1]); err != nil {
+				return err
+			}
+			line = line[pos+1:]
+		}
+	}
+	if err := writeLines(tail); err != nil {
+		return
+	}
+	flusher.Flush()
+	closed := r.Context().Done()
+	for {
+		select {
+		case out, ok := <-ch:
+			if !ok {
+				mgr.writeConsoleLine(w, line)
+				fmt.Fprintf(w, "</pre>\n<b>vm-%v has finished or the page does not keep up with the output,"+
+					" <a href='/console?vm=%v'>reload</a> to continue.</b>\n</body></html>\n", index, index)
+				return
+			}
+			if err := writeLines(out); err != nil {
+				return
+			}
+			flusher.Flush()
+		case <-closed:
+			return
+		}
+	}
+}
+
+func (mgr *Manager) writeConsoleLine(w http.ResponseWriter, line []byte) error {
+	if len(line) == 0 {
+		return nil
+	}
+	crash := mgr.reporter.ContainsCrash(line)
+	if crash {
+		if _, err := w.Write([]byte("<span class='crash'>")); err != nil {
+			return err
+		}
+	}
+	template.HTMLEscape(w, line)
+	if crash {
+		if _, err := w.Write([]byte("</span>")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (mgr *Manager) httpConsoleList(w http.ResponseWriter, r *http.Request) {
+	data := &UIConsoleListData{
+		Name: mgr.cfg.Name,
+	}
+	mgr.mu.Lock()
+	for index, con := range mgr.consoles {
+		data.VMs = append(data.VMs, UIConsoleVM{
+			Index:  index,
+			Uptime: time.Since(con.start) / 1e9 * 1e9,
+		})
+	}
+	mgr.mu.Unlock()
+	sort.Slice(data.VMs, func(i, j int) bool {
+		return data.VMs[i].Index < data.VMs[j].Index
+	})
+	if err := consoleListTemplate.Execute(w, data); err != nil {
+		http.Error(w, fmt.Sprintf("failed to execute template: %v", err),
+			http.StatusInternalServerError)
+		return
+	}
+}
+
+type UIConsoleListData struct {
+	Name string
+	VMs  []UIConsoleVM
+}
+
+type UIConsoleVM struct {
+	Index  int
+	Uptime time.Duration
+}
+
+type UIConsoleData struct {
+	Name  string
+	VM    int
+	Start string
+}
+
+var consoleListTemplate = template.Must(template.New("").Parse(addStyle(`
+<!doctype html>
+<html>
+<head>
+	<title>{{.Name }} syzkaller</title>
+	{{STYLE}}
+</head>
+<body>
+<table>
+	<caption>Running VMs:</caption>
+	<tr>
+		<th>VM</th>
+		<th>Uptime</th>
+	</tr>
+	{{range $vm := $.VMs}}
+	<tr>
+		<td><a href='/console?vm={{$vm.Index}}'>vm-{{$vm.Index}}</a></td>
+		<td>{{$vm.Uptime}}</td>
+	</tr>
+	{{end}}
+</table>
+</body></html>
+`)))
+
+var consoleHeaderTemplate = template.Must(template.New("").Parse(addStyle(`
+<!doctype html>
+<html>
+<head>
+	<title>{{.Name }} syzkaller vm-{{.VM}}</title>
+	{{STYLE}}
+	<style type="text/css">
+		.crash {
+			color: red;
+			font-weight: bold;
+		}
+	</style>
+</head>
+<body>
+<b>vm-{{.VM}} console output (started {{.Start}}):</b>
+<pre>
+`)))
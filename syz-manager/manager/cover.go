@@ -1,7 +1,7 @@
 // Copyright 2015 syzkaller project authors. All rights reserved.
 // Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
 
-package main
+package manager
 
 import (
 	"bufio"
@@ -237,16 +237,11 @@ func uncoveredPcsInFuncs(vmlinux string, pcs []uint64) ([]uint64, error) {
 	handledFuncs := make(map[uint64]bool)
 	uncovered := make(map[uint64]bool)
 	for _, pc := range pcs {
-		idx := sort.Search(len(initCoverSymbols), func(i int) bool {
-			return pc < initCoverSymbols[i].end
-		})
-		if idx == len(initCoverSymbols) {
+		idx := findSymbol(pc)
+		if idx == -1 {
 			continue
 		}
 		s := initCoverSymbols[idx]
-		if pc < s.start || pc > s.end {
-			continue
-		}
 		if !handledFuncs[s.start] {
 			handledFuncs[s.start] = true
 			startPC := sort.Search(len(initCoverPCs), func(i int) bool {
@@ -268,6 +263,21 @@ func uncoveredPcsInFuncs(vmlinux string, pcs []uint64) ([]uint64, error) {
 	return uncoveredPCs, nil
 }
 
+// findSymbol returns index of the symbol in initCoverSymbols that contains pc, or -1.
+func findSymbol(pc uint64) int {
+	idx := sort.Search(len(initCoverSymbols), func(i int) bool {
+		return pc < initCoverSymbols[i].end
+	})
+	if idx == len(initCoverSymbols) {
+		return -1
+	}
+	s := initCoverSymbols[idx]
+	if pc < s.start || pc > s.end {
+		return -1
+	}
+	return idx
+}
+
 // coveredPCs returns list of PCs of __sanitizer_cov_trace_pc calls in binary bin.
 func coveredPCs(arch, bin string) ([]uint64, error) {
 	cmd := osutil.Command("objdump", "-d", "--no-show-raw-insn", bin)
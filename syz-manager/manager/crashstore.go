@@ -0,0 +1,254 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package manager
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/google/syzkaller/pkg/crypt"
+	"github.com/google/syzkaller/pkg/log"
+	"github.com/google/syzkaller/pkg/osutil"
+	"github.com/google/syzkaller/syz-manager/mgrconfig"
+)
+
+// Storage of duplicate crashes. Artifacts of a crash (log, report, json, tag, etc) are stored
+// in files with the same numeric suffix (slot) in the crash dir. By default (no policy is configured)
+// slots [0, maxCrashLogs) are a ring of the most recent crashes. With a policy slots are laid out as:
+//   [0, KeepFirst)                           - first KeepFirst crashes
+//   KeepFirst                                - the most recent crash
+//   [KeepFirst+1, KeepFirst+1+KeepSampled)   - ring of every SampleRate-th crash
+// Total number of crashes is stored in the count file.
+const (
+	crashCountFile = "count"
+	maxCrashLogs   = 100
+	// Crash dirs are re-laid out by copying all artifacts into this subdir first,
+	// so that an interrupted relayout can be redone from the copy.
+	crashRelayoutDir = "relayout"
+)
+
+var crashArtifacts = []string{"log", "report", "json", "tag", "fingerprint", "trail", "postmortem"}
+
+// crashSlots returns slots where the n-th (starting from 1) crash of a single type is stored.
+func crashSlots(policy mgrconfig.CrashStorage, n int) []int {
+	if policy == (mgrconfig.CrashStorage{}) {
+		return []int{(n - 1) % maxCrashLogs}
+	}
+	if n <= policy.KeepFirst {
+		return []int{n - 1}
+	}
+	slots := []int{policy.KeepFirst}
+	if policy.SampleRate != 0 {
+		if i := n - policy.KeepFirst; i%policy.SampleRate == 0 {
+			slots = append(slots, policy.KeepFirst+1+(i/policy.SampleRate-1)%policy.KeepSampled)
+		}
+	}
+	return slots
+}
+
+// maxCrashSlot returns the largest slot that can be used by the policy.
+func maxCrashSlot(policy mgrconfig.CrashStorage) int {
+	if policy == (mgrconfig.CrashStorage{}) {
+		return maxCrashLogs - 1
+	}
+	if policy.SampleRate == 0 {
+		return policy.KeepFirst
+	}
+	return policy.KeepFirst + policy.KeepSampled
+}
+
+func readCrashCount(dir string, key *crypt.Key) (int, bool) {
+	data, err := key.ReadFile(filepath.Join(dir, crashCountFile))
+	if err != nil {
+		return 0, false
+	}
+	n, err := strconv.Atoi(strings.TrimSpace(string(data)))
+	if err != nil {
+		return 0, false
+	}
+	return n, true
+}
+
+func writeCrashCount(dir string, key *crypt.Key, n int) error {
+	return key.WriteFile(filepath.Join(dir, crashCountFile), []byte(fmt.Sprintf("%v\n", n)))
+}
+
+// crashLogSlots returns slots of all logs in the crash dir ordered by modification time.
+func crashLogSlots(dir string) ([]int, error) {
+	files, err := osutil.ListDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	type entry struct {
+		slot  int
+		mtime int64
+	}
+	var entries []entry
+	for _, f := range files {
+		if !strings.HasPrefix(f, "log") {
+			continue
+		}
+		slot, err := strconv.Atoi(f[3:])
+		if err != nil {
+			continue
+		}
+		info, err := os.Stat(filepath.Join(dir, f))
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry{slot, info.ModTime().UnixNano()})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].mtime != entries[j].mtime {
+			return entries[i].mtime < entries[j].mtime
+		}
+		return entries[i].slot < entries[j].slot
+	})
+	slots := make([]int, len(entries))
+	for i, e := range entries {
+		slots[i] = e.slot
+	}
+	return slots, nil
+}
+
+// cleanupCrashes brings all existing crash dirs in line with the storage policy.
+// Crash dirs created before the count file was introduced are re-laid out
+// as if the crashes happened in the order of log modification times.
+// For other dirs slots that are not used by the (possibly changed) policy are removed.
+func cleanupCrashes(crashdir string, policy mgrconfig.CrashStorage, key *crypt.Key) {
+	dirs, err := osutil.ListDir(crashdir)
+	if err != nil {
+		log.Logf(0, "failed to list crashes: %v", err)
+		return
+	}
+	for _, d := range dirs {
+		if err := cleanupCrashDir(filepath.Join(crashdir, d), policy, key); err != nil {
+			log.Logf(0, "failed to cleanup crash dir %v: %v", d, err)
+		}
+	}
+}
+
+func cleanupCrashDir(dir string, policy mgrconfig.CrashStorage, key *crypt.Key) error {
+	backup := filepath.Join(dir, crashRelayoutDir)
+	os.RemoveAll(backup + ".tmp")
+	if osutil.IsExist(backup) {
+		// The previous relayout was interrupted, redo it from the copy.
+		return relayoutCrashDir(dir, policy, key)
+	}
+	logs, err := crashLogSlots(dir)
+	if err != nil {
+		return err
+	}
+	if _, ok := readCrashCount(dir, key); ok {
+		for _, slot := range logs {
+			if slot > maxCrashSlot(policy) {
+				removeCrashSlot(dir, slot)
+			}
+		}
+		return nil
+	}
+	if relayoutNeeded(policy, logs) {
+		return relayoutCrashDir(dir, policy, key)
+	}
+	return writeCrashCount(dir, key, len(logs))
+}
+
+// relayoutTarget returns target slot -> source slot mapping for the crashes
+// if they were saved with the policy (logs are ordered from the oldest).
+func relayoutTarget(policy mgrconfig.CrashStorage, logs []int) map[int]int {
+	target := make(map[int]int)
+	for i, src := range logs {
+		for _, slot := range crashSlots(policy, i+1) {
+			target[slot] = src
+		}
+	}
+	return target
+}
+
+func relayoutNeeded(policy mgrconfig.CrashStorage, logs []int) bool {
+	target := relayoutTarget(policy, logs)
+	if len(target) != len(logs) {
+		return true
+	}
+	for slot, src := range target {
+		if slot != src {
+			return true
+		}
+	}
+	return false
+}
+
+// relayoutCrashDir moves crash artifacts to slots they would occupy
+// if the crashes were saved with the policy (in the order of log modification times).
+// All artifacts are copied aside first and removed only after the relayout is finished,
+// if it is interrupted, the next cleanupCrashDir redoes it from the copy.
+func relayoutCrashDir(dir string, policy mgrconfig.CrashStorage, key *crypt.Key) error {
+	backup := filepath.Join(dir, crashRelayoutDir)
+	if !osutil.IsExist(backup) {
+		logs, err := crashLogSlots(dir)
+		if err != nil {
+			return err
+		}
+		tmp := backup + ".tmp"
+		if err := osutil.MkdirAll(tmp); err != nil {
+			return err
+		}
+		for _, src := range logs {
+			for _, name := range crashArtifacts {
+				file := fmt.Sprintf("%v%v", name, src)
+				if !osutil.IsExist(filepath.Join(dir, file)) {
+					continue
+				}
+				// CopyFile preserves modification time, it is shown as crash time.
+				if err := osutil.CopyFile(filepath.Join(dir, file), filepath.Join(tmp, file)); err != nil {
+					return err
+				}
+			}
+		}
+		if err := os.Rename(tmp, backup); err != nil {
+			return err
+		}
+	}
+	logs, err := crashLogSlots(backup)
+	if err != nil {
+		return err
+	}
+	target := relayoutTarget(policy, logs)
+	for slot, src := range target {
+		for _, name := range crashArtifacts {
+			dst := filepath.Join(dir, fmt.Sprintf("%v%v", name, slot))
+			file := filepath.Join(backup, fmt.Sprintf("%v%v", name, src))
+			if !osutil.IsExist(file) {
+				os.Remove(dst)
+				continue
+			}
+			if err := osutil.CopyFile(file, dst); err != nil {
+				return err
+			}
+		}
+	}
+	existing, err := crashLogSlots(dir)
+	if err != nil {
+		return err
+	}
+	for _, slot := range existing {
+		if _, ok := target[slot]; !ok {
+			removeCrashSlot(dir, slot)
+		}
+	}
+	if err := writeCrashCount(dir, key, len(logs)); err != nil {
+		return err
+	}
+	return os.RemoveAll(backup)
+}
+
+func removeCrashSlot(dir string, slot int) {
+	for _, name := range crashArtifacts {
+		os.Remove(filepath.Join(dir, fmt.Sprintf("%v%v", name, slot)))
+	}
+}
@@ -0,0 +1,175 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package manager
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/syzkaller/pkg/osutil"
+	"github.com/google/syzkaller/syz-manager/mgrconfig"
+)
+
+func TestCrashSlots(t *testing.T) {
+	tests := []struct {
+		policy mgrconfig.CrashStorage
+		n      int
+		slots  []int
+	}{
+		// Default: ring of the most recent crashes.
+		{mgrconfig.CrashStorage{}, 1, []int{0}},
+		{mgrconfig.CrashStorage{}, 100, []int{99}},
+		{mgrconfig.CrashStorage{}, 101, []int{0}},
+		{mgrconfig.CrashStorage{}, 250, []int{49}},
+		// First crashes, then the most recent one.
+		{mgrconfig.CrashStorage{KeepFirst: 3}, 1, []int{0}},
+		{mgrconfig.CrashStorage{KeepFirst: 3}, 3, []int{2}},
+		{mgrconfig.CrashStorage{KeepFirst: 3}, 4, []int{3}},
+		{mgrconfig.CrashStorage{KeepFirst: 3}, 1000, []int{3}},
+		// Sampling.
+		{mgrconfig.CrashStorage{KeepFirst: 2, SampleRate: 10, KeepSampled: 2}, 2, []int{1}},
+		{mgrconfig.CrashStorage{KeepFirst: 2, SampleRate: 10, KeepSampled: 2}, 3, []int{2}},
+		{mgrconfig.CrashStorage{KeepFirst: 2, SampleRate: 10, KeepSampled: 2}, 12, []int{2, 3}},
+		{mgrconfig.CrashStorage{KeepFirst: 2, SampleRate: 10, KeepSampled: 2}, 22, []int{2, 4}},
+		{mgrconfig.CrashStorage{KeepFirst: 2, SampleRate: 10, KeepSampled: 2}, 32, []int{2, 3}},
+		{mgrconfig.CrashStorage{KeepFirst: 2, SampleRate: 10, KeepSampled: 2}, 33, []int{2}},
+		{mgrconfig.CrashStorage{SampleRate: 1, KeepSampled: 2}, 1, []int{0, 1}},
+		{mgrconfig.CrashStorage{SampleRate: 1, KeepSampled: 2}, 2, []int{0, 2}},
+	}
+	for _, test := range tests {
+		slots := crashSlots(test.policy, test.n)
+		if !reflect.DeepEqual(slots, test.slots) {
+			t.Errorf("crashSlots(%+v, %v) = %v, want %v", test.policy, test.n, slots, test.slots)
+		}
+		for _, slot := range slots {
+			if max := maxCrashSlot(test.policy); slot > max {
+				t.Errorf("crashSlots(%+v, %v): slot %v is larger than max slot %v",
+					test.policy, test.n, slot, max)
+			}
+		}
+	}
+}
+
+func TestRelayoutCrashDir(t *testing.T) {
+	tests := []struct {
+		policy mgrconfig.CrashStorage
+		// Slots of existing logs, from the oldest to the newest.
+		logs []int
+		// Target slot -> index of the crash in logs.
+		want map[int]int
+	}{
+		{
+			policy: mgrconfig.CrashStorage{},
+			logs:   []int{2, 0, 1},
+			want:   map[int]int{0: 0, 1: 1, 2: 2},
+		},
+		{
+			policy: mgrconfig.CrashStorage{KeepFirst: 2},
+			logs:   []int{3, 1, 0, 2},
+			want:   map[int]int{0: 0, 1: 1, 2: 3},
+		},
+		{
+			policy: mgrconfig.CrashStorage{KeepFirst: 1, SampleRate: 2, KeepSampled: 1},
+			logs:   []int{4, 3, 2, 1, 0},
+			want:   map[int]int{0: 0, 1: 4, 2: 4},
+		},
+	}
+	for i, test := range tests {
+		test := test
+		t.Run(fmt.Sprint(i), func(t *testing.T) {
+			dir, err := ioutil.TempDir("", "syz-crashstore")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(dir)
+			writeTestCrashes(t, dir, test.logs)
+			if err := cleanupCrashDir(dir, test.policy, nil); err != nil {
+				t.Fatal(err)
+			}
+			checkTestCrashes(t, dir, test.want)
+			if n, ok := readCrashCount(dir, nil); !ok || n != len(test.logs) {
+				t.Fatalf("crash count %v/%v, want %v", n, ok, len(test.logs))
+			}
+			if osutil.IsExist(filepath.Join(dir, crashRelayoutDir)) {
+				t.Fatalf("relayout dir is not removed")
+			}
+		})
+	}
+}
+
+func TestRelayoutCrashDirInterrupted(t *testing.T) {
+	dir, err := ioutil.TempDir("", "syz-crashstore")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	policy := mgrconfig.CrashStorage{KeepFirst: 2}
+	logs := []int{3, 1, 0, 2}
+	// Emulate a relayout that was interrupted after the copy was made
+	// and some of the slots were overwritten.
+	backup := filepath.Join(dir, crashRelayoutDir)
+	osutil.MkdirAll(backup)
+	writeTestCrashes(t, backup, logs)
+	writeTestCrashes(t, dir, logs)
+	if err := osutil.CopyFile(filepath.Join(backup, "log3"), filepath.Join(dir, "log0")); err != nil {
+		t.Fatal(err)
+	}
+	removeCrashSlot(dir, 1)
+	// A partial copy of an interrupted relayout that did not finish copying is ignored.
+	osutil.MkdirAll(backup + ".tmp")
+	if err := osutil.WriteFile(filepath.Join(backup+".tmp", "log0"), []byte("garbage")); err != nil {
+		t.Fatal(err)
+	}
+	if err := cleanupCrashDir(dir, policy, nil); err != nil {
+		t.Fatal(err)
+	}
+	checkTestCrashes(t, dir, map[int]int{0: 0, 1: 1, 2: 3})
+	if osutil.IsExist(backup) || osutil.IsExist(backup+".tmp") {
+		t.Fatalf("relayout dir is not removed")
+	}
+}
+
+// writeTestCrashes creates artifacts of crashes in the slots,
+// the i-th crash is older than the (i+1)-th and its artifacts contain "crash i".
+func writeTestCrashes(t *testing.T, dir string, logs []int) {
+	start := time.Now().Add(-time.Hour)
+	for i, slot := range logs {
+		for _, name := range []string{"log", "report"} {
+			file := filepath.Join(dir, fmt.Sprintf("%v%v", name, slot))
+			if err := osutil.WriteFile(file, []byte(fmt.Sprintf("crash %v", i))); err != nil {
+				t.Fatal(err)
+			}
+			mtime := start.Add(time.Duration(i) * time.Minute)
+			if err := os.Chtimes(file, mtime, mtime); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+}
+
+func checkTestCrashes(t *testing.T, dir string, want map[int]int) {
+	slots, err := crashLogSlots(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(slots) != len(want) {
+		t.Fatalf("got slots %v, want %v", slots, want)
+	}
+	for slot, crash := range want {
+		for _, name := range []string{"log", "report"} {
+			data, err := ioutil.ReadFile(filepath.Join(dir, fmt.Sprintf("%v%v", name, slot)))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := string(data); got != fmt.Sprintf("crash %v", crash) {
+				t.Errorf("%v%v contains %q, want crash %v", name, slot, got, crash)
+			}
+		}
+	}
+}
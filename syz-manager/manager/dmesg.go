@@ -0,0 +1,150 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package manager
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"sort"
+
+	"github.com/google/syzkaller/pkg/crypt"
+	"github.com/google/syzkaller/pkg/log"
+	"github.com/google/syzkaller/pkg/report"
+)
+
+const dmesgErrorsFile = "dmesg-errors.json"
+
+// dmesgErrors counts non-fatal, but worrying kernel messages (see report.DmesgScanner)
+// per kernel build (manager config tag). The counts are persisted in the workdir, so that
+// after a kernel update messages that were never seen with previous builds can be reported.
+type dmesgErrors struct {
+	Tag      string            // kernel build the counts are for
+	Previous string            // previous kernel build, empty if there was no kernel update
+	Counts   map[string]uint64 // message title -> number of occurrences with this build
+	Known    map[string]bool   // titles seen with previous builds
+}
+
+func loadDmesgErrors(workdir, tag string, key *crypt.Key) *dmesgErrors {
+	de := new(dmesgErrors)
+	if data, err := key.ReadFile(filepath.Join(workdir, dmesgErrorsFile)); err == nil {
+		if err := json.Unmarshal(data, de); err != nil {
+			log.Logf(0, "failed to parse %v: %v", dmesgErrorsFile, err)
+			de = new(dmesgErrors)
+		}
+	}
+	if de.Counts == nil {
+		de.Counts = make(map[string]uint64)
+	}
+	if de.Known == nil {
+		de.Known = make(map[string]bool)
+	}
+	if de.Tag != tag {
+		if de.Tag != "" {
+			for title := range de.Counts {
+				de.Known[title] = true
+			}
+			de.Previous = de.Tag
+		}
+		de.Tag = tag
+		de.Counts = make(map[string]uint64)
+	}
+	return de
+}
+
+// isNew says if the message was not seen with previous kernel builds.
+func (de *dmesgErrors) isNew(title string) bool {
+	return de.Previous != "" && !de.Known[title]
+}
+
+// watchDmesg scans VM console output for non-fatal kernel messages and passes the output through
+// to the returned channel. stop must be closed when the returned channel is not read anymore.
+func (mgr *Manager) watchDmesg(outc <-chan []byte, stop <-chan bool) <-chan []byte {
+	scanner := report.NewDmesgScanner(mgr.cfg)
+	res := make(chan []byte, cap(outc))
+	go func() {
+		defer close(res)
+		for out := range outc {
+			if titles := scanner.Scan(out); len(titles) != 0 {
+				mgr.addDmesgErrors(titles)
+			}
+			select {
+			case res <- out:
+			case <-stop:
+				return
+			}
+		}
+	}()
+	return res
+}
+
+func (mgr *Manager) addDmesgErrors(titles []string) {
+	var newTitles []string
+	mgr.mu.Lock()
+	de := mgr.dmesgErrors
+	for _, title := range titles {
+		if de.Counts[title] == 0 && de.isNew(title) {
+			newTitles = append(newTitles, title)
+		}
+		de.Counts[title]++
+	}
+	previous := de.Previous
+	mgr.mu.Unlock()
+	if len(newTitles) == 0 {
+		return
+	}
+	for _, title := range newTitles {
+		log.Logf(0, "new kernel message after kernel update from %v: %v", previous, title)
+		if mgr.dash != nil {
+			mgr.dash.LogError(mgr.cfg.Name, "new kernel message after kernel update from %v: %v",
+				previous, title)
+		}
+	}
+	mgr.saveDmesgErrors()
+}
+
+func (mgr *Manager) saveDmesgErrors() {
+	mgr.mu.Lock()
+	data, err := json.MarshalIndent(mgr.dmesgErrors, "", "\t")
+	mgr.mu.Unlock()
+	if err != nil {
+		log.Logf(0, "failed to serialize dmesg errors: %v", err)
+		return
+	}
+	if err := mgr.key.WriteFile(filepath.Join(mgr.cfg.Workdir, dmesgErrorsFile), data); err != nil {
+		log.Logf(0, "failed to save dmesg errors: %v", err)
+	}
+}
+
+func (mgr *Manager) httpDmesg(w http.ResponseWriter, r *http.Request) {
+	mgr.mu.Lock()
+	de := mgr.dmesgErrors
+	data := &UIDmesgData{
+		Name:     mgr.cfg.Name,
+		Tag:      de.Tag,
+		Previous: de.Previous,
+	}
+	for title, count := range de.Counts {
+		data.Messages = append(data.Messages, UIDmesgMessage{
+			Title: title,
+			Count: count,
+			New:   de.isNew(title),
+		})
+	}
+	mgr.mu.Unlock()
+	sort.Slice(data.Messages, func(i, j int) bool {
+		m1, m2 := data.Messages[i], data.Messages[j]
+		if m1.Count != m2.Count {
+			return m1.Count > m2.Count
+		}
+		return m1.Title < m2.Title
+	})
+
+	if err := dmesgTemplate.Execute(w, data); err != nil {
+		http.Error(w, fmt.Sprintf("failed to execute template: %v", err),
+			http.StatusInternalServerError)
+		return
+	}
+}
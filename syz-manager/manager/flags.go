@@ -0,0 +1,155 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package manager
+
+import (
+	"fmt"
+	"html/template"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/syzkaller/pkg/experiment"
+	"github.com/google/syzkaller/syz-manager/mgrconfig"
+)
+
+// Feature flags (mgrconfig.FeatureFlags) enable experimental fuzzer behaviors on a percentage of VMs.
+// The manager attributes fuzzing time, executions, new corpus inputs and crashes to VMs
+// with and without every flag, /flags page compares these groups (see experiment.CompareFlag).
+// Stats are not persisted, comparisons start from scratch after manager restart.
+type featureFlags struct {
+	flags []mgrconfig.FeatureFlag
+	arms  map[string]*[2]experiment.ArmStats // flag name -> stats of VMs with the flag off/on
+}
+
+func makeFeatureFlags(cfg *mgrconfig.Config) *featureFlags {
+	ff := &featureFlags{
+		flags: cfg.FeatureFlags,
+		arms:  make(map[string]*[2]experiment.ArmStats),
+	}
+	for _, flag := range ff.flags {
+		ff.arms[flag.Name] = new([2]experiment.ArmStats)
+	}
+	return ff
+}
+
+// vmFlags returns state of all flags on the VM that runs fuzzer name.
+// Fuzzers that don't run in manager VMs have all flags disabled.
+func (mgr *Manager) vmFlags(name string) map[string]bool {
+	if len(mgr.flags.flags) == 0 {
+		return nil
+	}
+	index, count := -1, 0
+	if idx, err := strconv.Atoi(strings.TrimPrefix(name, "vm-")); err == nil && mgr.vmPool != nil {
+		index, count = idx, mgr.vmPool.Count()
+	}
+	flags := make(map[string]bool)
+	for _, flag := range mgr.flags.flags {
+		flags[flag.Name] = experiment.FlagEnabled(flag.Name, flag.Percent, index, count)
+	}
+	return flags
+}
+
+// flagStats updates stats of the arms of VMs with the given flags.
+func (mgr *Manager) flagStats(flags map[string]bool, update func(*experiment.ArmStats)) {
+	for name, enabled := range flags {
+		arm := 0
+		if enabled {
+			arm = 1
+		}
+		update(&mgr.flags.arms[name][arm])
+	}
+}
+
+func (mgr *Manager) httpFlags(w http.ResponseWriter, r *http.Request) {
+	data := &UIFlagsData{
+		Name: mgr.cfg.Name,
+	}
+	mgr.mu.Lock()
+	for _, flag := range mgr.flags.flags {
+		arms := mgr.flags.arms[flag.Name]
+		uiFlag := &UIFlag{
+			Name:    flag.Name,
+			Percent: flag.Percent,
+			Fuzzing: [2]time.Duration{arms[0].Fuzzing / time.Minute * time.Minute,
+				arms[1].Fuzzing / time.Minute * time.Minute},
+		}
+		for _, m := range experiment.CompareFlag(&arms[0], &arms[1]) {
+			uiFlag.Metrics = append(uiFlag.Metrics, &UIFlagMetric{
+				Name:        m.Name,
+				Off:         fmt.Sprintf("%.1f", m.A),
+				On:          fmt.Sprintf("%.1f", m.B),
+				Diff:        fmt.Sprintf("%+.1f%%", m.Diff()*100),
+				P:           fmt.Sprintf("%.3f", m.P),
+				Significant: m.Significant(0.05),
+			})
+		}
+		data.Flags = append(data.Flags, uiFlag)
+	}
+	mgr.mu.Unlock()
+	if err := flagsTemplate.Execute(w, data); err != nil {
+		http.Error(w, fmt.Sprintf("failed to execute template: %v", err),
+			http.StatusInternalServerError)
+		return
+	}
+}
+
+type UIFlagsData struct {
+	Name  string
+	Flags []*UIFlag
+}
+
+type UIFlag struct {
+	Name    string
+	Percent int
+	Fuzzing [2]time.Duration // fuzzing time of VMs with the flag off/on
+	Metrics []*UIFlagMetric  // empty until both groups fuzz
+}
+
+type UIFlagMetric struct {
+	Name        string
+	Off         string // rate per fuzzing hour on VMs without the flag
+	On          string // rate per fuzzing hour on VMs with the flag
+	Diff        string
+	P           string
+	Significant bool
+}
+
+var flagsTemplate = template.Must(template.New("").Parse(addStyle(`
+<!doctype html>
+<html>
+<head>
+	<title>{{.Name }} syzkaller</title>
+	{{STYLE}}
+</head>
+<body>
+{{range $f := $.Flags}}
+<table>
+	<caption>{{$f.Name}}: enabled on {{$f.Percent}}% of VMs, fuzzing time off/on: {{index $f.Fuzzing 0}} / {{index $f.Fuzzing 1}}</caption>
+	<tr>
+		<th>Metric (per fuzzing hour)</th>
+		<th>Off</th>
+		<th>On</th>
+		<th>Diff</th>
+		<th>p-value</th>
+	</tr>
+	{{range $m := $f.Metrics}}
+	<tr>
+		<td>{{$m.Name}}</td>
+		<td>{{$m.Off}}</td>
+		<td>{{$m.On}}</td>
+		<td>{{if $m.Significant}}<b>{{$m.Diff}}</b>{{else}}{{$m.Diff}}{{end}}</td>
+		<td>{{$m.P}}</td>
+	</tr>
+	{{else}}
+	<tr><td colspan="5">Not enough fuzzing time on VMs with and without the flag yet.</td></tr>
+	{{end}}
+</table>
+<br>
+{{else}}
+	No feature flags configured.
+{{end}}
+</body></html>
+`)))
@@ -0,0 +1,1793 @@
+// Copyright 2015 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package manager
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"io/ioutil"
+	"net"
+	"net/http"
+	_ "net/http/pprof"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	"github.com/google/syzkaller/pkg/btf"
+	"github.com/google/syzkaller/pkg/callstats"
+	"github.com/google/syzkaller/pkg/cover"
+	"github.com/google/syzkaller/pkg/crypt"
+	"github.com/google/syzkaller/pkg/health"
+	"github.com/google/syzkaller/pkg/log"
+	"github.com/google/syzkaller/pkg/osutil"
+	"github.com/google/syzkaller/pkg/report"
+	"github.com/google/syzkaller/pkg/signal"
+	"github.com/google/syzkaller/prog"
+)
+
+const dateFormat = "Jan 02 2006 15:04:05 MST"
+
+// initHTTP starts serving the web UI. Every manager uses own mux, so that several managers
+// can run in one process (pprof handlers registered in http.DefaultServeMux are shared).
+func (mgr *Manager) initHTTP() error {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", mgr.httpSummary)
+	mux.HandleFunc("/syscalls", mgr.httpSyscalls)
+	mux.HandleFunc("/callstats", mgr.httpCallStats)
+	mux.HandleFunc("/triage", mgr.httpTriage)
+	mux.HandleFunc("/corpus", mgr.httpCorpus)
+	mux.HandleFunc("/corpus/minimize", mgr.httpCorpusMinimize)
+	mux.HandleFunc("/corpus/pin", mgr.httpCorpusPin)
+	mux.HandleFunc("/crash", mgr.httpCrash)
+	mux.HandleFunc("/cover", mgr.httpCover)
+	mux.HandleFunc("/prio", mgr.httpPrio)
+	mux.HandleFunc("/file", mgr.httpFile)
+	mux.HandleFunc("/report", mgr.httpReport)
+	mux.HandleFunc("/rawcover", mgr.httpRawCover)
+	mux.HandleFunc("/vms", mgr.httpVMs)
+	mux.HandleFunc("/console", mgr.httpConsole)
+	mux.HandleFunc("/subsystems", mgr.httpSubsystems)
+	mux.HandleFunc("/layout", mgr.httpLayout)
+	mux.HandleFunc("/dmesg", mgr.httpDmesg)
+	mux.HandleFunc("/clusters", mgr.httpClusters)
+	mux.HandleFunc("/flags", mgr.httpFlags)
+	mux.HandleFunc("/run", mgr.httpRun)
+	mux.HandleFunc("/validate", mgr.httpValidate)
+	mux.HandleFunc("/regression", mgr.httpRegression)
+	mux.HandleFunc("/api", mgr.httpAPI)
+	// Browsers like to request this, without special handler this goes to / handler.
+	mux.HandleFunc("/favicon.ico", func(w http.ResponseWriter, r *http.Request) {})
+	mux.Handle("/debug/pprof/", http.DefaultServeMux)
+	mgr.initHealth(mux)
+
+	ln, err := net.Listen(mgr.httpNetwork, mgr.cfg.HTTP)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %v: %v", mgr.cfg.HTTP, err)
+	}
+	log.Logf(0, "serving http on http://%v", ln.Addr())
+	mgr.httpServer = &http.Server{Handler: mux}
+	go func() {
+		err := mgr.httpServer.Serve(ln)
+		if err != http.ErrServerClosed {
+			mgr.fatalf("failed to serve http: %v", err)
+		}
+	}()
+	return nil
+}
+
+// initHealth registers /healthz and /readyz endpoints.
+// Readiness additionally requires writable workdir and at least one VM that is fuzzing.
+func (mgr *Manager) initHealth(mux *http.ServeMux) {
+	checker := health.NewChecker()
+	checker.Add("rpc", true, mgr.rpcServing.Check)
+	checker.Add("storage", false, health.CheckDir(mgr.cfg.Workdir))
+	checker.Add("vms", false, func() error {
+		if mgr.vmPool == nil {
+			// VMs are started manually, so check that at least one fuzzer is connected.
+			mgr.mu.Lock()
+			fuzzers := len(mgr.fuzzers)
+			mgr.mu.Unlock()
+			if fuzzers == 0 {
+				return fmt.Errorf("no fuzzers are connected")
+			}
+			return nil
+		}
+		mgr.mu.Lock()
+		paused := mgr.paused
+		mgr.mu.Unlock()
+		if atomic.LoadUint32(&mgr.numFuzzing) == 0 && !paused {
+			return fmt.Errorf("no VMs are fuzzing")
+		}
+		return nil
+	})
+	checker.Register(mux)
+}
+
+func (mgr *Manager) httpSummary(w http.ResponseWriter, r *http.Request) {
+	data := &UISummaryData{
+		Name:  mgr.cfg.Name,
+		Log:   log.CachedLogOutput(),
+		Stats: mgr.collectStats(),
+	}
+
+	var err error
+	if data.Crashes, err = mgr.collectCrashes(mgr.cfg.Workdir); err != nil {
+		http.Error(w, fmt.Sprintf("failed to collect crashes: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	if err := summaryTemplate.Execute(w, data); err != nil {
+		http.Error(w, fmt.Sprintf("failed to execute template: %v", err),
+			http.StatusInternalServerError)
+		return
+	}
+}
+
+// httpVMs shows partitioning of VMs into roles and held VMs with commands to connect to them,
+// POST requests change the partitioning at runtime.
+func (mgr *Manager) httpVMs(w http.ResponseWriter, r *http.Request) {
+	if r.Method == "POST" {
+		if err := mgr.updateVMs(r.FormValue("repro_vms"), r.FormValue("hold_vms")); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+	mgr.mu.Lock()
+	reproVMs, holdVMs := mgr.reproVMs, mgr.holdVMs
+	var unhealthy []int
+	health := make(map[int]string)
+	for idx, status := range mgr.vmHealth {
+		unhealthy = append(unhealthy, idx)
+		health[idx] = status
+	}
+	var heldIdx []int
+	held := make(map[int]string)
+	for idx, status := range mgr.heldVMs {
+		heldIdx = append(heldIdx, idx)
+		held[idx] = status
+	}
+	mgr.mu.Unlock()
+	fuzzing := atomic.LoadUint32(&mgr.numFuzzing)
+	reproducing := atomic.LoadUint32(&mgr.numReproducing)
+	fmt.Fprintf(w, "repro_vms: %v\nhold_vms: %v\nfuzzing VMs: %v\nrepro jobs: %v\n",
+		reproVMs, holdVMs, fuzzing, reproducing)
+	sort.Ints(heldIdx)
+	for _, idx := range heldIdx {
+		fmt.Fprintf(w, "vm-%v: held: %v\n", idx, held[idx])
+	}
+	sort.Ints(unhealthy)
+	for _, idx := range unhealthy {
+		fmt.Fprintf(w, "vm-%v: %v\n", idx, health[idx])
+	}
+}
+
+func (mgr *Manager) updateVMs(reproVMs, holdVMs string) error {
+	parse := func(name, val string, res *int) error {
+		if val == "" {
+			return nil
+		}
+		v, err := strconv.Atoi(val)
+		if err != nil || v < 0 {
+			return fmt.Errorf("bad %v value %q", name, val)
+		}
+		*res = v
+		return nil
+	}
+	mgr.mu.Lock()
+	newRepro, newHold := mgr.reproVMs, mgr.holdVMs
+	mgr.mu.Unlock()
+	if err := parse("repro_vms", reproVMs, &newRepro); err != nil {
+		return err
+	}
+	if err := parse("hold_vms", holdVMs, &newHold); err != nil {
+		return err
+	}
+	mgr.mu.Lock()
+	mgr.reproVMs, mgr.holdVMs = newRepro, newHold
+	mgr.mu.Unlock()
+	log.Logf(0, "VM partitioning changed: repro_vms=%v hold_vms=%v", newRepro, newHold)
+	select {
+	case mgr.vmsChanged <- true:
+	default:
+	}
+	return nil
+}
+
+// httpRun serves one-off program runs (see run.go).
+// POST submits a new run (syz program in "prog" or C reproducer in "csource" form value/file,
+// "count" VMs, "duration"), GET with "id" shows status of a run, GET without "id" shows the submission form.
+// "json=1" returns results as JSON instead of HTML (POST returns the run status).
+func (mgr *Manager) httpRun(w http.ResponseWriter, r *http.Request) {
+	asJSON := r.FormValue("json") != ""
+	if r.Method == "POST" {
+		run, err := parseRunRequest(r)
+		if err == nil {
+			err = mgr.submitRun(run)
+		}
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if !asJSON {
+			http.Redirect(w, r, fmt.Sprintf("/run?id=%v", run.ID), http.StatusSeeOther)
+			return
+		}
+		mgr.serveRun(w, run, true)
+		return
+	}
+	if idStr := r.FormValue("id"); idStr != "" {
+		id, err := strconv.Atoi(idStr)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("bad run id %q", idStr), http.StatusBadRequest)
+			return
+		}
+		run := mgr.findRun(id)
+		if run == nil {
+			http.Error(w, fmt.Sprintf("run %v is not found", id), http.StatusNotFound)
+			return
+		}
+		mgr.serveRun(w, run, asJSON)
+		return
+	}
+	data := &UIRunsData{
+		Name: mgr.cfg.Name,
+	}
+	if mgr.vmPool != nil {
+		data.MaxVM = mgr.vmPool.Count()
+	}
+	mgr.mu.Lock()
+	runs := append([]*ProgRun{}, mgr.runs...)
+	mgr.mu.Unlock()
+	for i := len(runs) - 1; i >= 0; i-- {
+		data.Runs = append(data.Runs, runs[i].uiData())
+	}
+	if err := runsTemplate.Execute(w, data); err != nil {
+		http.Error(w, fmt.Sprintf("failed to execute template: %v", err),
+			http.StatusInternalServerError)
+		return
+	}
+}
+
+func (mgr *Manager) serveRun(w http.ResponseWriter, run *ProgRun, asJSON bool) {
+	data := run.uiData()
+	if asJSON {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(data)
+		return
+	}
+	if err := runTemplate.Execute(w, data); err != nil {
+		http.Error(w, fmt.Sprintf("failed to execute template: %v", err),
+			http.StatusInternalServerError)
+		return
+	}
+}
+
+func parseRunRequest(r *http.Request) (*ProgRun, error) {
+	prog, err := formData(r, "prog")
+	if err != nil {
+		return nil, err
+	}
+	csource, err := formData(r, "csource")
+	if err != nil {
+		return nil, err
+	}
+	run := &ProgRun{
+		Prog:    prog,
+		CSource: csource,
+	}
+	count, err := strconv.Atoi(r.FormValue("count"))
+	if err != nil {
+		return nil, fmt.Errorf("bad count %q", r.FormValue("count"))
+	}
+	run.Count = count
+	duration, err := time.ParseDuration(r.FormValue("duration"))
+	if err != nil {
+		return nil, fmt.Errorf("bad duration %q: %v", r.FormValue("duration"), err)
+	}
+	run.Duration = duration
+	return run, nil
+}
+
+// httpValidate parses and validates a program against the current descriptions and formats it.
+// The program is passed in "prog" form value/file or as raw POST body.
+// "nonstrict=1" skips unknown calls (with warnings), "versioned=1" formats with the versioned header.
+// Returns JSON-encoded UIValidation, with 400 status code if the program is invalid.
+func (mgr *Manager) httpValidate(w http.ResponseWriter, r *http.Request) {
+	data, err := formData(r, "prog")
+	if err == nil && len(data) == 0 && r.Method == "POST" {
+		data, err = ioutil.ReadAll(r.Body)
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	res := validateProg(mgr.target, data, r.FormValue("nonstrict") != "", r.FormValue("versioned") != "")
+	w.Header().Set("Content-Type", "application/json")
+	if !res.OK {
+		w.WriteHeader(http.StatusBadRequest)
+	}
+	json.NewEncoder(w).Encode(res)
+}
+
+func validateProg(target *prog.Target, data []byte, nonStrict, versioned bool) *UIValidation {
+	res := new(UIValidation)
+	var p *prog.Prog
+	var err error
+	if nonStrict {
+		p, res.Warnings, err = target.DeserializeNonStrict(data)
+	} else {
+		p, err = target.Deserialize(data)
+	}
+	if err == nil && len(p.Calls) == 0 {
+		err = fmt.Errorf("program is empty")
+	}
+	if err != nil {
+		res.Error = err.Error()
+		if perr, ok := err.(*prog.ParseError); ok {
+			res.Error = perr.Msg
+			res.Line = perr.Line
+			res.Col = perr.Col
+		}
+		return res
+	}
+	res.OK = true
+	res.Calls = len(p.Calls)
+	if versioned {
+		res.Prog = string(p.SerializeVersioned())
+	} else {
+		res.Prog = string(p.Serialize())
+	}
+	return res
+}
+
+// httpRegression serves a regression suite: a minimal subset of the corpus that covers the same signal.
+// The suite is in the execution log format, so it can be executed with syz-execprog
+// (e.g. after every kernel build as a fast sanity check).
+func (mgr *Manager) httpRegression(w http.ResponseWriter, r *http.Request) {
+	mgr.mu.Lock()
+	inputs := make([]signal.Context, 0, len(mgr.corpus))
+	for _, inp := range mgr.corpus {
+		inputs = append(inputs, signal.Context{
+			Signal:  inp.Signal.Deserialize(),
+			Context: inp.Prog,
+		})
+	}
+	corpusSignal := mgr.corpusSignal.Len()
+	mgr.mu.Unlock()
+	suite := signal.MinimalCover(inputs)
+	log.Logf(0, "regression suite: %v programs out of %v corpus inputs", len(suite), len(inputs))
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.Header().Set("Content-Disposition", "attachment; filename=regression.log")
+	fmt.Fprintf(w, "# regression suite: %v programs out of %v corpus inputs, %v signal\n\n",
+		len(suite), len(inputs), corpusSignal)
+	for _, data := range suite {
+		fmt.Fprintf(w, "executing program 0:\n%s\n", data.([]byte))
+	}
+}
+
+// formData returns contents of form value or uploaded file name.
+func formData(r *http.Request, name string) ([]byte, error) {
+	file, _, err := r.FormFile(name)
+	if err != nil {
+		return bytes.TrimSpace([]byte(r.FormValue(name))), nil
+	}
+	defer file.Close()
+	data, err := ioutil.ReadAll(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %v: %v", name, err)
+	}
+	return bytes.TrimSpace(data), nil
+}
+
+func (mgr *Manager) httpSyscalls(w http.ResponseWriter, r *http.Request) {
+	data := &UISyscallsData{
+		Name: mgr.cfg.Name,
+	}
+	for c, cc := range mgr.collectSyscallInfo() {
+		data.Calls = append(data.Calls, UICallType{
+			Name:   c,
+			Inputs: cc.count,
+			Cover:  len(cc.cov),
+			Reason: cc.reason,
+		})
+	}
+	sort.Sort(UICallTypeArray(data.Calls))
+	mgr.mu.Lock()
+	for _, dc := range mgr.disabledCalls {
+		data.Disabled = append(data.Disabled, UICallType{
+			Name:   mgr.target.Syscalls[dc.ID].Name,
+			Reason: dc.Reason,
+		})
+	}
+	mgr.mu.Unlock()
+	sort.Sort(UICallTypeArray(data.Disabled))
+
+	if err := syscallsTemplate.Execute(w, data); err != nil {
+		http.Error(w, fmt.Sprintf("failed to execute template: %v", err),
+			http.StatusInternalServerError)
+		return
+	}
+}
+
+// callAlwaysFails returns true if the call has enough samples and all of them failed.
+// Many such calls after a kernel update may mean a silently broken subsystem.
+func callAlwaysFails(c *callstats.Call) bool {
+	const minSamples = 100
+	return c.Count >= minSamples && c.Errors() == c.Count
+}
+
+// httpCallStats shows latency and errno distributions of executed calls (see pkg/callstats).
+func (mgr *Manager) httpCallStats(w http.ResponseWriter, r *http.Request) {
+	data := &UICallStatsData{
+		Name: mgr.cfg.Name,
+	}
+	mgr.mu.Lock()
+	for name, c := range mgr.callStats {
+		uc := UICallStats{
+			Name:        name,
+			Count:       c.Count,
+			Errors:      fmt.Sprintf("%.1f%%", float64(c.Errors())*100/float64(c.Count)),
+			AlwaysFails: callAlwaysFails(c),
+			P50:         formatLatency(c.Quantile(0.5)),
+			P90:         formatLatency(c.Quantile(0.9)),
+			P99:         formatLatency(c.Quantile(0.99)),
+		}
+		var errnos []int
+		for errno := range c.Errnos {
+			if errno != 0 {
+				errnos = append(errnos, errno)
+			}
+		}
+		sort.Slice(errnos, func(i, j int) bool {
+			return c.Errnos[errnos[i]] > c.Errnos[errnos[j]] ||
+				c.Errnos[errnos[i]] == c.Errnos[errnos[j]] && errnos[i] < errnos[j]
+		})
+		for i, errno := range errnos {
+			if i == 3 {
+				uc.Errnos = append(uc.Errnos, "...")
+				break
+			}
+			uc.Errnos = append(uc.Errnos, fmt.Sprintf("%v %.1f%%",
+				mgr.errnoName(errno), float64(c.Errnos[errno])*100/float64(c.Count)))
+		}
+		data.Calls = append(data.Calls, uc)
+	}
+	mgr.mu.Unlock()
+	sort.Slice(data.Calls, func(i, j int) bool {
+		return data.Calls[i].Name < data.Calls[j].Name
+	})
+	if err := callStatsTemplate.Execute(w, data); err != nil {
+		http.Error(w, fmt.Sprintf("failed to execute template: %v", err),
+			http.StatusInternalServerError)
+		return
+	}
+}
+
+// errnoName returns symbolic name of errno if the target OS is the same as host OS
+// (errno values differ between OSes), otherwise just the number.
+func (mgr *Manager) errnoName(errno int) string {
+	if mgr.cfg.TargetOS != runtime.GOOS {
+		return fmt.Sprintf("errno %v", errno)
+	}
+	return fmt.Sprintf("errno %v (%v)", errno, syscall.Errno(errno).Error())
+}
+
+func formatLatency(d time.Duration) string {
+	if d == 0 {
+		return fmt.Sprintf(">%v", callstats.BucketLimit(callstats.NumBuckets-2))
+	}
+	return fmt.Sprintf("<%v", d)
+}
+
+// httpSubsystems shows coverage attributed to kernel subsystems and modules,
+// along with coverage snapshots taken over time.
+func (mgr *Manager) httpSubsystems(w http.ResponseWriter, r *http.Request) {
+	if !mgr.cfg.Cover || mgr.cfg.KernelObj == "" {
+		http.Error(w, "coverage is not enabled or kernel_obj is not specified", http.StatusInternalServerError)
+		return
+	}
+	cur, err := mgr.subsystemSnapshot()
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to attribute coverage: %v", err), http.StatusInternalServerError)
+		return
+	}
+	m, _ := mgr.subsystems()
+	mgr.mu.Lock()
+	history := append([]*subsystemSnapshot{}, mgr.subsystemHistory...)
+	mgr.mu.Unlock()
+
+	data := &UISubsystemsData{
+		Name: mgr.cfg.Name,
+	}
+	for _, snapshot := range history {
+		data.Times = append(data.Times, snapshot.time.Format("Jan 02 15:04"))
+	}
+	names := make(map[string]bool)
+	for name := range cur.covered {
+		names[name] = true
+	}
+	for name := range m.total {
+		names[name] = true
+	}
+	for name := range names {
+		total := m.total[name]
+		ss := UISubsystem{
+			Name:    name,
+			Covered: cur.covered[name],
+			Total:   total,
+			Percent: coveragePercent(cur.covered[name], total),
+		}
+		for _, snapshot := range history {
+			ss.History = append(ss.History, coveragePercent(snapshot.covered[name], total))
+		}
+		data.Subsystems = append(data.Subsystems, ss)
+	}
+	sort.Slice(data.Subsystems, func(i, j int) bool {
+		return data.Subsystems[i].Name < data.Subsystems[j].Name
+	})
+
+	if err := subsystemsTemplate.Execute(w, data); err != nil {
+		http.Error(w, fmt.Sprintf("failed to execute template: %v", err),
+			http.StatusInternalServerError)
+		return
+	}
+}
+
+// coveragePercent formats coverage of a subsystem,
+// for modules total is unknown, so we show just the number of covered PCs.
+func coveragePercent(covered, total int) string {
+	if total == 0 {
+		return fmt.Sprint(covered)
+	}
+	return fmt.Sprintf("%.1f%%", float64(covered)*100/float64(total))
+}
+
+type CallCov struct {
+	count  int
+	cov    cover.Cover
+	reason string // why the call is down-weighted
+}
+
+func (mgr *Manager) collectStats() []UIStat {
+	mgr.mu.Lock()
+	defer mgr.mu.Unlock()
+
+	stats := []UIStat{
+		{Name: "uptime", Value: fmt.Sprint(time.Since(mgr.startTime) / 1e9 * 1e9)},
+		{Name: "fuzzing", Value: fmt.Sprint(mgr.fuzzingTime / 60e9 * 60e9)},
+		{Name: "corpus", Value: fmt.Sprint(len(mgr.corpus))},
+		{Name: "triage queue", Value: fmt.Sprint(len(mgr.candidates))},
+		{Name: "cover", Value: fmt.Sprint(len(mgr.corpusCover)), Link: "/cover"},
+		{Name: "signal", Value: fmt.Sprint(mgr.corpusSignal.Len())},
+	}
+	if n := len(mgr.subsystemHistory); n != 0 {
+		stats = append(stats, UIStat{
+			Name:  "subsystems",
+			Value: fmt.Sprint(len(mgr.subsystemHistory[n-1].covered)),
+			Link:  "/subsystems",
+		})
+	}
+	if mgr.layoutChecked {
+		val := fmt.Sprint(len(mgr.layoutMismatches))
+		if mgr.layoutErr != nil {
+			val = "error"
+		}
+		stats = append(stats, UIStat{Name: "layout mismatches", Value: val, Link: "/layout"})
+	}
+	if n := len(mgr.dmesgErrors.Counts); n != 0 {
+		val := fmt.Sprint(n)
+		newMessages := 0
+		for title := range mgr.dmesgErrors.Counts {
+			if mgr.dmesgErrors.isNew(title) {
+				newMessages++
+			}
+		}
+		if newMessages != 0 {
+			val += fmt.Sprintf(" (%v new)", newMessages)
+		}
+		stats = append(stats, UIStat{Name: "kernel messages", Value: val, Link: "/dmesg"})
+	}
+	if mgr.vmPool != nil {
+		stats = append(stats, UIStat{Name: "runs", Value: fmt.Sprint(len(mgr.runs)), Link: "/run"})
+		stats = append(stats, UIStat{Name: "running VMs", Value: fmt.Sprint(len(mgr.consoles)), Link: "/console"})
+	}
+	if mgr.checkResult != nil {
+		stats = append(stats, UIStat{
+			Name:  "syscalls",
+			Value: fmt.Sprint(len(mgr.checkResult.EnabledCalls)),
+			Link:  "/syscalls",
+		})
+	}
+	if n := len(mgr.flags.flags); n != 0 {
+		stats = append(stats, UIStat{Name: "feature flags", Value: fmt.Sprint(n), Link: "/flags"})
+	}
+	if mgr.cfg.HubCoordinationGroup != "" {
+		stats = append(stats, UIStat{Name: "hub weighted calls", Value: fmt.Sprint(len(mgr.callWeights))})
+	}
+	if len(mgr.callStats) != 0 {
+		failing := 0
+		for _, c := range mgr.callStats {
+			if callAlwaysFails(c) {
+				failing++
+			}
+		}
+		stats = append(stats, UIStat{
+			Name:  "always failing calls",
+			Value: fmt.Sprint(failing),
+			Link:  "/callstats",
+		})
+	}
+
+	secs := uint64(1)
+	if !mgr.firstConnect.IsZero() {
+		secs = uint64(time.Since(mgr.firstConnect))/1e9 + 1
+	}
+
+	var intStats []UIStat
+	for k, v := range mgr.stats {
+		val := fmt.Sprintf("%v", v)
+		if x := v / secs; x >= 10 {
+			val += fmt.Sprintf(" (%v/sec)", x)
+		} else if x := v * 60 / secs; x >= 10 {
+			val += fmt.Sprintf(" (%v/min)", x)
+		} else {
+			x := v * 60 * 60 / secs
+			val += fmt.Sprintf(" (%v/hour)", x)
+		}
+		intStats = append(intStats, UIStat{Name: k, Value: val})
+	}
+	sort.Sort(UIStatArray(intStats))
+	stats = append(stats, intStats...)
+	return stats
+}
+
+func (mgr *Manager) collectSyscallInfo() map[string]*CallCov {
+	mgr.mu.Lock()
+	defer mgr.mu.Unlock()
+
+	calls := make(map[string]*CallCov)
+	if mgr.checkResult != nil {
+		// Show all effectively enabled calls, including the ones without inputs.
+		for _, id := range mgr.checkResult.EnabledCalls {
+			calls[mgr.target.Syscalls[id].Name] = new(CallCov)
+		}
+		for _, dc := range mgr.checkResult.DownweightedCalls {
+			calls[mgr.target.Syscalls[dc.ID].Name].reason = dc.Reason
+		}
+	}
+	for _, inp := range mgr.corpus {
+		if calls[inp.Call] == nil {
+			calls[inp.Call] = new(CallCov)
+		}
+		cc := calls[inp.Call]
+		cc.count++
+		cc.cov.Merge(inp.Cover)
+	}
+	return calls
+}
+
+func (mgr *Manager) httpCrash(w http.ResponseWriter, r *http.Request) {
+	crashID := r.FormValue("id")
+	crash := readCrash(mgr.cfg.Workdir, crashID, mgr.key, nil, true)
+	if crash == nil {
+		http.Error(w, fmt.Sprintf("failed to read crash info"), http.StatusInternalServerError)
+		return
+	}
+	if r.FormValue("json") != "" {
+		mgr.serveCrashJSON(w, crash)
+		return
+	}
+	if err := crashTemplate.Execute(w, crash); err != nil {
+		http.Error(w, fmt.Sprintf("failed to execute template: %v", err), http.StatusInternalServerError)
+		return
+	}
+}
+
+// serveCrashJSON serves reports of the crash in the format of report.JSONReport,
+// reports of crashes saved before the format was introduced are omitted.
+func (mgr *Manager) serveCrashJSON(w http.ResponseWriter, crash *UICrashType) {
+	data := &UICrashJSON{
+		Title: crash.Description,
+		Count: crash.Count,
+	}
+	for _, c := range crash.Crashes {
+		file := filepath.Join(mgr.cfg.Workdir, "crashes", crash.ID, fmt.Sprintf("json%v", c.Index))
+		raw, err := mgr.key.ReadFile(file)
+		if err != nil {
+			continue
+		}
+		rep, err := report.ParseJSONReport(raw)
+		if err != nil {
+			continue
+		}
+		data.Reports = append(data.Reports, rep)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(data)
+}
+
+// httpCorpusMinimize starts corpus re-minimization on POST requests (see reminimizeCorpus).
+func (mgr *Manager) httpCorpusMinimize(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		http.Error(w, "use POST to start corpus re-minimization", http.StatusMethodNotAllowed)
+		return
+	}
+	n, err := mgr.reminimizeCorpus()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusConflict)
+		return
+	}
+	fmt.Fprintf(w, "queued %v inputs for re-minimization\n", n)
+}
+
+// httpCorpusPin pins (or unpins with unpin=1) corpus programs on POST requests (see pin.go).
+func (mgr *Manager) httpCorpusPin(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		http.Error(w, "use POST to pin corpus programs", http.StatusMethodNotAllowed)
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	sigs := r.Form["sig"]
+	if len(sigs) == 0 {
+		http.Error(w, "no programs specified", http.StatusBadRequest)
+		return
+	}
+	pin := r.FormValue("unpin") == ""
+	n, err := mgr.pinPrograms(sigs, pin)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if pin {
+		fmt.Fprintf(w, "pinned %v programs\n", n)
+	} else {
+		fmt.Fprintf(w, "unpinned %v programs\n", n)
+	}
+}
+
+func (mgr *Manager) httpCorpus(w http.ResponseWriter, r *http.Request) {
+	mgr.mu.Lock()
+	defer mgr.mu.Unlock()
+
+	var data []UIInput
+	call := r.FormValue("call")
+	for sig, inp := range mgr.corpus {
+		if call != inp.Call {
+			continue
+		}
+		p, err := mgr.target.Deserialize(inp.Prog)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("failed to deserialize program: %v", err), http.StatusInternalServerError)
+			return
+		}
+		data = append(data, UIInput{
+			Short:  p.String(),
+			Full:   string(inp.Prog),
+			Cover:  len(inp.Cover),
+			Sig:    sig,
+			Pinned: mgr.pins.pinned[sig],
+		})
+	}
+	sort.Sort(UIInputArray(data))
+
+	if err := corpusTemplate.Execute(w, data); err != nil {
+		http.Error(w, fmt.Sprintf("failed to execute template: %v", err), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (mgr *Manager) httpCover(w http.ResponseWriter, r *http.Request) {
+	mgr.mu.Lock()
+	defer mgr.mu.Unlock()
+
+	if mgr.checkResult == nil {
+		http.Error(w, fmt.Sprintf("machine is not checked yet"), http.StatusInternalServerError)
+		return
+	}
+	if mgr.cfg.Cover {
+		mgr.httpCoverCover(w, r)
+	} else {
+		mgr.httpCoverFallback(w, r)
+	}
+}
+
+func (mgr *Manager) httpCoverCover(w http.ResponseWriter, r *http.Request) {
+	if mgr.cfg.KernelObj == "" {
+		http.Error(w, fmt.Sprintf("no kernel_obj in config file"), http.StatusInternalServerError)
+		return
+	}
+	var cov cover.Cover
+	if sig := r.FormValue("input"); sig != "" {
+		cov.Merge(mgr.corpus[sig].Cover)
+	} else {
+		call := r.FormValue("call")
+		for _, inp := range mgr.corpus {
+			if call == "" || call == inp.Call {
+				cov.Merge(inp.Cover)
+			}
+		}
+	}
+
+	if err := generateCoverHTML(w, mgr.cfg.KernelObj, mgr.cfg.TargetVMArch, cov); err != nil {
+		http.Error(w, fmt.Sprintf("failed to generate coverage profile: %v", err), http.StatusInternalServerError)
+		return
+	}
+	runtime.GC()
+}
+
+func (mgr *Manager) httpCoverFallback(w http.ResponseWriter, r *http.Request) {
+	calls := make(map[int][]int)
+	for s := range mgr.maxSignal {
+		id, errno := prog.DecodeFallbackSignal(uint32(s))
+		calls[id] = append(calls[id], errno)
+	}
+	data := &UIFallbackCoverData{}
+	for _, id := range mgr.checkResult.EnabledCalls {
+		errnos := calls[id]
+		sort.Ints(errnos)
+		successful := 0
+		for len(errnos) != 0 && errnos[0] == 0 {
+			successful++
+			errnos = errnos[1:]
+		}
+		data.Calls = append(data.Calls, UIFallbackCall{
+			Name:       mgr.target.Syscalls[id].Name,
+			Successful: successful,
+			Errnos:     errnos,
+		})
+	}
+	sort.Slice(data.Calls, func(i, j int) bool {
+		return data.Calls[i].Name < data.Calls[j].Name
+	})
+
+	if err := fallbackCoverTemplate.Execute(w, data); err != nil {
+		http.Error(w, fmt.Sprintf("failed to execute template: %v", err), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (mgr *Manager) httpPrio(w http.ResponseWriter, r *http.Request) {
+	mgr.mu.Lock()
+	defer mgr.mu.Unlock()
+
+	mgr.minimizeCorpus()
+	call := r.FormValue("call")
+	idx := -1
+	for i, c := range mgr.target.Syscalls {
+		if c.CallName == call {
+			idx = i
+			break
+		}
+	}
+	if idx == -1 {
+		http.Error(w, fmt.Sprintf("unknown call: %v", call), http.StatusInternalServerError)
+		return
+	}
+
+	data := &UIPrioData{Call: call}
+	for i, p := range mgr.prios[idx] {
+		data.Prios = append(data.Prios, UIPrio{mgr.target.Syscalls[i].Name, p})
+	}
+	sort.Sort(UIPrioArray(data.Prios))
+
+	if err := prioTemplate.Execute(w, data); err != nil {
+		http.Error(w, fmt.Sprintf("failed to execute template: %v", err), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (mgr *Manager) httpFile(w http.ResponseWriter, r *http.Request) {
+	file := filepath.Clean(r.FormValue("name"))
+	if !strings.HasPrefix(file, "crashes/") && !strings.HasPrefix(file, "corpus/") {
+		http.Error(w, "oh, oh, oh!", http.StatusInternalServerError)
+		return
+	}
+	data, err := mgr.key.ReadFile(filepath.Join(mgr.cfg.Workdir, file))
+	if err != nil {
+		http.Error(w, "failed to open the file", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.Write(data)
+}
+
+func (mgr *Manager) httpReport(w http.ResponseWriter, r *http.Request) {
+	mgr.mu.Lock()
+	defer mgr.mu.Unlock()
+
+	crashID := r.FormValue("id")
+	desc, err := mgr.key.ReadFile(filepath.Join(mgr.crashdir, crashID, "description"))
+	if err != nil {
+		http.Error(w, "failed to read description file", http.StatusInternalServerError)
+		return
+	}
+	tag, _ := mgr.key.ReadFile(filepath.Join(mgr.crashdir, crashID, "repro.tag"))
+	prog, _ := mgr.key.ReadFile(filepath.Join(mgr.crashdir, crashID, "repro.prog"))
+	cprog, _ := mgr.key.ReadFile(filepath.Join(mgr.crashdir, crashID, "repro.cprog"))
+	rep, _ := mgr.key.ReadFile(filepath.Join(mgr.crashdir, crashID, "repro.report"))
+	log, _ := mgr.key.ReadFile(filepath.Join(mgr.crashdir, crashID, "repro.stats.log"))
+	stats, _ := mgr.key.ReadFile(filepath.Join(mgr.crashdir, crashID, "repro.stats"))
+
+	commitDesc := ""
+	if len(tag) != 0 {
+		commitDesc = fmt.Sprintf(" on commit %s.", trimNewLines(tag))
+	}
+	fmt.Fprintf(w, "Syzkaller hit '%s' bug%s.\n\n", trimNewLines(desc), commitDesc)
+	if len(rep) != 0 {
+		fmt.Fprintf(w, "%s\n\n", rep)
+	}
+	if len(prog) == 0 && len(cprog) == 0 {
+		fmt.Fprintf(w, "The bug is not reproducible.\n")
+	} else {
+		fmt.Fprintf(w, "Syzkaller reproducer:\n%s\n\n", prog)
+		if len(cprog) != 0 {
+			fmt.Fprintf(w, "C reproducer:\n%s\n\n", cprog)
+		}
+	}
+	if len(stats) > 0 {
+		fmt.Fprintf(w, "Reproducing stats:\n%s\n\n", stats)
+	}
+	if len(log) > 0 {
+		fmt.Fprintf(w, "Reproducing log:\n%s\n\n", log)
+	}
+}
+
+func (mgr *Manager) httpRawCover(w http.ResponseWriter, r *http.Request) {
+	mgr.mu.Lock()
+	defer mgr.mu.Unlock()
+
+	initCoverOnce.Do(func() { initCoverError = initCover(mgr.cfg.KernelObj, mgr.cfg.TargetArch) })
+	if initCoverError != nil {
+		http.Error(w, initCoverError.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var cov cover.Cover
+	for _, inp := range mgr.corpus {
+		cov.Merge(inp.Cover)
+	}
+	pcs := make([]uint64, 0, len(cov))
+	for pc := range cov {
+		fullPC := cover.RestorePC(pc, initCoverVMOffset)
+		prevPC := previousInstructionPC(mgr.cfg.TargetVMArch, fullPC)
+		pcs = append(pcs, prevPC)
+	}
+	sort.Slice(pcs, func(i, j int) bool {
+		return pcs[i] < pcs[j]
+	})
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	buf := bufio.NewWriter(w)
+	for _, pc := range pcs {
+		fmt.Fprintf(buf, "0x%x\n", pc)
+	}
+	buf.Flush()
+}
+
+func (mgr *Manager) collectCrashes(workdir string) ([]*UICrashType, error) {
+	// Note: mu is not locked here.
+	reproReply := make(chan map[string]bool)
+	mgr.reproRequest <- reproReply
+	repros := <-reproReply
+
+	crashdir := filepath.Join(workdir, "crashes")
+	dirs, err := osutil.ListDir(crashdir)
+	if err != nil {
+		return nil, err
+	}
+	var crashTypes []*UICrashType
+	for _, dir := range dirs {
+		crash := readCrash(workdir, dir, mgr.key, repros, false)
+		if crash != nil {
+			crashTypes = append(crashTypes, crash)
+		}
+	}
+	sort.Sort(UICrashTypeArray(crashTypes))
+	return crashTypes, nil
+}
+
+func readCrash(workdir, dir string, key *crypt.Key, repros map[string]bool, full bool) *UICrashType {
+	if len(dir) != 40 {
+		return nil
+	}
+	crashdir := filepath.Join(workdir, "crashes")
+	descFile := filepath.Join(crashdir, dir, "description")
+	desc, err := key.ReadFile(descFile)
+	if err != nil || len(desc) == 0 {
+		return nil
+	}
+	desc = trimNewLines(desc)
+	stat, err := os.Stat(descFile)
+	if err != nil {
+		return nil
+	}
+	modTime := stat.ModTime()
+
+	files, err := osutil.ListDir(filepath.Join(crashdir, dir))
+	if err != nil {
+		return nil
+	}
+	var crashes []*UICrash
+	reproAttempts := 0
+	hasRepro, hasCRepro := false, false
+	reports := make(map[string]bool)
+	for _, f := range files {
+		if strings.HasPrefix(f, "log") {
+			index, err := strconv.ParseUint(f[3:], 10, 64)
+			if err == nil {
+				crashes = append(crashes, &UICrash{
+					Index: int(index),
+				})
+			}
+		} else if strings.HasPrefix(f, "report") {
+			reports[f] = true
+		} else if f == "repro.prog" {
+			hasRepro = true
+		} else if f == "repro.cprog" {
+			hasCRepro = true
+		} else if f == "repro.report" {
+		} else if f == "repro0" || f == "repro1" || f == "repro2" {
+			reproAttempts++
+		}
+	}
+
+	if full {
+		for _, crash := range crashes {
+			index := strconv.Itoa(crash.Index)
+			crash.Log = filepath.Join("crashes", dir, "log"+index)
+			if stat, err := os.Stat(filepath.Join(workdir, crash.Log)); err == nil {
+				crash.Time = stat.ModTime()
+				crash.TimeStr = crash.Time.Format(dateFormat)
+			}
+			tag, _ := key.ReadFile(filepath.Join(crashdir, dir, "tag"+index))
+			crash.Tag = string(tag)
+			reportFile := filepath.Join("crashes", dir, "report"+index)
+			if osutil.IsExist(filepath.Join(workdir, reportFile)) {
+				crash.Report = reportFile
+			}
+		}
+		sort.Sort(UICrashArray(crashes))
+	}
+
+	count := len(crashes)
+	if n, ok := readCrashCount(filepath.Join(crashdir, dir), key); ok {
+		count = n
+	}
+	triaged := ""
+	if hasRepro {
+		if hasCRepro {
+			triaged = "has C repro"
+		} else {
+			triaged = "has repro"
+		}
+	} else if repros[string(desc)] {
+		triaged = "reproducing"
+	} else if reproAttempts >= maxReproAttempts {
+		triaged = "non-reproducible"
+	}
+	return &UICrashType{
+		Description: string(desc),
+		LastTime:    modTime.Format(dateFormat),
+		ID:          dir,
+		Count:       count,
+		Triaged:     triaged,
+		Crashes:     crashes,
+	}
+}
+
+func trimNewLines(data []byte) []byte {
+	for len(data) > 0 && data[len(data)-1] == '\n' {
+		data = data[:len(data)-1]
+	}
+	return data
+}
+
+type UISummaryData struct {
+	Name    string
+	Stats   []UIStat
+	Crashes []*UICrashType
+	Log     string
+}
+
+type UISyscallsData struct {
+	Name     string
+	Calls    []UICallType
+	Disabled []UICallType
+}
+
+type UICallStatsData struct {
+	Name  string
+	Calls []UICallStats
+}
+
+type UICallStats struct {
+	Name        string
+	Count       uint64
+	Errors      string
+	AlwaysFails bool
+	Errnos      []string
+	P50         string
+	P90         string
+	P99         string
+}
+
+type UISubsystemsData struct {
+	Name       string
+	Times      []string
+	Subsystems []UISubsystem
+}
+
+type UISubsystem struct {
+	Name    string
+	Covered int
+	Total   int
+	Percent string
+	History []string
+}
+
+type UILayoutData struct {
+	Name       string
+	Checked    bool
+	Error      string
+	Mismatches []*btf.Mismatch
+}
+
+type UIDmesgData struct {
+	Name     string
+	Tag      string
+	Previous string
+	Messages []UIDmesgMessage
+}
+
+type UIDmesgMessage struct {
+	Title string
+	Count uint64
+	New   bool
+}
+
+type UIClustersData struct {
+	Name     string
+	Clusters []*UICluster
+}
+
+type UICluster struct {
+	Count   int
+	Frames  []string
+	Crashes []*UICrashType
+}
+
+type UICrashType struct {
+	Description string
+	LastTime    string
+	ID          string
+	Count       int
+	Triaged     string
+	Crashes     []*UICrash
+}
+
+type UICrashJSON struct {
+	Title   string               `json:"title"`
+	Count   int                  `json:"count"`
+	Reports []*report.JSONReport `json:"reports"`
+}
+
+type UICrash struct {
+	Index   int
+	Time    time.Time
+	TimeStr string
+	Log     string
+	Report  string
+	Tag     string
+}
+
+type UIRunsData struct {
+	Name  string
+	MaxVM int
+	Runs  []*UIRun
+}
+
+type UIRun struct {
+	ID       int
+	Created  string
+	Duration string
+	Prog     string `json:",omitempty"`
+	CSource  string `json:",omitempty"`
+	Status   string
+	Finished bool
+	VMs      []*UIRunVM
+}
+
+type UIRunVM struct {
+	Index  int
+	Status string
+	Error  string `json:",omitempty"`
+	Title  string `json:",omitempty"`
+	Report string `json:",omitempty"`
+	Output string
+}
+
+type UIValidation struct {
+	OK       bool     `json:"ok"`
+	Prog     string   `json:"prog,omitempty"` // formatted program
+	Calls    int      `json:"calls,omitempty"`
+	Warnings []string `json:"warnings,omitempty"`
+	Error    string   `json:"error,omitempty"`
+	Line     int      `json:"line,omitempty"` // 1-based position of the error, if known
+	Col      int      `json:"col,omitempty"`
+}
+
+type UIStat struct {
+	Name  string
+	Value string
+	Link  string
+}
+
+type UICallType struct {
+	Name   string
+	Inputs int
+	Cover  int
+	Reason string // why the call is disabled or down-weighted
+}
+
+type UIInput struct {
+	Short  string
+	Full   string
+	Calls  int
+	Cover  int
+	Sig    string
+	Pinned bool
+}
+
+type UICallTypeArray []UICallType
+
+func (a UICallTypeArray) Len() int           { return len(a) }
+func (a UICallTypeArray) Less(i, j int) bool { return a[i].Name < a[j].Name }
+func (a UICallTypeArray) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
+
+type UIInputArray []UIInput
+
+func (a UIInputArray) Len() int           { return len(a) }
+func (a UIInputArray) Less(i, j int) bool { return a[i].Cover > a[j].Cover }
+func (a UIInputArray) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
+
+type UIStatArray []UIStat
+
+func (a UIStatArray) Len() int           { return len(a) }
+func (a UIStatArray) Less(i, j int) bool { return a[i].Name < a[j].Name }
+func (a UIStatArray) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
+
+type UICrashTypeArray []*UICrashType
+
+func (a UICrashTypeArray) Len() int           { return len(a) }
+func (a UICrashTypeArray) Less(i, j int) bool { return a[i].Description < a[j].Description }
+func (a UICrashTypeArray) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
+
+type UICrashArray []*UICrash
+
+func (a UICrashArray) Len() int           { return len(a) }
+func (a UICrashArray) Less(i, j int) bool { return a[i].Time.After(a[j].Time) }
+func (a UICrashArray) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
+
+var summaryTemplate = template.Must(template.New("").Parse(addStyle(`
+<!doctype html>
+<html>
+<head>
+	<title>{{.Name }} syzkaller</title>
+	{{STYLE}}
+</head>
+<body>
+<b>{{.Name }} syzkaller</b>
+<br>
+<br>
+
+<table>
+	<caption>Stats:</caption>
+	{{range $s := $.Stats}}
+	<tr>
+		<td>{{$s.Name}}</td>
+		{{if $s.Link}}
+			<td><a href="{{$s.Link}}">{{$s.Value}}</a></td>
+		{{else}}
+			<td>{{$s.Value}}</td>
+		{{end}}
+	</tr>
+	{{end}}
+</table>
+<br>
+
+<table>
+	<caption>Crashes (<a href="/clusters">clusters by stack</a>):</caption>
+	<tr>
+		<th>Description</th>
+		<th>Count</th>
+		<th>Last Time</th>
+		<th>Report</th>
+	</tr>
+	{{range $c := $.Crashes}}
+	<tr>
+		<td><a href="/crash?id={{$c.ID}}">{{$c.Description}}</a></td>
+		<td>{{$c.Count}}</td>
+		<td>{{$c.LastTime}}</td>
+		<td>
+			{{if $c.Triaged}}
+				<a href="/report?id={{$c.ID}}">{{$c.Triaged}}</a>
+			{{end}}
+		</td>
+	</tr>
+	{{end}}
+</table>
+<br>
+
+<b>Log:</b>
+<br>
+<textarea id="log_textarea" readonly rows="20">
+{{.Log}}
+</textarea>
+<script>
+	var textarea = document.getElementById("log_textarea");
+	textarea.scrollTop = textarea.scrollHeight;
+</script>
+</body></html>
+`)))
+
+var syscallsTemplate = template.Must(template.New("").Parse(addStyle(`
+<!doctype html>
+<html>
+<head>
+	<title>{{.Name }} syzkaller</title>
+	{{STYLE}}
+</head>
+<body>
+<b>Per-call coverage</b> (<a href='/triage'>corpus contribution</a>)<b>:</b>
+<br>
+{{range $c := $.Calls}}
+	{{$c.Name}}
+		<a href='/corpus?call={{$c.Name}}'>inputs:{{$c.Inputs}}</a>
+		<a href='/cover?call={{$c.Name}}'>cover:{{$c.Cover}}</a>
+		<a href='/prio?call={{$c.Name}}'>prio</a>
+		{{if $c.Reason}}(down-weighted: {{$c.Reason}}){{end}} <br>
+{{end}}
+{{if $.Disabled}}
+<br>
+<b>Disabled syscalls ({{len $.Disabled}}):</b>
+<br>
+{{range $c := $.Disabled}}
+	{{$c.Name}}: {{$c.Reason}} <br>
+{{end}}
+{{end}}
+</body></html>
+`)))
+
+var callStatsTemplate = template.Must(template.New("").Parse(addStyle(`
+<!doctype html>
+<html>
+<head>
+	<title>{{.Name }} syzkaller</title>
+	{{STYLE}}
+</head>
+<body>
+<table>
+	<caption>Per-call latency and errors (sampled):</caption>
+	<tr>
+		<th>Call</th>
+		<th>Samples</th>
+		<th>Errors</th>
+		<th>Top errnos</th>
+		<th>p50</th>
+		<th>p90</th>
+		<th>p99</th>
+	</tr>
+	{{range $c := $.Calls}}
+	<tr>
+		<td>{{$c.Name}}</td>
+		<td>{{$c.Count}}</td>
+		<td>{{if $c.AlwaysFails}}<b>{{$c.Errors}}</b>{{else}}{{$c.Errors}}{{end}}</td>
+		<td>{{range $e := $c.Errnos}}{{$e}}<br>{{end}}</td>
+		<td>{{$c.P50}}</td>
+		<td>{{$c.P90}}</td>
+		<td>{{$c.P99}}</td>
+	</tr>
+	{{end}}
+</table>
+</body></html>
+`)))
+
+var subsystemsTemplate = template.Must(template.New("").Parse(addStyle(`
+<!doctype html>
+<html>
+<head>
+	<title>{{.Name }} syzkaller</title>
+	{{STYLE}}
+</head>
+<body>
+<table>
+	<caption>Per-subsystem coverage:</caption>
+	<tr>
+		<th>Subsystem</th>
+		<th>Covered</th>
+		<th>Total</th>
+		<th>Coverage</th>
+		{{range $t := $.Times}}
+			<th>{{$t}}</th>
+		{{end}}
+	</tr>
+	{{range $s := $.Subsystems}}
+	<tr>
+		<td>{{$s.Name}}</td>
+		<td>{{$s.Covered}}</td>
+		<td>{{if $s.Total}}{{$s.Total}}{{end}}</td>
+		<td>{{$s.Percent}}</td>
+		{{range $h := $s.History}}
+			<td>{{$h}}</td>
+		{{end}}
+	</tr>
+	{{end}}
+</table>
+</body></html>
+`)))
+
+var layoutTemplate = template.Must(template.New("").Parse(addStyle(`
+<!doctype html>
+<html>
+<head>
+	<title>{{.Name }} syzkaller</title>
+	{{STYLE}}
+</head>
+<body>
+{{if not .Checked}}
+	Struct layouts are not checked yet.
+{{else if .Error}}
+	Failed to check struct layouts: {{.Error}}
+{{else}}
+<table>
+	<caption>Struct layout mismatches with kernel BTF ({{len .Mismatches}}):</caption>
+	<tr>
+		<th>Struct</th>
+		<th>Field</th>
+		<th>Mismatch</th>
+		<th>Descriptions</th>
+		<th>Kernel</th>
+	</tr>
+	{{range $m := $.Mismatches}}
+	<tr>
+		<td>{{$m.Struct}}</td>
+		<td>{{$m.Field}}</td>
+		<td>{{$m.What}}</td>
+		<td>{{$m.Desc}}</td>
+		<td>{{$m.Kernel}}</td>
+	</tr>
+	{{end}}
+</table>
+{{end}}
+</body></html>
+`)))
+
+var dmesgTemplate = template.Must(template.New("").Parse(addStyle(`
+<!doctype html>
+<html>
+<head>
+	<title>{{.Name }} syzkaller</title>
+	{{STYLE}}
+</head>
+<body>
+<table>
+	<caption>Kernel messages with build {{.Tag}}{{if .Previous}} (new since {{.Previous}} in bold){{end}}:</caption>
+	<tr>
+		<th>Message</th>
+		<th>Count</th>
+	</tr>
+	{{range $m := $.Messages}}
+	<tr>
+		<td>{{if $m.New}}<b>{{$m.Title}}</b>{{else}}{{$m.Title}}{{end}}</td>
+		<td>{{$m.Count}}</td>
+	</tr>
+	{{end}}
+</table>
+</body></html>
+`)))
+
+var clustersTemplate = template.Must(template.New("").Parse(addStyle(`
+<!doctype html>
+<html>
+<head>
+	<title>{{.Name }} syzkaller</title>
+	{{STYLE}}
+</head>
+<body>
+{{range $c := $.Clusters}}
+<table>
+	<caption>Cluster of {{len $c.Crashes}} crashes ({{$c.Count}} total), common frames: {{range $f := $c.Frames}}{{$f}} {{end}}</caption>
+	<tr>
+		<th>Description</th>
+		<th>Count</th>
+		<th>Last Time</th>
+	</tr>
+	{{range $crash := $c.Crashes}}
+	<tr>
+		<td><a href="/crash?id={{$crash.ID}}">{{$crash.Description}}</a></td>
+		<td>{{$crash.Count}}</td>
+		<td>{{$crash.LastTime}}</td>
+	</tr>
+	{{end}}
+</table>
+<br>
+{{else}}
+	No crashes with similar stacks.
+{{end}}
+</body></html>
+`)))
+
+var crashTemplate = template.Must(template.New("").Parse(addStyle(`
+<!doctype html>
+<html>
+<head>
+	<title>{{.Description}}</title>
+	{{STYLE}}
+</head>
+<body>
+<b>{{.Description}}</b>
+<br><br>
+
+{{if .Triaged}}
+Report: <a href="/report?id={{.ID}}">{{.Triaged}}</a>
+{{end}}
+<br><br>
+
+<table>
+	<tr>
+		<th>#</th>
+		<th>Log</th>
+		<th>Report</th>
+		<th>Time</th>
+		<th>Tag</th>
+	</tr>
+	{{range $c := $.Crashes}}
+	<tr>
+		<td>{{$c.Index}}</td>
+		<td><a href="/file?name={{$c.Log}}">log</a></td>
+		{{if $c.Report}}
+			<td><a href="/file?name={{$c.Report}}">report</a></td>
+		{{else}}
+			<td></td>
+		{{end}}
+		<td>{{$c.TimeStr}}</td>
+		<td>{{$c.Tag}}</td>
+	</tr>
+	{{end}}
+</table>
+</body></html>
+`)))
+
+var runsTemplate = template.Must(template.New("").Parse(addStyle(`
+<!doctype html>
+<html>
+<head>
+	<title>{{.Name }} syzkaller</title>
+	{{STYLE}}
+</head>
+<body>
+<b>Run program:</b>
+<br>
+<form action="/run" method="post" enctype="multipart/form-data">
+	syz program:<br>
+	<textarea id="prog_textarea" name="prog" rows="15"></textarea><br>
+	<input type="button" value="Validate" onclick="validateProg()">
+	<span id="validate_result"></span><br>
+	or C reproducer: <input type="file" name="csource"><br><br>
+	VMs: <input type="number" name="count" value="1" min="1" max="{{.MaxVM}}">
+	duration: <input type="text" name="duration" value="5m" size="6">
+	<input type="submit" value="Run">
+</form>
+<script>
+	function validateProg() {
+		var textarea = document.getElementById("prog_textarea");
+		var result = document.getElementById("validate_result");
+		var form = new FormData();
+		form.append("prog", textarea.value);
+		fetch("/validate", {method: "POST", body: form}).then(function(resp) {
+			return resp.json();
+		}).then(function(res) {
+			if (!res.ok) {
+				result.textContent = res.error;
+				if (res.line) {
+					result.textContent = "line " + res.line + ":" + res.col + ": " + res.error;
+					var lines = textarea.value.split("\n");
+					var pos = res.col - 1;
+					for (var i = 0; i < res.line - 1; i++) {
+						pos += lines[i].length + 1;
+					}
+					textarea.focus();
+					textarea.setSelectionRange(pos, pos + 1);
+				}
+				return;
+			}
+			textarea.value = res.prog;
+			result.textContent = "OK, " + res.calls + " calls";
+		}).catch(function(err) {
+			result.textContent = "validation failed: " + err;
+		});
+	}
+</script>
+<br>
+
+<table>
+	<caption>Recent runs:</caption>
+	<tr>
+		<th>ID</th>
+		<th>Created</th>
+		<th>Duration</th>
+		<th>Status</th>
+	</tr>
+	{{range $r := $.Runs}}
+	<tr>
+		<td><a href="/run?id={{$r.ID}}">{{$r.ID}}</a></td>
+		<td>{{$r.Created}}</td>
+		<td>{{$r.Duration}}</td>
+		<td>{{$r.Status}}</td>
+	</tr>
+	{{end}}
+</table>
+</body></html>
+`)))
+
+var runTemplate = template.Must(template.New("").Parse(addStyle(`
+<!doctype html>
+<html>
+<head>
+	<title>run {{.ID}}</title>
+	{{if not .Finished}}<meta http-equiv="refresh" content="5">{{end}}
+	{{STYLE}}
+</head>
+<body>
+<b>Run {{.ID}}: {{.Status}}</b>
+<br>
+created {{.Created}}, duration {{.Duration}}
+<br><br>
+{{if .Prog}}
+<textarea readonly rows="10">{{.Prog}}</textarea>
+{{else}}
+<textarea readonly rows="10">{{.CSource}}</textarea>
+{{end}}
+<br>
+
+{{range $vm := $.VMs}}
+<br>
+<b>vm-{{$vm.Index}}: {{$vm.Status}}</b>
+{{if $vm.Error}}<br>{{$vm.Error}}{{end}}
+{{if $vm.Title}}
+<br>crash: {{$vm.Title}}
+<br>
+<textarea readonly rows="20">{{$vm.Report}}</textarea>
+{{end}}
+{{if $vm.Output}}
+<br>console output:
+<br>
+<textarea readonly rows="20">{{$vm.Output}}</textarea>
+{{end}}
+<br>
+{{end}}
+</body></html>
+`)))
+
+var corpusTemplate = template.Must(template.New("").Parse(addStyle(`
+<!doctype html>
+<html>
+<head>
+	<title>syzkaller corpus</title>
+	{{STYLE}}
+</head>
+<body>
+{{range $c := $}}
+	<span title="{{$c.Full}}">{{$c.Short}}</span>
+		<a href='/cover?input={{$c.Sig}}'>cover:{{$c.Cover}}</a>
+		{{if $c.Pinned}}<b>pinned</b>{{end}}
+		<br>
+{{end}}
+</body></html>
+`)))
+
+type UIPrioData struct {
+	Call  string
+	Prios []UIPrio
+}
+
+type UIPrio struct {
+	Call string
+	Prio float32
+}
+
+type UIPrioArray []UIPrio
+
+func (a UIPrioArray) Len() int           { return len(a) }
+func (a UIPrioArray) Less(i, j int) bool { return a[i].Prio > a[j].Prio }
+func (a UIPrioArray) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
+
+var prioTemplate = template.Must(template.New("").Parse(addStyle(`
+<!doctype html>
+<html>
+<head>
+	<title>syzkaller priorities</title>
+	{{STYLE}}
+</head>
+<body>
+Priorities for {{$.Call}} <br> <br>
+{{range $p := $.Prios}}
+	{{printf "%.4f\t%s" $p.Prio $p.Call}} <br>
+{{end}}
+</body></html>
+`)))
+
+type UIFallbackCoverData struct {
+	Calls []UIFallbackCall
+}
+
+type UIFallbackCall struct {
+	Name       string
+	Successful int
+	Errnos     []int
+}
+
+var fallbackCoverTemplate = template.Must(template.New("").Parse(addStyle(`
+<!doctype html>
+<html>
+<head>
+	<title>syzkaller coverage</title>
+	{{STYLE}}
+</head>
+<body>
+<table>
+	<tr>
+		<th>Call</th>
+		<th>Successful</th>
+		<th>Errnos</th>
+	</tr>
+	{{range $c := $.Calls}}
+	<tr>
+		<td>{{$c.Name}}</td>
+		<td>{{if $c.Successful}}{{$c.Successful}}{{end}}</td>
+		<td>{{range $e := $c.Errnos}}{{$e}}&nbsp;{{end}}</td>
+	</tr>
+	{{end}}
+</table>
+</body></html>
+`)))
+
+func addStyle(html string) string {
+	return strings.Replace(html, "{{STYLE}}", htmlStyle, -1)
+}
+
+const htmlStyle = `
+	<style type="text/css" media="screen">
+		table {
+			border-collapse:collapse;
+			border:1px solid;
+		}
+		table caption {
+			font-weight: bold;
+		}
+		table td {
+			border:1px solid;
+			padding: 3px;
+		}
+		table th {
+			border:1px solid;
+			padding: 3px;
+		}
+		textarea {
+			width:100%;
+		}
+	</style>
+`
@@ -0,0 +1,122 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package manager
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/google/syzkaller/pkg/cover"
+	"github.com/google/syzkaller/pkg/rpctype"
+	"github.com/google/syzkaller/prog"
+	"github.com/google/syzkaller/syz-manager/mgrconfig"
+)
+
+// hubFilter restricts programs exchanged with syz-hub according to mgrconfig.HubFilter.
+// Empty call sets don't restrict anything.
+type hubFilter struct {
+	pushCalls      map[string]bool
+	pushSubsystems []string
+	pushMinSignal  int
+	pullCalls      map[string]bool
+}
+
+func makeHubFilter(cfg *mgrconfig.HubFilter, target *prog.Target) (*hubFilter, error) {
+	f := &hubFilter{
+		pushSubsystems: cfg.PushSubsystems,
+		pushMinSignal:  cfg.PushMinSignal,
+	}
+	var err error
+	if f.pushCalls, err = hubFilterCalls(target, cfg.PushCalls); err != nil {
+		return nil, err
+	}
+	if f.pullCalls, err = hubFilterCalls(target, cfg.PullCalls); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+func hubFilterCalls(target *prog.Target, patterns []string) (map[string]bool, error) {
+	if len(patterns) == 0 {
+		return nil, nil
+	}
+	ids, err := mgrconfig.ParseEnabledSyscalls(target, patterns, nil)
+	if err != nil {
+		return nil, err
+	}
+	calls := make(map[string]bool)
+	for id := range ids {
+		calls[target.Syscalls[id].Name] = true
+	}
+	return calls, nil
+}
+
+// pullCallNames returns calls for rpctype.HubConnectArgs.PullCalls.
+func (f *hubFilter) pullCallNames() []string {
+	var calls []string
+	for c := range f.pullCalls {
+		calls = append(calls, c)
+	}
+	sort.Strings(calls)
+	return calls
+}
+
+// needSubsystems says if push filtering requires attribution of coverage to subsystems.
+func (f *hubFilter) needSubsystems() bool {
+	return len(f.pushSubsystems) != 0
+}
+
+// push says if the corpus input should be sent to hub.
+// subsystems must be non-nil if needSubsystems returns true.
+func (f *hubFilter) push(inp rpctype.RPCInput, subsystems *subsystemMap, arch string) bool {
+	if len(inp.Signal.Elems) < f.pushMinSignal {
+		return false
+	}
+	if len(f.pushCalls) != 0 && !containsCall(inp.Prog, f.pushCalls) {
+		return false
+	}
+	if len(f.pushSubsystems) != 0 {
+		cov := make(cover.Cover)
+		cov.Merge(inp.Cover)
+		found := false
+		for subsystem := range subsystems.attribute(cov, arch) {
+			if f.matchSubsystem(subsystem) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func (f *hubFilter) matchSubsystem(subsystem string) bool {
+	for _, want := range f.pushSubsystems {
+		if subsystem == want || strings.HasPrefix(subsystem, want+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// pull says if the program received from hub should be accepted.
+// The filter is applied by hub as well, this is a safety net for older hubs.
+func (f *hubFilter) pull(data []byte) bool {
+	return len(f.pullCalls) == 0 || containsCall(data, f.pullCalls)
+}
+
+func containsCall(data []byte, calls map[string]bool) bool {
+	progCalls, err := prog.CallSet(data)
+	if err != nil {
+		return false
+	}
+	for c := range progCalls {
+		if calls[c] {
+			return true
+		}
+	}
+	return false
+}
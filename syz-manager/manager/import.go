@@ -0,0 +1,93 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package manager
+
+import (
+	"bytes"
+	"path/filepath"
+
+	"github.com/google/syzkaller/pkg/db"
+	"github.com/google/syzkaller/pkg/log"
+	"github.com/google/syzkaller/pkg/rpctype"
+	"github.com/google/syzkaller/prog"
+)
+
+// Programs imported from other managers (hub, API.AddPrograms, mgrconfig.CorpusImport) can use syscalls
+// that are not enabled on this manager, or even unknown to our descriptions. Instead of discarding
+// such programs, we rewrite calls to enabled equivalents (e.g. open to openat) and drop the rest
+// of disabled calls (see prog.Target.Translate). Only programs without any enabled calls are dropped.
+
+type importStats struct {
+	added        int // programs added to the triage queue
+	salvaged     int // added programs that had calls rewritten or dropped
+	dropped      int // unparsable programs and programs without enabled calls
+	droppedCalls int // calls dropped from salvaged programs
+}
+
+// salvageProg parses an imported program and adapts it to the enabled syscalls.
+// Returns nil if nothing is left of the program.
+func (mgr *Manager) salvageProg(data []byte, enabled map[*prog.Syscall]bool, stats *importStats) *prog.Prog {
+	p, _, err := mgr.target.DeserializeNonStrict(data)
+	if err != nil {
+		stats.dropped++
+		return nil
+	}
+	p1, warnings, err := mgr.target.Translate(p, enabled)
+	if err != nil {
+		stats.dropped++
+		return nil
+	}
+	stats.added++
+	stats.droppedCalls += len(warnings)
+	if !bytes.Equal(bytes.TrimSpace(p1.Serialize()), bytes.TrimSpace(data)) {
+		stats.salvaged++
+	}
+	return p1
+}
+
+func (mgr *Manager) enabledCalls(ids []int) map[*prog.Syscall]bool {
+	enabled := make(map[*prog.Syscall]bool)
+	for _, id := range ids {
+		enabled[mgr.target.Syscalls[id]] = true
+	}
+	return enabled
+}
+
+// importCorpus adds programs from corpus databases of other managers (mgrconfig.CorpusImport)
+// to the triage queue, programs that give new signal end up in corpus.
+func (mgr *Manager) importCorpus(enabled map[*prog.Syscall]bool) {
+	if len(mgr.cfg.CorpusImport) == 0 {
+		return
+	}
+	stats := new(importStats)
+	for _, pattern := range mgr.cfg.CorpusImport {
+		matches, err := filepath.Glob(pattern)
+		if err != nil || len(matches) == 0 {
+			log.Logf(0, "no corpus databases match %v", pattern)
+			continue
+		}
+		for _, file := range matches {
+			corpusDB, err := db.OpenEncrypted(file, mgr.key)
+			if err != nil {
+				log.Logf(0, "failed to open imported corpus %v: %v", file, err)
+				continue
+			}
+			for _, rec := range corpusDB.Records {
+				p := mgr.salvageProg(rec.Val, enabled, stats)
+				if p == nil {
+					continue
+				}
+				mgr.candidates = append(mgr.candidates, rpctype.RPCCandidate{
+					Prog:      p.Serialize(),
+					Minimized: false, // minimized on another kernel/config
+					Smashed:   false,
+				})
+			}
+		}
+	}
+	mgr.stats["import salvaged"] += uint64(stats.salvaged)
+	mgr.stats["import dropped calls"] += uint64(stats.droppedCalls)
+	log.Logf(0, "%-24v: %v programs (%v salvaged, %v calls dropped), %v dropped", "imported corpus",
+		stats.added, stats.salvaged, stats.droppedCalls, stats.dropped)
+}
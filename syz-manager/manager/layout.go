@@ -0,0 +1,55 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package manager
+
+import (
+	"fmt"
+	"net/http"
+	"path/filepath"
+
+	"github.com/google/syzkaller/pkg/btf"
+	"github.com/google/syzkaller/pkg/log"
+	"github.com/google/syzkaller/pkg/osutil"
+)
+
+// checkLayouts compares struct layouts in descriptions with BTF of the kernel build.
+// Mismatches mean that programs pass garbage to the kernel, so they are shown on the status page.
+func (mgr *Manager) checkLayouts() {
+	vmlinux := filepath.Join(mgr.cfg.KernelObj, "vmlinux")
+	if !osutil.IsExist(vmlinux) {
+		return
+	}
+	var mismatches []*btf.Mismatch
+	spec, err := btf.Load(vmlinux)
+	if err == nil {
+		mismatches = btf.CheckLayouts(mgr.target, spec)
+		log.Logf(0, "found %v struct layout mismatches with the kernel", len(mismatches))
+	} else {
+		log.Logf(0, "failed to check struct layouts: %v", err)
+	}
+	mgr.mu.Lock()
+	mgr.layoutChecked = true
+	mgr.layoutErr = err
+	mgr.layoutMismatches = mismatches
+	mgr.mu.Unlock()
+}
+
+func (mgr *Manager) httpLayout(w http.ResponseWriter, r *http.Request) {
+	mgr.mu.Lock()
+	data := &UILayoutData{
+		Name:       mgr.cfg.Name,
+		Checked:    mgr.layoutChecked,
+		Mismatches: mgr.layoutMismatches,
+	}
+	if mgr.layoutErr != nil {
+		data.Error = mgr.layoutErr.Error()
+	}
+	mgr.mu.Unlock()
+
+	if err := layoutTemplate.Execute(w, data); err != nil {
+		http.Error(w, fmt.Sprintf("failed to execute template: %v", err),
+			http.StatusInternalServerError)
+		return
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package manager
+
+import (
+	"encoding/json"
+	"path/filepath"
+
+	"github.com/google/syzkaller/pkg/crypt"
+	"github.com/google/syzkaller/pkg/log"
+	"github.com/google/syzkaller/pkg/report"
+)
+
+// Titles of lockdep reports are derived from the function that tried to acquire a lock,
+// so the same deadlock scenario reached via different paths produces many crashes.
+// Such reports are deduplicated by the involved lock classes (see report.Lockdep):
+// a report with the same lock classes as a previously seen one gets the title of the first report.
+// The mapping is persisted in the workdir, so that titles stay the same after restarts.
+const lockdepTitlesFile = "lockdep-titles.json"
+
+func loadLockdepTitles(workdir string, key *crypt.Key) map[string]string {
+	titles := make(map[string]string)
+	if data, err := key.ReadFile(filepath.Join(workdir, lockdepTitlesFile)); err == nil {
+		if err := json.Unmarshal(data, &titles); err != nil {
+			log.Logf(0, "failed to parse %v: %v", lockdepTitlesFile, err)
+			titles = make(map[string]string)
+		}
+	}
+	return titles
+}
+
+func (mgr *Manager) dedupLockdep(crash *Crash) {
+	if crash.Corrupted {
+		return
+	}
+	ld := report.ParseLockdep(crash.Report.Report)
+	if ld == nil {
+		return
+	}
+	key := ld.Key()
+	mgr.mu.Lock()
+	title, ok := mgr.lockdepTitles[key]
+	if !ok {
+		mgr.lockdepTitles[key] = crash.Title
+	}
+	data, err := json.MarshalIndent(mgr.lockdepTitles, "", "\t")
+	mgr.mu.Unlock()
+	if !ok {
+		if err != nil {
+			log.Logf(0, "failed to serialize lockdep titles: %v", err)
+		} else if err := mgr.key.WriteFile(filepath.Join(mgr.cfg.Workdir, lockdepTitlesFile), data); err != nil {
+			log.Logf(0, "failed to save lockdep titles: %v", err)
+		}
+		return
+	}
+	if title != crash.Title {
+		log.Logf(0, "vm-%v: %v has the same locks as %v (%v)", crash.vmIndex, crash.Title, title, key)
+		crash.Title = title
+	}
+}
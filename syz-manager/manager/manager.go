@@ -0,0 +1,2020 @@
+// Copyright 2015 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+// Package manager implements syz-manager: it runs VMs with fuzzers, collects and triages
+// corpus and crashes, reproduces crashes and serves the web UI.
+// The package allows to embed manager functionality into other tools
+// (e.g. syz-ci or custom orchestrators) instead of running the syz-manager binary:
+//
+//	mgr, err := manager.New(cfg, target, syscalls, manager.Options{})
+//	if err != nil { ... }
+//	go func() { err := mgr.Run() ... }()
+//	...
+//	stats := mgr.Stats()
+//	...
+//	mgr.Drain() // Run returns when all VMs are stopped
+//	mgr.Close()
+//
+// Several managers can run in one process. Note: all managers shut down when vm.Shutdown
+// is closed (syz-manager binary closes it on SIGINT). Fatal errors of a manager (e.g. files used
+// by the manager are modified by an external program) drain the manager and Run returns the error.
+package manager
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"net"
+	"net/http"
+	"net/rpc"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/syzkaller/dashboard/dashapi"
+	"github.com/google/syzkaller/pkg/btf"
+	"github.com/google/syzkaller/pkg/callstats"
+	"github.com/google/syzkaller/pkg/cover"
+	"github.com/google/syzkaller/pkg/crypt"
+	"github.com/google/syzkaller/pkg/csource"
+	"github.com/google/syzkaller/pkg/db"
+	"github.com/google/syzkaller/pkg/experiment"
+	"github.com/google/syzkaller/pkg/gce"
+	"github.com/google/syzkaller/pkg/hash"
+	"github.com/google/syzkaller/pkg/health"
+	"github.com/google/syzkaller/pkg/host"
+	"github.com/google/syzkaller/pkg/log"
+	"github.com/google/syzkaller/pkg/osutil"
+	"github.com/google/syzkaller/pkg/report"
+	"github.com/google/syzkaller/pkg/repro"
+	"github.com/google/syzkaller/pkg/rpctype"
+	"github.com/google/syzkaller/pkg/signal"
+	"github.com/google/syzkaller/prog"
+	"github.com/google/syzkaller/sys"
+	"github.com/google/syzkaller/syz-manager/mgrconfig"
+	"github.com/google/syzkaller/vm"
+)
+
+// Options are manager parameters that are not part of the manager config.
+type Options struct {
+	// Dump all VM output to console.
+	Debug bool
+	// Write execution statistics into this file periodically (optional).
+	Bench string
+}
+
+// Manager is a running syz-manager.
+type Manager struct {
+	cfg            *mgrconfig.Config
+	opts           Options
+	vmPool         *vm.Pool
+	target         *prog.Target
+	reporter       report.Reporter
+	crashdir       string
+	key            *crypt.Key // workdir encryption key, nil if workdir is not encrypted
+	port           int
+	corpusDB       *db.DB
+	startTime      time.Time
+	firstConnect   time.Time
+	fuzzingTime    time.Duration
+	stats          map[string]uint64
+	callStats      callstats.Set     // sampled latencies and errnos of executed calls
+	dmesgErrors    *dmesgErrors      // non-fatal kernel messages, see dmesg.go
+	lockdepTitles  map[string]string // lockdep report key -> title of the first such report
+	crashTypes     map[string]bool
+	vmStop         chan bool
+	checkResult    *rpctype.CheckArgs
+	modules        map[host.KernelModule]bool // modules loaded on any VM, see addModules
+	disabledCalls  []rpctype.SyscallReason
+	fresh          bool
+	numFuzzing     uint32
+	numReproducing uint32
+	rpcServing     *health.Flag
+	httpServer     *http.Server
+	apiServer      *rpc.Server // control API, see api.go
+	rpcServer      *rpctype.RPCServer
+	drain          chan struct{} // closed by Drain
+	drainOnce      sync.Once
+	fatalErr       error // the first fatal error, see fatalf
+	fatalOnce      sync.Once
+	closed         chan struct{} // closed by Close, stops all background goroutines
+
+	dash     *dashapi.Dashboard
+	assets   *assetUploader  // nil if crash assets are not uploaded, see assets.go
+	scrubber *repro.Scrubber // nil if mgrconfig.ReproScrub is disabled
+
+	mu              sync.Mutex
+	phase           int
+	enabledSyscalls []int
+	callBudgets     []prog.CallBudget
+	reproPriorities []reproPriority  // see mgrconfig.ReproPriority
+	callWeights     map[int]float64  // syscall weights from hub, see mgrconfig.HubCoordinationGroup
+	reproVMs        int              // max number of VMs used for reproduction (0 means no limit)
+	holdVMs         int              // number of VMs held for manual debugging
+	heldVMs         map[int]string   // VM index -> status of a VM held for manual debugging
+	paused          bool             // fuzzing is paused via the control API
+	vmHealth        map[int]string   // VM index -> last provisioning failure
+	consoles        map[int]*console // VM index -> live console output, see console.go
+	runs            []*ProgRun       // recent one-off program runs, see run.go
+	lastRunID       int
+
+	vmsChanged chan bool // notifies vmLoop about changes of reproVMs/holdVMs/paused
+
+	httpNetwork string // network for HTTP listener (tcp/tcp4/tcp6)
+	rpcNetwork  string // network for RPC listener (tcp/tcp4/tcp6)
+
+	candidates     []rpctype.RPCCandidate // untriaged inputs from corpus and hub
+	disabledHashes map[string]struct{}
+	pins           *corpusPins   // see pin.go
+	flags          *featureFlags // see flags.go
+	corpus         map[string]rpctype.RPCInput
+	corpusCover    cover.Cover
+	corpusSignal   signal.Signal
+	maxSignal      signal.Signal
+	prios          [][]float32
+	newRepros      [][]byte
+
+	subsystemHistory []*subsystemSnapshot // per-subsystem coverage over time, see subsystemLoop
+	callHistory      []*callSnapshot      // per-call signal over time, see callSnapshotLoop
+
+	layoutChecked    bool // struct layouts were checked against kernel BTF, see layout.go
+	layoutErr        error
+	layoutMismatches []*btf.Mismatch
+
+	fuzzers        map[string]*Fuzzer
+	hub            *rpctype.RPCClient
+	hubCorpus      map[hash.Sig]bool
+	hubFilter      *hubFilter
+	needMoreRepros chan chan bool
+	hubReproQueue  chan *Crash
+	reproRequest   chan chan map[string]bool
+	runQueue       chan *ProgRun
+
+	// For checking that files that we are using are not changing under us.
+	// Maps file name to modification time.
+	usedFiles map[string]time.Time
+}
+
+const (
+	// Just started, nothing done yet.
+	phaseInit = iota
+	// Corpus is loaded and machine is checked.
+	phaseLoadedCorpus
+	// Triaged all inputs from corpus.
+	// This is when we start querying hub and minimizing persistent corpus.
+	phaseTriagedCorpus
+	// Done the first request to hub.
+	phaseQueriedHub
+	// Triaged all new inputs from hub.
+	// This is when we start reproducing crashes.
+	phaseTriagedHub
+)
+
+// CorpusDBVersion is the current version of corpus.db in the workdir (see loadCorpus),
+// older versions are upgraded on start.
+const CorpusDBVersion = 3
+
+type Fuzzer struct {
+	name         string
+	inputs       []rpctype.RPCInput
+	newMaxSignal signal.Signal
+	trail        map[int][]uint32 // the last coverage trail per proc, see Manager.Trail
+	flags        map[string]bool  // feature flags of the fuzzer VM, see flags.go
+	lastPoll     time.Time
+}
+
+type Crash struct {
+	vmIndex       int
+	hub           bool             // this crash was created based on a repro from hub
+	trail         map[int][]uint32 // coverage trail of the crashed VM (if crash_trail is enabled)
+	reproPriority int              // position in the repro queue, see mgrconfig.ReproPriority
+	reproVMs      int              // number of VMs to reproduce on (0 means the default number)
+	*report.Report
+}
+
+// New creates a manager for the config with the given enabled syscalls
+// (see mgrconfig.ParseEnabledSyscalls): creates the VM pool, opens the corpus database
+// and starts serving HTTP and RPC. Fuzzing starts with Run.
+func New(cfg *mgrconfig.Config, target *prog.Target, syscalls map[int]bool, opts Options) (*Manager, error) {
+	var vmPool *vm.Pool
+	// Type "none" is a special case for debugging/development when manager
+	// does not start any VMs, but instead you start them manually
+	// and start syz-fuzzer there.
+	if cfg.Type != "none" {
+		var err error
+		vmPool, err = vm.Create(cfg, opts.Debug)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	key, err := crypt.LoadKey(cfg.WorkdirKey, cfg.WorkdirKeyCommand)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load workdir key: %v", err)
+	}
+
+	crashdir := filepath.Join(cfg.Workdir, "crashes")
+	osutil.MkdirAll(crashdir)
+	cleanupCrashes(crashdir, cfg.CrashStorage, key)
+
+	var enabledSyscalls []int
+	for c := range syscalls {
+		enabledSyscalls = append(enabledSyscalls, c)
+	}
+	hubFilter, err := makeHubFilter(&cfg.HubFilter, target)
+	if err != nil {
+		return nil, fmt.Errorf("bad hub_filter config: %v", err)
+	}
+	callBudgets, err := mgrconfig.ParseSyscallBudgets(target, cfg.SyscallBudgets)
+	if err != nil {
+		return nil, fmt.Errorf("bad syscall_budgets config: %v", err)
+	}
+	pins, err := makeCorpusPins(cfg, target, key)
+	if err != nil {
+		return nil, fmt.Errorf("bad pinned_calls config: %v", err)
+	}
+	reproPriorities, err := makeReproPriorities(cfg.ReproPriorities)
+	if err != nil {
+		return nil, fmt.Errorf("bad repro_priorities config: %v", err)
+	}
+	var scrubber *repro.Scrubber
+	if cfg.ReproScrub.Enabled {
+		if scrubber, err = repro.MakeScrubber(cfg); err != nil {
+			return nil, fmt.Errorf("bad repro_scrub config: %v", err)
+		}
+	}
+
+	reporter, err := report.NewReporter(cfg)
+	if err != nil {
+		return nil, err
+	}
+	// HTTP is served on IPv4 and RPC on all addresses by default.
+	httpNetwork, err := rpctype.Network(cfg.IPFamily, "tcp4")
+	if err != nil {
+		return nil, err
+	}
+	rpcNetwork, err := rpctype.Network(cfg.IPFamily, "tcp")
+	if err != nil {
+		return nil, err
+	}
+
+	mgr := &Manager{
+		httpNetwork:     httpNetwork,
+		rpcNetwork:      rpcNetwork,
+		cfg:             cfg,
+		opts:            opts,
+		vmPool:          vmPool,
+		target:          target,
+		reporter:        reporter,
+		crashdir:        crashdir,
+		key:             key,
+		startTime:       time.Now(),
+		stats:           make(map[string]uint64),
+		callStats:       make(callstats.Set),
+		dmesgErrors:     loadDmesgErrors(cfg.Workdir, cfg.Tag, key),
+		lockdepTitles:   loadLockdepTitles(cfg.Workdir, key),
+		crashTypes:      make(map[string]bool),
+		modules:         make(map[host.KernelModule]bool),
+		enabledSyscalls: enabledSyscalls,
+		callBudgets:     callBudgets,
+		reproPriorities: reproPriorities,
+		scrubber:        scrubber,
+		corpus:          make(map[string]rpctype.RPCInput),
+		disabledHashes:  make(map[string]struct{}),
+		pins:            pins,
+		flags:           makeFeatureFlags(cfg),
+		fuzzers:         make(map[string]*Fuzzer),
+		fresh:           true,
+		vmStop:          make(chan bool),
+		hubReproQueue:   make(chan *Crash, 10),
+		hubFilter:       hubFilter,
+		needMoreRepros:  make(chan chan bool),
+		reproRequest:    make(chan chan map[string]bool),
+		runQueue:        make(chan *ProgRun, maxPendingRuns),
+		usedFiles:       make(map[string]time.Time),
+		reproVMs:        cfg.ReproVMs,
+		holdVMs:         cfg.HoldVMs,
+		vmsChanged:      make(chan bool, 1),
+		vmHealth:        make(map[int]string),
+		heldVMs:         make(map[int]string),
+		consoles:        make(map[int]*console),
+		rpcServing:      health.NewFlag(fmt.Errorf("rpc server is not started")),
+		drain:           make(chan struct{}),
+		closed:          make(chan struct{}),
+	}
+
+	log.Logf(0, "loading corpus...")
+	mgr.corpusDB, err = db.OpenEncrypted(filepath.Join(cfg.Workdir, "corpus.db"), key)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open corpus database: %v", err)
+	}
+
+	// Create HTTP server.
+	mgr.initAPI()
+	if err := mgr.initHTTP(); err != nil {
+		return nil, err
+	}
+	if err := mgr.collectUsedFiles(); err != nil {
+		mgr.httpServer.Close()
+		return nil, err
+	}
+
+	// Create RPC server for fuzzers.
+	s, err := rpctype.NewRPCServer(mgr.rpcNetwork, cfg.RPC, mgr)
+	if err != nil {
+		mgr.httpServer.Close()
+		return nil, fmt.Errorf("failed to create rpc server: %v", err)
+	}
+	log.Logf(0, "serving rpc on tcp://%v", s.Addr())
+	mgr.port = s.Addr().(*net.TCPAddr).Port
+	mgr.rpcServer = s
+	go s.Serve()
+	mgr.rpcServing.Set()
+
+	if cfg.DashboardAddr != "" {
+		mgr.dash = dashapi.New(cfg.DashboardClient, cfg.DashboardAddr, cfg.DashboardKey)
+	}
+	mgr.initAssets()
+	return mgr, nil
+}
+
+// Run runs the manager until shutdown (see vm.Shutdown), Drain or a fatal error.
+// Returns the fatal error, if any.
+func (mgr *Manager) Run() error {
+	go func() {
+		for lastTime := time.Now(); mgr.sleep(10 * time.Second); {
+			now := time.Now()
+			diff := now.Sub(lastTime)
+			lastTime = now
+			mgr.mu.Lock()
+			if mgr.firstConnect.IsZero() {
+				mgr.mu.Unlock()
+				continue
+			}
+			mgr.fuzzingTime += diff * time.Duration(atomic.LoadUint32(&mgr.numFuzzing))
+			executed := mgr.stats["exec total"]
+			crashes := mgr.stats["crashes"]
+			signal := mgr.corpusSignal.Len()
+			mgr.mu.Unlock()
+			numReproducing := atomic.LoadUint32(&mgr.numReproducing)
+			numFuzzing := atomic.LoadUint32(&mgr.numFuzzing)
+
+			log.Logf(0, "VMs %v, executed %v, cover %v, crashes %v, repro %v",
+				numFuzzing, executed, signal, crashes, numReproducing)
+		}
+	}()
+
+	if mgr.opts.Bench != "" {
+		f, err := os.OpenFile(mgr.opts.Bench, os.O_WRONLY|os.O_CREATE|os.O_EXCL, osutil.DefaultFilePerm)
+		if err != nil {
+			return fmt.Errorf("failed to open bench file: %v", err)
+		}
+		go func() {
+			defer f.Close()
+			for mgr.sleep(time.Minute) {
+				mgr.mu.Lock()
+				if mgr.firstConnect.IsZero() {
+					mgr.mu.Unlock()
+					continue
+				}
+				mgr.minimizeCorpus()
+				vals := mgr.statsLocked()
+				mgr.mu.Unlock()
+
+				data, err := json.MarshalIndent(vals, "", "  ")
+				if err != nil {
+					mgr.fatalf("failed to serialize bench data: %v", err)
+					return
+				}
+				if _, err := f.Write(append(data, '\n')); err != nil {
+					mgr.fatalf("failed to write bench data: %v", err)
+					return
+				}
+			}
+		}()
+	}
+
+	if mgr.cfg.Experiment != "" {
+		go mgr.experimentLoop()
+	}
+
+	if mgr.cfg.Cover && mgr.cfg.KernelObj != "" {
+		go mgr.subsystemLoop()
+	}
+	go mgr.callSnapshotLoop()
+
+	if mgr.cfg.CorpusMinimizePeriod != 0 {
+		go func() {
+			for mgr.sleep(time.Duration(mgr.cfg.CorpusMinimizePeriod) * time.Hour) {
+				if _, err := mgr.reminimizeCorpus(); err != nil {
+					log.Logf(0, "corpus re-minimization: %v", err)
+				}
+			}
+		}()
+	}
+
+	if mgr.cfg.TargetOS == "linux" && mgr.cfg.KernelObj != "" {
+		go mgr.checkLayouts()
+	}
+
+	if mgr.dash != nil {
+		go mgr.dashboardReporter()
+	}
+	if mgr.assets != nil {
+		go mgr.assetLoop()
+	}
+
+	if mgr.cfg.HubClient != "" {
+		go func() {
+			for mgr.sleep(time.Minute) {
+				mgr.hubSync()
+			}
+		}()
+	}
+
+	if mgr.vmPool == nil {
+		log.Logf(0, "no VMs started (type=none)")
+		log.Logf(0, "you are supposed to start syz-fuzzer manually as:")
+		log.Logf(0, "syz-fuzzer -manager=manager.ip:%v [other flags as necessary]", mgr.port)
+		select {
+		case <-vm.Shutdown:
+		case <-mgr.drain:
+		}
+		return mgr.fatalErr
+	}
+	mgr.vmLoop()
+	return mgr.fatalErr
+}
+
+// Drain stops fuzzing: running fuzzing VMs are stopped, running reproductions
+// are finished and no new VMs are started. Run returns when all VMs are stopped.
+// The manager still serves the web UI until Close.
+func (mgr *Manager) Drain() {
+	mgr.drainOnce.Do(func() {
+		log.Logf(0, "draining...")
+		close(mgr.drain)
+	})
+}
+
+// fatalf stops the manager because of an unrecoverable error: the manager is drained
+// and Run returns the error. syz-manager binary exits, syz-ci restarts in-process managers.
+func (mgr *Manager) fatalf(msg string, args ...interface{}) {
+	err := fmt.Errorf(msg, args...)
+	log.Logf(0, "fatal error: %v", err)
+	mgr.fatalOnce.Do(func() {
+		mgr.fatalErr = err
+	})
+	mgr.Drain()
+}
+
+// Close stops HTTP and RPC servers and background goroutines. It must be called after Run returns.
+// After Close a new manager with the same config can be created in the same process.
+func (mgr *Manager) Close() {
+	close(mgr.closed)
+	mgr.rpcServer.Close()
+	mgr.httpServer.Close()
+}
+
+// sleep sleeps for d and returns false if the manager is closed in the meantime.
+func (mgr *Manager) sleep(d time.Duration) bool {
+	select {
+	case <-time.After(d):
+		return true
+	case <-mgr.closed:
+		return false
+	}
+}
+
+// Snapshot saves a consistent copy of the corpus database into file
+// (e.g. to seed a new manager, or before an upgrade). The copy is encrypted
+// with the workdir key, if the workdir is encrypted.
+func (mgr *Manager) Snapshot(file string) error {
+	mgr.mu.Lock()
+	defer mgr.mu.Unlock()
+	tmpFile := file + ".tmp"
+	os.Remove(tmpFile)
+	snapshot, err := db.OpenEncrypted(tmpFile, mgr.key)
+	if err != nil {
+		return fmt.Errorf("failed to create corpus snapshot: %v", err)
+	}
+	for key, rec := range mgr.corpusDB.Records {
+		snapshot.Save(key, rec.Val, rec.Seq)
+	}
+	if err := snapshot.BumpVersion(mgr.corpusDB.Version); err != nil {
+		return fmt.Errorf("failed to write corpus snapshot: %v", err)
+	}
+	return os.Rename(tmpFile, file)
+}
+
+// ReloadSyscalls replaces enabled syscalls (see mgrconfig.ParseEnabledSyscalls).
+// The next connected fuzzer checks the machine again with the new syscalls,
+// running fuzzers continue to use the old syscalls until their VMs are restarted.
+// Corpus programs that use disabled syscalls are not removed from the corpus.
+func (mgr *Manager) ReloadSyscalls(syscalls map[int]bool) {
+	var enabledSyscalls []int
+	for c := range syscalls {
+		enabledSyscalls = append(enabledSyscalls, c)
+	}
+	mgr.mu.Lock()
+	defer mgr.mu.Unlock()
+	log.Logf(0, "reloading syscalls: %v enabled", len(enabledSyscalls))
+	mgr.enabledSyscalls = enabledSyscalls
+	mgr.checkResult = nil
+}
+
+// Stats returns current manager stats: corpus size, signal, coverage, uptime and fuzzing time
+// (in seconds) and all counters shown on the web UI (e.g. "exec total", "crashes").
+func (mgr *Manager) Stats() map[string]uint64 {
+	mgr.mu.Lock()
+	defer mgr.mu.Unlock()
+	return mgr.statsLocked()
+}
+
+func (mgr *Manager) statsLocked() map[string]uint64 {
+	vals := make(map[string]uint64)
+	vals["corpus"] = uint64(len(mgr.corpus))
+	vals["pinned"] = uint64(len(mgr.pins.pinned))
+	if !mgr.firstConnect.IsZero() {
+		vals["uptime"] = uint64(time.Since(mgr.firstConnect)) / 1e9
+	}
+	vals["fuzzing"] = uint64(mgr.fuzzingTime) / 1e9
+	vals["signal"] = uint64(mgr.corpusSignal.Len())
+	vals["coverage"] = uint64(len(mgr.corpusCover))
+	for k, v := range mgr.stats {
+		vals[k] = v
+	}
+	return vals
+}
+
+type RunResult struct {
+	idx   int
+	crash *Crash
+	err   error
+}
+
+type ReproResult struct {
+	instances  []int
+	title0     string
+	altTitles0 []string
+	res        *repro.Result
+	err        error
+	hub        bool // repro came from hub
+}
+
+func (mgr *Manager) vmLoop() {
+	log.Logf(0, "booting test machines...")
+	log.Logf(0, "wait for the connection from test machine...")
+	instancesPerRepro := 4
+	vmCount := mgr.vmPool.Count()
+	if instancesPerRepro > vmCount {
+		instancesPerRepro = vmCount
+	}
+	instances := make([]int, vmCount)
+	for i := range instances {
+		instances[i] = vmCount - i - 1
+	}
+	runDone := make(chan *RunResult, 1)
+	pendingRepro := make(map[*Crash]bool)
+	reproducing := make(map[string]bool)
+	reproInstances := 0
+	fuzzInstances := 0
+	var reproQueue []*Crash
+	reproDone := make(chan *ReproResult, 1)
+	var pendingRuns []*ProgRun
+	runInstances := make(map[*ProgRun][]int)
+	progRunDone := make(chan *ProgRun, 1)
+	held := make(map[int]chan bool) // VM index -> stop channel of the holdInstance goroutine
+	holdDone := make(chan int, 1)
+	stopPending := false
+	shutdown := vm.Shutdown
+	drain := mgr.drain
+	for {
+		mgr.mu.Lock()
+		phase := mgr.phase
+		reproVMs, holdVMs, paused := mgr.reproVMs, mgr.holdVMs, mgr.paused
+		mgr.mu.Unlock()
+		holdVMs, maxReproInstances := vmPartition(vmCount, reproVMs, holdVMs)
+		for crash := range pendingRepro {
+			if reproducing[crash.Title] {
+				continue
+			}
+			delete(pendingRepro, crash)
+			if !crash.hub {
+				if mgr.dash == nil {
+					if !mgr.needRepro(crash) {
+						continue
+					}
+				} else {
+					cid := &dashapi.CrashID{
+						BuildID:   mgr.cfg.Tag,
+						Title:     crash.Title,
+						AltTitles: crash.AltTitles,
+						Corrupted: crash.Corrupted,
+					}
+					needRepro, err := mgr.dash.NeedRepro(cid)
+					if err != nil {
+						log.Logf(0, "dashboard.NeedRepro failed: %v", err)
+					}
+					if !needRepro {
+						continue
+					}
+				}
+			}
+			log.Logf(1, "loop: add to repro queue '%v'", crash.Title)
+			reproducing[crash.Title] = true
+			crash.reproPriority, crash.reproVMs = mgr.reproPriority(crash.Title)
+			reproQueue = queueRepro(reproQueue, crash)
+		}
+
+		log.Logf(1, "loop: phase=%v shutdown=%v instances=%v/%v %+v hold=%v/%v repro: pending=%v reproducing=%v queued=%v"+
+			" runs: pending=%v running=%v",
+			phase, shutdown == nil, len(instances), vmCount, instances, len(held), holdVMs,
+			len(pendingRepro), len(reproducing), len(reproQueue), len(pendingRuns), len(runInstances))
+
+		// Number of VMs for the next crash in the repro queue.
+		reproCount := func() int {
+			crashVMs := 0
+			if len(reproQueue) != 0 {
+				crashVMs = reproQueue[len(reproQueue)-1].reproVMs
+			}
+			return reproInstanceCount(instancesPerRepro, crashVMs, maxReproInstances)
+		}
+		canRepro := func() bool {
+			return phase >= phaseTriagedHub && len(reproQueue) != 0 &&
+				reproCount() != 0 && reproInstances+reproCount() <= maxReproInstances
+		}
+
+		// Runs requested by users take precedence over fuzzing and reproduction,
+		// but don't use VMs held for manual debugging.
+		canRun := func() bool {
+			return shutdown != nil && len(pendingRuns) != 0
+		}
+
+		if shutdown == nil {
+			for idx, stop := range held {
+				close(stop)
+				delete(held, idx)
+			}
+			if len(instances) == vmCount {
+				return
+			}
+		} else {
+			// Held VMs are taken before anything else, so that they are not starved by runs and repros.
+			for len(held) < holdVMs && len(instances) != 0 {
+				last := len(instances) - 1
+				idx := instances[last]
+				instances = instances[:last]
+				stop := make(chan bool)
+				held[idx] = stop
+				log.Logf(1, "loop: holding instance %v", idx)
+				go func() {
+					mgr.holdInstance(idx, stop)
+					holdDone <- idx
+				}()
+			}
+			for idx, stop := range held {
+				if len(held) <= holdVMs {
+					break
+				}
+				log.Logf(1, "loop: releasing instance %v", idx)
+				close(stop)
+				delete(held, idx)
+			}
+			// hold_vms can be increased after a run was queued, such runs would wait forever.
+			for len(pendingRuns) != 0 && pendingRuns[0].Count > vmCount-holdVMs {
+				run := pendingRuns[0]
+				pendingRuns = pendingRuns[1:]
+				mgr.failRun(run, fmt.Errorf("run needs %v VMs, but only %v are not held",
+					run.Count, vmCount-holdVMs))
+			}
+			for canRun() && len(instances) >= pendingRuns[0].Count {
+				run := pendingRuns[0]
+				pendingRuns = pendingRuns[1:]
+				vmIndexes := append([]int{}, instances[len(instances)-run.Count:]...)
+				instances = instances[:len(instances)-run.Count]
+				runInstances[run] = vmIndexes
+				log.Logf(1, "loop: starting run %v on instances %+v", run.ID, vmIndexes)
+				go func() {
+					mgr.executeRun(run, vmIndexes)
+					progRunDone <- run
+				}()
+			}
+			for !canRun() && canRepro() && len(instances) >= reproCount() {
+				count := reproCount()
+				last := len(reproQueue) - 1
+				crash := reproQueue[last]
+				reproQueue[last] = nil
+				reproQueue = reproQueue[:last]
+				vmIndexes := append([]int{}, instances[len(instances)-count:]...)
+				instances = instances[:len(instances)-count]
+				reproInstances += count
+				atomic.AddUint32(&mgr.numReproducing, 1)
+				log.Logf(1, "loop: starting repro of '%v' on instances %+v", crash.Title, vmIndexes)
+				go func() {
+					res, err := repro.Run(crash.Output, mgr.cfg, mgr.reporter, mgr.vmPool, vmIndexes)
+					reproDone <- &ReproResult{vmIndexes, crash.Title, crash.AltTitles, res, err, crash.hub}
+				}()
+			}
+			for !canRun() && !canRepro() && !paused && len(instances) != 0 {
+				last := len(instances) - 1
+				idx := instances[last]
+				instances = instances[:last]
+				fuzzInstances++
+				log.Logf(1, "loop: starting instance %v", idx)
+				go func() {
+					crash, err := mgr.runInstance(idx)
+					runDone <- &RunResult{idx, crash, err}
+				}()
+			}
+		}
+
+		var stopRequest chan bool
+		if !stopPending && (canRun() || canRepro() || len(held) < holdVMs ||
+			paused && fuzzInstances != 0 || drain == nil && shutdown == nil) {
+			// When draining, stop fuzzing VMs one by one.
+			stopRequest = mgr.vmStop
+		}
+
+		select {
+		case stopRequest <- true:
+			log.Logf(1, "loop: issued stop request")
+			stopPending = true
+		case res := <-runDone:
+			log.Logf(1, "loop: instance %v finished, crash=%v", res.idx, res.crash != nil)
+			if res.err != nil && shutdown != nil {
+				log.Logf(0, "%v", res.err)
+			}
+			stopPending = false
+			instances = append(instances, res.idx)
+			fuzzInstances--
+			// On shutdown qemu crashes with "qemu: terminating on signal 2",
+			// which we detect as "lost connection". Don't save that as crash.
+			if shutdown != nil && res.crash != nil {
+				needRepro := mgr.saveCrash(res.crash)
+				if needRepro && mgr.reproAllowed(res.crash) {
+					log.Logf(1, "loop: add pending repro for '%v'", res.crash.Title)
+					pendingRepro[res.crash] = true
+				}
+			}
+		case res := <-reproDone:
+			atomic.AddUint32(&mgr.numReproducing, ^uint32(0))
+			crepro := false
+			title := ""
+			if res.res != nil {
+				crepro = res.res.CRepro
+				title = res.res.Report.Title
+			}
+			log.Logf(1, "loop: repro on %+v finished '%v', repro=%v crepro=%v desc='%v'",
+				res.instances, res.title0, res.res != nil, crepro, title)
+			if res.err != nil {
+				log.Logf(0, "repro failed: %v", res.err)
+			}
+			delete(reproducing, res.title0)
+			instances = append(instances, res.instances...)
+			reproInstances -= len(res.instances)
+			if res.res == nil {
+				if !res.hub {
+					mgr.saveFailedRepro(res.title0, res.altTitles0)
+				}
+			} else {
+				mgr.saveRepro(res.res, res.hub)
+			}
+		case run := <-progRunDone:
+			log.Logf(1, "loop: run %v finished", run.ID)
+			instances = append(instances, runInstances[run]...)
+			delete(runInstances, run)
+		case idx := <-holdDone:
+			log.Logf(1, "loop: released instance %v", idx)
+			instances = append(instances, idx)
+		case run := <-mgr.runQueue:
+			log.Logf(1, "loop: got run %v", run.ID)
+			pendingRuns = append(pendingRuns, run)
+		case <-mgr.vmsChanged:
+			log.Logf(1, "loop: VM partitioning changed")
+		case <-shutdown:
+			log.Logf(1, "loop: shutting down...")
+			shutdown = nil
+		case <-drain:
+			log.Logf(1, "loop: draining...")
+			shutdown = nil
+			drain = nil
+		case crash := <-mgr.hubReproQueue:
+			log.Logf(1, "loop: get repro from hub")
+			pendingRepro[crash] = true
+		case reply := <-mgr.needMoreRepros:
+			reply <- phase >= phaseTriagedHub &&
+				len(reproQueue)+len(pendingRepro)+len(reproducing) == 0
+		case reply := <-mgr.reproRequest:
+			repros := make(map[string]bool)
+			for title := range reproducing {
+				repros[title] = true
+			}
+			reply <- repros
+		}
+	}
+}
+
+func (mgr *Manager) loadCorpus() {
+	// By default we don't re-minimize/re-smash programs from corpus,
+	// it takes lots of time on start and is unnecessary.
+	// However, on version bumps we can selectively re-minimize/re-smash.
+	minimized, smashed := true, true
+	switch mgr.corpusDB.Version {
+	case 0:
+		// Version 0 had broken minimization, so we need to re-minimize.
+		minimized = false
+		fallthrough
+	case 1:
+		// Version 1->2: memory is preallocated so lots of mmaps become unnecessary.
+		minimized = false
+		fallthrough
+	case 2:
+		// Version 2->3: big-endian hints.
+		smashed = false
+		fallthrough
+	case CorpusDBVersion:
+	}
+	syscalls := make(map[int]bool)
+	for _, id := range mgr.checkResult.EnabledCalls {
+		syscalls[id] = true
+	}
+	deleted := 0
+	var repaired [][]byte
+	repinned := false
+	for key, rec := range mgr.corpusDB.Records {
+		// Non-strict mode allows to keep programs that contain calls that were renamed
+		// or removed from descriptions, such calls are skipped.
+		p, warnings, err := mgr.target.DeserializeNonStrict(rec.Val)
+		if err != nil {
+			if deleted < 10 {
+				log.Logf(0, "deleting broken program: %v\n%s", err, rec.Val)
+			}
+			mgr.corpusDB.Delete(key)
+			deleted++
+			continue
+		}
+		data := rec.Val
+		if len(warnings) != 0 {
+			// The program has changed, so replace the record (after the loop,
+			// to not iterate over the new record).
+			if len(repaired) < 10 {
+				log.Logf(0, "repairing program: %v\n%s", strings.Join(warnings, "\n"), rec.Val)
+			}
+			mgr.corpusDB.Delete(key)
+			data = p.Serialize()
+			repaired = append(repaired, data)
+			if mgr.pins.progs[key] {
+				delete(mgr.pins.progs, key)
+				mgr.pins.progs[hash.String(data)] = true
+				repinned = true
+			}
+		}
+		mgr.pins.update(hash.String(data), p)
+		disabled := false
+		for _, c := range p.Calls {
+			if !syscalls[c.Meta.ID] {
+				disabled = true
+				break
+			}
+		}
+		if disabled {
+			// This program contains a disabled syscall.
+			// We won't execute it, but remember its hash so
+			// it is not deleted during minimization.
+			mgr.disabledHashes[hash.String(data)] = struct{}{}
+			continue
+		}
+		mgr.candidates = append(mgr.candidates, rpctype.RPCCandidate{
+			Prog:      data,
+			Minimized: minimized,
+			Smashed:   smashed,
+		})
+	}
+	for _, data := range repaired {
+		mgr.corpusDB.Save(hash.String(data), data, 0)
+	}
+	if len(repaired) != 0 {
+		if err := mgr.corpusDB.Flush(); err != nil {
+			log.Logf(0, "failed to save corpus database: %v", err)
+		}
+	}
+	if repinned {
+		if err := mgr.savePinnedProgs(); err != nil {
+			log.Logf(0, "failed to save pinned programs: %v", err)
+		}
+	}
+	mgr.fresh = len(mgr.corpusDB.Records) == 0
+	log.Logf(0, "%-24v: %v (%v deleted, %v repaired, %v pinned)", "corpus",
+		len(mgr.candidates), deleted, len(repaired), len(mgr.pins.pinned))
+	mgr.importSeedTraces(syscalls)
+	mgr.importCorpus(mgr.enabledCalls(mgr.checkResult.EnabledCalls))
+
+	// Now this is ugly.
+	// We duplicate all inputs in the corpus and shuffle the second part.
+	// This solves the following problem. A fuzzer can crash while triaging candidates,
+	// in such case it will also lost all cached candidates. Or, the input can be somewhat flaky
+	// and doesn't give the coverage on first try. So we give each input the second chance.
+	// Shuffling should alleviate deterministically losing the same inputs on fuzzer crashing.
+	mgr.candidates = append(mgr.candidates, mgr.candidates...)
+	shuffle := mgr.candidates[len(mgr.candidates)/2:]
+	for i := range shuffle {
+		j := i + rand.Intn(len(shuffle)-i)
+		shuffle[i], shuffle[j] = shuffle[j], shuffle[i]
+	}
+	if mgr.phase != phaseInit {
+		panic(fmt.Sprintf("loadCorpus: bad phase %v", mgr.phase))
+	}
+	mgr.phase = phaseLoadedCorpus
+}
+
+// vmPartition returns the number of VMs held for manual debugging and the max number of VMs
+// used for reproduction given the total number of VMs and the hold_vms/repro_vms settings.
+// The rest of VMs are used for fuzzing (which includes triage of new inputs) and runs.
+func vmPartition(vmCount, reproVMs, holdVMs int) (hold, maxRepro int) {
+	hold = holdVMs
+	if hold > vmCount {
+		hold = vmCount
+	}
+	maxRepro = vmCount - hold
+	if reproVMs != 0 && reproVMs < maxRepro {
+		maxRepro = reproVMs
+	}
+	return
+}
+
+// reproInstanceCount returns the number of VMs used to reproduce a crash
+// that needs crashVMs VMs (0 means the default perRepro) given the max number of repro VMs.
+func reproInstanceCount(perRepro, crashVMs, maxRepro int) int {
+	count := perRepro
+	if crashVMs != 0 {
+		count = crashVMs
+	}
+	if count > maxRepro {
+		count = maxRepro
+	}
+	return count
+}
+
+// holdInstance boots VM index and keeps it running for manual debugging until stop is closed.
+// Failed boots are retried. Called by vmLoop.
+func (mgr *Manager) holdInstance(index int, stop <-chan bool) {
+	setStatus := func(status string) {
+		mgr.mu.Lock()
+		defer mgr.mu.Unlock()
+		if status == "" {
+			delete(mgr.heldVMs, index)
+		} else {
+			mgr.heldVMs[index] = status
+		}
+	}
+	defer setStatus("")
+	for {
+		setStatus("booting")
+		if err := mgr.checkUsedFiles(); err != nil {
+			setStatus(err.Error())
+			<-stop
+			return
+		}
+		inst, err := mgr.vmPool.Create(index)
+		mgr.updateVMHealth(index, err)
+		if err != nil {
+			log.Logf(0, "vm-%v: failed to create held instance: %v", index, err)
+			setStatus(fmt.Sprintf("failed to create instance: %v", err))
+			select {
+			case <-stop:
+				return
+			case <-time.After(time.Minute):
+				continue
+			}
+		}
+		status := "running"
+		if addr := inst.Address(); addr != "" {
+			status += ": " + addr
+		}
+		setStatus(status)
+		<-stop
+		inst.Close()
+		return
+	}
+}
+
+func (mgr *Manager) runInstance(index int) (*Crash, error) {
+	if err := mgr.checkUsedFiles(); err != nil {
+		return nil, err
+	}
+	inst, err := mgr.vmPool.Create(index)
+	mgr.updateVMHealth(index, err)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create instance: %v", err)
+	}
+	defer inst.Close()
+
+	fwdAddr, err := inst.Forward(mgr.port)
+	if err != nil {
+		return nil, fmt.Errorf("failed to setup port forwarding: %v", err)
+	}
+	fuzzerBin, err := inst.Copy(mgr.cfg.SyzFuzzerBin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to copy binary: %v", err)
+	}
+	executorBin, err := inst.Copy(mgr.cfg.SyzExecutorBin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to copy binary: %v", err)
+	}
+
+	fuzzerV := 0
+	procs := mgr.cfg.Procs
+	if mgr.opts.Debug {
+		fuzzerV = 100
+		procs = 1
+	}
+
+	// Drop the trail of the previous fuzzer on this VM, so that it's not saved with a crash
+	// that happens before the new fuzzer connects.
+	name := fmt.Sprintf("vm-%v", index)
+	mgr.mu.Lock()
+	if f := mgr.fuzzers[name]; f != nil {
+		f.trail = nil
+	}
+	mgr.mu.Unlock()
+
+	// Run the fuzzer binary.
+	start := time.Now()
+	atomic.AddUint32(&mgr.numFuzzing, 1)
+	defer atomic.AddUint32(&mgr.numFuzzing, ^uint32(0))
+	cmd := fmt.Sprintf("%v -executor=%v -name=vm-%v -arch=%v -manager=%v -procs=%v"+
+		" -cover=%v -sandbox=%v -debug=%v -v=%d",
+		fuzzerBin, executorBin, index, mgr.cfg.TargetArch, fwdAddr, procs,
+		mgr.cfg.Cover, mgr.cfg.Sandbox, mgr.opts.Debug, fuzzerV)
+	outc, errc, err := inst.Run(time.Hour, mgr.vmStop, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to run fuzzer: %v", err)
+	}
+	stopDmesg := make(chan bool)
+	defer close(stopDmesg)
+	defer mgr.saveDmesgErrors()
+	outc = mgr.watchDmesg(outc, stopDmesg)
+	outc = mgr.watchConsole(index, outc, stopDmesg)
+
+	rep := inst.MonitorExecution(outc, errc, mgr.reporter, false)
+	if rep == nil {
+		// This is the only "OK" outcome.
+		if inst.Preempted() {
+			// Preemption is not a crash, the VM is just re-created.
+			log.Logf(0, "vm-%v: preempted after %v, restarting", index, time.Since(start))
+			mgr.mu.Lock()
+			mgr.stats["vm preemptions"]++
+			mgr.mu.Unlock()
+			return nil, nil
+		}
+		log.Logf(0, "vm-%v: running for %v, restarting", index, time.Since(start))
+		return nil, nil
+	}
+	if !rep.Suppressed {
+		rep.PostMortem = inst.PostMortem()
+	}
+	cash := &Crash{
+		vmIndex: index,
+		hub:     false,
+		Report:  rep,
+	}
+	mgr.mu.Lock()
+	if f := mgr.fuzzers[name]; f != nil {
+		cash.trail = f.trail
+	}
+	mgr.mu.Unlock()
+	return cash, nil
+}
+
+// updateVMHealth records provisioning failures of VMs for the web UI.
+func (mgr *Manager) updateVMHealth(index int, err error) {
+	mgr.mu.Lock()
+	defer mgr.mu.Unlock()
+	perr, ok := err.(*vm.ProvisionError)
+	if !ok {
+		delete(mgr.vmHealth, index)
+		return
+	}
+	mgr.stats["vm provision errors"]++
+	mgr.vmHealth[index] = fmt.Sprintf("provisioning step %q failed: %v", perr.Step, perr.Err)
+}
+
+func (mgr *Manager) emailCrash(crash *Crash) {
+	if len(mgr.cfg.EmailAddrs) == 0 {
+		return
+	}
+	args := []string{"-s", "syzkaller: " + crash.Title}
+	args = append(args, mgr.cfg.EmailAddrs...)
+	log.Logf(0, "sending email to %v", mgr.cfg.EmailAddrs)
+
+	cmd := exec.Command("mailx", args...)
+	cmd.Stdin = bytes.NewReader(mgr.scrub(crash.Report.Report))
+	if _, err := osutil.Run(10*time.Minute, cmd); err != nil {
+		log.Logf(0, "failed to send email: %v", err)
+	}
+}
+
+func (mgr *Manager) saveCrash(crash *Crash) bool {
+	if crash.Suppressed {
+		log.Logf(0, "vm-%v: suppressed crash %v", crash.vmIndex, crash.Title)
+		mgr.mu.Lock()
+		mgr.stats["suppressed"]++
+		mgr.mu.Unlock()
+		return false
+	}
+	mgr.dedupLockdep(crash)
+	corrupted := ""
+	if crash.Corrupted {
+		corrupted = " [corrupted]"
+	}
+	log.Logf(0, "vm-%v: crash: %v%v", crash.vmIndex, crash.Title, corrupted)
+	if err := mgr.reporter.Symbolize(crash.Report); err != nil {
+		log.Logf(0, "failed to symbolize report: %v", err)
+	}
+
+	mgr.mu.Lock()
+	mgr.stats["crashes"]++
+	if crash.vmIndex >= 0 {
+		mgr.flagStats(mgr.vmFlags(fmt.Sprintf("vm-%v", crash.vmIndex)), func(arm *experiment.ArmStats) {
+			arm.Crashes++
+		})
+	}
+	if !mgr.crashTypes[crash.Title] {
+		mgr.crashTypes[crash.Title] = true
+		mgr.stats["crash types"]++
+	}
+	fingerprint := mgr.fingerprint(mgr.cfg.Sandbox)
+	mgr.mu.Unlock()
+
+	jsonReport, err := json.Marshal(report.NewJSONReport(mgr.reporter, crash.Report, mgr.cfg.Tag))
+	if err != nil {
+		log.Logf(0, "failed to serialize report: %v", err)
+	}
+	if mgr.dash != nil {
+		dc := &dashapi.Crash{
+			BuildID:     mgr.cfg.Tag,
+			Title:       crash.Title,
+			AltTitles:   crash.AltTitles,
+			Corrupted:   crash.Corrupted,
+			Embargoed:   mgr.cfg.Embargo,
+			Maintainers: crash.Maintainers,
+			Log:         mgr.scrub(crash.Output),
+			Report:      mgr.scrub(crash.Report.Report),
+			ReportJSON:  mgr.scrub(jsonReport),
+			Fingerprint: fingerprint.String(),
+			Assets:      mgr.crashAssets(crash.Output),
+		}
+		resp, err := mgr.dash.ReportCrash(dc)
+		if err != nil {
+			log.Logf(0, "failed to report crash to dashboard: %v", err)
+		} else {
+			// Don't store the crash locally, if we've successfully
+			// uploaded it to the dashboard. These will just eat disk space.
+			return resp.NeedRepro
+		}
+	}
+
+	sig := hash.Hash([]byte(crash.Title))
+	id := sig.String()
+	dir := filepath.Join(mgr.crashdir, id)
+	osutil.MkdirAll(dir)
+	if err := mgr.key.WriteFile(filepath.Join(dir, "description"), []byte(crash.Title+"\n")); err != nil {
+		log.Logf(0, "failed to write crash: %v", err)
+	}
+	// Save artifacts according to the crash storage policy. The most recent crash
+	// is always saved, it is needed to understand if a bug still happens or already fixed.
+	n, _ := readCrashCount(dir, mgr.key)
+	n++
+	if err := writeCrashCount(dir, mgr.key, n); err != nil {
+		log.Logf(0, "failed to write crash: %v", err)
+	}
+	if n == 1 {
+		go mgr.emailCrash(crash)
+	}
+	for _, slot := range crashSlots(mgr.cfg.CrashStorage, n) {
+		mgr.key.WriteFile(filepath.Join(dir, fmt.Sprintf("log%v", slot)), crash.Output)
+		if len(mgr.cfg.Tag) > 0 {
+			mgr.key.WriteFile(filepath.Join(dir, fmt.Sprintf("tag%v", slot)), []byte(mgr.cfg.Tag))
+		} else {
+			os.Remove(filepath.Join(dir, fmt.Sprintf("tag%v", slot)))
+		}
+		if len(crash.Report.Report) > 0 {
+			mgr.key.WriteFile(filepath.Join(dir, fmt.Sprintf("report%v", slot)), crash.Report.Report)
+		} else {
+			os.Remove(filepath.Join(dir, fmt.Sprintf("report%v", slot)))
+		}
+		mgr.key.WriteFile(filepath.Join(dir, fmt.Sprintf("json%v", slot)), jsonReport)
+		mgr.key.WriteFile(filepath.Join(dir, fmt.Sprintf("fingerprint%v", slot)), []byte(fingerprint.String()))
+		if len(crash.trail) != 0 {
+			mgr.key.WriteFile(filepath.Join(dir, fmt.Sprintf("trail%v", slot)), mgr.formatTrail(crash.trail))
+		} else {
+			os.Remove(filepath.Join(dir, fmt.Sprintf("trail%v", slot)))
+		}
+		if len(crash.PostMortem) != 0 {
+			mgr.key.WriteFile(filepath.Join(dir, fmt.Sprintf("postmortem%v", slot)), crash.PostMortem)
+		} else {
+			os.Remove(filepath.Join(dir, fmt.Sprintf("postmortem%v", slot)))
+		}
+	}
+
+	return mgr.needRepro(crash)
+}
+
+const maxReproAttempts = 3
+
+// reproAllowed checks the crash against the warnings policy.
+func (mgr *Manager) reproAllowed(crash *Crash) bool {
+	return mgr.cfg.Warnings != "norepro" || !report.IsWarning(crash.Report)
+}
+
+func (mgr *Manager) needRepro(crash *Crash) bool {
+	if !mgr.cfg.Reproduce || crash.Corrupted {
+		return false
+	}
+	sig := hash.Hash([]byte(crash.Title))
+	dir := filepath.Join(mgr.crashdir, sig.String())
+	if osutil.IsExist(filepath.Join(dir, "repro.prog")) {
+		return false
+	}
+	for i := 0; i < maxReproAttempts; i++ {
+		if !osutil.IsExist(filepath.Join(dir, fmt.Sprintf("repro%v", i))) {
+			return true
+		}
+	}
+	return false
+}
+
+func (mgr *Manager) saveFailedRepro(desc string, altTitles []string) {
+	if mgr.dash != nil {
+		cid := &dashapi.CrashID{
+			BuildID:   mgr.cfg.Tag,
+			Title:     desc,
+			AltTitles: altTitles,
+		}
+		if err := mgr.dash.ReportFailedRepro(cid); err != nil {
+			log.Logf(0, "failed to report failed repro to dashboard: %v", err)
+		}
+	}
+	dir := filepath.Join(mgr.crashdir, hash.String([]byte(desc)))
+	osutil.MkdirAll(dir)
+	for i := 0; i < maxReproAttempts; i++ {
+		name := filepath.Join(dir, fmt.Sprintf("repro%v", i))
+		if !osutil.IsExist(name) {
+			osutil.WriteFile(name, nil)
+			break
+		}
+	}
+}
+
+func (mgr *Manager) saveRepro(res *repro.Result, hub bool) {
+	rep := res.Report
+	if err := mgr.reporter.Symbolize(rep); err != nil {
+		log.Logf(0, "failed to symbolize repro: %v", err)
+	}
+	mgr.mu.Lock()
+	fingerprint := mgr.fingerprint(res.Opts.Sandbox)
+	mgr.mu.Unlock()
+	opts := fmt.Sprintf("# %+v\n%v%v\n", res.Opts, host.FingerprintPrefix, fingerprint)
+	prog := res.Prog.Serialize()
+	// Repros that may contain host-identifying strings must not leave this manager.
+	export := mgr.scrubber == nil || res.Scrubbed
+	if !export {
+		log.Logf(0, "repro of '%v' is not scrubbed, saving it only locally", rep.Title)
+	}
+
+	// Append this repro to repro list to send to hub if it didn't come from hub originally.
+	// Repros of embargoed crashes must not leave this manager.
+	if !hub && !mgr.cfg.Embargo && export {
+		progForHub := []byte(fmt.Sprintf("# %+v\n# %v\n# %v\n%s",
+			res.Opts, res.Report.Title, mgr.cfg.Tag, prog))
+		mgr.mu.Lock()
+		mgr.newRepros = append(mgr.newRepros, progForHub)
+		mgr.mu.Unlock()
+	}
+
+	var cprogText []byte
+	if res.CRepro {
+		cprog, err := csource.Write(res.Prog, res.Opts)
+		if err == nil {
+			formatted, err := csource.Format(cprog)
+			if err == nil {
+				cprog = formatted
+			}
+			cprogText = cprog
+		} else {
+			log.Logf(0, "failed to write C source: %v", err)
+		}
+	}
+
+	if mgr.dash != nil && export {
+		// Note: we intentionally don't set Corrupted for reproducers:
+		// 1. This is reproducible so can be debugged even with corrupted report.
+		// 2. Repro re-tried 3 times and still got corrupted report at the end,
+		//    so maybe corrupted report detection is broken.
+		// 3. Reproduction is expensive so it's good to persist the result.
+		dc := &dashapi.Crash{
+			BuildID:     mgr.cfg.Tag,
+			Title:       res.Report.Title,
+			AltTitles:   res.Report.AltTitles,
+			Embargoed:   mgr.cfg.Embargo,
+			Maintainers: res.Report.Maintainers,
+			Log:         mgr.scrub(res.Report.Output),
+			Report:      mgr.scrub(res.Report.Report),
+			ReproOpts:   res.Opts.Serialize(),
+			ReproSyz:    res.Prog.Serialize(),
+			ReproC:      cprogText,
+			Fingerprint: fingerprint.String(),
+			Assets:      mgr.crashAssets(res.Report.Output),
+		}
+		if _, err := mgr.dash.ReportCrash(dc); err != nil {
+			log.Logf(0, "failed to report repro to dashboard: %v", err)
+		} else {
+			// Don't store the crash locally, if we've successfully
+			// uploaded it to the dashboard. These will just eat disk space.
+			return
+		}
+	}
+
+	dir := filepath.Join(mgr.crashdir, hash.String([]byte(rep.Title)))
+	osutil.MkdirAll(dir)
+
+	if err := mgr.key.WriteFile(filepath.Join(dir, "description"), []byte(rep.Title+"\n")); err != nil {
+		log.Logf(0, "failed to write crash: %v", err)
+	}
+	mgr.key.WriteFile(filepath.Join(dir, "repro.prog"), append([]byte(opts), res.Prog.SerializeVersioned()...))
+	if len(mgr.cfg.Tag) > 0 {
+		mgr.key.WriteFile(filepath.Join(dir, "repro.tag"), []byte(mgr.cfg.Tag))
+	}
+	if len(rep.Output) > 0 {
+		mgr.key.WriteFile(filepath.Join(dir, "repro.log"), rep.Output)
+	}
+	if len(rep.Report) > 0 {
+		mgr.key.WriteFile(filepath.Join(dir, "repro.report"), rep.Report)
+	}
+	if len(cprogText) > 0 {
+		mgr.key.WriteFile(filepath.Join(dir, "repro.cprog"), cprogText)
+	}
+	mgr.key.WriteFile(filepath.Join(dir, "repro.stats.log"), res.Stats.Log)
+	stats := fmt.Sprintf("Extracting prog: %s\nMinimizing prog: %s\nSimplifying prog options: %s\n"+
+		"Extracting C: %s\nSimplifying C: %s\n",
+		res.Stats.ExtractProgTime, res.Stats.MinimizeProgTime, res.Stats.SimplifyProgTime,
+		res.Stats.ExtractCTime, res.Stats.SimplifyCTime)
+	mgr.key.WriteFile(filepath.Join(dir, "repro.stats"), []byte(stats))
+}
+
+// scrub removes host-identifying strings from data that leaves the manager (see mgrconfig.ReproScrub).
+func (mgr *Manager) scrub(data []byte) []byte {
+	if mgr.scrubber == nil {
+		return data
+	}
+	return mgr.scrubber.Scrub(data)
+}
+
+// fingerprint returns fingerprint of the environment in which programs are executed,
+// features are unknown until the first machine check.
+// Must be called with mgr.mu held.
+func (mgr *Manager) fingerprint(sandbox string) *host.Fingerprint {
+	var features *host.Features
+	if mgr.checkResult != nil {
+		features = mgr.checkResult.Features
+	}
+	fp := host.MakeFingerprint(sys.GitRevision, mgr.target.Revision, sandbox, features)
+	fp.LSM = mgr.cfg.LSM
+	return fp
+}
+
+func (mgr *Manager) minimizeCorpus() {
+	if mgr.phase < phaseLoadedCorpus {
+		return
+	}
+	inputs := make([]signal.Context, 0, len(mgr.corpus))
+	for _, inp := range mgr.corpus {
+		inputs = append(inputs, signal.Context{
+			Signal:  inp.Signal.Deserialize(),
+			Context: inp,
+		})
+	}
+	newCorpus := make(map[string]rpctype.RPCInput)
+	for _, ctx := range signal.Minimize(inputs) {
+		inp := ctx.(rpctype.RPCInput)
+		newCorpus[hash.String(inp.Prog)] = inp
+	}
+	for key := range mgr.pins.pinned {
+		if inp, ok := mgr.corpus[key]; ok {
+			newCorpus[key] = inp
+		}
+	}
+	log.Logf(1, "minimized corpus: %v -> %v", len(mgr.corpus), len(newCorpus))
+	mgr.corpus = newCorpus
+
+	// Don't minimize persistent corpus until fuzzers have triaged all inputs from it.
+	if mgr.phase < phaseTriagedCorpus {
+		return
+	}
+	for key := range mgr.corpusDB.Records {
+		_, ok1 := mgr.corpus[key]
+		_, ok2 := mgr.disabledHashes[key]
+		if !ok1 && !ok2 && !mgr.pins.pinned[key] {
+			mgr.corpusDB.Delete(key)
+		}
+	}
+	mgr.corpusDB.BumpVersion(CorpusDBVersion)
+}
+
+// reminimizeCorpus drops corpus inputs with signal subsumed by other inputs and sends the rest
+// to fuzzers for re-minimization. Fuzzers send minimized inputs back with NewInputArgs.Replaces,
+// the original inputs are then replaced in corpus and corpus.db. Inputs that fail to re-minimize
+// (e.g. lost their signal due to a kernel update) are kept as is.
+// Returns the number of inputs queued for re-minimization.
+func (mgr *Manager) reminimizeCorpus() (int, error) {
+	mgr.mu.Lock()
+	defer mgr.mu.Unlock()
+
+	if mgr.phase < phaseTriagedCorpus {
+		return 0, fmt.Errorf("corpus is not triaged yet")
+	}
+	if len(mgr.candidates) != 0 {
+		return 0, fmt.Errorf("triage of %v candidates is in progress", len(mgr.candidates))
+	}
+	before := len(mgr.corpus)
+	mgr.minimizeCorpus()
+	for key, inp := range mgr.corpus {
+		if mgr.pins.pinned[key] {
+			// Pinned programs are kept exactly as they are.
+			continue
+		}
+		mgr.candidates = append(mgr.candidates, rpctype.RPCCandidate{
+			Prog:       inp.Prog,
+			Smashed:    true,
+			Reminimize: true,
+			Call:       inp.Call,
+		})
+	}
+	mgr.stats["corpus reminimizations"]++
+	log.Logf(0, "corpus re-minimization: dropped %v subsumed inputs, re-minimizing %v inputs",
+		before-len(mgr.corpus), len(mgr.candidates))
+	return len(mgr.candidates), nil
+}
+
+func (mgr *Manager) Connect(a *rpctype.ConnectArgs, r *rpctype.ConnectRes) error {
+	log.Logf(1, "fuzzer %v connected", a.Name)
+	mgr.mu.Lock()
+	defer mgr.mu.Unlock()
+
+	mgr.stats["vm restarts"]++
+	f := &Fuzzer{
+		name:  a.Name,
+		flags: mgr.vmFlags(a.Name),
+	}
+	mgr.fuzzers[a.Name] = f
+	mgr.addModules(a.Modules)
+	mgr.minimizeCorpus()
+	f.newMaxSignal = mgr.maxSignal.Copy()
+	f.inputs = make([]rpctype.RPCInput, 0, len(mgr.corpus))
+	for _, inp := range mgr.corpus {
+		f.inputs = append(f.inputs, inp)
+	}
+	r.EnabledCalls = mgr.enabledSyscalls
+	r.CallBudgets = mgr.callBudgets
+	r.CallWeights = mgr.callWeights
+	r.Mutators = mgr.cfg.Mutators
+	r.FeatureFlags = f.flags
+	r.CrashTrail = mgr.cfg.CrashTrail
+	r.Creds = mgr.cfg.Creds
+	r.CheckResult = mgr.checkResult
+	r.GitRevision = sys.GitRevision
+	r.TargetRevision = mgr.target.Revision
+	return nil
+}
+
+func (mgr *Manager) Check(a *rpctype.CheckArgs, r *int) error {
+	mgr.mu.Lock()
+	defer mgr.mu.Unlock()
+
+	if mgr.checkResult != nil {
+		return nil
+	}
+	if len(mgr.cfg.EnabledSyscalls) != 0 && len(a.DisabledCalls) != 0 {
+		disabled := make(map[string]string)
+		for _, dc := range a.DisabledCalls {
+			disabled[mgr.target.Syscalls[dc.ID].Name] = dc.Reason
+		}
+		for _, id := range mgr.enabledSyscalls {
+			name := mgr.target.Syscalls[id].Name
+			if reason := disabled[name]; reason != "" {
+				log.Logf(0, "disabling %v: %v", name, reason)
+			}
+		}
+	}
+	if a.Error != "" {
+		// Machine check fails on misconfigured VMs/kernels, there is no point in restarting VMs.
+		mgr.fatalf("machine check: %v", a.Error)
+		return fmt.Errorf("machine check: %v", a.Error)
+	}
+	log.Logf(0, "machine check:")
+	log.Logf(0, "%-24v: %v/%v", "syscalls", len(a.EnabledCalls), len(mgr.target.Syscalls))
+	if len(a.DownweightedCalls) != 0 {
+		log.Logf(0, "%-24v: %v", "down-weighted syscalls", len(a.DownweightedCalls))
+	}
+	for _, feat := range a.Features {
+		log.Logf(0, "%-24v: %v", feat.Name, feat.Reason)
+	}
+	// Disabled calls are needed only for the web UI, don't send them to every fuzzer.
+	mgr.disabledCalls = a.DisabledCalls
+	a.DisabledCalls = nil
+	mgr.addModules(a.Modules)
+	mgr.checkResult = a
+	if mgr.phase == phaseInit {
+		// This is not the first check if syscalls were reloaded.
+		mgr.loadCorpus()
+		mgr.firstConnect = time.Now()
+	}
+	return nil
+}
+
+func (mgr *Manager) NewInput(a *rpctype.NewInputArgs, r *int) error {
+	inputSignal := a.Signal.Deserialize()
+	log.Logf(4, "new input from %v for syscall %v (signal=%v, cover=%v)",
+		a.Name, a.Call, inputSignal.Len(), len(a.Cover))
+	mgr.mu.Lock()
+	defer mgr.mu.Unlock()
+
+	f := mgr.fuzzers[a.Name]
+	if f == nil {
+		return fmt.Errorf("fuzzer %v is not connected", a.Name)
+	}
+
+	p, err := mgr.target.Deserialize(a.RPCInput.Prog)
+	if err != nil {
+		// This should not happen, but we see such cases episodically, reason unknown.
+		log.Logf(0, "failed to deserialize program from fuzzer: %v\n%s", err, a.RPCInput.Prog)
+		return nil
+	}
+	if a.Replaces != "" {
+		// Re-minimized version of an existing input, see reminimizeCorpus.
+		if _, ok := mgr.corpus[a.Replaces]; !ok || hash.String(a.RPCInput.Prog) == a.Replaces ||
+			mgr.pins.pinned[a.Replaces] {
+			return nil
+		}
+		delete(mgr.corpus, a.Replaces)
+		mgr.corpusDB.Delete(a.Replaces)
+		mgr.stats["reminimized inputs"]++
+	} else {
+		newSignal := mgr.corpusSignal.Diff(inputSignal)
+		if newSignal.Empty() {
+			return nil
+		}
+		mgr.stats["manager new inputs"]++
+		mgr.flagStats(f.flags, func(arm *experiment.ArmStats) {
+			arm.NewInputs++
+			arm.NewSignal += uint64(newSignal.Len())
+		})
+	}
+	mgr.corpusSignal.Merge(inputSignal)
+	mgr.corpusCover.Merge(a.Cover)
+	sig := hash.String(a.RPCInput.Prog)
+	if inp, ok := mgr.corpus[sig]; ok {
+		// The input is already present, but possibly with diffent signal/coverage/call.
+		inputSignal.Merge(inp.Signal.Deserialize())
+		inp.Signal = inputSignal.Serialize()
+		var inputCover cover.Cover
+		inputCover.Merge(inp.Cover)
+		inputCover.Merge(a.RPCInput.Cover)
+		inp.Cover = inputCover.Serialize()
+		mgr.corpus[sig] = inp
+	} else {
+		mgr.corpus[sig] = a.RPCInput
+		mgr.corpusDB.Save(sig, a.RPCInput.Prog, 0)
+		mgr.pins.update(sig, p)
+		if err := mgr.corpusDB.Flush(); err != nil {
+			log.Logf(0, "failed to save corpus database: %v", err)
+		}
+		for _, f1 := range mgr.fuzzers {
+			if f1 == f {
+				continue
+			}
+			inp := a.RPCInput
+			inp.Cover = nil // Don't send coverage back to all fuzzers.
+			f1.inputs = append(f1.inputs, inp)
+		}
+	}
+	return nil
+}
+
+func (mgr *Manager) Poll(a *rpctype.PollArgs, r *rpctype.PollRes) error {
+	mgr.mu.Lock()
+	defer mgr.mu.Unlock()
+
+	for k, v := range a.Stats {
+		mgr.stats[k] += v
+	}
+	mgr.callStats.Merge(a.CallStats)
+
+	f := mgr.fuzzers[a.Name]
+	if f == nil {
+		return fmt.Errorf("fuzzer %v is not connected", a.Name)
+	}
+	if f.flags != nil {
+		// Fuzzers poll every few seconds, so time between polls is the fuzzing time of the VM.
+		now := time.Now()
+		var fuzzing time.Duration
+		if !f.lastPoll.IsZero() {
+			fuzzing = now.Sub(f.lastPoll)
+		}
+		f.lastPoll = now
+		mgr.flagStats(f.flags, func(arm *experiment.ArmStats) {
+			arm.Fuzzing += fuzzing
+			arm.Execs += a.Stats["exec total"]
+		})
+	}
+	newMaxSignal := mgr.maxSignal.Diff(a.MaxSignal.Deserialize())
+	if !newMaxSignal.Empty() {
+		mgr.maxSignal.Merge(newMaxSignal)
+		for _, f1 := range mgr.fuzzers {
+			if f1 == f {
+				continue
+			}
+			f1.newMaxSignal.Merge(newMaxSignal)
+		}
+	}
+	r.MaxSignal = f.newMaxSignal.Split(500).Serialize()
+	maxInputs := 5
+	if maxInputs < mgr.cfg.Procs {
+		maxInputs = mgr.cfg.Procs
+	}
+	if a.NeedCandidates {
+		for i := 0; i < maxInputs && len(mgr.candidates) > 0; i++ {
+			last := len(mgr.candidates) - 1
+			r.Candidates = append(r.Candidates, mgr.candidates[last])
+			mgr.candidates[last] = rpctype.RPCCandidate{}
+			mgr.candidates = mgr.candidates[:last]
+		}
+		if len(mgr.candidates) == 0 {
+			mgr.candidates = nil
+			if mgr.phase == phaseLoadedCorpus {
+				if mgr.cfg.HubClient != "" {
+					mgr.phase = phaseTriagedCorpus
+				} else {
+					mgr.phase = phaseTriagedHub
+				}
+			}
+		}
+	}
+	if len(r.Candidates) == 0 {
+		for i := 0; i < maxInputs && len(f.inputs) > 0; i++ {
+			last := len(f.inputs) - 1
+			r.NewInputs = append(r.NewInputs, f.inputs[last])
+			f.inputs[last] = rpctype.RPCInput{}
+			f.inputs = f.inputs[:last]
+		}
+		if len(f.inputs) == 0 {
+			f.inputs = nil
+		}
+	}
+	log.Logf(4, "poll from %v: candidates=%v inputs=%v maxsignal=%v",
+		a.Name, len(r.Candidates), len(r.NewInputs), len(r.MaxSignal.Elems))
+	return nil
+}
+
+func (mgr *Manager) hubSync() {
+	var subsystems *subsystemMap
+	if mgr.hubFilter.needSubsystems() {
+		var err error
+		if subsystems, err = mgr.subsystems(); err != nil {
+			log.Logf(0, "hub sync: failed to attribute coverage to subsystems: %v", err)
+			return
+		}
+	}
+	mgr.mu.Lock()
+	defer mgr.mu.Unlock()
+
+	switch mgr.phase {
+	case phaseInit, phaseLoadedCorpus:
+		return
+	case phaseTriagedCorpus:
+		mgr.phase = phaseQueriedHub
+	case phaseQueriedHub:
+		if len(mgr.candidates) == 0 {
+			mgr.phase = phaseTriagedHub
+		}
+	case phaseTriagedHub:
+	default:
+		panic("unknown phase")
+	}
+
+	mgr.minimizeCorpus()
+	if mgr.hub == nil {
+		a := &rpctype.HubConnectArgs{
+			Client:    mgr.cfg.HubClient,
+			Key:       mgr.cfg.HubKey,
+			Manager:   mgr.cfg.Name,
+			Fresh:     mgr.fresh,
+			PullCalls: mgr.hubFilter.pullCallNames(),
+		}
+		for _, id := range mgr.checkResult.EnabledCalls {
+			a.Calls = append(a.Calls, mgr.target.Syscalls[id].Name)
+		}
+		hubCorpus := make(map[hash.Sig]bool)
+		for _, inp := range mgr.corpus {
+			if !mgr.hubFilter.push(inp, subsystems, mgr.cfg.TargetVMArch) {
+				continue
+			}
+			hubCorpus[hash.Hash(inp.Prog)] = true
+			a.Corpus = append(a.Corpus, inp.Prog)
+		}
+		mgr.mu.Unlock()
+		// Hub.Connect request can be very large, so do it on a transient connection
+		// (rpc connection buffers never shrink).
+		// Also don't do hub rpc's under the mutex -- hub can be slow or inaccessible.
+		if err := rpctype.RPCCall(mgr.cfg.HubAddr, "Hub.Connect", a, nil); err != nil {
+			mgr.mu.Lock()
+			log.Logf(0, "Hub.Connect rpc failed: %v", err)
+			return
+		}
+		conn, err := rpctype.NewRPCClient(mgr.cfg.HubAddr)
+		if err != nil {
+			mgr.mu.Lock()
+			log.Logf(0, "failed to connect to hub at %v: %v", mgr.cfg.HubAddr, err)
+			return
+		}
+		mgr.mu.Lock()
+		mgr.hub = conn
+		mgr.hubCorpus = hubCorpus
+		mgr.fresh = false
+		log.Logf(0, "connected to hub at %v, corpus %v", mgr.cfg.HubAddr, len(mgr.corpus))
+	}
+
+	a := &rpctype.HubSyncArgs{
+		Client:            mgr.cfg.HubClient,
+		Key:               mgr.cfg.HubKey,
+		Manager:           mgr.cfg.Name,
+		CoordinationGroup: mgr.cfg.HubCoordinationGroup,
+	}
+	if a.CoordinationGroup != "" {
+		a.CallCoverage = mgr.callCoverage()
+	}
+	corpus := make(map[hash.Sig]bool)
+	for _, inp := range mgr.corpus {
+		sig := hash.Hash(inp.Prog)
+		if mgr.hubCorpus[sig] {
+			corpus[sig] = true
+			continue
+		}
+		if !mgr.hubFilter.push(inp, subsystems, mgr.cfg.TargetVMArch) {
+			continue
+		}
+		corpus[sig] = true
+		mgr.hubCorpus[sig] = true
+		a.Add = append(a.Add, inp.Prog)
+	}
+	for sig := range mgr.hubCorpus {
+		if corpus[sig] {
+			continue
+		}
+		delete(mgr.hubCorpus, sig)
+		a.Del = append(a.Del, sig.String())
+	}
+	for {
+		a.Repros = mgr.newRepros
+
+		mgr.mu.Unlock()
+
+		if mgr.cfg.Reproduce && mgr.dash != nil {
+			needReproReply := make(chan bool)
+			mgr.needMoreRepros <- needReproReply
+			a.NeedRepros = <-needReproReply
+		}
+
+		r := new(rpctype.HubSyncRes)
+		if err := mgr.hub.Call("Hub.Sync", a, r); err != nil {
+			mgr.mu.Lock()
+			log.Logf(0, "Hub.Sync rpc failed: %v", err)
+			mgr.hub.Close()
+			mgr.hub = nil
+			return
+		}
+
+		reproDropped := 0
+		for _, repro := range r.Repros {
+			_, err := mgr.target.Deserialize(repro)
+			if err != nil {
+				reproDropped++
+				continue
+			}
+			mgr.hubReproQueue <- &Crash{
+				vmIndex: -1,
+				hub:     true,
+				Report: &report.Report{
+					Title:  "external repro",
+					Output: repro,
+				},
+			}
+		}
+
+		mgr.mu.Lock()
+		mgr.newRepros = nil
+		if a.CoordinationGroup != "" {
+			mgr.updateCallWeights(r.CallWeights)
+		}
+		// Managers connected to the hub can use different descriptions and enabled syscalls.
+		enabled := mgr.enabledCalls(mgr.checkResult.EnabledCalls)
+		salvage := new(importStats)
+		filtered := 0
+		for _, inp := range r.Progs {
+			p := mgr.salvageProg(inp, enabled, salvage)
+			if p == nil {
+				continue
+			}
+			data := p.Serialize()
+			if !mgr.hubFilter.pull(data) {
+				filtered++
+				continue
+			}
+			mgr.candidates = append(mgr.candidates, rpctype.RPCCandidate{
+				Prog:      data,
+				Minimized: false, // don't trust programs from hub
+				Smashed:   false,
+			})
+		}
+		dropped := salvage.dropped
+		mgr.stats["hub add"] += uint64(len(a.Add))
+		mgr.stats["hub del"] += uint64(len(a.Del))
+		mgr.stats["hub drop"] += uint64(dropped)
+		mgr.stats["hub filtered"] += uint64(filtered)
+		mgr.stats["hub salvaged"] += uint64(salvage.salvaged)
+		mgr.stats["hub new"] += uint64(len(r.Progs) - dropped - filtered)
+		mgr.stats["hub sent repros"] += uint64(len(a.Repros))
+		mgr.stats["hub recv repros"] += uint64(len(r.Repros) - reproDropped)
+		log.Logf(0, "hub sync: send: add %v, del %v, repros %v; recv: progs: drop %v, filtered %v, new %v"+
+			" (salvaged %v, %v calls dropped), repros: drop: %v, new %v; more %v",
+			len(a.Add), len(a.Del), len(a.Repros), dropped, filtered, len(r.Progs)-dropped-filtered,
+			salvage.salvaged, salvage.droppedCalls, reproDropped, len(r.Repros)-reproDropped, r.More)
+		if len(r.Progs)+r.More == 0 {
+			break
+		}
+		a.Add = nil
+		a.Del = nil
+		a.CallCoverage = nil
+	}
+}
+
+// callCoverage returns coverage of the corpus per enabled syscall name
+// (all enabled syscalls are present, including the ones without coverage).
+func (mgr *Manager) callCoverage() map[string]int {
+	coverage := make(map[string]int)
+	for _, id := range mgr.checkResult.EnabledCalls {
+		coverage[mgr.target.Syscalls[id].Name] = 0
+	}
+	for _, inp := range mgr.corpus {
+		if _, ok := coverage[inp.Call]; ok {
+			coverage[inp.Call] += len(inp.Signal.Elems)
+		}
+	}
+	return coverage
+}
+
+// updateCallWeights saves syscall weights received from hub, they are passed to fuzzers on connect.
+func (mgr *Manager) updateCallWeights(weights map[string]float64) {
+	mgr.callWeights = make(map[int]float64)
+	for name, w := range weights {
+		if call := mgr.target.SyscallMap[name]; call != nil {
+			mgr.callWeights[call.ID] = w
+		}
+	}
+}
+
+func (mgr *Manager) collectUsedFiles() error {
+	if mgr.vmPool == nil {
+		return nil
+	}
+	cfg := mgr.cfg
+	files := []string{cfg.SyzFuzzerBin, cfg.SyzExecprogBin, cfg.SyzExecutorBin, cfg.SSHKey}
+	if vmlinux := filepath.Join(cfg.KernelObj, "vmlinux"); osutil.IsExist(vmlinux) {
+		files = append(files, vmlinux)
+	}
+	if zircon := filepath.Join(cfg.KernelObj, "zircon.elf"); osutil.IsExist(zircon) {
+		files = append(files, zircon)
+	}
+	if cfg.Image != "9p" {
+		files = append(files, cfg.Image)
+	}
+	for _, f := range files {
+		if f == "" {
+			continue
+		}
+		stat, err := os.Stat(f)
+		if err != nil {
+			return fmt.Errorf("failed to stat %v: %v", f, err)
+		}
+		mgr.usedFiles[f] = stat.ModTime()
+	}
+	return nil
+}
+
+// checkUsedFiles checks that files used by the manager are not modified.
+// Modified files are a fatal error (see fatalf).
+func (mgr *Manager) checkUsedFiles() error {
+	for f, mod := range mgr.usedFiles {
+		stat, err := os.Stat(f)
+		if err != nil {
+			mgr.fatalf("failed to stat %v: %v", f, err)
+			return err
+		}
+		if mod != stat.ModTime() {
+			err := fmt.Errorf("file %v that syz-manager uses has been modified by an external program\n"+
+				"this can lead to arbitrary syz-manager misbehavior\n"+
+				"modification time has changed: %v -> %v\n"+
+				"don't modify files that syz-manager uses. stopping to prevent harm",
+				f, mod, stat.ModTime())
+			mgr.fatalf("%v", err)
+			return err
+		}
+	}
+	return nil
+}
+
+// experimentLoop periodically checkpoints stats for A/B experiments.
+func (mgr *Manager) experimentLoop() {
+	w, err := experiment.NewWriter(filepath.Join(mgr.cfg.Workdir, "experiment.stats"), mgr.cfg.Experiment)
+	if err != nil {
+		mgr.fatalf("failed to open experiment stats: %v", err)
+		return
+	}
+	for mgr.sleep(10 * time.Minute) {
+		crashTypes, err := osutil.ListDir(mgr.crashdir)
+		if err != nil {
+			log.Logf(0, "failed to list crashes: %v", err)
+		}
+		mgr.mu.Lock()
+		if mgr.firstConnect.IsZero() {
+			mgr.mu.Unlock()
+			continue
+		}
+		cp := experiment.Checkpoint{
+			Fuzzing:    uint64(mgr.fuzzingTime / time.Second),
+			Execs:      mgr.stats["exec total"],
+			Crashes:    mgr.stats["crashes"],
+			CrashTypes: uint64(len(crashTypes)),
+			Coverage:   uint64(len(mgr.corpusCover)),
+			Corpus:     uint64(len(mgr.corpus)),
+		}
+		mgr.mu.Unlock()
+		if err := w.Write(cp); err != nil {
+			log.Logf(0, "failed to write experiment stats: %v", err)
+		}
+	}
+}
+
+func (mgr *Manager) dashboardReporter() {
+	webAddr := publicWebAddr(mgr.cfg.HTTP)
+	var lastFuzzingTime time.Duration
+	var lastCrashes, lastExecs uint64
+	for mgr.sleep(time.Minute) {
+		mgr.mu.Lock()
+		if mgr.firstConnect.IsZero() {
+			mgr.mu.Unlock()
+			continue
+		}
+		crashes := mgr.stats["crashes"]
+		execs := mgr.stats["exec total"]
+		req := &dashapi.ManagerStatsReq{
+			Name:        mgr.cfg.Name,
+			Addr:        webAddr,
+			UpTime:      time.Since(mgr.firstConnect),
+			Corpus:      uint64(len(mgr.corpus)),
+			Cover:       uint64(mgr.corpusSignal.Len()),
+			FuzzingTime: mgr.fuzzingTime - lastFuzzingTime,
+			Crashes:     crashes - lastCrashes,
+			Execs:       execs - lastExecs,
+		}
+		mgr.mu.Unlock()
+
+		if err := mgr.dash.UploadManagerStats(req); err != nil {
+			log.Logf(0, "faield to upload dashboard stats: %v", err)
+			continue
+		}
+		mgr.mu.Lock()
+		lastFuzzingTime += req.FuzzingTime
+		lastCrashes += req.Crashes
+		lastExecs += req.Execs
+		mgr.mu.Unlock()
+	}
+}
+
+func publicWebAddr(addr string) string {
+	_, port, err := net.SplitHostPort(addr)
+	if err == nil && port != "" {
+		if host, err := os.Hostname(); err == nil {
+			addr = net.JoinHostPort(host, port)
+		}
+		if GCE, err := gce.NewContext(); err == nil {
+			addr = net.JoinHostPort(GCE.ExternalIP, port)
+		}
+	}
+	return "http://" + addr
+}
@@ -0,0 +1,110 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package manager
+
+import (
+	"testing"
+
+	"github.com/google/syzkaller/pkg/report"
+	"github.com/google/syzkaller/syz-manager/mgrconfig"
+)
+
+func TestReproAllowed(t *testing.T) {
+	const (
+		warning = `
+[    0.000000] WARNING: CPU: 0 PID: 1 at net/core/dev.c:2641 skb_warn_bad_offload+0x2bd/0x3b0
+`
+		lockdep = `
+[   36.351334] ======================================================
+[   36.351334] WARNING: possible circular locking dependency detected
+[   36.351334] 4.15.0-rc7+ #255 Not tainted
+[   36.351334] ------------------------------------------------------
+`
+		kasan = `
+[    0.000000] BUG: KASAN: use-after-free in skb_release_data+0x1d8/0x1f0 at addr ffff8800302e7e10
+`
+	)
+	tests := []struct {
+		warnings string
+		log      string
+		allowed  bool
+	}{
+		{"crash", warning, true},
+		{"crash", lockdep, true},
+		{"crash", kasan, true},
+		{"norepro", warning, false},
+		{"norepro", lockdep, true},
+		{"norepro", kasan, true},
+	}
+	reporter, err := report.NewReporter(&mgrconfig.Config{TargetOS: "linux"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, test := range tests {
+		rep := reporter.Parse([]byte(test.log))
+		if rep == nil {
+			t.Fatalf("#%v: no crash", i)
+		}
+		mgr := &Manager{cfg: &mgrconfig.Config{Warnings: test.warnings}}
+		if got := mgr.reproAllowed(&Crash{Report: rep}); got != test.allowed {
+			t.Errorf("#%v: warnings=%v %q: reproAllowed=%v, want %v",
+				i, test.warnings, rep.Title, got, test.allowed)
+		}
+	}
+}
+
+func TestVMPartition(t *testing.T) {
+	for _, test := range []struct {
+		vmCount, reproVMs, holdVMs int
+		hold, maxRepro             int
+	}{
+		{vmCount: 10, reproVMs: 0, holdVMs: 0, hold: 0, maxRepro: 10},
+		{vmCount: 10, reproVMs: 3, holdVMs: 0, hold: 0, maxRepro: 3},
+		{vmCount: 10, reproVMs: 0, holdVMs: 2, hold: 2, maxRepro: 8},
+		{vmCount: 10, reproVMs: 3, holdVMs: 2, hold: 2, maxRepro: 3},
+		{vmCount: 10, reproVMs: 9, holdVMs: 2, hold: 2, maxRepro: 8},
+		{vmCount: 10, reproVMs: 3, holdVMs: 20, hold: 10, maxRepro: 0},
+		{vmCount: 1, reproVMs: 0, holdVMs: 1, hold: 1, maxRepro: 0},
+	} {
+		hold, maxRepro := vmPartition(test.vmCount, test.reproVMs, test.holdVMs)
+		if hold != test.hold || maxRepro != test.maxRepro {
+			t.Errorf("vmPartition(%v, %v, %v) = %v, %v, want %v, %v",
+				test.vmCount, test.reproVMs, test.holdVMs, hold, maxRepro, test.hold, test.maxRepro)
+		}
+	}
+}
+
+func TestReproInstanceCount(t *testing.T) {
+	for _, test := range []struct {
+		perRepro, crashVMs, maxRepro int
+		count                        int
+	}{
+		{perRepro: 4, crashVMs: 0, maxRepro: 10, count: 4},
+		{perRepro: 4, crashVMs: 0, maxRepro: 2, count: 2},
+		{perRepro: 4, crashVMs: 8, maxRepro: 10, count: 8},
+		{perRepro: 4, crashVMs: 8, maxRepro: 5, count: 5},
+		{perRepro: 4, crashVMs: 1, maxRepro: 5, count: 1},
+		{perRepro: 4, crashVMs: 0, maxRepro: 0, count: 0},
+	} {
+		count := reproInstanceCount(test.perRepro, test.crashVMs, test.maxRepro)
+		if count != test.count {
+			t.Errorf("reproInstanceCount(%v, %v, %v) = %v, want %v",
+				test.perRepro, test.crashVMs, test.maxRepro, count, test.count)
+		}
+	}
+}
+
+func TestFatalf(t *testing.T) {
+	mgr := &Manager{drain: make(chan struct{})}
+	mgr.fatalf("first %v", 1)
+	mgr.fatalf("second %v", 2)
+	select {
+	case <-mgr.drain:
+	default:
+		t.Fatalf("the manager is not drained")
+	}
+	if mgr.fatalErr == nil || mgr.fatalErr.Error() != "first 1" {
+		t.Fatalf("got fatal error %v, want the first error", mgr.fatalErr)
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package manager
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sort"
+
+	"github.com/google/syzkaller/pkg/crypt"
+	"github.com/google/syzkaller/pkg/log"
+	"github.com/google/syzkaller/prog"
+	"github.com/google/syzkaller/syz-manager/mgrconfig"
+)
+
+// Pinned corpus programs are never dropped by corpus minimization and re-minimization
+// and are never deleted from corpus.db, even if they lose their signal (e.g. after a kernel update).
+// Programs are pinned either explicitly by hash (via /corpus/pin or the control API),
+// such pins are persisted in the workdir, or because they contain one of mgrconfig.PinnedCalls.
+const pinnedProgsFile = "corpus-pinned.json"
+
+type corpusPins struct {
+	calls  map[int]bool    // syscalls from mgrconfig.PinnedCalls
+	progs  map[string]bool // explicitly pinned program hashes
+	pinned map[string]bool // hashes of all pinned programs in corpus.db
+}
+
+func makeCorpusPins(cfg *mgrconfig.Config, target *prog.Target, key *crypt.Key) (*corpusPins, error) {
+	pins := &corpusPins{
+		calls:  make(map[int]bool),
+		progs:  make(map[string]bool),
+		pinned: make(map[string]bool),
+	}
+	if len(cfg.PinnedCalls) != 0 {
+		calls, err := mgrconfig.ParseEnabledSyscalls(target, cfg.PinnedCalls, nil)
+		if err != nil {
+			return nil, err
+		}
+		pins.calls = calls
+	}
+	if data, err := key.ReadFile(filepath.Join(cfg.Workdir, pinnedProgsFile)); err == nil {
+		var progs []string
+		if err := json.Unmarshal(data, &progs); err != nil {
+			return nil, fmt.Errorf("failed to parse %v: %v", pinnedProgsFile, err)
+		}
+		for _, sig := range progs {
+			pins.progs[sig] = true
+		}
+	}
+	return pins, nil
+}
+
+// update records whether the corpus.db program with hash sig is pinned.
+func (pins *corpusPins) update(sig string, p *prog.Prog) {
+	pinned := pins.progs[sig]
+	for _, c := range p.Calls {
+		if pins.calls[c.Meta.ID] {
+			pinned = true
+			break
+		}
+	}
+	if pinned {
+		pins.pinned[sig] = true
+	} else {
+		delete(pins.pinned, sig)
+	}
+}
+
+// pinPrograms pins (or unpins) corpus.db programs with the given hashes.
+// Programs pinned because of mgrconfig.PinnedCalls can't be unpinned.
+// Returns the number of changed pins.
+func (mgr *Manager) pinPrograms(sigs []string, pin bool) (int, error) {
+	mgr.mu.Lock()
+	defer mgr.mu.Unlock()
+
+	pins := mgr.pins
+	for _, sig := range sigs {
+		if _, ok := mgr.corpusDB.Records[sig]; !ok {
+			return 0, fmt.Errorf("unknown program %q", sig)
+		}
+	}
+	changed := 0
+	for _, sig := range sigs {
+		if pins.progs[sig] == pin {
+			continue
+		}
+		if pin {
+			pins.progs[sig] = true
+		} else {
+			delete(pins.progs, sig)
+		}
+		p, _, err := mgr.target.DeserializeNonStrict(mgr.corpusDB.Records[sig].Val)
+		if err != nil {
+			return changed, err
+		}
+		pins.update(sig, p)
+		changed++
+	}
+	if changed != 0 {
+		if err := mgr.savePinnedProgs(); err != nil {
+			return changed, fmt.Errorf("failed to save pinned programs: %v", err)
+		}
+		log.Logf(0, "pinned=%v %v corpus programs", pin, changed)
+	}
+	return changed, nil
+}
+
+func (mgr *Manager) savePinnedProgs() error {
+	progs := []string{}
+	for sig := range mgr.pins.progs {
+		progs = append(progs, sig)
+	}
+	sort.Strings(progs)
+	data, err := json.MarshalIndent(progs, "", "\t")
+	if err != nil {
+		return err
+	}
+	return mgr.key.WriteFile(filepath.Join(mgr.cfg.Workdir, pinnedProgsFile), data)
+}
@@ -0,0 +1,54 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package manager
+
+import (
+	"regexp"
+
+	"github.com/google/syzkaller/syz-manager/mgrconfig"
+)
+
+// reproPriority is a compiled mgrconfig.ReproPriority.
+type reproPriority struct {
+	title    *regexp.Regexp
+	priority int
+	vms      int
+}
+
+func makeReproPriorities(cfg []mgrconfig.ReproPriority) ([]reproPriority, error) {
+	var res []reproPriority
+	for _, prio := range cfg {
+		re, err := regexp.Compile(prio.Title)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, reproPriority{re, prio.Priority, prio.VMs})
+	}
+	return res, nil
+}
+
+// reproPriority returns reproduction priority of a crash and number of VMs to reproduce it with
+// (0 means the default number).
+func (mgr *Manager) reproPriority(title string) (priority, vms int) {
+	for _, prio := range mgr.reproPriorities {
+		if prio.title.MatchString(title) {
+			return prio.priority, prio.vms
+		}
+	}
+	return 0, 0
+}
+
+// queueRepro inserts the crash into the repro queue that is ordered by priority,
+// crashes are taken from the end of the queue. Among crashes with the same priority
+// the most recent one is taken first.
+func queueRepro(queue []*Crash, crash *Crash) []*Crash {
+	pos := len(queue)
+	for pos > 0 && queue[pos-1].reproPriority > crash.reproPriority {
+		pos--
+	}
+	queue = append(queue, nil)
+	copy(queue[pos+1:], queue[pos:])
+	queue[pos] = crash
+	return queue
+}
@@ -0,0 +1,311 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package manager
+
+import (
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/google/syzkaller/pkg/csource"
+	"github.com/google/syzkaller/pkg/log"
+	"github.com/google/syzkaller/pkg/osutil"
+)
+
+// One-off runs of user-provided programs (syz programs or C reproducers) on test machines.
+// Runs are submitted via web UI (see httpRun), queued and started by vmLoop
+// which takes the requested number of VMs away from fuzzing for the duration of the run.
+
+const (
+	maxPendingRuns  = 10
+	maxKeptRuns     = 50
+	maxRunDuration  = time.Hour
+	maxRunOutputLen = 1 << 20
+)
+
+type ProgRun struct {
+	ID       int
+	Created  time.Time
+	Duration time.Duration
+	Count    int    // number of VMs
+	Prog     []byte // syz program, if not C
+	CSource  []byte // C reproducer, if not syz program
+
+	bin string // compiled CSource
+
+	mu       sync.Mutex
+	Started  time.Time
+	Finished time.Time
+	VMs      []*ProgRunVM
+}
+
+type ProgRunVM struct {
+	Index  int
+	Status string // booting/running/ok/crashed/error
+	Error  string
+	Title  string // crash title
+	Report []byte // crash report
+	Output []byte // console output
+}
+
+const (
+	runStatusQueued  = "queued"
+	runStatusBooting = "booting"
+	runStatusRunning = "running"
+	runStatusOK      = "ok"
+	runStatusCrashed = "crashed"
+	runStatusError   = "error"
+)
+
+// submitRun validates the run and queues it for execution.
+func (mgr *Manager) submitRun(run *ProgRun) error {
+	if mgr.vmPool == nil {
+		return fmt.Errorf("VMs are started manually, can't run programs")
+	}
+	mgr.mu.Lock()
+	holdVMs := mgr.holdVMs
+	mgr.mu.Unlock()
+	// Runs don't use held VMs, so a run that needs more VMs would stay queued forever.
+	if maxCount := mgr.vmPool.Count() - holdVMs; run.Count <= 0 || run.Count > maxCount {
+		return fmt.Errorf("bad number of VMs %v, must be in [1, %v] (%v VMs are held)",
+			run.Count, maxCount, holdVMs)
+	}
+	if run.Duration <= 0 || run.Duration > maxRunDuration {
+		return fmt.Errorf("bad duration %v, must be in (0, %v]", run.Duration, maxRunDuration)
+	}
+	switch {
+	case len(run.Prog) != 0 && len(run.CSource) != 0:
+		return fmt.Errorf("both program and C source are specified")
+	case len(run.Prog) != 0:
+		p, err := mgr.target.Deserialize(run.Prog)
+		if err != nil {
+			return fmt.Errorf("failed to parse program: %v", err)
+		}
+		if len(p.Calls) == 0 {
+			return fmt.Errorf("program is empty")
+		}
+		run.Prog = p.Serialize()
+	case len(run.CSource) != 0:
+		bin, err := csource.Build(mgr.target, run.CSource)
+		if err != nil {
+			return fmt.Errorf("failed to build C source: %v", err)
+		}
+		run.bin = bin
+	default:
+		return fmt.Errorf("no program specified")
+	}
+	run.Created = time.Now()
+	for i := 0; i < run.Count; i++ {
+		run.VMs = append(run.VMs, &ProgRunVM{Index: -1, Status: runStatusQueued})
+	}
+	mgr.mu.Lock()
+	mgr.lastRunID++
+	run.ID = mgr.lastRunID
+	mgr.mu.Unlock()
+	select {
+	case mgr.runQueue <- run:
+	default:
+		if run.bin != "" {
+			os.Remove(run.bin)
+		}
+		return fmt.Errorf("too many pending runs, try again later")
+	}
+	mgr.mu.Lock()
+	mgr.runs = append(mgr.runs, run)
+	if len(mgr.runs) > maxKeptRuns {
+		mgr.runs = mgr.runs[len(mgr.runs)-maxKeptRuns:]
+	}
+	mgr.mu.Unlock()
+	log.Logf(0, "run %v: queued on %v VMs for %v", run.ID, run.Count, run.Duration)
+	return nil
+}
+
+func (mgr *Manager) findRun(id int) *ProgRun {
+	mgr.mu.Lock()
+	defer mgr.mu.Unlock()
+	for _, run := range mgr.runs {
+		if run.ID == id {
+			return run
+		}
+	}
+	return nil
+}
+
+// failRun marks a queued run that can't be started as failed. Called by vmLoop.
+func (mgr *Manager) failRun(run *ProgRun, err error) {
+	if run.bin != "" {
+		os.Remove(run.bin)
+	}
+	run.mu.Lock()
+	run.Finished = time.Now()
+	for _, res := range run.VMs {
+		res.Status = runStatusError
+		res.Error = err.Error()
+	}
+	run.mu.Unlock()
+	log.Logf(0, "run %v: %v", run.ID, err)
+}
+
+// executeRun runs the program on VMs with the given indexes. Called by vmLoop.
+func (mgr *Manager) executeRun(run *ProgRun, vmIndexes []int) {
+	if run.bin != "" {
+		defer os.Remove(run.bin)
+	}
+	run.mu.Lock()
+	run.Started = time.Now()
+	for i, idx := range vmIndexes {
+		run.VMs[i].Index = idx
+		run.VMs[i].Status = runStatusBooting
+	}
+	run.mu.Unlock()
+	var wg sync.WaitGroup
+	for i, idx := range vmIndexes {
+		wg.Add(1)
+		go func(res *ProgRunVM, idx int) {
+			defer wg.Done()
+			crashed, err := mgr.executeRunInstance(run, res, idx)
+			run.mu.Lock()
+			defer run.mu.Unlock()
+			switch {
+			case err != nil:
+				res.Status = runStatusError
+				res.Error = err.Error()
+			case crashed:
+				res.Status = runStatusCrashed
+			default:
+				res.Status = runStatusOK
+			}
+		}(run.VMs[i], idx)
+	}
+	wg.Wait()
+	run.mu.Lock()
+	run.Finished = time.Now()
+	run.mu.Unlock()
+	log.Logf(0, "run %v: finished", run.ID)
+}
+
+func (mgr *Manager) executeRunInstance(run *ProgRun, res *ProgRunVM, index int) (bool, error) {
+	if err := mgr.checkUsedFiles(); err != nil {
+		return false, err
+	}
+	inst, err := mgr.vmPool.Create(index)
+	mgr.updateVMHealth(index, err)
+	if err != nil {
+		return false, fmt.Errorf("failed to create instance: %v", err)
+	}
+	defer inst.Close()
+
+	var cmd string
+	if run.bin != "" {
+		bin, err := inst.Copy(run.bin)
+		if err != nil {
+			return false, fmt.Errorf("failed to copy binary: %v", err)
+		}
+		cmd = bin
+	} else {
+		execprogBin, err := inst.Copy(mgr.cfg.SyzExecprogBin)
+		if err != nil {
+			return false, fmt.Errorf("failed to copy binary: %v", err)
+		}
+		executorBin, err := inst.Copy(mgr.cfg.SyzExecutorBin)
+		if err != nil {
+			return false, fmt.Errorf("failed to copy binary: %v", err)
+		}
+		progFile, err := osutil.WriteTempFile(run.Prog)
+		if err != nil {
+			return false, err
+		}
+		defer os.Remove(progFile)
+		vmProgFile, err := inst.Copy(progFile)
+		if err != nil {
+			return false, fmt.Errorf("failed to copy program: %v", err)
+		}
+		cmd = fmt.Sprintf("%v -executor=%v -arch=%v -cover=0 -procs=%v -repeat=0 -sandbox=%v %v",
+			execprogBin, executorBin, mgr.cfg.TargetArch, mgr.cfg.Procs, mgr.cfg.Sandbox, vmProgFile)
+	}
+	outc, errc, err := inst.Run(run.Duration, nil, cmd)
+	if err != nil {
+		return false, fmt.Errorf("failed to run program: %v", err)
+	}
+	run.mu.Lock()
+	res.Status = runStatusRunning
+	run.mu.Unlock()
+	done := make(chan bool)
+	rep := inst.MonitorExecution(teeRunOutput(run, res, outc, done), errc, mgr.reporter, true)
+	close(done)
+	if rep == nil {
+		return false, nil
+	}
+	if err := mgr.reporter.Symbolize(rep); err != nil {
+		log.Logf(0, "failed to symbolize report: %v", err)
+	}
+	run.mu.Lock()
+	res.Title = rep.Title
+	res.Report = rep.Report
+	if len(rep.Output) != 0 {
+		res.Output = rep.Output
+	}
+	run.mu.Unlock()
+	log.Logf(0, "run %v: vm-%v crashed: %v", run.ID, index, rep.Title)
+	return true, nil
+}
+
+// teeRunOutput copies console output into res as it arrives,
+// so that it can be shown while the run is in progress.
+func teeRunOutput(run *ProgRun, res *ProgRunVM, outc <-chan []byte, done <-chan bool) <-chan []byte {
+	teec := make(chan []byte, cap(outc))
+	go func() {
+		for out := range outc {
+			run.mu.Lock()
+			res.Output = append(res.Output, out...)
+			if len(res.Output) > maxRunOutputLen {
+				res.Output = res.Output[len(res.Output)-maxRunOutputLen:]
+			}
+			run.mu.Unlock()
+			select {
+			case teec <- out:
+			case <-done:
+				return
+			}
+		}
+	}()
+	return teec
+}
+
+func (run *ProgRun) uiData() *UIRun {
+	run.mu.Lock()
+	defer run.mu.Unlock()
+	data := &UIRun{
+		ID:       run.ID,
+		Created:  run.Created.Format(dateFormat),
+		Duration: run.Duration.String(),
+		Prog:     string(run.Prog),
+		CSource:  string(run.CSource),
+		Finished: !run.Finished.IsZero(),
+	}
+	crashed := 0
+	for _, res := range run.VMs {
+		if res.Status == runStatusCrashed {
+			crashed++
+		}
+		data.VMs = append(data.VMs, &UIRunVM{
+			Index:  res.Index,
+			Status: res.Status,
+			Error:  res.Error,
+			Title:  res.Title,
+			Report: string(res.Report),
+			Output: string(res.Output),
+		})
+	}
+	switch {
+	case data.Finished:
+		data.Status = fmt.Sprintf("finished, %v/%v VMs crashed", crashed, len(run.VMs))
+	case !run.Started.IsZero():
+		data.Status = runStatusRunning
+	default:
+		data.Status = runStatusQueued
+	}
+	return data
+}
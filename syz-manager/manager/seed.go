@@ -0,0 +1,67 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package manager
+
+import (
+	"io/ioutil"
+	"path/filepath"
+
+	"github.com/google/syzkaller/pkg/log"
+	"github.com/google/syzkaller/pkg/rpctype"
+	"github.com/google/syzkaller/pkg/trace2syz"
+	"github.com/google/syzkaller/prog"
+)
+
+// Max number of calls in programs converted from seed traces,
+// longer traces are split into several programs.
+const seedProgCalls = 30
+
+// importSeedTraces converts syscall traces from mgrconfig.SeedTraces into programs and adds them
+// to the triage queue, programs that give new signal end up in corpus. Traces are imported
+// on every start, programs that don't give new signal are dropped by triage.
+func (mgr *Manager) importSeedTraces(syscalls map[int]bool) {
+	if len(mgr.cfg.SeedTraces) == 0 {
+		return
+	}
+	enabled := make(map[*prog.Syscall]bool)
+	for id := range syscalls {
+		enabled[mgr.target.Syscalls[id]] = true
+	}
+	consts := trace2syz.Consts(mgr.target)
+	files, progs, skipped := 0, 0, 0
+	for _, pattern := range mgr.cfg.SeedTraces {
+		matches, err := filepath.Glob(pattern)
+		if err != nil || len(matches) == 0 {
+			log.Logf(0, "no seed traces match %v", pattern)
+			continue
+		}
+		for _, file := range matches {
+			data, err := ioutil.ReadFile(file)
+			if err != nil {
+				log.Logf(0, "failed to read seed trace: %v", err)
+				continue
+			}
+			traces, err := trace2syz.Parse(data, consts)
+			if err != nil {
+				log.Logf(0, "failed to parse seed trace %v: %v", file, err)
+				continue
+			}
+			files++
+			for _, trace := range traces {
+				ps, n := trace2syz.Convert(mgr.target, trace, enabled, seedProgCalls)
+				for _, p := range ps {
+					mgr.candidates = append(mgr.candidates, rpctype.RPCCandidate{
+						Prog:      p.Serialize(),
+						Minimized: false,
+						Smashed:   false,
+					})
+				}
+				progs += len(ps)
+				skipped += n
+			}
+		}
+	}
+	mgr.stats["seed programs"] += uint64(progs)
+	log.Logf(0, "%-24v: %v programs from %v files (%v calls skipped)", "seed traces", progs, files, skipped)
+}
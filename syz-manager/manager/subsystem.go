@@ -0,0 +1,202 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package manager
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/syzkaller/pkg/cover"
+	"github.com/google/syzkaller/pkg/host"
+	"github.com/google/syzkaller/pkg/log"
+	"github.com/google/syzkaller/pkg/symbolizer"
+)
+
+// Coverage attribution to kernel subsystems. A subsystem is a source directory
+// truncated to subsystemDepth components (e.g. "drivers/net", "net/ipv4", "mm").
+// Coverage of loadable modules is attributed to the module using load addresses
+// reported by fuzzers on every connect, so modules loaded on any VM (including
+// VMs that loaded them at different addresses) are recognized once that VM boots.
+// Total number of coverage points in modules is unknown.
+const subsystemDepth = 2
+
+type subsystemMap struct {
+	subsystems []string // subsystem of the corresponding initCoverSymbols entry
+	total      map[string]int
+	modules    []host.KernelModule // snapshot of Manager.modules, see Manager.subsystems
+}
+
+type subsystemSnapshot struct {
+	time    time.Time
+	covered map[string]int
+}
+
+var (
+	initSubsystemsOnce  sync.Once
+	initSubsystemsError error
+	initSubsystemsMap   *subsystemMap
+)
+
+func initSubsystems(kernelObj, arch string) (*subsystemMap, error) {
+	initCoverOnce.Do(func() { initCoverError = initCover(kernelObj, arch) })
+	if initCoverError != nil {
+		return nil, initCoverError
+	}
+	m := &subsystemMap{
+		subsystems: make([]string, len(initCoverSymbols)),
+		total:      make(map[string]int),
+	}
+	// Symbolize entry PCs of all functions with coverage callbacks to find their source files.
+	funcPCs := make(map[int]int)
+	for _, pc := range initCoverPCs {
+		if idx := findSymbol(pc); idx != -1 {
+			funcPCs[idx]++
+		}
+	}
+	var starts []uint64
+	for idx := range funcPCs {
+		starts = append(starts, initCoverSymbols[idx].start)
+	}
+	vmlinux := filepath.Join(kernelObj, "vmlinux")
+	symb := symbolizer.NewSymbolizer()
+	defer symb.Close()
+	frames, err := symb.SymbolizeArray(vmlinux, starts)
+	if err != nil {
+		return nil, err
+	}
+	// Strip common prefix of all files (kernel source dir).
+	files := make(map[uint64]string)
+	prefix := ""
+	for _, frame := range frames {
+		if frame.Inline {
+			continue
+		}
+		if len(files) == 0 {
+			prefix = frame.File
+		}
+		files[frame.PC] = frame.File
+		for !strings.HasPrefix(frame.File, prefix) {
+			prefix = prefix[:len(prefix)-1]
+		}
+	}
+	prefix = prefix[:strings.LastIndexByte(prefix, '/')+1]
+	for idx, n := range funcPCs {
+		file := files[initCoverSymbols[idx].start]
+		if file == "" {
+			continue
+		}
+		subsystem := fileSubsystem(strings.TrimPrefix(file, prefix))
+		m.subsystems[idx] = subsystem
+		m.total[subsystem] += n
+	}
+	return m, nil
+}
+
+// fileSubsystem returns subsystem for a source file path relative to the kernel source dir.
+func fileSubsystem(file string) string {
+	dir := filepath.ToSlash(filepath.Dir(filepath.Clean(file)))
+	parts := strings.Split(dir, "/")
+	if len(parts) > subsystemDepth {
+		parts = parts[:subsystemDepth]
+	}
+	return strings.Join(parts, "/")
+}
+
+// attribute returns number of covered PCs per subsystem.
+func (m *subsystemMap) attribute(cov cover.Cover, arch string) map[string]int {
+	covered := make(map[string]int)
+	for pc := range cov {
+		fullPC := previousInstructionPC(arch, cover.RestorePC(pc, initCoverVMOffset))
+		covered[m.subsystemOf(fullPC)]++
+	}
+	return covered
+}
+
+func (m *subsystemMap) subsystemOf(pc uint64) string {
+	if idx := findSymbol(pc); idx != -1 && m.subsystems[idx] != "" {
+		return m.subsystems[idx]
+	}
+	for _, mod := range m.modules {
+		if pc >= mod.Addr && pc < mod.Addr+mod.Size {
+			return "module " + mod.Name
+		}
+	}
+	return "other"
+}
+
+func (mgr *Manager) subsystems() (*subsystemMap, error) {
+	mgr.mu.Lock()
+	if mgr.checkResult == nil {
+		mgr.mu.Unlock()
+		return nil, fmt.Errorf("machine is not checked yet")
+	}
+	modules := make([]host.KernelModule, 0, len(mgr.modules))
+	for mod := range mgr.modules {
+		modules = append(modules, mod)
+	}
+	mgr.mu.Unlock()
+	sort.Slice(modules, func(i, j int) bool {
+		return modules[i].Addr < modules[j].Addr
+	})
+	initSubsystemsOnce.Do(func() {
+		initSubsystemsMap, initSubsystemsError = initSubsystems(mgr.cfg.KernelObj, mgr.cfg.TargetVMArch)
+	})
+	if initSubsystemsError != nil {
+		return nil, initSubsystemsError
+	}
+	// Symbol attribution is computed once, modules change as VMs boot and load modules.
+	m := *initSubsystemsMap
+	m.modules = modules
+	return &m, nil
+}
+
+// addModules records modules reported by a fuzzer. Modules are never removed:
+// coverage collected while a module was loaded still belongs to it.
+// Must be called with mgr.mu held.
+func (mgr *Manager) addModules(modules []host.KernelModule) {
+	for _, mod := range modules {
+		mgr.modules[mod] = true
+	}
+}
+
+// subsystemSnapshot attributes current corpus coverage to subsystems.
+func (mgr *Manager) subsystemSnapshot() (*subsystemSnapshot, error) {
+	m, err := mgr.subsystems()
+	if err != nil {
+		return nil, err
+	}
+	mgr.mu.Lock()
+	cov := make(cover.Cover, len(mgr.corpusCover))
+	for pc := range mgr.corpusCover {
+		cov[pc] = struct{}{}
+	}
+	mgr.mu.Unlock()
+	return &subsystemSnapshot{
+		time:    time.Now(),
+		covered: m.attribute(cov, mgr.cfg.TargetVMArch),
+	}, nil
+}
+
+// subsystemLoop periodically records per-subsystem coverage
+// to show how it changes over time.
+func (mgr *Manager) subsystemLoop() {
+	const maxSnapshots = 48
+	for mgr.sleep(time.Hour) {
+		snapshot, err := mgr.subsystemSnapshot()
+		if err != nil {
+			log.Logf(1, "failed to attribute coverage to subsystems: %v", err)
+			continue
+		}
+		mgr.mu.Lock()
+		mgr.subsystemHistory = append(mgr.subsystemHistory, snapshot)
+		if len(mgr.subsystemHistory) > maxSnapshots {
+			mgr.subsystemHistory = mgr.subsystemHistory[1:]
+		}
+		mgr.mu.Unlock()
+	}
+}
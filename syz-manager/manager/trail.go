@@ -0,0 +1,69 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package manager
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/google/syzkaller/pkg/cover"
+	"github.com/google/syzkaller/pkg/log"
+	"github.com/google/syzkaller/pkg/rpctype"
+)
+
+// Coverage trails are root cause hints for crashes. If crash_trail is enabled, every proc
+// of the fuzzer keeps the last crash_trail coverage PCs of the programs it executed
+// and the fuzzer sends them to manager every few seconds. When a VM crashes, the last
+// received trail of the VM is saved in the crash dir (trailN file next to logN/reportN),
+// symbolized to function+offset if kernel_obj is specified.
+
+// Trail records the last coverage trail of the fuzzer.
+func (mgr *Manager) Trail(a *rpctype.TrailArgs, r *int) error {
+	mgr.mu.Lock()
+	defer mgr.mu.Unlock()
+
+	f := mgr.fuzzers[a.Name]
+	if f == nil {
+		return fmt.Errorf("fuzzer %v is not connected", a.Name)
+	}
+	f.trail = a.Procs
+	return nil
+}
+
+func (mgr *Manager) formatTrail(trail map[int][]uint32) []byte {
+	symbolize := false
+	if mgr.cfg.KernelObj != "" {
+		initCoverOnce.Do(func() { initCoverError = initCover(mgr.cfg.KernelObj, mgr.cfg.TargetArch) })
+		if initCoverError != nil {
+			log.Logf(0, "failed to symbolize coverage trail: %v", initCoverError)
+		} else {
+			symbolize = true
+		}
+	}
+	var procs []int
+	for proc := range trail {
+		procs = append(procs, proc)
+	}
+	sort.Ints(procs)
+	buf := new(bytes.Buffer)
+	for _, proc := range procs {
+		fmt.Fprintf(buf, "proc %v (oldest first):\n", proc)
+		for _, pc := range trail[proc] {
+			if !symbolize {
+				fmt.Fprintf(buf, "0x%x\n", pc)
+				continue
+			}
+			fullPC := previousInstructionPC(mgr.cfg.TargetArch, cover.RestorePC(pc, initCoverVMOffset))
+			if idx := findSymbol(fullPC); idx != -1 {
+				s := initCoverSymbols[idx]
+				fmt.Fprintf(buf, "0x%x %v+0x%x\n", fullPC, s.name, fullPC-s.start)
+			} else {
+				fmt.Fprintf(buf, "0x%x\n", fullPC)
+			}
+		}
+		buf.WriteByte('\n')
+	}
+	return buf.Bytes()
+}
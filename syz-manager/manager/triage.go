@@ -0,0 +1,228 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package manager
+
+import (
+	"fmt"
+	"html/template"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/google/syzkaller/pkg/cover"
+	"github.com/google/syzkaller/pkg/log"
+	"github.com/google/syzkaller/pkg/signal"
+)
+
+// The /triage page shows contribution of every enabled syscall to the corpus:
+// number of corpus inputs triaged for the call, number of corpus programs that contain the call,
+// coverage and signal of the call's inputs and coverage that only the call's inputs have.
+// Per-call signal is also recorded periodically to show signal growth over time,
+// so that it's easy to see which parts of the enabled syscall set are starved.
+
+type callSnapshot struct {
+	time   time.Time
+	signal int            // total corpus signal
+	calls  map[string]int // call name -> signal of the call's corpus inputs
+}
+
+type callContribution struct {
+	inputs   int // corpus inputs triaged for the call
+	programs int // corpus programs that contain the call
+	cover    int
+	unique   int // PCs covered only by inputs of this call
+	signal   int
+}
+
+func (mgr *Manager) callContributions() map[string]*callContribution {
+	mgr.mu.Lock()
+	calls := make(map[string]*callContribution)
+	if mgr.checkResult != nil {
+		for _, id := range mgr.checkResult.EnabledCalls {
+			calls[mgr.target.Syscalls[id].Name] = new(callContribution)
+		}
+	}
+	covers := make(map[string]cover.Cover)
+	signals := make(map[string]signal.Signal)
+	var progs [][]byte
+	for _, inp := range mgr.corpus {
+		if calls[inp.Call] == nil {
+			calls[inp.Call] = new(callContribution)
+		}
+		calls[inp.Call].inputs++
+		cov := covers[inp.Call]
+		cov.Merge(inp.Cover)
+		covers[inp.Call] = cov
+		sig := signals[inp.Call]
+		sig.Merge(inp.Signal.Deserialize())
+		signals[inp.Call] = sig
+		progs = append(progs, inp.Prog)
+	}
+	mgr.mu.Unlock()
+
+	// Parsing of the whole corpus is slow, so do it without holding the lock.
+	for _, data := range progs {
+		p, err := mgr.target.Deserialize(data)
+		if err != nil {
+			continue
+		}
+		seen := make(map[string]bool)
+		for _, c := range p.Calls {
+			if cc := calls[c.Meta.Name]; cc != nil && !seen[c.Meta.Name] {
+				seen[c.Meta.Name] = true
+				cc.programs++
+			}
+		}
+	}
+	pcCalls := make(map[uint32]int)
+	for _, cov := range covers {
+		for pc := range cov {
+			pcCalls[pc]++
+		}
+	}
+	for name, cov := range covers {
+		cc := calls[name]
+		cc.cover = len(cov)
+		for pc := range cov {
+			if pcCalls[pc] == 1 {
+				cc.unique++
+			}
+		}
+	}
+	for name, sig := range signals {
+		calls[name].signal = sig.Len()
+	}
+	return calls
+}
+
+// callSnapshotLoop periodically records per-call signal to show how it changes over time.
+func (mgr *Manager) callSnapshotLoop() {
+	const maxSnapshots = 48
+	for mgr.sleep(time.Hour) {
+		snapshot := &callSnapshot{
+			time:  time.Now(),
+			calls: make(map[string]int),
+		}
+		for name, cc := range mgr.callContributions() {
+			snapshot.calls[name] = cc.signal
+		}
+		mgr.mu.Lock()
+		snapshot.signal = mgr.corpusSignal.Len()
+		mgr.callHistory = append(mgr.callHistory, snapshot)
+		if len(mgr.callHistory) > maxSnapshots {
+			mgr.callHistory = mgr.callHistory[1:]
+		}
+		mgr.mu.Unlock()
+		log.Logf(1, "recorded per-call signal snapshot: %v calls", len(snapshot.calls))
+	}
+}
+
+func (mgr *Manager) httpTriage(w http.ResponseWriter, r *http.Request) {
+	calls := mgr.callContributions()
+	mgr.mu.Lock()
+	history := append([]*callSnapshot{}, mgr.callHistory...)
+	data := &UITriageData{
+		Name:   mgr.cfg.Name,
+		Signal: []int{mgr.corpusSignal.Len()},
+	}
+	mgr.mu.Unlock()
+	for _, snapshot := range history {
+		data.Times = append(data.Times, snapshot.time.Format("Jan 02 15:04"))
+		data.Signal = append(data.Signal, snapshot.signal)
+	}
+	for name, cc := range calls {
+		call := UITriageCall{
+			Name:     name,
+			Inputs:   cc.inputs,
+			Programs: cc.programs,
+			Cover:    cc.cover,
+			Unique:   cc.unique,
+			Signal:   cc.signal,
+			Starved:  cc.programs == 0 || cc.inputs == 0,
+		}
+		for _, snapshot := range history {
+			call.History = append(call.History, snapshot.calls[name])
+		}
+		data.Calls = append(data.Calls, call)
+	}
+	// Starved calls first.
+	sort.Slice(data.Calls, func(i, j int) bool {
+		c1, c2 := data.Calls[i], data.Calls[j]
+		if c1.Signal != c2.Signal {
+			return c1.Signal < c2.Signal
+		}
+		return c1.Name < c2.Name
+	})
+	if err := triageTemplate.Execute(w, data); err != nil {
+		http.Error(w, fmt.Sprintf("failed to execute template: %v", err),
+			http.StatusInternalServerError)
+		return
+	}
+}
+
+type UITriageData struct {
+	Name   string
+	Times  []string
+	Signal []int // total corpus signal now and at Times
+	Calls  []UITriageCall
+}
+
+type UITriageCall struct {
+	Name     string
+	Inputs   int
+	Programs int
+	Cover    int
+	Unique   int
+	Signal   int
+	Starved  bool
+	History  []int
+}
+
+var triageTemplate = template.Must(template.New("").Parse(addStyle(`
+<!doctype html>
+<html>
+<head>
+	<title>{{.Name }} syzkaller</title>
+	{{STYLE}}
+</head>
+<body>
+<table>
+	<caption>Per-call corpus contribution (starved calls first):</caption>
+	<tr>
+		<th>Call</th>
+		<th>Inputs</th>
+		<th>Programs</th>
+		<th>Cover</th>
+		<th>Unique cover</th>
+		<th>Signal</th>
+		{{range $t := $.Times}}
+			<th>{{$t}}</th>
+		{{end}}
+	</tr>
+	<tr>
+		<td><b>total</b></td>
+		<td></td>
+		<td></td>
+		<td></td>
+		<td></td>
+		{{range $s := $.Signal}}
+			<td>{{$s}}</td>
+		{{end}}
+	</tr>
+	{{range $c := $.Calls}}
+	<tr>
+		<td>{{if $c.Starved}}<b>{{$c.Name}}</b>{{else}}{{$c.Name}}{{end}}</td>
+		<td><a href='/corpus?call={{$c.Name}}'>{{$c.Inputs}}</a></td>
+		<td>{{$c.Programs}}</td>
+		<td><a href='/cover?call={{$c.Name}}'>{{$c.Cover}}</a></td>
+		<td>{{$c.Unique}}</td>
+		<td>{{$c.Signal}}</td>
+		{{range $h := $c.History}}
+			<td>{{$h}}</td>
+		{{end}}
+	</tr>
+	{{end}}
+</table>
+</body></html>
+`)))
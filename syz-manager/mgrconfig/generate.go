@@ -0,0 +1,187 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package mgrconfig
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/google/syzkaller/pkg/osutil"
+	"github.com/google/syzkaller/sys/targets"
+)
+
+// Config generation for new users (syz-manager -generate-config).
+// Each VM type has a set of parameters that the user needs to provide (or accept defaults for),
+// the rest of the config is filled with defaults that work for most setups.
+
+type GenerateParam struct {
+	Name        string // config param name
+	Description string
+	Default     string
+	Required    bool // the value can't be empty
+	VM          bool // the param is part of VM-type-specific config
+	kind        int
+}
+
+const (
+	paramString = iota
+	paramInt
+	paramList // comma-separated list
+	paramPath // file or dir path, converted to absolute
+)
+
+var commonGenerateParams = []GenerateParam{
+	{Name: "workdir", Description: "working directory for corpus and crashes", Default: "workdir", Required: true,
+		kind: paramPath},
+	{Name: "syzkaller", Description: "syzkaller checkout with built binaries", Default: ".", Required: true,
+		kind: paramPath},
+	{Name: "kernel_obj", Description: "kernel build directory with vmlinux (for symbolization and coverage)",
+		kind: paramPath},
+	{Name: "http", Description: "address to serve web UI on", Default: "127.0.0.1:56741", Required: true},
+	{Name: "procs", Description: "number of parallel test processes in each VM", Default: "8", kind: paramInt},
+	{Name: "sandbox", Description: "sandbox for test processes (none/setuid/namespace)", Default: "none"},
+}
+
+var vmGenerateParams = map[string][]GenerateParam{
+	"qemu": {
+		{Name: "image", Description: "disk image", kind: paramPath},
+		{Name: "sshkey", Description: "ssh key for the image", kind: paramPath},
+		{Name: "kernel", Description: "kernel for injected boot (e.g. arch/x86/boot/bzImage)", VM: true,
+			kind: paramPath},
+		{Name: "count", Description: "number of VMs", Default: "4", VM: true, kind: paramInt},
+		{Name: "cpu", Description: "number of CPUs in each VM", Default: "2", VM: true, kind: paramInt},
+		{Name: "mem", Description: "memory in each VM (MB)", Default: "2048", VM: true, kind: paramInt},
+	},
+	"gce": {
+		{Name: "image", Description: "disk image (disk.tar.gz) to upload to GCE", kind: paramPath},
+		{Name: "sshkey", Description: "ssh key for the image", kind: paramPath},
+		{Name: "count", Description: "number of VMs", Default: "4", VM: true, kind: paramInt},
+		{Name: "machine_type", Description: "GCE machine type", Default: "n1-highcpu-2", VM: true},
+		{Name: "gcs_path", Description: "GCS path to upload the image to", VM: true},
+	},
+	"adb": {
+		{Name: "devices", Description: "comma-separated adb device IDs", Required: true, VM: true, kind: paramList},
+	},
+	"isolated": {
+		{Name: "sshkey", Description: "ssh key for the target machines", kind: paramPath},
+		{Name: "targets", Description: "comma-separated target machines (host[:port])", Required: true,
+			VM: true, kind: paramList},
+		{Name: "target_dir", Description: "directory to use on the targets", Default: "/tmp/syzkaller", VM: true},
+	},
+	"gvisor": {
+		{Name: "image", Description: "runsc binary", Default: "/usr/local/bin/runsc", Required: true,
+			kind: paramPath},
+		{Name: "count", Description: "number of sandboxes", Default: "4", VM: true, kind: paramInt},
+	},
+	"kvm": {
+		{Name: "kernel", Description: "kernel image (e.g. arch/x86/boot/bzImage)", Required: true,
+			VM: true, kind: paramPath},
+		{Name: "count", Description: "number of VMs", Default: "4", VM: true, kind: paramInt},
+		{Name: "cpu", Description: "number of CPUs in each VM", Default: "2", VM: true, kind: paramInt},
+		{Name: "mem", Description: "memory in each VM (MB)", Default: "1024", VM: true, kind: paramInt},
+	},
+}
+
+// GenerateParams returns params that need to be specified to generate a config for target
+// (e.g. "linux/amd64/qemu", where the last component is VM type, see SplitGenerateTarget).
+func GenerateParams(target string) ([]GenerateParam, error) {
+	_, vmType, err := SplitGenerateTarget(target)
+	if err != nil {
+		return nil, err
+	}
+	return append(append([]GenerateParam{}, commonGenerateParams...), vmGenerateParams[vmType]...), nil
+}
+
+// SplitGenerateTarget splits "os/arch[/arch]/vmtype" into config target and VM type.
+func SplitGenerateTarget(target string) (string, string, error) {
+	pos := strings.LastIndexByte(target, '/')
+	if pos == -1 {
+		return "", "", fmt.Errorf("bad target %q, want os/arch/vmtype", target)
+	}
+	cfgTarget, vmType := target[:pos], target[pos+1:]
+	if vmGenerateParams[vmType] == nil {
+		var types []string
+		for typ := range vmGenerateParams {
+			types = append(types, typ)
+		}
+		sort.Strings(types)
+		return "", "", fmt.Errorf("unsupported VM type %q, supported: %v", vmType, strings.Join(types, ", "))
+	}
+	targetOS, vmArch, arch, err := splitTarget(cfgTarget)
+	if err != nil {
+		return "", "", err
+	}
+	if targets.Get(targetOS, vmArch) == nil || targets.Get(targetOS, arch) == nil {
+		return "", "", fmt.Errorf("unknown target %v", cfgTarget)
+	}
+	return cfgTarget, vmType, nil
+}
+
+// Generate generates a config for target given values of params returned by GenerateParams.
+// Missing values are replaced with defaults. The resulting config is not validated,
+// use LoadData for that.
+func Generate(target string, values map[string]string) ([]byte, error) {
+	params, err := GenerateParams(target)
+	if err != nil {
+		return nil, err
+	}
+	cfgTarget, vmType, _ := SplitGenerateTarget(target)
+	known := make(map[string]bool)
+	cfg := map[string]interface{}{
+		"target": cfgTarget,
+		"type":   vmType,
+	}
+	vmCfg := make(map[string]interface{})
+	for _, param := range params {
+		known[param.Name] = true
+		val, ok := values[param.Name]
+		if !ok {
+			val = param.Default
+		}
+		if val == "" {
+			if param.Required {
+				return nil, fmt.Errorf("param %v is required", param.Name)
+			}
+			continue
+		}
+		var v interface{} = val
+		switch param.kind {
+		case paramInt:
+			n, err := strconv.Atoi(val)
+			if err != nil {
+				return nil, fmt.Errorf("param %v must be an integer: %q", param.Name, val)
+			}
+			v = n
+		case paramList:
+			var list []string
+			for _, elem := range strings.Split(val, ",") {
+				if elem = strings.TrimSpace(elem); elem != "" {
+					list = append(list, elem)
+				}
+			}
+			v = list
+		case paramPath:
+			v = osutil.Abs(val)
+		}
+		if param.VM {
+			vmCfg[param.Name] = v
+		} else {
+			cfg[param.Name] = v
+		}
+	}
+	for name := range values {
+		if !known[name] {
+			return nil, fmt.Errorf("unknown param %v for %v", name, target)
+		}
+	}
+	cfg["vm"] = vmCfg
+	data, err := json.MarshalIndent(cfg, "", "\t")
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
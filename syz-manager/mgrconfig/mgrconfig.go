@@ -8,9 +8,13 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"regexp"
+	"sort"
 	"strings"
 
 	"github.com/google/syzkaller/pkg/config"
+	"github.com/google/syzkaller/pkg/creds"
+	"github.com/google/syzkaller/pkg/experiment"
 	"github.com/google/syzkaller/pkg/osutil"
 	"github.com/google/syzkaller/prog"
 	_ "github.com/google/syzkaller/sys" // most mgrconfig users want targets too
@@ -25,9 +29,17 @@ type Config struct {
 	// TCP address to serve HTTP stats page (e.g. "localhost:50000").
 	HTTP string `json:"http"`
 	// TCP address to serve RPC for fuzzer processes (optional).
-	RPC           string `json:"rpc"`
+	RPC string `json:"rpc"`
+	// Address family used for HTTP/RPC listeners: "ipv4", "ipv6", "dual" (dual-stack)
+	// or empty (HTTP on IPv4, RPC dual-stack).
+	IPFamily      string `json:"ip_family"`
 	Workdir       string `json:"workdir"`
 	VmlinuxUnused string `json:"vmlinux"` // vmlinux should go away eventually.
+	// File with a hex-encoded 256-bit key used to encrypt corpus and crash artifacts
+	// in workdir (optional). Alternatively, WorkdirKeyCommand prints the key to stdout
+	// (e.g. a KMS client that decrypts a wrapped key).
+	WorkdirKey        string `json:"workdir_key"`
+	WorkdirKeyCommand string `json:"workdir_key_command"`
 	// Directory with kernel object files.
 	// If not set, inferred as base dir of Vmlinux.
 	KernelObj string `json:"kernel_obj"`
@@ -35,16 +47,34 @@ type Config struct {
 	KernelSrc string `json:"kernel_src"`
 	// Arbitrary optional tag that is saved along with crash reports (e.g. branch/commit).
 	Tag string `json:"tag"`
+	// LSM profile the kernel and image are built with (e.g. "selinux-enforcing", see build.CheckLSM),
+	// saved along with crash reports in their fingerprints (optional).
+	LSM string `json:"lsm"`
+	// Experiment arm name for A/B experiments (optional). If set, manager periodically
+	// checkpoints long-term stats into workdir/experiment.stats, see tools/syz-experiment.
+	Experiment string `json:"experiment"`
 	// Linux image for VMs.
 	Image string `json:"image"`
 	// SSH key for the image (may be empty for some VM types).
 	SSHKey string `json:"sshkey"`
 	// SSH user ("root" by default).
 	SSHUser string `json:"ssh_user"`
+	// Reuse a single ssh connection for all sessions to a VM (ssh ControlMaster),
+	// reduces connection setup overhead (default: false).
+	SSHMultiplex bool `json:"ssh_multiplex"`
+	// Optional jump host ([user@]host[:port]) used to reach remote VMs (ssh ProxyJump),
+	// for deployments where VMs are accessible only through a gateway.
+	SSHJumpHost string `json:"ssh_jump_host"`
 
 	HubClient string `json:"hub_client"`
 	HubAddr   string `json:"hub_addr"`
 	HubKey    string `json:"hub_key"`
+	// Filters for programs exchanged with syz-hub (optional), see HubFilter.
+	HubFilter HubFilter `json:"hub_filter"`
+	// Managers that fuzz the same kernel and have the same coordination group get syscall weights
+	// from syz-hub that bias them towards syscalls where the other managers of the group
+	// have little coverage, reducing duplicated effort across the fleet (optional).
+	HubCoordinationGroup string `json:"hub_coordination_group"`
 
 	// syz-manager will send crash emails to this list of emails using mailx (optional).
 	EmailAddrs []string `json:"email_addrs"`
@@ -52,6 +82,15 @@ type Config struct {
 	DashboardClient string `json:"dashboard_client"`
 	DashboardAddr   string `json:"dashboard_addr"`
 	DashboardKey    string `json:"dashboard_key"`
+	// Large files uploaded to dashboard with every crash (optional), any of:
+	//  - "console_log": full console output (crash logs in reports are truncated)
+	//  - "vmlinux": kernel_obj/vmlinux
+	//  - "disk_image": image
+	// Contents are deduplicated, so build files are uploaded once per kernel build.
+	DashboardAssets []string `json:"dashboard_assets"`
+	// Crashes found by this manager are under responsible disclosure (optional):
+	// new bugs are embargoed on dashboard and reproducers are not sent to hub.
+	Embargo bool `json:"embargo"`
 
 	// Path to syzkaller checkout (syz-manager will look for binaries in bin subdir).
 	Syzkaller string `json:"syzkaller"`
@@ -65,20 +104,84 @@ type Config struct {
 	//	requires building kernel with CONFIG_NAMESPACES, CONFIG_UTS_NS, CONFIG_USER_NS,
 	//	CONFIG_PID_NS and CONFIG_NET_NS.
 	Sandbox string `json:"sandbox"`
+	// Credential contexts to execute fuzzing programs in (optional, linux with sandbox "none" only),
+	// see pkg/creds for the format, e.g. ["root", "uid=65534", "uid=65534/caps=net_raw", "nnp"].
+	// The fuzzer chooses a random context for every generated/mutated program and records it
+	// in the execution log, so reproducers show the access level required to trigger a bug.
+	Creds []string `json:"creds"`
 
 	// Use KCOV coverage (default: true).
 	Cover bool `json:"cover"`
 	// Reproduce, localize and minimize crashers (default: true).
 	Reproduce bool `json:"reproduce"`
+	// Max number of VMs simultaneously used for crash reproduction,
+	// the rest are always used for fuzzing (default: 0, no limit).
+	ReproVMs int `json:"repro_vms"`
+	// Max time in minutes spent on reproduction of a single crash (default: 0, no limit).
+	// When the budget is exhausted, the reproduction fails and its VMs return to fuzzing,
+	// unless a reproducer is already found (then it's used without further minimization).
+	ReproTimeBudget int `json:"repro_time_budget"`
+	// Ranking of crashes for reproduction (optional), see ReproPriority.
+	ReproPriorities []ReproPriority `json:"repro_priorities"`
+	// Control of guest randomization in VMs used for crash reproduction,
+	// see ReproGuest for details.
+	ReproGuest ReproGuest `json:"repro_guest"`
+	// Scrubbing of host-identifying strings from reproducers, see ReproScrub.
+	ReproScrub ReproScrub `json:"repro_scrub"`
+	// Number of VMs that are not used by manager and are held
+	// for manual debugging (default: 0).
+	HoldVMs int `json:"hold_vms"`
 
 	EnabledSyscalls  []string `json:"enable_syscalls"`
 	DisabledSyscalls []string `json:"disable_syscalls"`
+	// Limits on the share of generated calls for groups of syscalls (optional), see SyscallBudget.
+	SyscallBudgets []SyscallBudget `json:"syscall_budgets"`
+	// Program mutation strategies used by fuzzer (optional, default: all strategies
+	// compiled into syz-fuzzer), see pkg/mutator.
+	Mutators []string `json:"mutators"`
+	// Experimental fuzzer behaviors enabled on a percentage of VMs (optional),
+	// the web UI compares stats of VMs with and without every flag.
+	FeatureFlags []FeatureFlag `json:"feature_flags"`
+	// Period of corpus re-minimization in hours (default: 0, only on demand via /corpus/minimize):
+	// inputs with signal subsumed by other inputs are dropped and the rest are re-minimized by fuzzers.
+	CorpusMinimizePeriod int `json:"corpus_minimize_period"`
+	// Corpus programs that contain any of these syscalls (same syntax as in enable_syscalls)
+	// are pinned: they are never dropped from corpus by minimization (optional).
+	PinnedCalls []string `json:"pinned_calls"`
+	// Syscall traces of real workloads (strace or perf script output, glob patterns are allowed)
+	// that are converted into programs and added to corpus triage on start (optional).
+	SeedTraces []string `json:"seed_traces"`
+	// Corpus databases of other managers (glob patterns are allowed) that are added to corpus triage
+	// on start (optional). Calls that are not enabled on this manager are rewritten or dropped.
+	CorpusImport []string `json:"corpus_import"`
 	// Don't save reports matching these regexps, but reboot VM after them,
 	// matched against whole report output.
 	Suppressions []string `json:"suppressions"`
 	// Completely ignore reports matching these regexps (don't save nor reboot),
 	// must match the first line of crash message.
 	Ignores []string `json:"ignores"`
+	// Storage policy for logs and reports of duplicate crashes.
+	CrashStorage CrashStorage `json:"crash_storage"`
+	// Number of last coverage PCs of every fuzzer process to save along with crashes
+	// as a root cause hint (default: 0, disabled). Requires cover, makes the fuzzer
+	// collect coverage for all executed programs.
+	CrashTrail int `json:"crash_trail"`
+	// Report OOM-killer invocations, page allocation failures and memcg OOM kills
+	// as crashes titled with the allocation stack (default: false, such events are ignored).
+	ReportOOM bool `json:"report_oom"`
+	// Policy for kernel WARNING reports:
+	//  - "crash": treat them as any other crash (default)
+	//  - "norepro": save them, but don't try to reproduce
+	//  - "ignore": ignore them completely
+	// VM types that control kernel command line (qemu) set panic_on_warn according to the policy.
+	Warnings string `json:"warnings"`
+	// Ignore WARNINGs issued at these sites (regexps matched against "file:line function"
+	// part of the WARNING line, e.g. "net/core/dev.c:[0-9]+ skb_warn_bad_offload").
+	IgnoreWarnings []string `json:"ignore_warnings"`
+
+	// Provisioning steps executed in every VM after boot before fuzzing starts
+	// (e.g. load modules, create device nodes, bring up interfaces, mount debugfs).
+	Provision []ProvisionStep `json:"provision"`
 
 	// VM type (qemu, gce, android, isolated, etc).
 	Type string `json:"type"`
@@ -94,6 +197,111 @@ type Config struct {
 	SyzFuzzerBin   string `json:"-"`
 	SyzExecprogBin string `json:"-"`
 	SyzExecutorBin string `json:"-"`
+	SyzAgentBin    string `json:"-"` // optional, used by VM types that support the guest agent
+}
+
+// maxCrashTrail bounds crash_trail, the trail is sent by every fuzzer every few seconds.
+const maxCrashTrail = 10000
+
+// CrashStorage limits disk space consumed by logs and reports of duplicate crashes.
+// For each crash type artifacts of the first KeepFirst crashes are stored,
+// then 1 in SampleRate crashes is stored in a ring of KeepSampled entries.
+// Artifacts of the most recent crash are always stored,
+// and total number of crashes of each type is counted.
+// If no policy is configured (all fields are 0), artifacts of the 100 most recent crashes are stored.
+type CrashStorage struct {
+	KeepFirst   int `json:"keep_first"`
+	SampleRate  int `json:"sample_rate"` // 0 means no sampling
+	KeepSampled int `json:"keep_sampled"`
+}
+
+// SyscallBudget keeps a group of syscalls (e.g. a less common protocol family) enabled,
+// but bounds the share of calls from the group chosen by the generator,
+// so that the group does not consume a disproportionate share of executions.
+type SyscallBudget struct {
+	// Syscalls in the group (same syntax as in enable_syscalls).
+	Syscalls []string `json:"syscalls"`
+	// Max share of the group in (0, 1], e.g. 0.05.
+	Share float64 `json:"share"`
+}
+
+// FeatureFlag enables an experimental fuzzer behavior on a percentage of VMs,
+// see pkg/experiment for the list of flags.
+type FeatureFlag struct {
+	// Flag name, e.g. "comparisons" or "mutator:splice".
+	Name string `json:"name"`
+	// Percentage of VMs the flag is enabled on in [1, 99], the rest of VMs is the control group.
+	Percent int `json:"percent"`
+}
+
+// HubFilter restricts programs exchanged with syz-hub, so that specialized deployments
+// (e.g. fuzzing only a particular subsystem) exchange only relevant programs.
+// Empty filters don't restrict anything.
+type HubFilter struct {
+	// Push only programs that contain at least one of these syscalls
+	// (same syntax as in enable_syscalls).
+	PushCalls []string `json:"push_calls"`
+	// Push only programs that cover at least one of these kernel subsystems
+	// (source directories as shown on the /subsystems page, e.g. "net" or "fs/ext4").
+	// Requires kernel_obj with vmlinux.
+	PushSubsystems []string `json:"push_subsystems"`
+	// Push only programs that have at least this much signal.
+	PushMinSignal int `json:"push_min_signal"`
+	// Pull only programs that contain at least one of these syscalls
+	// (same syntax as in enable_syscalls). The filter is applied by the hub.
+	PullCalls []string `json:"pull_calls"`
+}
+
+// ReproPriority ranks crashes with matching titles for reproduction. Crashes are taken
+// from the repro queue in order of priority (crashes that don't match any entry have priority 0),
+// so that e.g. use-after-free reports are not stuck behind hours of WARNING reproductions.
+// The first matching entry is used.
+type ReproPriority struct {
+	// Regexp matched against crash titles (e.g. "^KASAN: use-after-free" or "^WARNING").
+	Title string `json:"title"`
+	// Crashes with higher priority are reproduced first (can be negative).
+	Priority int `json:"priority"`
+	// Number of VMs used to reproduce a matching crash (default: 4, bounded by repro_vms).
+	VMs int `json:"vms"`
+}
+
+// ReproGuest controls guest randomization during crash reproduction.
+// Address-layout-dependent bugs may fail to reproduce with a different layout,
+// so reproduction can disable randomization or try both layouts.
+// Only VM types that control kernel command line (qemu with kernel) support this,
+// for other VM types the settings are ignored.
+type ReproGuest struct {
+	// KASLR mode:
+	//  - "": leave kernel command line as is (default)
+	//  - "on": boot with kaslr
+	//  - "off": boot with nokaslr
+	//  - "alternate": alternate between kaslr and nokaslr on every boot
+	KASLR string `json:"kaslr"`
+	// Disable randomization of user-space address space (norandmaps).
+	NoRandMaps bool `json:"norandmaps"`
+	// Additional kernel command line for reproduction VMs,
+	// e.g. parameters that fix random seeds in kernels that support them.
+	Cmdline string `json:"cmdline"`
+}
+
+// ReproScrub controls removal of host-identifying strings (host name, host IP addresses,
+// paths from this config and the additional patterns) from reproducers, crash logs and reports
+// before they are sent to dashboard, hub or email, so that crashes found by private deployments
+// can be disclosed publicly. The scrubbed program is re-tested and if it does not reproduce
+// the crash anymore, the reproducer is saved only locally.
+type ReproScrub struct {
+	Enabled bool `json:"enabled"`
+	// Additional regexps of strings to remove (e.g. internal domain names).
+	Patterns []string `json:"patterns"`
+}
+
+// ProvisionStep is a single guest provisioning step, either a shell command
+// or a host script that is copied into the VM and executed with sh.
+type ProvisionStep struct {
+	Command string `json:"command"`
+	Script  string `json:"script"`
+	// Failures of optional steps are logged, but don't fail the VM.
+	Optional bool `json:"optional"`
 }
 
 func LoadData(data []byte) (*Config, error) {
@@ -139,6 +347,7 @@ func defaultValues() *Config {
 		SSHUser:   "root",
 		Cover:     true,
 		Reproduce: true,
+		Warnings:  "crash",
 		Sandbox:   "none",
 		RPC:       ":0",
 		Procs:     1,
@@ -154,6 +363,12 @@ func loadPartial(cfg *Config) (*Config, error) {
 	return cfg, nil
 }
 
+// PanicOnWarn says if the kernel should panic on WARNINGs.
+// If some WARNINGs need to be ignored, the kernel must survive them.
+func (cfg *Config) PanicOnWarn() bool {
+	return cfg.Warnings != "ignore" && len(cfg.IgnoreWarnings) == 0
+}
+
 func Complete(cfg *Config) error {
 	if cfg.TargetOS == "" || cfg.TargetVMArch == "" || cfg.TargetArch == "" {
 		return fmt.Errorf("target parameters are not filled in")
@@ -165,6 +380,15 @@ func Complete(cfg *Config) error {
 		return fmt.Errorf("config param workdir is empty")
 	}
 	cfg.Workdir = osutil.Abs(cfg.Workdir)
+	if cfg.WorkdirKey != "" {
+		if cfg.WorkdirKeyCommand != "" {
+			return fmt.Errorf("config params workdir_key and workdir_key_command are mutually exclusive")
+		}
+		cfg.WorkdirKey = osutil.Abs(cfg.WorkdirKey)
+		if !osutil.IsExist(cfg.WorkdirKey) {
+			return fmt.Errorf("config param workdir_key file %v does not exist", cfg.WorkdirKey)
+		}
+	}
 	if cfg.Syzkaller == "" {
 		return fmt.Errorf("config param syzkaller is empty")
 	}
@@ -176,6 +400,7 @@ func Complete(cfg *Config) error {
 	cfg.SyzFuzzerBin = targetBin("syz-fuzzer", cfg.TargetVMArch)
 	cfg.SyzExecprogBin = targetBin("syz-execprog", cfg.TargetVMArch)
 	cfg.SyzExecutorBin = targetBin("syz-executor", cfg.TargetArch)
+	cfg.SyzAgentBin = targetBin("syz-agent", cfg.TargetVMArch)
 	if !osutil.IsExist(cfg.SyzFuzzerBin) {
 		return fmt.Errorf("bad config syzkaller param: can't find %v", cfg.SyzFuzzerBin)
 	}
@@ -199,6 +424,101 @@ func Complete(cfg *Config) error {
 	default:
 		return fmt.Errorf("config param sandbox must contain one of none/setuid/namespace")
 	}
+	if len(cfg.Creds) != 0 {
+		if cfg.TargetOS != "linux" || cfg.Sandbox != "none" {
+			return fmt.Errorf("config param creds requires linux and sandbox none")
+		}
+		for _, c := range cfg.Creds {
+			if _, err := creds.Parse(c); err != nil {
+				return fmt.Errorf("bad config param creds: %v", err)
+			}
+		}
+	}
+	switch cfg.IPFamily {
+	case "", "ipv4", "ipv6", "dual":
+	default:
+		return fmt.Errorf("config param ip_family must contain one of ipv4/ipv6/dual or be empty")
+	}
+	if cfg.ReproVMs < 0 || cfg.HoldVMs < 0 {
+		return fmt.Errorf("config params repro_vms and hold_vms must not be negative")
+	}
+	if cfg.ReproTimeBudget < 0 {
+		return fmt.Errorf("config param repro_time_budget must not be negative")
+	}
+	for i, prio := range cfg.ReproPriorities {
+		if _, err := regexp.Compile(prio.Title); err != nil {
+			return fmt.Errorf("config param repro_priorities #%v: bad title regexp: %v", i, err)
+		}
+		if prio.VMs < 0 {
+			return fmt.Errorf("config param repro_priorities #%v: vms must not be negative", i)
+		}
+	}
+	for i, pattern := range cfg.ReproScrub.Patterns {
+		if _, err := regexp.Compile(pattern); err != nil {
+			return fmt.Errorf("config param repro_scrub.patterns #%v: bad regexp: %v", i, err)
+		}
+	}
+	switch cfg.ReproGuest.KASLR {
+	case "", "on", "off", "alternate":
+	default:
+		return fmt.Errorf("config param repro_guest.kaslr must contain one of on/off/alternate or be empty")
+	}
+	switch cfg.Warnings {
+	case "crash", "norepro", "ignore":
+	default:
+		return fmt.Errorf("config param warnings must contain one of crash/norepro/ignore")
+	}
+	if cfg.CrashStorage.KeepFirst < 0 || cfg.CrashStorage.SampleRate < 0 || cfg.CrashStorage.KeepSampled < 0 {
+		return fmt.Errorf("config param crash_storage must not contain negative values")
+	}
+	if cfg.CrashStorage.SampleRate != 0 && cfg.CrashStorage.KeepSampled == 0 {
+		return fmt.Errorf("config param crash_storage: sample_rate is set, but keep_sampled is 0")
+	}
+	if cfg.CorpusMinimizePeriod < 0 {
+		return fmt.Errorf("config param corpus_minimize_period must not be negative")
+	}
+	for _, pattern := range cfg.SeedTraces {
+		if _, err := filepath.Match(pattern, ""); err != nil {
+			return fmt.Errorf("config param seed_traces: bad pattern %q: %v", pattern, err)
+		}
+	}
+	flags := make(map[string]bool)
+	for _, flag := range cfg.FeatureFlags {
+		if err := experiment.CheckFlag(flag.Name); err != nil {
+			return fmt.Errorf("config param feature_flags: %v", err)
+		}
+		if flags[flag.Name] {
+			return fmt.Errorf("config param feature_flags: duplicate flag %v", flag.Name)
+		}
+		flags[flag.Name] = true
+		if flag.Percent < 1 || flag.Percent > 99 {
+			return fmt.Errorf("config param feature_flags: bad percent %v for %v, want [1, 99]",
+				flag.Percent, flag.Name)
+		}
+	}
+	for _, pattern := range cfg.CorpusImport {
+		if _, err := filepath.Match(pattern, ""); err != nil {
+			return fmt.Errorf("config param corpus_import: bad pattern %q: %v", pattern, err)
+		}
+	}
+	if cfg.CrashTrail < 0 || cfg.CrashTrail > maxCrashTrail {
+		return fmt.Errorf("bad config param crash_trail: %v, want [0, %v]", cfg.CrashTrail, maxCrashTrail)
+	}
+	if cfg.CrashTrail != 0 && !cfg.Cover {
+		return fmt.Errorf("config param crash_trail requires cover")
+	}
+	for i := range cfg.Provision {
+		step := &cfg.Provision[i]
+		if (step.Command == "") == (step.Script == "") {
+			return fmt.Errorf("provision step #%v must contain exactly one of command/script", i)
+		}
+		if step.Script != "" {
+			step.Script = osutil.Abs(step.Script)
+			if !osutil.IsExist(step.Script) {
+				return fmt.Errorf("provision script %v does not exist", step.Script)
+			}
+		}
+	}
 	if cfg.SSHKey != "" {
 		info, err := os.Stat(cfg.SSHKey)
 		if err != nil {
@@ -223,11 +543,33 @@ func Complete(cfg *Config) error {
 	if cfg.HubClient != "" && (cfg.Name == "" || cfg.HubAddr == "" || cfg.HubKey == "") {
 		return fmt.Errorf("hub_client is set, but name/hub_addr/hub_key is empty")
 	}
+	for i, budget := range cfg.SyscallBudgets {
+		if len(budget.Syscalls) == 0 {
+			return fmt.Errorf("config param syscall_budgets #%v has no syscalls", i)
+		}
+		if budget.Share <= 0 || budget.Share > 1 {
+			return fmt.Errorf("config param syscall_budgets #%v: bad share %v, want (0, 1]", i, budget.Share)
+		}
+	}
+	if cfg.HubCoordinationGroup != "" && cfg.HubClient == "" {
+		return fmt.Errorf("hub_coordination_group is set, but hub_client is empty")
+	}
+	if cfg.HubFilter.PushMinSignal < 0 {
+		return fmt.Errorf("config param hub_filter.push_min_signal must not be negative")
+	}
 	if cfg.DashboardClient != "" && (cfg.Name == "" ||
 		cfg.DashboardAddr == "" ||
 		cfg.DashboardKey == "") {
 		return fmt.Errorf("dashboard_client is set, but name/dashboard_addr/dashboard_key is empty")
 	}
+	for _, asset := range cfg.DashboardAssets {
+		switch asset {
+		case "console_log", "vmlinux", "disk_image":
+		default:
+			return fmt.Errorf("config param dashboard_assets: unknown asset %q, want console_log/vmlinux/disk_image",
+				asset)
+		}
+	}
 
 	return nil
 }
@@ -287,6 +629,24 @@ func ParseEnabledSyscalls(target *prog.Target, enabled, disabled []string) (map[
 	return syscalls, nil
 }
 
+// ParseSyscallBudgets resolves syscall patterns of budgets into syscall IDs.
+func ParseSyscallBudgets(target *prog.Target, budgets []SyscallBudget) ([]prog.CallBudget, error) {
+	var res []prog.CallBudget
+	for _, budget := range budgets {
+		syscalls, err := ParseEnabledSyscalls(target, budget.Syscalls, nil)
+		if err != nil {
+			return nil, err
+		}
+		cb := prog.CallBudget{Share: budget.Share}
+		for id := range syscalls {
+			cb.Calls = append(cb.Calls, id)
+		}
+		sort.Ints(cb.Calls)
+		res = append(res, cb)
+	}
+	return res, nil
+}
+
 func matchSyscall(name, pattern string) bool {
 	if pattern == name || strings.HasPrefix(name, pattern+"$") {
 		return true
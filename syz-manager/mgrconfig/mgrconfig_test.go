@@ -5,10 +5,16 @@ package mgrconfig
 
 import (
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/google/syzkaller/pkg/config"
+	"github.com/google/syzkaller/prog"
+	"github.com/google/syzkaller/vm/adb"
 	"github.com/google/syzkaller/vm/gce"
+	"github.com/google/syzkaller/vm/gvisor"
+	"github.com/google/syzkaller/vm/isolated"
+	"github.com/google/syzkaller/vm/kvm"
 	"github.com/google/syzkaller/vm/qemu"
 )
 
@@ -62,3 +68,84 @@ func TestMatchSyscall(t *testing.T) {
 		}
 	}
 }
+
+func TestParseSyscallBudgets(t *testing.T) {
+	target, err := prog.GetTarget("linux", "amd64")
+	if err != nil {
+		t.Fatal(err)
+	}
+	budgets, err := ParseSyscallBudgets(target, []SyscallBudget{
+		{Syscalls: []string{"socket$inet_sctp", "socket$inet6_sctp"}, Share: 0.1},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(budgets) != 1 || len(budgets[0].Calls) != 2 || budgets[0].Share != 0.1 {
+		t.Fatalf("bad budgets: %+v", budgets)
+	}
+	for _, id := range budgets[0].Calls {
+		if name := target.Syscalls[id].Name; !strings.HasSuffix(name, "_sctp") {
+			t.Fatalf("unexpected call %v in budget", name)
+		}
+	}
+	if _, err := ParseSyscallBudgets(target, []SyscallBudget{
+		{Syscalls: []string{"foobar"}, Share: 0.1},
+	}); err == nil {
+		t.Fatalf("unknown syscall in budget did not fail")
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	vmConfigs := map[string]interface{}{
+		"qemu":     new(qemu.Config),
+		"gce":      new(gce.Config),
+		"adb":      new(adb.Config),
+		"isolated": new(isolated.Config),
+		"gvisor":   new(gvisor.Config),
+		"kvm":      new(kvm.Config),
+	}
+	if len(vmConfigs) != len(vmGenerateParams) {
+		t.Fatalf("not all VM types are tested")
+	}
+	for vmType, vmCfg := range vmConfigs {
+		target := "linux/amd64/" + vmType
+		params, err := GenerateParams(target)
+		if err != nil {
+			t.Fatal(err)
+		}
+		values := make(map[string]string)
+		for _, param := range params {
+			if param.Required && param.Default == "" {
+				values[param.Name] = "foo,bar"
+			}
+		}
+		data, err := Generate(target, values)
+		if err != nil {
+			t.Fatalf("%v: %v", vmType, err)
+		}
+		cfg := new(Config)
+		if err := config.LoadData(data, cfg); err != nil {
+			t.Fatalf("%v: failed to load config: %v\n%s", vmType, err, data)
+		}
+		if cfg.Target != "linux/amd64" || cfg.Type != vmType || cfg.Workdir == "" {
+			t.Fatalf("%v: bad config:\n%s", vmType, data)
+		}
+		if err := config.LoadData(cfg.VM, vmCfg); err != nil {
+			t.Fatalf("%v: failed to load VM config: %v\n%s", vmType, err, data)
+		}
+	}
+	for _, target := range []string{"linux/amd64", "linux/foo/qemu", "linux/amd64/foo"} {
+		if _, err := Generate(target, nil); err == nil {
+			t.Errorf("generation for %v did not fail", target)
+		}
+	}
+	if _, err := Generate("linux/amd64/qemu", map[string]string{"foo": "bar"}); err == nil {
+		t.Errorf("generation with unknown param did not fail")
+	}
+	if _, err := Generate("linux/amd64/qemu", map[string]string{"count": "many"}); err == nil {
+		t.Errorf("generation with bad int param did not fail")
+	}
+	if _, err := Generate("linux/amd64/adb", nil); err == nil {
+		t.Errorf("generation without required param did not fail")
+	}
+}
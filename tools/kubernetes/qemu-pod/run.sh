@@ -0,0 +1,21 @@
+#!/bin/sh
+# Copyright 2018 syzkaller project authors. All rights reserved.
+# Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+# Entrypoint of the kubernetes VM type pod image: boots the VM, forwards VM ssh to pod port 10022
+# and writes VM console to stdout. See vm/kubernetes for the contract.
+
+set -eu
+
+ACCEL=""
+if [ -e /dev/kvm ]; then
+	ACCEL="-enable-kvm -cpu host"
+fi
+
+exec qemu-system-x86_64 $ACCEL \
+	-smp "${SYZ_CPU:-2}" -m "${SYZ_MEM:-2048}" \
+	-display none -serial stdio -no-reboot \
+	-device e1000,netdev=net0 -netdev user,id=net0,hostfwd=tcp::10022-:22 \
+	-snapshot -hda /wheezy.img \
+	-kernel /bzImage \
+	-append "root=/dev/sda console=ttyS0 earlyprintk=serial oops=panic panic_on_warn=1 panic=86400"
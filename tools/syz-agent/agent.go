@@ -0,0 +1,86 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+// syz-agent is the guest agent that executes commands on behalf of the host (see pkg/agent).
+// It serves either a virtio-serial port with the given name:
+//   $ syz-agent -port=syz-agent
+// or TCP connections:
+//   $ syz-agent -listen=:33333 -token=secret
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/syzkaller/pkg/agent"
+	"github.com/google/syzkaller/pkg/log"
+)
+
+var (
+	flagPort   = flag.String("port", "", "name of virtio-serial port to serve")
+	flagListen = flag.String("listen", "", "TCP address to serve")
+	flagToken  = flag.String("token", "", "token that clients must present")
+)
+
+func main() {
+	flag.Parse()
+	switch {
+	case *flagPort != "":
+		dev, err := findPort(*flagPort)
+		if err != nil {
+			log.Fatalf("%v", err)
+		}
+		for {
+			// The port returns EOF while the host is not connected, so reopen it.
+			f, err := os.OpenFile(dev, os.O_RDWR, 0)
+			if err != nil {
+				log.Fatalf("failed to open %v: %v", dev, err)
+			}
+			err = agent.Serve(f, *flagToken)
+			log.Logf(0, "connection closed: %v", err)
+			f.Close()
+			time.Sleep(time.Second)
+		}
+	case *flagListen != "":
+		ln, err := net.Listen("tcp", *flagListen)
+		if err != nil {
+			log.Fatalf("failed to listen on %v: %v", *flagListen, err)
+		}
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				log.Fatalf("failed to accept: %v", err)
+			}
+			go func() {
+				err := agent.Serve(conn, *flagToken)
+				log.Logf(0, "connection from %v closed: %v", conn.RemoteAddr(), err)
+				conn.Close()
+			}()
+		}
+	default:
+		flag.PrintDefaults()
+		os.Exit(1)
+	}
+}
+
+// findPort returns device of the virtio-serial port with the name
+// (/dev/virtio-ports/name symlinks are created only by udev).
+func findPort(name string) (string, error) {
+	files, err := filepath.Glob("/sys/class/virtio-ports/*/name")
+	if err != nil {
+		return "", err
+	}
+	for _, file := range files {
+		data, err := ioutil.ReadFile(file)
+		if err == nil && strings.TrimSpace(string(data)) == name {
+			return filepath.Join("/dev", filepath.Base(filepath.Dir(file))), nil
+		}
+	}
+	return "", fmt.Errorf("virtio-serial port %v is not found", name)
+}
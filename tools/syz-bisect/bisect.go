@@ -14,6 +14,7 @@ import (
 
 	"github.com/google/syzkaller/pkg/bisect"
 	"github.com/google/syzkaller/pkg/config"
+	"github.com/google/syzkaller/pkg/osutil"
 	"github.com/google/syzkaller/syz-manager/mgrconfig"
 )
 
@@ -21,6 +22,12 @@ var (
 	flagConfig = flag.String("config", "", "bisect config file")
 	flagCrash  = flag.String("crash", "", "dir with crash info")
 	flagFix    = flag.Bool("fix", false, "search for crash fix")
+	// In backport mode crash dirs are passed as arguments and need to contain fix.title file
+	// with title of the fixing commit (e.g. on mainline).
+	flagBackport = flag.Bool("backport", false, "check if crash fixes need to be backported to backport_branches"+
+		" (crash dirs are passed as arguments, each needs fix.title file with the fixing commit title)")
+	// In config minimization mode the minimized config is saved into kernel.config.minimized in the crash dir.
+	flagMinimizeConfig = flag.Bool("minimize_config", false, "minimize kernel config starting from baseline_config")
 )
 
 type Config struct {
@@ -29,10 +36,22 @@ type Config struct {
 	KernelBranch  string          `json:"kernel_branch"`
 	Compiler      string          `json:"compiler"`
 	Userspace     string          `json:"userspace"`
+	ImageBackend  string          `json:"image_backend"`
+	RootStorage   string          `json:"root_storage"`
+	LSM           string          `json:"lsm"`
 	Sysctl        string          `json:"sysctl"`
 	Cmdline       string          `json:"cmdline"`
 	SyzkallerRepo string          `json:"syzkaller_repo"`
 	Manager       json.RawMessage `json:"manager"`
+	// Branches checked in backport mode.
+	BackportBranches []BackportBranch `json:"backport_branches"`
+	// Small kernel config used as the starting point in config minimization mode.
+	BaselineConfig string `json:"baseline_config"`
+}
+
+type BackportBranch struct {
+	Repo   string `json:"repo"`
+	Branch string `json:"branch"`
 }
 
 func main() {
@@ -62,27 +81,114 @@ func main() {
 		BinDir:   mycfg.BinDir,
 		DebugDir: *flagCrash,
 		Kernel: bisect.KernelConfig{
-			Repo:      mycfg.KernelRepo,
-			Branch:    mycfg.KernelBranch,
-			Userspace: mycfg.Userspace,
-			Sysctl:    mycfg.Sysctl,
-			Cmdline:   mycfg.Cmdline,
+			Repo:         mycfg.KernelRepo,
+			Branch:       mycfg.KernelBranch,
+			Userspace:    mycfg.Userspace,
+			ImageBackend: mycfg.ImageBackend,
+			RootStorage:  mycfg.RootStorage,
+			LSM:          mycfg.LSM,
+			Sysctl:       mycfg.Sysctl,
+			Cmdline:      mycfg.Cmdline,
 		},
 		Syzkaller: bisect.SyzkallerConfig{
 			Repo: mycfg.SyzkallerRepo,
 		},
 		Manager: *mgrcfg,
 	}
-	loadString("syzkaller.commit", &cfg.Syzkaller.Commit)
-	loadString("kernel.commit", &cfg.Kernel.Commit)
-	loadFile("kernel.config", &cfg.Kernel.Config)
-	loadFile("repro.syz", &cfg.Repro.Syz)
-	loadFile("repro.opts", &cfg.Repro.Opts)
+	if *flagBackport {
+		backport(cfg, mycfg.BackportBranches, flag.Args())
+		return
+	}
+	loadCrash(cfg, *flagCrash)
+	if *flagMinimizeConfig {
+		minimizeConfig(cfg, mycfg, *flagCrash)
+		return
+	}
 	bisect.Run(cfg)
 }
 
-func loadString(file string, dst *string) {
-	data, err := ioutil.ReadFile(filepath.Join(*flagCrash, file))
+// minimizeConfig minimizes kernel config of the crash with the compiler from the config
+// (only the crash commit is built, so compilers from bin_dir are not needed).
+func minimizeConfig(cfg *bisect.Config, mycfg *Config, dir string) {
+	if mycfg.BaselineConfig == "" || mycfg.Compiler == "" {
+		fmt.Fprintf(os.Stderr, "config minimization mode requires baseline_config and compiler in config\n")
+		os.Exit(1)
+	}
+	baseline, err := ioutil.ReadFile(mycfg.BaselineConfig)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	cfg.Kernel.Compiler = mycfg.Compiler
+	cfg.Kernel.BaselineConfig = baseline
+	config, err := bisect.MinimizeConfig(cfg)
+	if err != nil {
+		os.Exit(1)
+	}
+	file := filepath.Join(dir, "kernel.config.minimized")
+	if err := ioutil.WriteFile(file, config, 0644); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	fmt.Printf("minimized config saved to %v\n", file)
+}
+
+func loadCrash(cfg *bisect.Config, dir string) {
+	loadString(dir, "syzkaller.commit", &cfg.Syzkaller.Commit)
+	loadString(dir, "kernel.commit", &cfg.Kernel.Commit)
+	loadFile(dir, "kernel.config", &cfg.Kernel.Config)
+	loadFile(dir, "repro.syz", &cfg.Repro.Syz)
+	loadFile(dir, "repro.opts", &cfg.Repro.Opts)
+}
+
+// backport checks all crashes on all backport branches and prints the worklist of needed backports.
+func backport(cfg *bisect.Config, backportBranches []BackportBranch, crashes []string) {
+	if len(backportBranches) == 0 || len(crashes) == 0 {
+		fmt.Fprintf(os.Stderr, "backport mode requires backport_branches in config and crash dirs as arguments\n")
+		os.Exit(1)
+	}
+	var branches []bisect.BranchConfig
+	for _, branch := range backportBranches {
+		branches = append(branches, bisect.BranchConfig{
+			Repo:   branch.Repo,
+			Branch: branch.Branch,
+		})
+	}
+	var worklist []string
+	for _, crash := range crashes {
+		var fixTitle string
+		if !osutil.IsExist(filepath.Join(crash, "fix.title")) {
+			fmt.Fprintf(os.Stderr, "%v: no fix.title file, it must contain title of the fixing commit"+
+				" (e.g. on mainline)\n", crash)
+			os.Exit(1)
+		}
+		loadString(crash, "fix.title", &fixTitle)
+		if fixTitle == "" {
+			fmt.Fprintf(os.Stderr, "%v: fix.title is empty\n", crash)
+			os.Exit(1)
+		}
+		loadCrash(cfg, crash)
+		cfg.DebugDir = crash
+		results, err := bisect.CheckBackport(cfg, fixTitle, branches)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%v: %v\n", crash, err)
+			os.Exit(1)
+		}
+		for _, res := range results {
+			if res.Status == bisect.BackportNeeded || res.Status == bisect.BackportUnknown {
+				worklist = append(worklist, fmt.Sprintf("%v/%v (%v): %v [%v]",
+					res.Branch.Repo, res.Branch.Branch, res.Status, fixTitle, crash))
+			}
+		}
+	}
+	fmt.Printf("\nbackport worklist (%v):\n", len(worklist))
+	for _, item := range worklist {
+		fmt.Printf("%v\n", item)
+	}
+}
+
+func loadString(dir, file string, dst *string) {
+	data, err := ioutil.ReadFile(filepath.Join(dir, file))
 	if err != nil {
 		fmt.Fprintln(os.Stderr, err)
 		os.Exit(1)
@@ -90,8 +196,8 @@ func loadString(file string, dst *string) {
 	*dst = strings.TrimSpace(string(data))
 }
 
-func loadFile(file string, dst *[]byte) {
-	data, err := ioutil.ReadFile(filepath.Join(*flagCrash, file))
+func loadFile(dir, file string, dst *[]byte) {
+	data, err := ioutil.ReadFile(filepath.Join(dir, file))
 	if err != nil {
 		fmt.Fprintln(os.Stderr, err)
 		os.Exit(1)
@@ -0,0 +1,344 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+// syz-bootfuzz fuzzes early kernel init code paths: it repeatedly boots VMs with random
+// combinations of kernel command line parameters and early sysctl values (sysctl.* parameters)
+// from an allowlist and records combinations that fail to boot or produce kernel warnings.
+// Runtime fuzzing never reaches these paths, as the kernel is always booted the same way.
+// Usage:
+//
+//	syz-bootfuzz -config=manager.cfg [-params=params.json] [-output=bootfuzz] [-boots=N]
+//
+// The VM type must control kernel command line (e.g. qemu with an external kernel).
+// params.json overrides the builtin allowlist and maps parameter names to lists of values,
+// an empty value means a parameter without value (e.g. "nokaslr").
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/syzkaller/pkg/hash"
+	"github.com/google/syzkaller/pkg/log"
+	"github.com/google/syzkaller/pkg/osutil"
+	"github.com/google/syzkaller/pkg/report"
+	"github.com/google/syzkaller/syz-manager/mgrconfig"
+	"github.com/google/syzkaller/vm"
+)
+
+var (
+	flagConfig    = flag.String("config", "", "manager configuration file")
+	flagParams    = flag.String("params", "", "JSON file with allowlist of parameters (overrides builtin)")
+	flagOutput    = flag.String("output", "bootfuzz", "output directory")
+	flagBoots     = flag.Int("boots", 0, "number of boots (0 - until interrupted)")
+	flagMaxParams = flag.Int("max_params", 3, "max number of parameters mutated in a single boot")
+)
+
+// linuxParams is the builtin allowlist of Linux parameters that are safe to change
+// (i.e. should not prevent a correct kernel from booting or hide crashes).
+var linuxParams = map[string][]string{
+	"nokaslr":                           {""},
+	"norandmaps":                        {""},
+	"nosmp":                             {""},
+	"maxcpus":                           {"1", "2"},
+	"nr_cpus":                           {"1", "2"},
+	"numa":                              {"off"},
+	"noapic":                            {""},
+	"nohz":                              {"on", "off"},
+	"threadirqs":                        {""},
+	"mitigations":                       {"off", "auto", "auto,nosmt"},
+	"pti":                               {"on", "off"},
+	"init_on_alloc":                     {"0", "1"},
+	"init_on_free":                      {"0", "1"},
+	"page_alloc.shuffle":                {"0", "1"},
+	"slub_debug":                        {"FZPU", "-"},
+	"slub_nomerge":                      {""},
+	"transparent_hugepage":              {"always", "madvise", "never"},
+	"hugepages":                         {"0", "16"},
+	"cgroup_disable":                    {"memory"},
+	"cgroup_no_v1":                      {"all"},
+	"audit":                             {"0", "1"},
+	"ipv6.disable":                      {"0", "1"},
+	"rcupdate.rcu_expedited":            {"0", "1"},
+	"rcupdate.rcu_normal":               {"0", "1"},
+	"workqueue.power_efficient":         {"0", "1"},
+	"sysctl.kernel.randomize_va_space":  {"0", "1", "2"},
+	"sysctl.kernel.perf_event_paranoid": {"-1", "2"},
+	"sysctl.kernel.unprivileged_bpf_disabled": {"0", "1"},
+	"sysctl.net.core.bpf_jit_enable":          {"0", "1"},
+	"sysctl.vm.overcommit_memory":             {"0", "1"},
+	"sysctl.vm.swappiness":                    {"0", "100"},
+	"sysctl.vm.min_free_kbytes":               {"8192", "65536"},
+	"sysctl.net.ipv4.tcp_timestamps":          {"0", "1"},
+}
+
+type Fuzzer struct {
+	reporter report.Reporter
+	vmPool   *vm.Pool
+	params   map[string][]string
+	names    []string
+
+	mu      sync.Mutex
+	rnd     *rand.Rand
+	results *os.File
+	stats   map[string]*ParamStats // "param=value" -> stats
+	boots   int
+	failed  int
+}
+
+type ParamStats struct {
+	Boots    int
+	Failures int
+}
+
+func main() {
+	flag.Parse()
+	cfg, err := mgrconfig.LoadFile(*flagConfig)
+	if err != nil {
+		log.Fatalf("%v", err)
+	}
+	params := linuxParams
+	if *flagParams != "" {
+		params = make(map[string][]string)
+		data, err := ioutil.ReadFile(*flagParams)
+		if err != nil {
+			log.Fatalf("failed to read params: %v", err)
+		}
+		if err := json.Unmarshal(data, &params); err != nil {
+			log.Fatalf("failed to parse params: %v", err)
+		}
+	} else if cfg.TargetOS != "linux" {
+		log.Fatalf("no builtin parameters for %v, specify -params", cfg.TargetOS)
+	}
+	if len(params) == 0 || *flagMaxParams <= 0 {
+		log.Fatalf("no parameters to mutate")
+	}
+	vmPool, err := vm.Create(cfg, false)
+	if err != nil {
+		log.Fatalf("%v", err)
+	}
+	if !vmPool.SupportsCmdline() {
+		log.Fatalf("VM type %v does not control kernel command line", cfg.Type)
+	}
+	reporter, err := report.NewReporter(cfg)
+	if err != nil {
+		log.Fatalf("%v", err)
+	}
+	if err := osutil.MkdirAll(*flagOutput); err != nil {
+		log.Fatalf("failed to create output dir: %v", err)
+	}
+	results, err := os.OpenFile(filepath.Join(*flagOutput, "results"),
+		os.O_WRONLY|os.O_CREATE|os.O_APPEND, osutil.DefaultFilePerm)
+	if err != nil {
+		log.Fatalf("failed to open results file: %v", err)
+	}
+	defer results.Close()
+	fuzzer := &Fuzzer{
+		reporter: reporter,
+		vmPool:   vmPool,
+		params:   params,
+		rnd:      rand.New(rand.NewSource(time.Now().UnixNano())),
+		results:  results,
+		stats:    make(map[string]*ParamStats),
+	}
+	for name := range params {
+		fuzzer.names = append(fuzzer.names, name)
+	}
+	sort.Strings(fuzzer.names)
+
+	// Check that the kernel boots without additional parameters,
+	// otherwise all failures would be attributed to parameters.
+	log.Logf(0, "testing boot without additional parameters...")
+	if title := fuzzer.boot(0, ""); title != "" {
+		log.Fatalf("kernel fails to boot without additional parameters: %v", title)
+	}
+
+	var shutdown uint32
+	shutdownC := make(chan struct{})
+	osutil.HandleInterrupts(shutdownC)
+	go func() {
+		<-shutdownC
+		atomic.StoreUint32(&shutdown, 1)
+		log.Logf(0, "shutting down...")
+	}()
+	var wg sync.WaitGroup
+	for i := 0; i < vmPool.Count(); i++ {
+		wg.Add(1)
+		go func(index int) {
+			defer wg.Done()
+			for atomic.LoadUint32(&shutdown) == 0 && fuzzer.nextBoot() {
+				params := fuzzer.mutate()
+				title := fuzzer.boot(index, strings.Join(params, " "))
+				fuzzer.record(params, title)
+			}
+		}(i)
+	}
+	wg.Wait()
+	fuzzer.printStats()
+}
+
+func (fuzzer *Fuzzer) nextBoot() bool {
+	fuzzer.mu.Lock()
+	defer fuzzer.mu.Unlock()
+	if *flagBoots != 0 && fuzzer.boots >= *flagBoots {
+		return false
+	}
+	fuzzer.boots++
+	return true
+}
+
+// mutate returns a random combination of parameters in the form of "name=value".
+func (fuzzer *Fuzzer) mutate() []string {
+	fuzzer.mu.Lock()
+	defer fuzzer.mu.Unlock()
+	n := 1 + fuzzer.rnd.Intn(*flagMaxParams)
+	if n > len(fuzzer.names) {
+		n = len(fuzzer.names)
+	}
+	var params []string
+	for _, idx := range fuzzer.rnd.Perm(len(fuzzer.names))[:n] {
+		name := fuzzer.names[idx]
+		values := fuzzer.params[name]
+		val := ""
+		if len(values) != 0 {
+			val = values[fuzzer.rnd.Intn(len(values))]
+		}
+		if val != "" {
+			name += "=" + val
+		}
+		params = append(params, name)
+	}
+	sort.Strings(params)
+	return params
+}
+
+// boot boots VM index with the additional command line and checks kernel log for crashes.
+// Returns title of the problem or empty string if the kernel booted fine.
+func (fuzzer *Fuzzer) boot(index int, cmdline string) string {
+	log.Logf(1, "vm-%v: booting with %q", index, cmdline)
+	var inst *vm.Instance
+	var err error
+	if cmdline == "" {
+		inst, err = fuzzer.vmPool.Create(index)
+	} else {
+		inst, err = fuzzer.vmPool.CreateWithCmdline(index, cmdline)
+	}
+	if err != nil {
+		bootErr, ok := err.(vm.BootErrorer)
+		if !ok {
+			// Not a kernel problem (e.g. failed to start qemu), don't blame parameters.
+			log.Logf(0, "vm-%v: failed to create instance: %v", index, err)
+			return ""
+		}
+		title, output := bootErr.BootError()
+		rep := fuzzer.reporter.Parse(output)
+		if rep == nil {
+			rep = &report.Report{Title: title, Output: output}
+		}
+		rep.Title = "boot failure: " + rep.Title
+		fuzzer.save(cmdline, rep)
+		return rep.Title
+	}
+	defer inst.Close()
+	// Warnings during boot are not detected by VM creation, so look at the kernel log.
+	outc, errc, err := inst.Run(time.Minute, nil, "dmesg")
+	if err != nil {
+		log.Logf(0, "vm-%v: failed to run dmesg: %v", index, err)
+		return ""
+	}
+	rep := inst.MonitorExecution(outc, errc, fuzzer.reporter, true)
+	if rep == nil {
+		return ""
+	}
+	fuzzer.save(cmdline, rep)
+	return rep.Title
+}
+
+const maxSavedCrashes = 10
+
+// save saves crash report along with the command line into output/hash(title)/.
+func (fuzzer *Fuzzer) save(cmdline string, rep *report.Report) {
+	log.Logf(0, "%v with %q", rep.Title, cmdline)
+	if err := fuzzer.reporter.Symbolize(rep); err != nil {
+		log.Logf(0, "failed to symbolize report: %v", err)
+	}
+	dir := filepath.Join(*flagOutput, hash.String([]byte(rep.Title)))
+	if err := osutil.MkdirAll(dir); err != nil {
+		log.Logf(0, "failed to create crash dir: %v", err)
+		return
+	}
+	fuzzer.mu.Lock()
+	defer fuzzer.mu.Unlock()
+	osutil.WriteFile(filepath.Join(dir, "description"), []byte(rep.Title+"\n"))
+	for i := 0; i < maxSavedCrashes; i++ {
+		cmdlineFile := filepath.Join(dir, fmt.Sprintf("cmdline%v", i))
+		if osutil.IsExist(cmdlineFile) {
+			continue
+		}
+		osutil.WriteFile(cmdlineFile, []byte(cmdline+"\n"))
+		osutil.WriteFile(filepath.Join(dir, fmt.Sprintf("log%v", i)), rep.Output)
+		if len(rep.Report) != 0 {
+			osutil.WriteFile(filepath.Join(dir, fmt.Sprintf("report%v", i)), rep.Report)
+		}
+		break
+	}
+}
+
+// record appends the boot result to the results file and updates per-parameter stats.
+func (fuzzer *Fuzzer) record(params []string, title string) {
+	fuzzer.mu.Lock()
+	defer fuzzer.mu.Unlock()
+	status := "OK"
+	if title != "" {
+		status = "FAIL " + title
+		fuzzer.failed++
+	}
+	fmt.Fprintf(fuzzer.results, "%v\t%v\t%v\n", time.Now().Format(time.RFC3339), strings.Join(params, " "), status)
+	for _, param := range params {
+		stats := fuzzer.stats[param]
+		if stats == nil {
+			stats = new(ParamStats)
+			fuzzer.stats[param] = stats
+		}
+		stats.Boots++
+		if title != "" {
+			stats.Failures++
+		}
+	}
+}
+
+// printStats prints per-parameter failure rates, parameters that cause failures
+// have noticeably higher rates than the rest.
+func (fuzzer *Fuzzer) printStats() {
+	fuzzer.mu.Lock()
+	defer fuzzer.mu.Unlock()
+	var params []string
+	for param := range fuzzer.stats {
+		params = append(params, param)
+	}
+	sort.Slice(params, func(i, j int) bool {
+		si, sj := fuzzer.stats[params[i]], fuzzer.stats[params[j]]
+		if si.Failures*sj.Boots != sj.Failures*si.Boots {
+			return si.Failures*sj.Boots > sj.Failures*si.Boots
+		}
+		return params[i] < params[j]
+	})
+	buf := new(bytes.Buffer)
+	fmt.Fprintf(buf, "boots: %v, failed: %v\n", fuzzer.boots, fuzzer.failed)
+	for _, param := range params {
+		stats := fuzzer.stats[param]
+		fmt.Fprintf(buf, "%-50v %5v/%-5v failed\n", param, stats.Failures, stats.Boots)
+	}
+	os.Stdout.Write(buf.Bytes())
+}
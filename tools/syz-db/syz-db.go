@@ -12,6 +12,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/google/syzkaller/pkg/crypt"
 	"github.com/google/syzkaller/pkg/db"
 	"github.com/google/syzkaller/pkg/hash"
 	"github.com/google/syzkaller/pkg/osutil"
@@ -24,6 +25,8 @@ func main() {
 		flagVersion = flag.Uint64("version", 0, "database version")
 		flagOS      = flag.String("os", "", "target OS")
 		flagArch    = flag.String("arch", "", "target arch")
+		flagKey     = flag.String("key", "", "file with database encryption key (manager workdir_key)")
+		flagToArch  = flag.String("to-arch", "", "target arch for translate")
 	)
 	flag.Parse()
 	args := flag.Args()
@@ -38,11 +41,24 @@ func main() {
 			failf("failed to find target: %v", err)
 		}
 	}
+	key, err := crypt.LoadKey(*flagKey, "")
+	if err != nil {
+		failf("%v", err)
+	}
 	switch args[0] {
 	case "pack":
-		pack(args[1], args[2], target, *flagVersion)
+		pack(args[1], args[2], target, key, *flagVersion)
 	case "unpack":
-		unpack(args[1], args[2])
+		unpack(args[1], args[2], key)
+	case "translate":
+		if target == nil || *flagToArch == "" {
+			failf("translate requires -os, -arch and -to-arch")
+		}
+		dst, err := prog.GetTarget(target.OS, *flagToArch)
+		if err != nil {
+			failf("failed to find target: %v", err)
+		}
+		translate(args[1], args[2], target, dst, key)
 	default:
 		usage()
 	}
@@ -52,16 +68,17 @@ func usage() {
 	fmt.Fprintf(os.Stderr, "usage:\n")
 	fmt.Fprintf(os.Stderr, "  syz-db pack dir corpus.db\n")
 	fmt.Fprintf(os.Stderr, "  syz-db unpack corpus.db dir\n")
+	fmt.Fprintf(os.Stderr, "  syz-db -os=linux -arch=amd64 -to-arch=arm64 translate corpus.db translated.db\n")
 	os.Exit(1)
 }
 
-func pack(dir, file string, target *prog.Target, version uint64) {
+func pack(dir, file string, target *prog.Target, cryptKey *crypt.Key, version uint64) {
 	files, err := ioutil.ReadDir(dir)
 	if err != nil {
 		failf("failed to read dir: %v", err)
 	}
 	os.Remove(file)
-	db, err := db.Open(file)
+	db, err := db.OpenEncrypted(file, cryptKey)
 	if err != nil {
 		failf("failed to open database file: %v", err)
 	}
@@ -83,10 +100,13 @@ func pack(dir, file string, target *prog.Target, version uint64) {
 		}
 		if sig := hash.String(data); key != sig {
 			if target != nil {
-				p, err := target.Deserialize(data)
+				p, warnings, err := target.DeserializeNonStrict(data)
 				if err != nil {
 					failf("failed to deserialize %v: %v", file.Name(), err)
 				}
+				for _, w := range warnings {
+					fmt.Fprintf(os.Stderr, "%v: %v\n", file.Name(), w)
+				}
 				data = p.Serialize()
 				sig = hash.String(data)
 			}
@@ -100,8 +120,8 @@ func pack(dir, file string, target *prog.Target, version uint64) {
 	}
 }
 
-func unpack(file, dir string) {
-	db, err := db.Open(file)
+func unpack(file, dir string, cryptKey *crypt.Key) {
+	db, err := db.OpenEncrypted(file, cryptKey)
 	if err != nil {
 		failf("failed to open database: %v", err)
 	}
@@ -117,6 +137,47 @@ func unpack(file, dir string) {
 	}
 }
 
+// translate converts programs in corpus database of target src to programs of target dst.
+// Calls that are not present on dst are rewritten or dropped, see prog.Target.Translate.
+func translate(file, outFile string, src, dst *prog.Target, cryptKey *crypt.Key) {
+	in, err := db.OpenEncrypted(file, cryptKey)
+	if err != nil {
+		failf("failed to open database: %v", err)
+	}
+	os.Remove(outFile)
+	out, err := db.OpenEncrypted(outFile, cryptKey)
+	if err != nil {
+		failf("failed to open database file: %v", err)
+	}
+	if err := out.BumpVersion(in.Version); err != nil {
+		failf("failed to bump database version: %v", err)
+	}
+	translated, dropped, droppedCalls := 0, 0, 0
+	for key, rec := range in.Records {
+		p, _, err := src.DeserializeNonStrict(rec.Val)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%v: failed to deserialize: %v\n", key, err)
+			dropped++
+			continue
+		}
+		p1, warnings, err := dst.Translate(p, nil)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%v: %v\n", key, err)
+			dropped++
+			continue
+		}
+		droppedCalls += len(warnings)
+		data := p1.Serialize()
+		out.Save(hash.String(data), data, rec.Seq)
+		translated++
+	}
+	if err := out.Flush(); err != nil {
+		failf("failed to save database file: %v", err)
+	}
+	fmt.Fprintf(os.Stderr, "translated %v programs, dropped %v programs and %v calls\n",
+		translated, dropped, droppedCalls)
+}
+
 func failf(msg string, args ...interface{}) {
 	fmt.Fprintf(os.Stderr, msg+"\n", args...)
 	os.Exit(1)
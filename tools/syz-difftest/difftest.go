@@ -0,0 +1,221 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+// syz-difftest executes the same programs on two kernels and reports programs
+// for which syscall errno sequences differ between the kernels. Usage:
+//   syz-difftest -config1=old.cfg -config2=new.cfg programs.log
+// The configs are normal manager configs that refer to the two kernel builds
+// (e.g. two consecutive images produced by syz-ci). Programs are executed
+// several times on each kernel and only programs with results that are stable
+// on both kernels, but differ between them, are reported. This is intended
+// for detection of unintended UAPI behavior changes.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/google/syzkaller/pkg/log"
+	"github.com/google/syzkaller/pkg/report"
+	"github.com/google/syzkaller/prog"
+	_ "github.com/google/syzkaller/sys"
+	"github.com/google/syzkaller/syz-manager/mgrconfig"
+	"github.com/google/syzkaller/vm"
+)
+
+var (
+	flagConfig1 = flag.String("config1", "", "manager configuration file for the first kernel")
+	flagConfig2 = flag.String("config2", "", "manager configuration file for the second kernel")
+	flagRuns    = flag.Int("runs", 3, "number of times to execute each program on each kernel")
+	flagTimeout = flag.Duration("timeout", time.Hour, "execution timeout for a single run")
+)
+
+// results maps program index in the log to errno sequences observed in all runs.
+type results map[int][]string
+
+func main() {
+	flag.Parse()
+	if *flagConfig1 == "" || *flagConfig2 == "" || len(flag.Args()) != 1 || *flagRuns < 1 {
+		fmt.Fprintf(os.Stderr, "usage: syz-difftest -config1=old.cfg -config2=new.cfg programs.log\n")
+		flag.PrintDefaults()
+		os.Exit(1)
+	}
+	logFile := flag.Args()[0]
+	data, err := ioutil.ReadFile(logFile)
+	if err != nil {
+		log.Fatalf("failed to read log file: %v", err)
+	}
+	cfg1, err := mgrconfig.LoadFile(*flagConfig1)
+	if err != nil {
+		log.Fatalf("%v", err)
+	}
+	cfg2, err := mgrconfig.LoadFile(*flagConfig2)
+	if err != nil {
+		log.Fatalf("%v", err)
+	}
+	if cfg1.TargetOS != cfg2.TargetOS || cfg1.TargetArch != cfg2.TargetArch {
+		log.Fatalf("configs have different targets: %v/%v vs %v/%v",
+			cfg1.TargetOS, cfg1.TargetArch, cfg2.TargetOS, cfg2.TargetArch)
+	}
+	target, err := prog.GetTarget(cfg1.TargetOS, cfg1.TargetArch)
+	if err != nil {
+		log.Fatalf("%v", err)
+	}
+	entries := target.ParseLog(data)
+	if len(entries) == 0 {
+		log.Fatalf("no programs found in %v", logFile)
+	}
+	log.Logf(0, "parsed %v programs", len(entries))
+
+	type runResult struct {
+		res results
+		err error
+	}
+	done1 := make(chan runResult, 1)
+	go func() {
+		res, err := runKernel(cfg1, logFile)
+		done1 <- runResult{res, err}
+	}()
+	res2, err := runKernel(cfg2, logFile)
+	if err != nil {
+		log.Fatalf("%v: %v", cfg2.Name, err)
+	}
+	r1 := <-done1
+	if r1.err != nil {
+		log.Fatalf("%v: %v", cfg1.Name, r1.err)
+	}
+	res1 := r1.res
+
+	diffs, unstable := 0, 0
+	for i, ent := range entries {
+		seq1, stable1 := stableResult(res1[i])
+		seq2, stable2 := stableResult(res2[i])
+		if !stable1 || !stable2 {
+			unstable++
+			continue
+		}
+		if seq1 == seq2 {
+			continue
+		}
+		diffs++
+		fmt.Printf("program #%v: errno differ:\n  %v: %v\n  %v: %v\n%s\n",
+			i, cfg1.Name, seq1, cfg2.Name, seq2, ent.P.Serialize())
+	}
+	log.Logf(0, "programs: %v, differ: %v, unstable: %v", len(entries), diffs, unstable)
+	if diffs != 0 {
+		os.Exit(1)
+	}
+}
+
+// stableResult returns the errno sequence if all runs produced the same result.
+func stableResult(seqs []string) (string, bool) {
+	if len(seqs) != *flagRuns {
+		return "", false
+	}
+	for _, seq := range seqs[1:] {
+		if seq != seqs[0] {
+			return "", false
+		}
+	}
+	return seqs[0], true
+}
+
+func runKernel(cfg *mgrconfig.Config, logFile string) (results, error) {
+	vmPool, err := vm.Create(cfg, false)
+	if err != nil {
+		return nil, err
+	}
+	reporter, err := report.NewReporter(cfg)
+	if err != nil {
+		return nil, err
+	}
+	inst, err := vmPool.Create(0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create instance: %v", err)
+	}
+	defer inst.Close()
+	execprogBin, err := inst.Copy(cfg.SyzExecprogBin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to copy execprog: %v", err)
+	}
+	executorBin, err := inst.Copy(cfg.SyzExecutorBin)
+	if err != nil {
+		return nil, fmt.Errorf("failed to copy executor: %v", err)
+	}
+	vmLogFile, err := inst.Copy(logFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to copy log: %v", err)
+	}
+	// Use a single proc so that programs don't interfere with each other,
+	// and execute calls sequentially so that errno results are deterministic.
+	cmd := fmt.Sprintf("%v -executor=%v -arch=%v -repeat=%v -procs=1 -threaded=false -collide=false"+
+		" -sandbox=%v -results %v",
+		execprogBin, executorBin, cfg.TargetArch, *flagRuns, cfg.Sandbox, vmLogFile)
+	log.Logf(0, "%v: executing programs...", cfg.Name)
+	outc, errc, err := inst.Run(*flagTimeout, nil, cmd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to run execprog: %v", err)
+	}
+	output, err := collectOutput(outc, errc)
+	if err != nil {
+		return nil, fmt.Errorf("execprog failed: %v\n%s", err, output)
+	}
+	if rep := reporter.Parse(output); rep != nil {
+		return nil, fmt.Errorf("kernel crashed: %v\n%s", rep.Title, rep.Report)
+	}
+	return parseResults(output), nil
+}
+
+func collectOutput(outc <-chan []byte, errc <-chan error) ([]byte, error) {
+	var output []byte
+	for {
+		select {
+		case out, ok := <-outc:
+			if !ok {
+				outc = nil
+				continue
+			}
+			output = append(output, out...)
+		case err := <-errc:
+			// Give it some time to finish writing the output.
+			timer := time.After(10 * time.Second)
+			for outc != nil {
+				select {
+				case out, ok := <-outc:
+					if !ok {
+						outc = nil
+						break
+					}
+					output = append(output, out...)
+				case <-timer:
+					outc = nil
+				}
+			}
+			return output, err
+		}
+	}
+}
+
+var resultRe = regexp.MustCompile(`RESULT #([0-9]+): ([0-9 \-]*|not executed)\s*$`)
+
+func parseResults(output []byte) results {
+	res := make(results)
+	for _, line := range bytes.Split(output, []byte{'\n'}) {
+		match := resultRe.FindSubmatch(line)
+		if match == nil {
+			continue
+		}
+		idx, err := strconv.Atoi(string(match[1]))
+		if err != nil {
+			continue
+		}
+		res[idx] = append(res[idx], string(bytes.TrimSpace(match[2])))
+	}
+	return res
+}
@@ -12,16 +12,18 @@ import (
 	"io/ioutil"
 	"os"
 	"runtime"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/google/syzkaller/pkg/cover"
+	"github.com/google/syzkaller/pkg/creds"
 	"github.com/google/syzkaller/pkg/host"
 	"github.com/google/syzkaller/pkg/ipc"
 	"github.com/google/syzkaller/pkg/log"
 	"github.com/google/syzkaller/pkg/osutil"
 	"github.com/google/syzkaller/prog"
-	_ "github.com/google/syzkaller/sys"
+	"github.com/google/syzkaller/sys"
 )
 
 var (
@@ -33,7 +35,9 @@ var (
 	flagOutput    = flag.String("output", "none", "write programs to none/stdout")
 	flagFaultCall = flag.Int("fault_call", -1, "inject fault into this call (0-based)")
 	flagFaultNth  = flag.Int("fault_nth", 0, "inject fault on n-th operation (0-based)")
+	flagCreds     = flag.String("creds", "", "credential context to execute programs in (e.g. uid=65534/nnp)")
 	flagHints     = flag.Bool("hints", false, "do a hints-generation run")
+	flagResults   = flag.Bool("results", false, "print per-call errno results of every program")
 )
 
 func main() {
@@ -49,7 +53,7 @@ func main() {
 		log.Fatalf("%v", err)
 	}
 
-	entries := loadPrograms(target, flag.Args())
+	entries, fingerprints := loadPrograms(target, flag.Args())
 	if len(entries) == 0 {
 		return
 	}
@@ -63,6 +67,7 @@ func main() {
 	}
 
 	config, execOpts := createConfig(entries, features)
+	checkFingerprints(target, config, features, fingerprints)
 
 	var wg sync.WaitGroup
 	wg.Add(*flagProcs)
@@ -106,15 +111,32 @@ func main() {
 						newOpts.FaultNth = entry.FaultNth
 						callOpts = &newOpts
 					}
+					if *flagCreds == "" && entry.Creds != "" {
+						entryCreds, err := creds.Parse(entry.Creds)
+						if err != nil {
+							log.Fatalf("%v", err)
+						}
+						newOpts := *callOpts
+						newOpts.Creds = entryCreds
+						callOpts = &newOpts
+					}
 					switch *flagOutput {
 					case "stdout":
-						strOpts := ""
+						var strOpts []string
 						if callOpts.Flags&ipc.FlagInjectFault != 0 {
-							strOpts = fmt.Sprintf(" (fault-call:%v fault-nth:%v)", callOpts.FaultCall, callOpts.FaultNth)
+							strOpts = append(strOpts, fmt.Sprintf("fault-call:%v fault-nth:%v",
+								callOpts.FaultCall, callOpts.FaultNth))
+						}
+						if !callOpts.Creds.IsRoot() {
+							strOpts = append(strOpts, "creds:"+callOpts.Creds.String())
 						}
 						data := entry.P.Serialize()
 						logMu.Lock()
-						log.Logf(0, "executing program %v%v:\n%s", pid, strOpts, data)
+						if len(strOpts) != 0 {
+							log.Logf(0, "executing program %v (%v):\n%s", pid, strings.Join(strOpts, " "), data)
+						} else {
+							log.Logf(0, "executing program %v:\n%s", pid, data)
+						}
 						logMu.Unlock()
 					}
 					output, info, failed, hanged, err := env.Exec(callOpts, entry.P)
@@ -130,6 +152,11 @@ func main() {
 						log.Logf(0, "result: failed=%v hanged=%v err=%v\n\n%s",
 							failed, hanged, err, output)
 					}
+					if *flagResults {
+						logMu.Lock()
+						log.Logf(0, "RESULT #%v: %v", idx%len(entries), formatResults(info))
+						logMu.Unlock()
+					}
 					if len(info) != 0 {
 						for i, inf := range info {
 							log.Logf(1, "CALL %v: signal %v, coverage %v errno %v",
@@ -193,17 +220,71 @@ func main() {
 	wg.Wait()
 }
 
-func loadPrograms(target *prog.Target, files []string) []*prog.LogEntry {
+// formatResults returns space-separated errno values of all calls,
+// calls that were not executed are denoted with "-".
+// The format is parsed by syz-difftest.
+func formatResults(info []ipc.CallInfo) string {
+	if info == nil {
+		// The executor failed before returning any results.
+		return "not executed"
+	}
+	res := make([]string, len(info))
+	for i, inf := range info {
+		if !inf.Executed {
+			res[i] = "-"
+			continue
+		}
+		res[i] = fmt.Sprint(inf.Errno)
+	}
+	return strings.Join(res, " ")
+}
+
+func loadPrograms(target *prog.Target, files []string) ([]*prog.LogEntry, map[string]*host.Fingerprint) {
 	var entries []*prog.LogEntry
+	fingerprints := make(map[string]*host.Fingerprint)
 	for _, fn := range files {
 		data, err := ioutil.ReadFile(fn)
 		if err != nil {
 			log.Fatalf("failed to read log file: %v", err)
 		}
 		entries = append(entries, target.ParseLog(data)...)
+		fp, err := host.ExtractFingerprint(data)
+		if err != nil {
+			log.Logf(0, "%v: %v", fn, err)
+		}
+		if fp != nil {
+			fingerprints[fn] = fp
+		}
 	}
 	log.Logf(0, "parsed %v programs", len(entries))
-	return entries
+	return entries, fingerprints
+}
+
+// checkFingerprints compares fingerprints of the environment where the programs were recorded
+// (e.g. crash reproducers) with the current environment. A mismatch is a frequent reason
+// for crashes that don't reproduce, so we warn loudly, but still execute the programs.
+func checkFingerprints(target *prog.Target, config *ipc.Config, features *host.Features,
+	fingerprints map[string]*host.Fingerprint) {
+	sandbox := "none"
+	switch {
+	case config.Flags&ipc.FlagSandboxSetuid != 0:
+		sandbox = "setuid"
+	case config.Flags&ipc.FlagSandboxNamespace != 0:
+		sandbox = "namespace"
+	}
+	current := host.MakeFingerprint(sys.GitRevision, target.Revision, sandbox, features)
+	for fn, fp := range fingerprints {
+		mismatch := fp.Mismatch(current)
+		if len(mismatch) == 0 {
+			continue
+		}
+		log.Logf(0, "!!! EXECUTION ENVIRONMENT MISMATCH !!!")
+		log.Logf(0, "%v was recorded in a different environment (recorded vs current):", fn)
+		for _, m := range mismatch {
+			log.Logf(0, "  %v", m)
+		}
+		log.Logf(0, "the programs may not reproduce the original behavior")
+	}
 }
 
 func createConfig(entries []*prog.LogEntry, features *host.Features) (*ipc.Config, *ipc.ExecOpts) {
@@ -231,6 +312,12 @@ func createConfig(entries []*prog.LogEntry, features *host.Features) (*ipc.Confi
 		execOpts.FaultCall = *flagFaultCall
 		execOpts.FaultNth = *flagFaultNth
 	}
+	if *flagCreds != "" {
+		execOpts.Creds, err = creds.Parse(*flagCreds)
+		if err != nil {
+			log.Fatalf("%v", err)
+		}
+	}
 	handled := make(map[string]bool)
 	for _, entry := range entries {
 		for _, call := range entry.P.Calls {
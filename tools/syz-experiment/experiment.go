@@ -0,0 +1,73 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+// syz-experiment compares results of an A/B experiment.
+// First, run two managers that differ only in the aspect under test (e.g. a kernel config toggle)
+// with "experiment" config parameter set to arm names (e.g. "base" and "kasan-inline").
+// Managers periodically checkpoint stats into workdir/experiment.stats.
+// Then, run syz-experiment -window=24h workdir_a/experiment.stats workdir_b/experiment.stats.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/google/syzkaller/pkg/experiment"
+)
+
+var (
+	flagWindow = flag.Duration("window", 0, "compare only the last window of checkpoints (all by default)")
+	flagAlpha  = flag.Float64("alpha", 0.05, "significance level")
+)
+
+func main() {
+	flag.Parse()
+	if len(flag.Args()) != 2 {
+		fmt.Fprintf(os.Stderr, "usage: syz-experiment [flags] experiment_a.stats experiment_b.stats\n")
+		flag.PrintDefaults()
+		os.Exit(1)
+	}
+	a := readArm(flag.Args()[0])
+	b := readArm(flag.Args()[1])
+	metrics, err := experiment.Compare(a, b)
+	if err != nil {
+		failf("%v", err)
+	}
+	fmt.Printf("A: %v, %v checkpoints, %v of fuzzing\n", a[0].Tag, len(a), fuzzingTime(a))
+	fmt.Printf("B: %v, %v checkpoints, %v of fuzzing\n", b[0].Tag, len(b), fuzzingTime(b))
+	fmt.Printf("rates are per VM fuzzing hour\n\n")
+	fmt.Printf("%-12v%14v%14v%10v%10v\n", "", "A", "B", "diff", "p-value")
+	for _, m := range metrics {
+		significant := ""
+		if m.Significant(*flagAlpha) {
+			significant = " *"
+		}
+		fmt.Printf("%-12v%14.3f%14.3f%+9.1f%%%10.4f%v\n",
+			m.Name, m.A, m.B, m.Diff()*100, m.P, significant)
+	}
+	fmt.Printf("\n* - significant difference at %v level\n", *flagAlpha)
+}
+
+func readArm(file string) []experiment.Checkpoint {
+	checkpoints, err := experiment.ReadFile(file)
+	if err != nil {
+		failf("%v", err)
+	}
+	checkpoints = experiment.Window(checkpoints, *flagWindow)
+	if len(checkpoints) == 0 {
+		failf("no checkpoints in %v", file)
+	}
+	return checkpoints
+}
+
+func fuzzingTime(checkpoints []experiment.Checkpoint) time.Duration {
+	first, last := checkpoints[0].Fuzzing, checkpoints[len(checkpoints)-1].Fuzzing
+	return time.Duration(last-first) * time.Second
+}
+
+func failf(msg string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, msg+"\n", args...)
+	os.Exit(1)
+}
@@ -0,0 +1,147 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+// syz-fixinfo attaches CVE identifiers and fix commits to dashboard bugs.
+// It can either add the info manually:
+//	syz-fixinfo -client=... -addr=... -key=... add -bug=ID -cve=CVE-2018-1234 -repo=URL -hash=HASH -title=TITLE
+// or import it from kernel trees (e.g. stable trees) by scanning commits for dashboard Reported-by tags:
+//	syz-fixinfo -client=... -addr=... -key=... import -dir=workdir -email=syzbot@example.com URL:BRANCH...
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/syzkaller/dashboard/dashapi"
+	"github.com/google/syzkaller/pkg/vcs"
+)
+
+var (
+	flagClient = flag.String("client", "", "dashboard client")
+	flagAddr   = flag.String("addr", "", "dashboard address")
+	flagKey    = flag.String("key", "", "dashboard API key")
+)
+
+func main() {
+	flag.Parse()
+	args := flag.Args()
+	if len(args) == 0 || *flagClient == "" || *flagAddr == "" || *flagKey == "" {
+		usage()
+	}
+	dash := dashapi.New(*flagClient, *flagAddr, *flagKey)
+	switch args[0] {
+	case "add":
+		add(dash, args[1:])
+	case "import":
+		importFixes(dash, args[1:])
+	default:
+		usage()
+	}
+}
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "usage:\n")
+	fmt.Fprintf(os.Stderr, "  syz-fixinfo -client=... -addr=... -key=... add -bug=ID [-cve=CVE]"+
+		" [-repo=URL -branch=BRANCH -hash=HASH -title=TITLE]\n")
+	fmt.Fprintf(os.Stderr, "  syz-fixinfo -client=... -addr=... -key=... import -dir=DIR -email=EMAIL URL:BRANCH...\n")
+	os.Exit(1)
+}
+
+func add(dash *dashapi.Dashboard, args []string) {
+	flags := flag.NewFlagSet("add", flag.ExitOnError)
+	var (
+		flagBug    = flags.String("bug", "", "bug reporting ID (as in Reported-by tag)")
+		flagCVE    = flags.String("cve", "", "comma-separated list of CVE identifiers")
+		flagRepo   = flags.String("repo", "", "repository of the fix commit")
+		flagBranch = flags.String("branch", "", "branch of the fix commit")
+		flagHash   = flags.String("hash", "", "fix commit hash")
+		flagTitle  = flags.String("title", "", "fix commit title")
+	)
+	flags.Parse(args)
+	if *flagBug == "" || *flagCVE == "" && *flagHash == "" {
+		usage()
+	}
+	info := &dashapi.BugFixInfo{
+		ID: *flagBug,
+	}
+	if *flagCVE != "" {
+		info.CVEs = strings.Split(*flagCVE, ",")
+	}
+	if *flagHash != "" {
+		info.Fixes = append(info.Fixes, dashapi.UpstreamFix{
+			Repo:   *flagRepo,
+			Branch: *flagBranch,
+			Hash:   *flagHash,
+			Title:  *flagTitle,
+		})
+	}
+	if err := dash.AddBugFixInfo(info); err != nil {
+		failf("failed to add fix info: %v", err)
+	}
+}
+
+func importFixes(dash *dashapi.Dashboard, args []string) {
+	flags := flag.NewFlagSet("import", flag.ExitOnError)
+	var (
+		flagDir   = flags.String("dir", "", "dir to checkout repositories")
+		flagEmail = flags.String("email", "", "dashboard reporting email (as in Reported-by tags)")
+	)
+	flags.Parse(args)
+	if *flagDir == "" || *flagEmail == "" || flags.NArg() == 0 {
+		usage()
+	}
+	for i, arg := range flags.Args() {
+		// Repo URLs contain colons, so split on the last one.
+		pos := strings.LastIndexByte(arg, ':')
+		if pos == -1 {
+			failf("bad repository %q, want URL:BRANCH", arg)
+		}
+		repo, branch := arg[:pos], arg[pos+1:]
+		dir := filepath.Join(*flagDir, fmt.Sprint(i))
+		if err := importRepo(dash, dir, repo, branch, *flagEmail); err != nil {
+			failf("failed to import %v/%v: %v", repo, branch, err)
+		}
+	}
+}
+
+func importRepo(dash *dashapi.Dashboard, dir, repo, branch, email string) error {
+	r, err := vcs.NewRepo("linux", "", dir)
+	if err != nil {
+		return err
+	}
+	head, err := r.Poll(repo, branch)
+	if err != nil {
+		return err
+	}
+	infos, err := r.ExtractFixInfoFromCommits(head.Hash, email)
+	if err != nil {
+		return err
+	}
+	for _, info := range infos {
+		fixInfo := &dashapi.BugFixInfo{
+			ID:   info.Tag,
+			CVEs: info.CVEs,
+			Fixes: []dashapi.UpstreamFix{{
+				Repo:   repo,
+				Branch: branch,
+				Hash:   info.Hash,
+				Title:  info.Title,
+			}},
+		}
+		if err := dash.AddBugFixInfo(fixInfo); err != nil {
+			// The tag may refer to a bug in another dashboard namespace, don't fail the whole import.
+			fmt.Fprintf(os.Stderr, "failed to add fix info for %v: %v\n", info.Tag, err)
+			continue
+		}
+		fmt.Printf("%v: %v %v\n", info.Tag, info.Hash, info.Title)
+	}
+	return nil
+}
+
+func failf(msg string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, msg+"\n", args...)
+	os.Exit(1)
+}
@@ -0,0 +1,236 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+// syz-fleet manages a fleet of syz-ci instances via their control API (see syz-ci/control.go).
+// Usage:
+//
+//	syz-fleet -config=fleet.cfg status
+//	syz-fleet -config=fleet.cfg pause|resume [instance...]
+//	syz-fleet -config=fleet.cfg push-config instance syz-ci.cfg
+//	syz-fleet -config=fleet.cfg update
+//
+// update rolls out syzkaller update in waves of wave_size instances: the next wave
+// is started only when all instances of the previous wave are running the new syzkaller
+// with all managers up, the rollout is stopped if a wave does not become healthy in wave_timeout.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/google/syzkaller/dashboard/dashapi"
+	"github.com/google/syzkaller/pkg/config"
+)
+
+var flagConfig = flag.String("config", "", "fleet config file")
+
+type Config struct {
+	Instances []*Instance `json:"instances"`
+	// Number of instances updated at once.
+	WaveSize int `json:"wave_size"`
+	// How long to wait for a wave to become healthy (in minutes).
+	WaveTimeout int `json:"wave_timeout"`
+}
+
+type Instance struct {
+	Name string `json:"name"`
+	Addr string `json:"addr"` // syz-ci http address
+	Key  string `json:"key"`  // syz-ci control_key
+}
+
+func main() {
+	flag.Parse()
+	cfg := &Config{
+		WaveSize:    1,
+		WaveTimeout: 180,
+	}
+	if err := config.LoadFile(*flagConfig, cfg); err != nil {
+		failf("%v", err)
+	}
+	if len(cfg.Instances) == 0 {
+		failf("no instances specified")
+	}
+	if cfg.WaveSize <= 0 || cfg.WaveTimeout <= 0 {
+		failf("wave_size and wave_timeout must be positive")
+	}
+	args := flag.Args()
+	if len(args) == 0 {
+		usage()
+	}
+	switch args[0] {
+	case "status":
+		printStatus(cfg.Instances)
+	case "pause", "resume":
+		for _, inst := range selectInstances(cfg, args[1:]) {
+			if err := inst.control(args[0], nil); err != nil {
+				failf("%v", err)
+			}
+		}
+	case "push-config":
+		if len(args) != 3 {
+			usage()
+		}
+		data, err := ioutil.ReadFile(args[2])
+		if err != nil {
+			failf("failed to read config: %v", err)
+		}
+		if err := selectInstances(cfg, args[1:2])[0].control("config", data); err != nil {
+			failf("%v", err)
+		}
+	case "update":
+		if err := rollout(cfg); err != nil {
+			failf("%v", err)
+		}
+	default:
+		usage()
+	}
+}
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "usage:\n")
+	fmt.Fprintf(os.Stderr, "  syz-fleet -config=fleet.cfg status\n")
+	fmt.Fprintf(os.Stderr, "  syz-fleet -config=fleet.cfg pause|resume [instance...]\n")
+	fmt.Fprintf(os.Stderr, "  syz-fleet -config=fleet.cfg push-config instance syz-ci.cfg\n")
+	fmt.Fprintf(os.Stderr, "  syz-fleet -config=fleet.cfg update\n")
+	os.Exit(1)
+}
+
+// selectInstances returns instances with the given names, or all instances if names are empty.
+func selectInstances(cfg *Config, names []string) []*Instance {
+	if len(names) == 0 {
+		return cfg.Instances
+	}
+	var res []*Instance
+	for _, name := range names {
+		found := false
+		for _, inst := range cfg.Instances {
+			if inst.Name == name {
+				res = append(res, inst)
+				found = true
+			}
+		}
+		if !found {
+			failf("unknown instance %v", name)
+		}
+	}
+	return res
+}
+
+func printStatus(instances []*Instance) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "INSTANCE\tUPTIME\tSYZKALLER\tMANAGER\tKERNEL\tMANAGER UPTIME\tSTATE\n")
+	for _, inst := range instances {
+		status, err := inst.status()
+		if err != nil {
+			fmt.Fprintf(w, "%v\t\t\t\t\t\tdown: %v\n", inst.Name, err)
+			continue
+		}
+		for _, mgr := range status.Managers {
+			state := "running"
+			switch {
+			case mgr.Paused:
+				state = "paused"
+			case mgr.UpTime == 0:
+				state = "not running"
+			}
+			if mgr.LastBuildError != "" {
+				state += ", build failing"
+			}
+			fmt.Fprintf(w, "%v\t%v\t%.12v\t%v\t%.12v\t%v\t%v\n", inst.Name, status.UpTime.Truncate(time.Minute),
+				status.SyzkallerCommit, mgr.Name, mgr.KernelCommit, mgr.UpTime.Truncate(time.Minute), state)
+		}
+	}
+	w.Flush()
+}
+
+// rollout updates syzkaller on all instances in waves.
+func rollout(cfg *Config) error {
+	for start := 0; start < len(cfg.Instances); start += cfg.WaveSize {
+		end := start + cfg.WaveSize
+		if end > len(cfg.Instances) {
+			end = len(cfg.Instances)
+		}
+		wave := cfg.Instances[start:end]
+		commits := make(map[*Instance]string)
+		for _, inst := range wave {
+			status, err := inst.status()
+			if err != nil {
+				return err
+			}
+			commits[inst] = status.SyzkallerCommit
+			fmt.Printf("updating %v (currently on %v)\n", inst.Name, status.SyzkallerCommit)
+			if err := inst.control("update", nil); err != nil {
+				return err
+			}
+		}
+		deadline := time.Now().Add(time.Duration(cfg.WaveTimeout) * time.Minute)
+		for _, inst := range wave {
+			for {
+				status, err := inst.status()
+				if err == nil && status.SyzkallerCommit != commits[inst] && healthy(status) {
+					fmt.Printf("%v is updated to %v\n", inst.Name, status.SyzkallerCommit)
+					break
+				}
+				if time.Now().After(deadline) {
+					return fmt.Errorf("%v did not become healthy after update in %v minutes, stopping rollout",
+						inst.Name, cfg.WaveTimeout)
+				}
+				time.Sleep(time.Minute)
+			}
+		}
+	}
+	return nil
+}
+
+func healthy(status *dashapi.CIHeartbeat) bool {
+	for _, mgr := range status.Managers {
+		if !mgr.Paused && mgr.UpTime == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func (inst *Instance) status() (*dashapi.CIHeartbeat, error) {
+	resp, err := http.Get(fmt.Sprintf("http://%v/status", inst.Addr))
+	if err != nil {
+		return nil, fmt.Errorf("%v: %v", inst.Name, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%v: status request failed: %v", inst.Name, resp.Status)
+	}
+	status := new(dashapi.CIHeartbeat)
+	if err := json.NewDecoder(resp.Body).Decode(status); err != nil {
+		return nil, fmt.Errorf("%v: failed to parse status: %v", inst.Name, err)
+	}
+	return status, nil
+}
+
+func (inst *Instance) control(method string, body []byte) error {
+	addr := fmt.Sprintf("http://%v/control/%v?key=%v", inst.Addr, method, url.QueryEscape(inst.Key))
+	resp, err := http.Post(addr, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("%v: %v", inst.Name, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("%v: %v failed: %v: %s", inst.Name, method, resp.Status, bytes.TrimSpace(msg))
+	}
+	fmt.Printf("%v: %v: OK\n", inst.Name, method)
+	return nil
+}
+
+func failf(msg string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, msg+"\n", args...)
+	os.Exit(1)
+}
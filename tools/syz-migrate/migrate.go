@@ -0,0 +1,323 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+// syz-migrate rewrites corpus programs across syscall description changes
+// that would otherwise make the programs unparsable (e.g. renamed syscalls or reordered arguments).
+// Usage:
+//
+//	syz-migrate -os=linux -arch=amd64 -rules=rules.json corpus.db new-corpus.db
+//
+// The rules file describes changes between two description revisions:
+//
+//	{
+//		"from_revision": "1f2a...",
+//		"to_revision": "8c3d...",
+//		"calls": {
+//			"foo$old": {"name": "foo$new"},
+//			"bar": {"args": [1, 0, -1]},
+//			"baz": {"remove": true}
+//		},
+//		"union_options": {
+//			"old_option": "new_option"
+//		}
+//	}
+//
+// "args" specifies index of the old argument for each argument of the new call,
+// -1 means that the argument is new and gets the default value.
+// References to results of removed calls are replaced with default values.
+// Flags don't need migration since programs store their numeric values.
+// Rewritten programs are parsed with the current descriptions (to_revision),
+// programs that still fail to parse are reported and not included into the new corpus.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/google/syzkaller/pkg/config"
+	"github.com/google/syzkaller/pkg/crypt"
+	"github.com/google/syzkaller/pkg/db"
+	"github.com/google/syzkaller/pkg/hash"
+	"github.com/google/syzkaller/prog"
+	_ "github.com/google/syzkaller/sys"
+)
+
+type Rules struct {
+	FromRevision string               `json:"from_revision"`
+	ToRevision   string               `json:"to_revision"`
+	Calls        map[string]*CallRule `json:"calls"`
+	UnionOptions map[string]string    `json:"union_options"`
+}
+
+type CallRule struct {
+	Name   string `json:"name"`
+	Args   []int  `json:"args"`
+	Remove bool   `json:"remove"`
+}
+
+func main() {
+	var (
+		flagOS    = flag.String("os", "", "target OS")
+		flagArch  = flag.String("arch", "", "target arch")
+		flagRules = flag.String("rules", "", "migration rules file")
+		flagKey   = flag.String("key", "", "file with database encryption key (manager workdir_key)")
+		flagForce = flag.Bool("force", false, "migrate even if descriptions revision does not match to_revision")
+	)
+	flag.Parse()
+	args := flag.Args()
+	if len(args) != 2 || *flagRules == "" {
+		fmt.Fprintf(os.Stderr, "usage: syz-migrate -os=linux -arch=amd64 -rules=rules.json corpus.db new-corpus.db\n")
+		os.Exit(1)
+	}
+	target, err := prog.GetTarget(*flagOS, *flagArch)
+	if err != nil {
+		failf("failed to find target: %v", err)
+	}
+	rules := new(Rules)
+	if err := config.LoadFile(*flagRules, rules); err != nil {
+		failf("%v", err)
+	}
+	if err := checkRules(rules, target); err != nil {
+		failf("bad rules: %v", err)
+	}
+	if rules.ToRevision != "" && rules.ToRevision != target.Revision && !*flagForce {
+		failf("descriptions revision is %v, but rules are for %v (use -force to migrate anyway)",
+			target.Revision, rules.ToRevision)
+	}
+	key, err := crypt.LoadKey(*flagKey, "")
+	if err != nil {
+		failf("%v", err)
+	}
+	oldDB, err := db.OpenEncrypted(args[0], key)
+	if err != nil {
+		failf("failed to open database: %v", err)
+	}
+	os.Remove(args[1])
+	newDB, err := db.OpenEncrypted(args[1], key)
+	if err != nil {
+		failf("failed to open database: %v", err)
+	}
+	if err := newDB.BumpVersion(oldDB.Version); err != nil {
+		failf("failed to bump database version: %v", err)
+	}
+	var keys []string
+	for key := range oldDB.Records {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	unchanged, migrated, failed := 0, 0, 0
+	for _, key := range keys {
+		rec := oldDB.Records[key]
+		data, err := migrate(rec.Val, rules)
+		if err == nil {
+			var p *prog.Prog
+			if p, err = target.Deserialize(data); err == nil {
+				data = p.Serialize()
+			}
+		}
+		if err != nil {
+			fmt.Printf("failed to migrate %v: %v\n%s\n", key, err, rec.Val)
+			failed++
+			continue
+		}
+		if bytes.Equal(data, rec.Val) {
+			unchanged++
+		} else {
+			migrated++
+		}
+		newDB.Save(hash.String(data), data, rec.Seq)
+	}
+	if err := newDB.Flush(); err != nil {
+		failf("failed to save database: %v", err)
+	}
+	fmt.Printf("programs: %v unchanged, %v migrated, %v failed\n", unchanged, migrated, failed)
+}
+
+func checkRules(rules *Rules, target *prog.Target) error {
+	for name, rule := range rules.Calls {
+		if rule.Remove {
+			continue
+		}
+		newName := name
+		if rule.Name != "" {
+			newName = rule.Name
+		}
+		meta := target.SyscallMap[newName]
+		if meta == nil {
+			return fmt.Errorf("call %v: unknown call %v", name, newName)
+		}
+		if len(rule.Args) > len(meta.Args) {
+			return fmt.Errorf("call %v: %v has only %v args", name, newName, len(meta.Args))
+		}
+		for _, idx := range rule.Args {
+			if idx < -1 {
+				return fmt.Errorf("call %v: bad arg index %v", name, idx)
+			}
+		}
+	}
+	return nil
+}
+
+// migrate applies the rules to a serialized program.
+func migrate(data []byte, rules *Rules) ([]byte, error) {
+	buf := new(bytes.Buffer)
+	for i, ln := range strings.Split(string(data), "\n") {
+		if s := strings.TrimSpace(ln); s == "" || s[0] == '#' {
+			// Programs in the versioned format record the revision they were saved with.
+			if header := strings.Fields(s); len(header) == 5 && header[1] == "syz-program" &&
+				rules.FromRevision != "" && header[4] != rules.FromRevision {
+				return nil, fmt.Errorf("program is saved with revision %v, rules are for %v",
+					header[4], rules.FromRevision)
+			}
+			buf.WriteString(ln)
+		} else {
+			ln1, err := migrateCall(s, rules)
+			if err != nil {
+				return nil, fmt.Errorf("line #%v: %v", i+1, err)
+			}
+			if ln1 == "" {
+				continue
+			}
+			buf.WriteString(ln1)
+		}
+		buf.WriteByte('\n')
+	}
+	return bytes.TrimRight(buf.Bytes(), "\n"), nil
+}
+
+// migrateCall rewrites a single call line: [rN = ]name(arg, ...).
+// Returns empty string if the call is removed.
+func migrateCall(ln string, rules *Rules) (string, error) {
+	ret := ""
+	if eq := strings.Index(ln, " = "); eq != -1 && eq < strings.IndexByte(ln, '(') {
+		ret = ln[:eq+3]
+		ln = ln[eq+3:]
+	}
+	paren := strings.IndexByte(ln, '(')
+	if paren == -1 || ln[len(ln)-1] != ')' {
+		return "", fmt.Errorf("bad call %q", ln)
+	}
+	name := ln[:paren]
+	args, err := splitArgs(ln[paren+1 : len(ln)-1])
+	if err != nil {
+		return "", err
+	}
+	if rule := rules.Calls[name]; rule != nil {
+		if rule.Remove {
+			return "", nil
+		}
+		if rule.Name != "" {
+			name = rule.Name
+		}
+		if rule.Args != nil {
+			var newArgs []string
+			for _, idx := range rule.Args {
+				arg := "0x0" // replaced with the default value during deserialization
+				if idx >= 0 && idx < len(args) {
+					arg = args[idx]
+				}
+				newArgs = append(newArgs, arg)
+			}
+			args = newArgs
+		}
+	}
+	for i, arg := range args {
+		args[i] = renameUnionOptions(arg, rules.UnionOptions)
+	}
+	return fmt.Sprintf("%v%v(%v)", ret, name, strings.Join(args, ", ")), nil
+}
+
+// splitArgs splits call arguments on top-level commas.
+func splitArgs(s string) ([]string, error) {
+	if strings.TrimSpace(s) == "" {
+		return nil, nil
+	}
+	var args []string
+	depth, start := 0, 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '(', '[', '{':
+			depth++
+		case ')', ']', '}':
+			depth--
+			if depth < 0 {
+				return nil, fmt.Errorf("unbalanced brackets in %q", s)
+			}
+		case '\'', '"':
+			end, err := skipString(s, i)
+			if err != nil {
+				return nil, err
+			}
+			i = end
+		case ',':
+			if depth == 0 {
+				args = append(args, strings.TrimSpace(s[start:i]))
+				start = i + 1
+			}
+		}
+	}
+	if depth != 0 {
+		return nil, fmt.Errorf("unbalanced brackets in %q", s)
+	}
+	return append(args, strings.TrimSpace(s[start:])), nil
+}
+
+// skipString returns index of the closing quote of the string literal starting at s[start].
+func skipString(s string, start int) (int, error) {
+	quote := s[start]
+	for i := start + 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case quote:
+			return i, nil
+		}
+	}
+	return 0, fmt.Errorf("unterminated string in %q", s)
+}
+
+// renameUnionOptions replaces union option names (@name) outside of string literals.
+func renameUnionOptions(arg string, renames map[string]string) string {
+	if len(renames) == 0 || !strings.Contains(arg, "@") {
+		return arg
+	}
+	buf := new(bytes.Buffer)
+	for i := 0; i < len(arg); i++ {
+		switch arg[i] {
+		case '\'', '"':
+			end, err := skipString(arg, i)
+			if err != nil {
+				return arg
+			}
+			buf.WriteString(arg[i : end+1])
+			i = end
+		case '@':
+			end := i + 1
+			for end < len(arg) && isIdentChar(arg[end]) {
+				end++
+			}
+			option := arg[i+1 : end]
+			if newOption, ok := renames[option]; ok {
+				option = newOption
+			}
+			buf.WriteString("@" + option)
+			i = end - 1
+		default:
+			buf.WriteByte(arg[i])
+		}
+	}
+	return buf.String()
+}
+
+func isIdentChar(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '_'
+}
+
+func failf(msg string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, msg+"\n", args...)
+	os.Exit(1)
+}
@@ -0,0 +1,204 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package main
+
+import (
+	"debug/dwarf"
+	"debug/elf"
+	"encoding/binary"
+	"fmt"
+)
+
+// kernel provides access to static data of a vmlinux binary:
+// global variables with their DWARF types and initial contents of their memory.
+type kernel struct {
+	file    *elf.File
+	dwarf   *dwarf.Data
+	order   binary.ByteOrder
+	ptrSize int
+	vars    map[uint64]*variable // keyed by address
+	enums   map[string]int64     // all enumerators
+}
+
+type variable struct {
+	name   string
+	addr   uint64
+	typOff dwarf.Offset
+}
+
+const dwOpAddr = 0x3
+
+func openKernel(vmlinux string) (*kernel, error) {
+	file, err := elf.Open(vmlinux)
+	if err != nil {
+		return nil, err
+	}
+	data, err := file.DWARF()
+	if err != nil {
+		file.Close()
+		return nil, fmt.Errorf("failed to read DWARF (is kernel built with CONFIG_DEBUG_INFO?): %v", err)
+	}
+	k := &kernel{
+		file:    file,
+		dwarf:   data,
+		order:   file.ByteOrder,
+		ptrSize: 8,
+		vars:    make(map[uint64]*variable),
+		enums:   make(map[string]int64),
+	}
+	if file.Class == elf.ELFCLASS32 {
+		k.ptrSize = 4
+	}
+	r := data.Reader()
+	for {
+		e, err := r.Next()
+		if err != nil {
+			file.Close()
+			return nil, fmt.Errorf("failed to read DWARF: %v", err)
+		}
+		if e == nil {
+			break
+		}
+		switch e.Tag {
+		case dwarf.TagVariable:
+			if err := k.addVariable(e); err != nil {
+				file.Close()
+				return nil, err
+			}
+		case dwarf.TagEnumerator:
+			name, _ := e.Val(dwarf.AttrName).(string)
+			if val, ok := e.Val(dwarf.AttrConstValue).(int64); ok && name != "" {
+				k.enums[name] = val
+			}
+		}
+	}
+	return k, nil
+}
+
+func (k *kernel) close() {
+	k.file.Close()
+}
+
+func (k *kernel) addVariable(e *dwarf.Entry) error {
+	loc, ok := e.Val(dwarf.AttrLocation).([]byte)
+	if !ok || len(loc) != 1+k.ptrSize || loc[0] != dwOpAddr {
+		return nil
+	}
+	var addr uint64
+	if k.ptrSize == 8 {
+		addr = k.order.Uint64(loc[1:])
+	} else {
+		addr = uint64(k.order.Uint32(loc[1:]))
+	}
+	// Definitions of global variables frequently refer to a separate declaration
+	// entry that holds name and type.
+	decl := e
+	if spec, ok := e.Val(dwarf.AttrSpecification).(dwarf.Offset); ok {
+		r := k.dwarf.Reader()
+		r.Seek(spec)
+		var err error
+		if decl, err = r.Next(); err != nil || decl == nil {
+			return fmt.Errorf("failed to read DWARF entry at 0x%x: %v", spec, err)
+		}
+	}
+	name, _ := decl.Val(dwarf.AttrName).(string)
+	typOff, ok := decl.Val(dwarf.AttrType).(dwarf.Offset)
+	if name == "" || !ok {
+		return nil
+	}
+	k.vars[addr] = &variable{name: name, addr: addr, typOff: typOff}
+	return nil
+}
+
+func (k *kernel) varType(v *variable) (dwarf.Type, error) {
+	return k.dwarf.Type(v.typOff)
+}
+
+// read returns size bytes of initial memory contents at addr.
+func (k *kernel) read(addr uint64, size int64) ([]byte, error) {
+	for _, s := range k.file.Sections {
+		if s.Flags&elf.SHF_ALLOC == 0 || s.Type == elf.SHT_NOBITS ||
+			addr < s.Addr || addr+uint64(size) > s.Addr+s.Size {
+			continue
+		}
+		buf := make([]byte, size)
+		if _, err := s.ReadAt(buf, int64(addr-s.Addr)); err != nil {
+			return nil, fmt.Errorf("failed to read 0x%x from %v: %v", addr, s.Name, err)
+		}
+		return buf, nil
+	}
+	return nil, fmt.Errorf("address 0x%x is not in a loaded section", addr)
+}
+
+func (k *kernel) readInt(addr uint64, size int64) (uint64, error) {
+	buf, err := k.read(addr, size)
+	if err != nil {
+		return 0, err
+	}
+	switch size {
+	case 1:
+		return uint64(buf[0]), nil
+	case 2:
+		return uint64(k.order.Uint16(buf)), nil
+	case 4:
+		return uint64(k.order.Uint32(buf)), nil
+	case 8:
+		return k.order.Uint64(buf), nil
+	}
+	return 0, fmt.Errorf("bad int size %v at 0x%x", size, addr)
+}
+
+// readField reads integer or pointer field name of struct typ located at addr.
+// Returns ok=false if the struct does not have such field.
+func (k *kernel) readField(addr uint64, typ *dwarf.StructType, name string) (uint64, bool, error) {
+	off, ftyp, ok := field(typ, name)
+	if !ok {
+		return 0, false, nil
+	}
+	size := ftyp.Size()
+	if _, ok := ftyp.(*dwarf.PtrType); ok {
+		size = int64(k.ptrSize)
+	}
+	v, err := k.readInt(addr+uint64(off), size)
+	return v, true, err
+}
+
+// field returns offset and type of field name of struct typ.
+// Fields of anonymous unions and structs are considered as well.
+func field(typ *dwarf.StructType, name string) (int64, dwarf.Type, bool) {
+	for _, f := range typ.Field {
+		ftyp := underlying(f.Type)
+		if f.Name == name {
+			return f.ByteOffset, ftyp, true
+		}
+		if inner, ok := ftyp.(*dwarf.StructType); ok && f.Name == "" {
+			if off, t, ok := field(inner, name); ok {
+				return f.ByteOffset + off, t, true
+			}
+		}
+	}
+	return 0, nil, false
+}
+
+// underlying strips typedefs and qualifiers from typ.
+func underlying(typ dwarf.Type) dwarf.Type {
+	for {
+		switch t := typ.(type) {
+		case *dwarf.TypedefType:
+			typ = t.Type
+		case *dwarf.QualType:
+			typ = t.Type
+		default:
+			return typ
+		}
+	}
+}
+
+func structType(typ dwarf.Type, name string) (*dwarf.StructType, bool) {
+	st, ok := underlying(typ).(*dwarf.StructType)
+	if !ok || st.StructName != name || st.Incomplete {
+		return nil, false
+	}
+	return st, true
+}
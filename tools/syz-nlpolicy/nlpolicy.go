@@ -0,0 +1,449 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+// syz-nlpolicy extracts generic netlink families and their attribute policies (struct nla_policy)
+// from DWARF debug info of vmlinux and generates syscall descriptions for families
+// that don't have hand-written descriptions yet.
+// Usage:
+//
+//	syz-nlpolicy -vmlinux vmlinux > sys/linux/socket_netlink_generic_autogen.txt
+//	make generate
+package main
+
+import (
+	"bytes"
+	"debug/dwarf"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/google/syzkaller/pkg/ast"
+)
+
+var (
+	flagVmlinux  = flag.String("vmlinux", "", "path to vmlinux with debug info")
+	flagDescs    = flag.String("descriptions", "sys/linux", "dir with existing descriptions (families described there are skipped)")
+	flagFamilies = flag.String("families", "", "comma-separated list of families to generate (all by default)")
+)
+
+const prefix = "nlgen_"
+
+func main() {
+	flag.Parse()
+	if *flagVmlinux == "" {
+		flag.PrintDefaults()
+		os.Exit(1)
+	}
+	k, err := openKernel(*flagVmlinux)
+	if err != nil {
+		failf("%v", err)
+	}
+	defer k.close()
+	skip, err := describedFamilies(*flagDescs)
+	if err != nil {
+		failf("%v", err)
+	}
+	families, err := extractFamilies(k)
+	if err != nil {
+		failf("%v", err)
+	}
+	allowed := make(map[string]bool)
+	for _, name := range strings.Split(*flagFamilies, ",") {
+		if name != "" {
+			allowed[name] = true
+		}
+	}
+	g, err := newGenerator(k)
+	if err != nil {
+		failf("%v", err)
+	}
+	for _, fam := range families {
+		if skip[fam.name] || len(allowed) != 0 && !allowed[fam.name] {
+			continue
+		}
+		g.family(fam)
+	}
+	if g.generated == 0 {
+		failf("no families to generate")
+	}
+	out := new(bytes.Buffer)
+	fmt.Fprintf(out, "# AUTOGENERATED by syz-nlpolicy from netlink policies in vmlinux. Do not edit.\n")
+	fmt.Fprintf(out, "# Families with hand-written descriptions in %v are skipped.\n\n", *flagDescs)
+	out.Write(g.out.Bytes())
+	var errs []string
+	desc := ast.Parse(out.Bytes(), "nlpolicy", func(pos ast.Pos, msg string) {
+		errs = append(errs, fmt.Sprintf("%v: %v", pos, msg))
+	})
+	if desc == nil {
+		failf("generated bad descriptions:\n%v", strings.Join(errs, "\n"))
+	}
+	os.Stdout.Write(ast.Format(desc))
+	fmt.Fprintf(os.Stderr, "generated %v families, skipped %v\n", g.generated, len(families)-g.generated)
+}
+
+var familyRe = regexp.MustCompile(`syz_genetlink_get_family_id\$\w+\(name ptr\[in, string\["([^"]+)"\]\]`)
+
+// describedFamilies returns names of families that have hand-written descriptions in dir.
+func describedFamilies(dir string) (map[string]bool, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.txt"))
+	if err != nil {
+		return nil, err
+	}
+	families := make(map[string]bool)
+	for _, file := range files {
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		if bytes.HasPrefix(data, []byte("# AUTOGENERATED")) {
+			continue
+		}
+		for _, match := range familyRe.FindAllSubmatch(data, -1) {
+			families[string(match[1])] = true
+		}
+	}
+	return families, nil
+}
+
+type family struct {
+	name    string
+	policy  uint64 // family-wide policy, if any
+	maxattr int
+	ops     []op
+}
+
+type op struct {
+	cmd    int
+	policy uint64 // per-op policy (older kernels), if any
+}
+
+// extractFamilies finds all static struct genl_family variables in the kernel.
+func extractFamilies(k *kernel) ([]*family, error) {
+	var families []*family
+	for _, v := range k.vars {
+		typ, err := k.varType(v)
+		if err != nil {
+			return nil, err
+		}
+		st, ok := structType(typ, "genl_family")
+		if !ok {
+			continue
+		}
+		fam, err := extractFamily(k, v.addr, st)
+		if err != nil {
+			return nil, fmt.Errorf("family %v: %v", v.name, err)
+		}
+		if fam != nil {
+			families = append(families, fam)
+		}
+	}
+	sort.Slice(families, func(i, j int) bool {
+		return families[i].name < families[j].name
+	})
+	return families, nil
+}
+
+func extractFamily(k *kernel, addr uint64, st *dwarf.StructType) (*family, error) {
+	off, ntyp, ok := field(st, "name")
+	if !ok {
+		return nil, fmt.Errorf("genl_family does not have name")
+	}
+	name, err := k.read(addr+uint64(off), ntyp.Size())
+	if err != nil {
+		return nil, err
+	}
+	if n := bytes.IndexByte(name, 0); n != -1 {
+		name = name[:n]
+	}
+	if len(name) == 0 {
+		return nil, nil
+	}
+	fam := &family{name: string(name)}
+	if fam.policy, _, err = k.readField(addr, st, "policy"); err != nil {
+		return nil, err
+	}
+	maxattr, _, err := k.readField(addr, st, "maxattr")
+	if err != nil {
+		return nil, err
+	}
+	fam.maxattr = int(maxattr)
+	for _, ops := range [][2]string{{"ops", "n_ops"}, {"small_ops", "n_small_ops"}} {
+		ptr, ok, err := k.readField(addr, st, ops[0])
+		if err != nil {
+			return nil, err
+		}
+		n, _, err := k.readField(addr, st, ops[1])
+		if !ok || err != nil || ptr == 0 {
+			continue
+		}
+		_, ptyp, _ := field(st, ops[0])
+		pt, ok := ptyp.(*dwarf.PtrType)
+		if !ok {
+			return nil, fmt.Errorf("%v is not a pointer", ops[0])
+		}
+		opType, ok := underlying(pt.Type).(*dwarf.StructType)
+		if !ok {
+			return nil, fmt.Errorf("%v does not point to a struct", ops[0])
+		}
+		for i := uint64(0); i < n; i++ {
+			opAddr := ptr + i*uint64(opType.Size())
+			cmd, _, err := k.readField(opAddr, opType, "cmd")
+			if err != nil {
+				return nil, err
+			}
+			policy, _, err := k.readField(opAddr, opType, "policy")
+			if err != nil {
+				return nil, err
+			}
+			fam.ops = append(fam.ops, op{int(cmd), policy})
+		}
+	}
+	return fam, nil
+}
+
+type generator struct {
+	k         *kernel
+	nla       map[int64]string   // NLA_* type value -> name
+	enums     map[int64][]string // enumerator value -> names
+	out       *bytes.Buffer
+	policies  map[uint64]string // policy address -> generated union name
+	inflight  map[uint64]bool
+	names     map[string]bool
+	generated int
+}
+
+func newGenerator(k *kernel) (*generator, error) {
+	g := &generator{
+		k:        k,
+		nla:      make(map[int64]string),
+		enums:    make(map[int64][]string),
+		out:      new(bytes.Buffer),
+		policies: make(map[uint64]string),
+		inflight: make(map[uint64]bool),
+		names:    make(map[string]bool),
+	}
+	// Values of NLA_* types change between kernel versions, so take them from DWARF.
+	for _, name := range nlaTypes {
+		if val, ok := k.enums[name]; ok {
+			g.nla[val] = name
+		}
+	}
+	if _, ok := k.enums["NLA_U8"]; !ok {
+		return nil, fmt.Errorf("no NLA_* enum in DWARF")
+	}
+	for name, val := range k.enums {
+		g.enums[val] = append(g.enums[val], name)
+	}
+	return g, nil
+}
+
+var nlaTypes = []string{
+	"NLA_UNSPEC", "NLA_U8", "NLA_U16", "NLA_U32", "NLA_U64", "NLA_STRING", "NLA_FLAG", "NLA_MSECS",
+	"NLA_NESTED", "NLA_NESTED_ARRAY", "NLA_NESTED_COMPAT", "NLA_NUL_STRING", "NLA_BINARY",
+	"NLA_S8", "NLA_S16", "NLA_S32", "NLA_S64", "NLA_BITFIELD32", "NLA_REJECT", "NLA_BE16", "NLA_BE32",
+}
+
+func (g *generator) family(fam *family) {
+	if len(fam.ops) == 0 {
+		return
+	}
+	id := ident(fam.name)
+	resource := prefix + id + "_family_id"
+	fmt.Fprintf(g.out, "# %v\n\n", fam.name)
+	fmt.Fprintf(g.out, "resource %v[int16]\n", resource)
+	fmt.Fprintf(g.out, "syz_genetlink_get_family_id$%v%v(name ptr[in, string[%q]]) %v\n",
+		prefix, id, fam.name, resource)
+	cmdNames := make(map[string]bool)
+	var body bytes.Buffer
+	for _, op := range fam.ops {
+		policy := op.policy
+		if policy == 0 {
+			policy = fam.policy
+		}
+		attrs := "nl_generic_attr"
+		if policy != 0 {
+			if name := g.policy(fam, policy, fam.maxattr, true); name != "" {
+				attrs = name
+			}
+		}
+		cmd := g.enumName(fam, "_CMD_", int64(op.cmd))
+		if cmd == "" {
+			cmd = fmt.Sprintf("%v%v_cmd%v", prefix, id, op.cmd)
+		}
+		if cmdNames[cmd] {
+			continue
+		}
+		cmdNames[cmd] = true
+		fmt.Fprintf(&body, "sendmsg$%v(fd sock_nl_generic, msg ptr[in, msghdr_netlink[netlink_msg_t[%v, genlmsghdr_t[%v], %v]]], f flags[send_flags])\n",
+			cmd, resource, op.cmd, attrs)
+	}
+	g.out.Write(body.Bytes())
+	g.out.WriteString("\n")
+	g.generated++
+}
+
+// policy generates a union for the policy array at addr and returns its name.
+// Returns empty string if the policy can't be described.
+// Attribute names are taken from the family enum (e.g. FOU_ATTR_PORT) only for top-level policies,
+// nested policies use own enums which we can't reliably match.
+func (g *generator) policy(fam *family, addr uint64, maxattr int, top bool) string {
+	if name, ok := g.policies[addr]; ok {
+		return name
+	}
+	if g.inflight[addr] {
+		// Recursive policies can't be described directly.
+		return ""
+	}
+	v := g.k.vars[addr]
+	if v == nil {
+		return ""
+	}
+	typ, err := g.k.varType(v)
+	if err != nil {
+		return ""
+	}
+	arr, ok := underlying(typ).(*dwarf.ArrayType)
+	if !ok {
+		return ""
+	}
+	elem, ok := structType(arr.Type, "nla_policy")
+	if !ok {
+		return ""
+	}
+	count := int(arr.Count)
+	if count <= 0 {
+		count = maxattr + 1
+	}
+	g.inflight[addr] = true
+	var fields []string
+	for i := 0; i < count; i++ {
+		entry := addr + uint64(i)*uint64(elem.Size())
+		attr := g.attr(fam, entry, elem)
+		if attr == "" {
+			continue
+		}
+		fname := ""
+		if top {
+			fname = g.enumName(fam, "_ATTR_", int64(i))
+		}
+		if fname == "" {
+			fname = fmt.Sprintf("attr%v", i)
+		}
+		fields = append(fields, fmt.Sprintf("\t%v\tnlattr[%v, %v]\n", fname, i, attr))
+	}
+	delete(g.inflight, addr)
+	if len(fields) == 0 {
+		return ""
+	}
+	name := prefix + ident(v.name)
+	for i := 1; g.names[name]; i++ {
+		name = fmt.Sprintf("%v%v_%v", prefix, ident(v.name), i)
+	}
+	g.names[name] = true
+	g.policies[addr] = name
+	fmt.Fprintf(g.out, "%v [\n", name)
+	for _, f := range fields {
+		g.out.WriteString(f)
+	}
+	fmt.Fprintf(g.out, "] [varlen]\n\n")
+	return name
+}
+
+// attr returns payload type for the nla_policy entry at addr.
+func (g *generator) attr(fam *family, addr uint64, elem *dwarf.StructType) string {
+	typ, _, err := g.k.readField(addr, elem, "type")
+	if err != nil {
+		return ""
+	}
+	size, _, err := g.k.readField(addr, elem, "len")
+	if err != nil {
+		return ""
+	}
+	nested := uint64(0)
+	for _, name := range []string{"nested_policy", "validation_data"} {
+		if v, ok, err := g.k.readField(addr, elem, name); ok && err == nil {
+			nested = v
+			break
+		}
+	}
+	switch g.nla[int64(typ)] {
+	case "NLA_UNSPEC":
+		if size == 0 {
+			// Unused entry.
+			return ""
+		}
+		return fmt.Sprintf("array[int8, %v]", size)
+	case "NLA_U8", "NLA_S8":
+		return "int8"
+	case "NLA_U16", "NLA_S16":
+		return "int16"
+	case "NLA_U32", "NLA_S32":
+		return "int32"
+	case "NLA_U64", "NLA_S64", "NLA_MSECS", "NLA_BITFIELD32":
+		return "int64"
+	case "NLA_BE16":
+		return "int16be"
+	case "NLA_BE32":
+		return "int32be"
+	case "NLA_FLAG":
+		return "void"
+	case "NLA_STRING", "NLA_NUL_STRING":
+		return "string"
+	case "NLA_BINARY":
+		if size != 0 {
+			return fmt.Sprintf("array[int8, 0:%v]", size)
+		}
+		return "array[int8]"
+	case "NLA_NESTED", "NLA_NESTED_COMPAT":
+		if nested != 0 {
+			if name := g.policy(fam, nested, 0, false); name != "" {
+				return fmt.Sprintf("array[%v]", name)
+			}
+		}
+		return "array[nl_generic_attr]"
+	case "NLA_NESTED_ARRAY":
+		if nested != 0 {
+			if name := g.policy(fam, nested, 0, false); name != "" {
+				return fmt.Sprintf("array[nlattr_anytype[array[%v]]]", name)
+			}
+		}
+		return "array[nl_generic_attr]"
+	case "NLA_REJECT":
+		return ""
+	default:
+		return "array[int8]"
+	}
+}
+
+// enumName tries to find name of a command/attribute with value val of the family,
+// e.g. FOU_CMD_ADD or FOU_ATTR_PORT. Returns empty string if there is no unique match.
+func (g *generator) enumName(fam *family, kind string, val int64) string {
+	prefix := strings.ToUpper(ident(fam.name)) + kind
+	res := ""
+	for _, name := range g.enums[val] {
+		if !strings.HasPrefix(name, prefix) || strings.HasSuffix(name, "_MAX") {
+			continue
+		}
+		if res != "" {
+			return ""
+		}
+		res = name
+	}
+	return res
+}
+
+var identRe = regexp.MustCompile("[^a-zA-Z0-9_]")
+
+func ident(name string) string {
+	return identRe.ReplaceAllString(name, "_")
+}
+
+func failf(msg string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, msg+"\n", args...)
+	os.Exit(1)
+}
@@ -27,6 +27,7 @@ var (
 	flagProg       = flag.String("prog", "", "file with program to convert (required)")
 	flagFaultCall  = flag.Int("fault_call", -1, "inject fault into this call (0-based)")
 	flagFaultNth   = flag.Int("fault_nth", 0, "inject fault on n-th operation (0-based)")
+	flagCreds      = flag.String("creds", "", "credential context to execute in (e.g. uid=65534/nnp)")
 	flagEnableTun  = flag.Bool("tun", false, "set up TUN/TAP interface")
 	flagUseTmpDir  = flag.Bool("tmpdir", false, "create a temporary dir and execute inside it")
 	flagCgroups    = flag.Bool("cgroups", false, "enable cgroups support")
@@ -67,6 +68,7 @@ func main() {
 		Fault:         *flagFaultCall >= 0,
 		FaultCall:     *flagFaultCall,
 		FaultNth:      *flagFaultNth,
+		Creds:         *flagCreds,
 		EnableTun:     *flagEnableTun,
 		UseTmpDir:     *flagUseTmpDir,
 		EnableCgroups: *flagCgroups,
@@ -70,7 +70,11 @@ func main() {
 		return
 	}
 
-	fmt.Printf("opts: %+v crepro: %v\n\n", res.Opts, res.CRepro)
+	fmt.Printf("opts: %+v crepro: %v\n", res.Opts, res.CRepro)
+	if res.Cmdline != "" {
+		fmt.Printf("kernel command line: %v\n", res.Cmdline)
+	}
+	fmt.Printf("\n")
 	fmt.Printf("%s\n", res.Prog.Serialize())
 	if res.CRepro {
 		src, err := csource.Write(res.Prog, res.Opts)
@@ -0,0 +1,77 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+// syz-trace2syz converts strace/perf script syscall traces into syzkaller programs.
+// The programs are printed or saved into a directory that can be packed into corpus.db with syz-db.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+
+	"github.com/google/syzkaller/pkg/hash"
+	"github.com/google/syzkaller/pkg/osutil"
+	"github.com/google/syzkaller/pkg/trace2syz"
+	"github.com/google/syzkaller/prog"
+	_ "github.com/google/syzkaller/sys"
+)
+
+var (
+	flagOS    = flag.String("os", runtime.GOOS, "target os")
+	flagArch  = flag.String("arch", runtime.GOARCH, "target arch")
+	flagCalls = flag.Int("calls", 30, "max number of calls in programs")
+	flagOut   = flag.String("out", "", "save programs into this dir (print if empty)")
+)
+
+func main() {
+	flag.Parse()
+	if flag.NArg() == 0 {
+		fmt.Fprintf(os.Stderr, "usage: syz-trace2syz [flags] trace-file...\n")
+		flag.PrintDefaults()
+		os.Exit(1)
+	}
+	target, err := prog.GetTarget(*flagOS, *flagArch)
+	if err != nil {
+		failf("%v", err)
+	}
+	if *flagOut != "" {
+		if err := osutil.MkdirAll(*flagOut); err != nil {
+			failf("failed to create output dir: %v", err)
+		}
+	}
+	consts := trace2syz.Consts(target)
+	for _, file := range flag.Args() {
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			failf("failed to read trace: %v", err)
+		}
+		traces, err := trace2syz.Parse(data, consts)
+		if err != nil {
+			failf("failed to parse %v: %v", file, err)
+		}
+		for _, trace := range traces {
+			progs, skipped := trace2syz.Convert(target, trace, nil, *flagCalls)
+			fmt.Fprintf(os.Stderr, "%v: pid %v: %v calls, %v programs, %v calls skipped\n",
+				file, trace.Pid, len(trace.Calls), len(progs), skipped)
+			for _, p := range progs {
+				data := p.Serialize()
+				if *flagOut == "" {
+					fmt.Printf("%s\n", data)
+					continue
+				}
+				if err := osutil.WriteFile(filepath.Join(*flagOut, hash.String(data)), data); err != nil {
+					failf("failed to write program: %v", err)
+				}
+			}
+		}
+	}
+}
+
+func failf(msg string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, msg+"\n", args...)
+	os.Exit(1)
+}
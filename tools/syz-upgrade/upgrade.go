@@ -40,9 +40,13 @@ func main() {
 		if err != nil {
 			fatalf("failed to read program: %v", err)
 		}
-		p, err := target.Deserialize(data)
+		// Calls that were removed from descriptions are dropped.
+		p, warnings, err := target.DeserializeNonStrict(data)
 		if err != nil {
-			fatalf("failed to deserialize program: %v", err)
+			fatalf("failed to deserialize program %v: %v", f.Name(), err)
+		}
+		for _, w := range warnings {
+			fmt.Printf("%v: %v\n", f.Name(), w)
 		}
 		data1 := p.Serialize()
 		if bytes.Equal(data, data1) {
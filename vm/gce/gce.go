@@ -15,13 +15,21 @@ import (
 	"archive/tar"
 	"bytes"
 	"compress/gzip"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
+	"net"
 	"os"
 	"path/filepath"
+	"regexp"
+	"sort"
 	"time"
 
+	"github.com/google/syzkaller/pkg/agent"
 	"github.com/google/syzkaller/pkg/config"
 	"github.com/google/syzkaller/pkg/gce"
 	"github.com/google/syzkaller/pkg/gcs"
@@ -40,6 +48,30 @@ type Config struct {
 	MachineType string `json:"machine_type"` // GCE machine type (e.g. "n1-highcpu-2")
 	GCSPath     string `json:"gcs_path"`     // GCS path to upload image
 	GCEImage    string `json:"gce_image"`    // Pre-created GCE image to use
+	// Per-resource project overrides for setups where the bucket/images live in other projects
+	// (e.g. shared VPC or separate billing). By default the project of the current instance is used.
+	ImageProject   string `json:"image_project"`    // project to create/take GCE image in/from
+	GCSUserProject string `json:"gcs_user_project"` // project billed for GCS requests (requester pays buckets)
+	// Use preemptible instances (default true). Preemptible instances are much cheaper,
+	// but GCE can stop them at any time. Preempted instances are re-created
+	// and preemptions are not reported as crashes.
+	Preemptible bool `json:"preemptible"`
+	// Before using a freshly uploaded image for all VMs, boot a single throwaway instance
+	// from the new image and check that it's reachable over ssh and can run syz-executor
+	// (default true). Otherwise a broken image takes down all VMs.
+	SmokeTest bool `json:"smoke_test"`
+	// Number of uploaded image versions to keep (default 2). Images are named name-<hash>
+	// by hash of the image file. If the new image can't be created or fails the smoke test,
+	// the most recent previous version is used instead.
+	ImageRetention int `json:"image_retention"`
+	// Create the image as UEFI bootable (required for arm64 instances), the image needs
+	// to contain an EFI system partition with a bootloader (optional).
+	UEFI bool `json:"uefi"`
+	// Run commands and copy files with the guest agent (syz-agent) instead of ssh (optional).
+	// The agent listens on TCP port 33333 of the instance internal IP, the port needs to be
+	// reachable from the manager instance (the default VPC firewall allows internal traffic).
+	// Connections are authenticated with a random per-instance token.
+	Agent bool `json:"agent"`
 }
 
 type Pool struct {
@@ -58,15 +90,23 @@ type instance struct {
 	gceKey  string // per-instance private ssh key associated with the instance
 	sshKey  string // ssh key
 	sshUser string
+	workdir string
 	closed  chan bool
+	agent   *agent.Client // guest agent connection if Config.Agent is set
 }
 
+// agentTCPPort is the port the guest agent listens on.
+const agentTCPPort = 33333
+
 func ctor(env *vmimpl.Env) (vmimpl.Pool, error) {
 	if env.Name == "" {
 		return nil, fmt.Errorf("config param name is empty (required for GCE)")
 	}
 	cfg := &Config{
-		Count: 1,
+		Count:          1,
+		Preemptible:    true,
+		SmokeTest:      true,
+		ImageRetention: 2,
 	}
 	if err := config.LoadData(env.Config, cfg); err != nil {
 		return nil, fmt.Errorf("failed to parse gce vm config: %v", err)
@@ -80,6 +120,9 @@ func ctor(env *vmimpl.Env) (vmimpl.Pool, error) {
 	if cfg.MachineType == "" {
 		return nil, fmt.Errorf("machine_type parameter is empty")
 	}
+	if cfg.ImageRetention < 1 {
+		return nil, fmt.Errorf("invalid config param image_retention: %v, want >= 1", cfg.ImageRetention)
+	}
 	if cfg.GCEImage == "" && cfg.GCSPath == "" {
 		return nil, fmt.Errorf("gcs_path parameter is empty")
 	}
@@ -89,35 +132,164 @@ func ctor(env *vmimpl.Env) (vmimpl.Pool, error) {
 	if cfg.GCEImage != "" && env.Image != "" {
 		return nil, fmt.Errorf("both image and gce_image are specified")
 	}
+	if cfg.Agent && !osutil.IsExist(env.AgentBin) {
+		return nil, fmt.Errorf("agent is enabled, but %v does not exist", env.AgentBin)
+	}
 
 	GCE, err := gce.NewContext()
 	if err != nil {
 		return nil, fmt.Errorf("failed to init gce: %v", err)
 	}
-	log.Logf(0, "GCE initialized: running on %v, internal IP %v, project %v, zone %v, net %v/%v",
-		GCE.Instance, GCE.InternalIP, GCE.ProjectID, GCE.ZoneID, GCE.Network, GCE.Subnetwork)
+	if cfg.ImageProject != "" {
+		GCE.ImageProject = cfg.ImageProject
+	}
+	log.Logf(0, "GCE initialized: running on %v, internal IP %v, project %v, zone %v, net %v/%v, image project %v",
+		GCE.Instance, GCE.InternalIP, GCE.ProjectID, GCE.ZoneID, GCE.Network, GCE.Subnetwork, GCE.ImageProject)
 
+	pool := &Pool{
+		cfg: cfg,
+		env: env,
+		GCE: GCE,
+	}
 	if cfg.GCEImage == "" {
-		cfg.GCEImage = env.Name
-		gcsImage := filepath.Join(cfg.GCSPath, env.Name+"-image.tar.gz")
-		log.Logf(0, "uploading image to %v...", gcsImage)
-		if err := uploadImageToGCS(env.Image, gcsImage); err != nil {
+		if err := pool.createImage(); err != nil {
 			return nil, err
 		}
-		log.Logf(0, "creating GCE image %v...", cfg.GCEImage)
-		if err := GCE.DeleteImage(cfg.GCEImage); err != nil {
-			return nil, fmt.Errorf("failed to delete GCE image: %v", err)
+	}
+	return pool, nil
+}
+
+// createImage creates a new version of the GCE image (name-<hash>) from the local image file
+// and sets cfg.GCEImage to the image to use. Old versions are deleted only after the new one
+// is successfully created and smoke tested, if anything fails the previous version is used.
+func (pool *Pool) createImage() error {
+	hash, err := fileHash(pool.env.Image)
+	if err != nil {
+		return err
+	}
+	image := fmt.Sprintf("%v-%v", pool.env.Name, hash)
+	versions, err := pool.imageVersions()
+	if err != nil {
+		return err
+	}
+	exists := false
+	for _, version := range versions {
+		if version == image {
+			exists = true
+			break
 		}
-		if err := GCE.CreateImage(cfg.GCEImage, gcsImage); err != nil {
-			return nil, fmt.Errorf("failed to create GCE image: %v", err)
+	}
+	if exists {
+		log.Logf(0, "using existing GCE image %v", image)
+	} else {
+		if err := pool.createImageVersion(image); err != nil {
+			if len(versions) == 0 {
+				return err
+			}
+			prev := versions[len(versions)-1]
+			log.Logf(0, "failed to create GCE image %v: %v", image, err)
+			log.Logf(0, "rolling back to the previous GCE image %v", prev)
+			pool.cfg.GCEImage = prev
+			return nil
+		}
+		versions = append(versions, image)
+	}
+	pool.cfg.GCEImage = image
+	for len(versions) > pool.cfg.ImageRetention {
+		if old := versions[0]; old != image {
+			log.Logf(0, "deleting old GCE image %v", old)
+			if err := pool.GCE.DeleteImage(old); err != nil {
+				log.Logf(0, "failed to delete GCE image %v: %v", old, err)
+			}
 		}
+		versions = versions[1:]
 	}
-	pool := &Pool{
-		cfg: cfg,
-		env: env,
-		GCE: GCE,
+	return nil
+}
+
+func (pool *Pool) createImageVersion(image string) error {
+	gcsImage := filepath.Join(pool.cfg.GCSPath, pool.env.Name+"-image.tar.gz")
+	log.Logf(0, "uploading image to %v...", gcsImage)
+	if err := uploadImageToGCS(pool.env.Image, gcsImage, pool.cfg.GCSUserProject); err != nil {
+		return err
 	}
-	return pool, nil
+	log.Logf(0, "creating GCE image %v...", image)
+	if err := pool.GCE.CreateImage(image, gcsImage, pool.cfg.UEFI); err != nil {
+		return fmt.Errorf("failed to create GCE image: %v", err)
+	}
+	if pool.cfg.SmokeTest {
+		if err := pool.smokeTestImage(image); err != nil {
+			pool.GCE.DeleteImage(image)
+			return err
+		}
+	}
+	return nil
+}
+
+// imageVersions returns names of existing versions of the image sorted by creation time.
+func (pool *Pool) imageVersions() ([]string, error) {
+	images, err := pool.GCE.ListImages()
+	if err != nil {
+		return nil, err
+	}
+	re := regexp.MustCompile("^" + regexp.QuoteMeta(pool.env.Name) + "-[0-9a-f]{8}$")
+	var versions []*gce.Resource
+	for _, image := range images {
+		if re.MatchString(image.Name) {
+			versions = append(versions, image)
+		}
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		return versions[i].Created.Before(versions[j].Created)
+	})
+	var names []string
+	for _, image := range versions {
+		names = append(names, image.Name)
+	}
+	return names, nil
+}
+
+// fileHash returns a short hex hash of the file contents used to name image versions.
+func fileHash(file string) (string, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return "", fmt.Errorf("failed to open image file: %v", err)
+	}
+	defer f.Close()
+	hash := sha256.New()
+	if _, err := io.Copy(hash, f); err != nil {
+		return "", fmt.Errorf("failed to read image file: %v", err)
+	}
+	return hex.EncodeToString(hash.Sum(nil))[:8], nil
+}
+
+// smokeTestImage boots a single throwaway instance from the image
+// and checks that the instance is reachable over ssh and can run syz-executor.
+func (pool *Pool) smokeTestImage(image string) error {
+	workdir, err := ioutil.TempDir(pool.env.Workdir, "smoke")
+	if err != nil {
+		return fmt.Errorf("failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(workdir)
+	log.Logf(0, "smoke testing GCE image %v...", image)
+	inst, err := pool.createInstance(pool.env.Name+"-smoke", image, workdir)
+	if err != nil {
+		return fmt.Errorf("smoke test of the new image failed: %v", err)
+	}
+	defer inst.Close()
+	if pool.env.ExecutorBin == "" {
+		return nil
+	}
+	executorBin, err := inst.Copy(pool.env.ExecutorBin)
+	if err != nil {
+		return fmt.Errorf("smoke test of the new image failed: failed to copy syz-executor: %v", err)
+	}
+	args := append(inst.sshArgs("-p"), inst.sshUser+"@"+inst.ip, executorBin+" version")
+	if err := runCmd(pool.env.Debug, "ssh", args...); err != nil {
+		return fmt.Errorf("smoke test of the new image failed: failed to run syz-executor: %v", err)
+	}
+	log.Logf(0, "smoke test of GCE image %v passed", image)
+	return nil
 }
 
 func (pool *Pool) Count() int {
@@ -126,6 +298,14 @@ func (pool *Pool) Count() int {
 
 func (pool *Pool) Create(workdir string, index int) (vmimpl.Instance, error) {
 	name := fmt.Sprintf("%v-%v", pool.env.Name, index)
+	inst, err := pool.createInstance(name, pool.cfg.GCEImage, workdir)
+	if err != nil {
+		return nil, err
+	}
+	return inst, nil
+}
+
+func (pool *Pool) createInstance(name, image, workdir string) (*instance, error) {
 	// Create SSH key for the instance.
 	gceKey := filepath.Join(workdir, "key")
 	keygen := osutil.Command("ssh-keygen", "-t", "rsa", "-b", "2048", "-N", "", "-C", "syzkaller", "-f", gceKey)
@@ -136,13 +316,31 @@ func (pool *Pool) Create(workdir string, index int) (vmimpl.Instance, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to read file: %v", err)
 	}
+	for attempt := 1; ; attempt++ {
+		inst, err := pool.create(name, image, workdir, gceKey, string(gceKeyPub))
+		if err != errBootPreempted || attempt == maxBootPreemptions {
+			return inst, err
+		}
+		log.Logf(0, "instance %v was preempted during boot, re-creating", name)
+	}
+}
+
+// maxBootPreemptions is how many times we re-create an instance that is preempted during boot.
+const maxBootPreemptions = 3
 
+var errBootPreempted = errors.New("instance was preempted during boot")
+
+// preemptCheckPeriod is how often running instances are checked for preemption.
+const preemptCheckPeriod = time.Minute
+
+func (pool *Pool) create(name, image, workdir, gceKey, gceKeyPub string) (*instance, error) {
 	log.Logf(0, "deleting instance: %v", name)
 	if err := pool.GCE.DeleteInstance(name, true); err != nil {
 		return nil, err
 	}
 	log.Logf(0, "creating instance: %v", name)
-	ip, err := pool.GCE.CreateInstance(name, pool.cfg.MachineType, pool.cfg.GCEImage, string(gceKeyPub))
+	ip, err := pool.GCE.CreateInstance(name, pool.cfg.MachineType, image, gceKeyPub,
+		pool.cfg.Preemptible)
 	if err != nil {
 		return nil, err
 	}
@@ -161,10 +359,12 @@ func (pool *Pool) Create(workdir string, index int) (vmimpl.Instance, error) {
 		sshUser = "syzkaller"
 	}
 	log.Logf(0, "wait instance to boot: %v (%v)", name, ip)
-	if err := pool.waitInstanceBoot(name, ip, sshKey, sshUser, gceKey); err != nil {
+	if err := pool.waitInstanceBoot(name, ip, sshKey, sshUser, gceKey, workdir); err != nil {
+		if pool.cfg.Preemptible && pool.GCE.IsInstancePreempted(name) {
+			return nil, errBootPreempted
+		}
 		return nil, err
 	}
-	ok = true
 	inst := &instance{
 		env:     pool.env,
 		cfg:     pool.cfg,
@@ -175,23 +375,76 @@ func (pool *Pool) Create(workdir string, index int) (vmimpl.Instance, error) {
 		gceKey:  gceKey,
 		sshKey:  sshKey,
 		sshUser: sshUser,
+		workdir: workdir,
 		closed:  make(chan bool),
 	}
+	if pool.cfg.Agent {
+		if err := inst.startAgent(); err != nil {
+			return nil, fmt.Errorf("failed to start agent: %v", err)
+		}
+	}
+	ok = true
 	return inst, nil
 }
 
+// startAgent copies syz-agent into the instance, starts it over ssh and connects to it,
+// after that Copy and Run use the agent instead of ssh.
+func (inst *instance) startAgent() error {
+	agentBin, err := inst.Copy(inst.env.AgentBin)
+	if err != nil {
+		return err
+	}
+	var tokenData [16]byte
+	if _, err := rand.Read(tokenData[:]); err != nil {
+		return err
+	}
+	token := hex.EncodeToString(tokenData[:])
+	command := fmt.Sprintf("nohup %v -listen=:%v -token=%v >/dev/null 2>&1 </dev/null &",
+		agentBin, agentTCPPort, token)
+	if inst.sshUser != "root" {
+		command = "sudo " + command
+	}
+	args := append(inst.sshArgs("-p"), inst.sshUser+"@"+inst.ip, command)
+	if err := runCmd(inst.debug, "ssh", args...); err != nil {
+		return err
+	}
+	addr := net.JoinHostPort(inst.ip, fmt.Sprint(agentTCPPort))
+	var conn net.Conn
+	for i := 0; ; i++ {
+		conn, err = net.DialTimeout("tcp", addr, 10*time.Second)
+		if err == nil {
+			break
+		}
+		if i == 10 {
+			return err
+		}
+		time.Sleep(time.Second)
+	}
+	inst.agent = agent.NewClient(conn, token)
+	return nil
+}
+
 func (inst *instance) Close() {
 	close(inst.closed)
+	if inst.agent != nil {
+		inst.agent.Close()
+	}
 	inst.GCE.DeleteInstance(inst.name, false)
 }
 
 func (inst *instance) Forward(port int) (string, error) {
-	return fmt.Sprintf("%v:%v", inst.GCE.InternalIP, port), nil
+	return net.JoinHostPort(inst.GCE.InternalIP, fmt.Sprint(port)), nil
 }
 
 func (inst *instance) Copy(hostSrc string) (string, error) {
 	vmDst := "./" + filepath.Base(hostSrc)
-	args := append(sshArgs(inst.debug, inst.sshKey, "-P", 22), hostSrc, inst.sshUser+"@"+inst.ip+":"+vmDst)
+	if inst.agent != nil {
+		if err := vmimpl.AgentCopy(inst.agent, hostSrc, vmDst); err != nil {
+			return "", err
+		}
+		return vmDst, nil
+	}
+	args := append(inst.sshArgs("-P"), hostSrc, inst.sshUser+"@"+vmimpl.SCPHost(inst.ip)+":"+vmDst)
 	if err := runCmd(inst.debug, "scp", args...); err != nil {
 		return "", err
 	}
@@ -274,31 +527,12 @@ func (inst *instance) Run(timeout time.Duration, stop <-chan bool, command strin
 		}
 	}
 
-	sshRpipe, sshWpipe, err := osutil.LongPipe()
+	cmd, err := inst.startCommand(merger, command)
 	if err != nil {
 		con.Process.Kill()
 		merger.Wait()
-		sshRpipe.Close()
 		return nil, nil, err
 	}
-	if inst.env.OS == "linux" {
-		if inst.sshUser != "root" {
-			command = fmt.Sprintf("sudo bash -c '%v'", command)
-		}
-	}
-	args := append(sshArgs(inst.debug, inst.sshKey, "-p", 22), inst.sshUser+"@"+inst.ip, command)
-	ssh := osutil.Command("ssh", args...)
-	ssh.Stdout = sshWpipe
-	ssh.Stderr = sshWpipe
-	if err := ssh.Start(); err != nil {
-		con.Process.Kill()
-		merger.Wait()
-		sshRpipe.Close()
-		sshWpipe.Close()
-		return nil, nil, fmt.Errorf("failed to connect to instance: %v", err)
-	}
-	sshWpipe.Close()
-	merger.Add("ssh", sshRpipe)
 
 	errc := make(chan error, 1)
 	signal := func(err error) {
@@ -309,19 +543,36 @@ func (inst *instance) Run(timeout time.Duration, stop <-chan bool, command strin
 	}
 
 	go func() {
+		timeoutTimer := time.NewTimer(timeout)
+		defer timeoutTimer.Stop()
+		// Periodically check if the instance is preempted, so that it's re-created promptly.
+		// Otherwise we only notice preemption when ssh times out.
+		var preemptCheck <-chan time.Time
+		if inst.cfg.Preemptible {
+			ticker := time.NewTicker(preemptCheckPeriod)
+			defer ticker.Stop()
+			preemptCheck = ticker.C
+		}
+	loop:
 		select {
-		case <-time.After(timeout):
+		case <-timeoutTimer.C:
 			signal(vmimpl.ErrTimeout)
 		case <-stop:
 			signal(vmimpl.ErrTimeout)
 		case <-inst.closed:
 			signal(fmt.Errorf("instance closed"))
+		case <-preemptCheck:
+			if !inst.GCE.IsInstancePreempted(inst.name) {
+				goto loop
+			}
+			log.Logf(0, "%v: instance is preempted", inst.name)
+			signal(vmimpl.ErrPreempted)
 		case err := <-merger.Err:
 			con.Process.Kill()
-			ssh.Process.Kill()
+			cmd.kill()
 			merger.Wait()
 			con.Wait()
-			if cmdErr := ssh.Wait(); cmdErr == nil {
+			if cmdErr := cmd.wait(); cmdErr == nil {
 				// If the command exited successfully, we got EOF error from merger.
 				// But in this case no error has happened and the EOF is expected.
 				err = nil
@@ -330,10 +581,16 @@ func (inst *instance) Run(timeout time.Duration, stop <-chan bool, command strin
 				// instance preemption or a GCE bug. In either case, not a kernel bug.
 				log.Logf(1, "%v: gce console connection failed with %v", inst.name, merr.Err)
 				err = vmimpl.ErrTimeout
+				if inst.GCE.IsInstancePreempted(inst.name) {
+					err = vmimpl.ErrPreempted
+				}
 			} else {
 				// Check if the instance was terminated due to preemption or host maintenance.
 				time.Sleep(5 * time.Second) // just to avoid any GCE races
-				if !inst.GCE.IsInstanceRunning(inst.name) {
+				if inst.GCE.IsInstancePreempted(inst.name) {
+					log.Logf(1, "%v: ssh exited and instance is preempted", inst.name)
+					err = vmimpl.ErrPreempted
+				} else if !inst.GCE.IsInstanceRunning(inst.name) {
 					log.Logf(1, "%v: ssh exited but instance is not running", inst.name)
 					err = vmimpl.ErrTimeout
 				}
@@ -342,19 +599,58 @@ func (inst *instance) Run(timeout time.Duration, stop <-chan bool, command strin
 			return
 		}
 		con.Process.Kill()
-		ssh.Process.Kill()
+		cmd.kill()
 		merger.Wait()
 		con.Wait()
-		ssh.Wait()
+		cmd.wait()
 	}()
 	return merger.Output, errc, nil
 }
 
+// runningCommand is the command started by Run either over ssh or with the guest agent.
+type runningCommand struct {
+	kill func()
+	wait func() error
+}
+
+func (inst *instance) startCommand(merger *vmimpl.OutputMerger, command string) (*runningCommand, error) {
+	if inst.agent != nil {
+		// The agent runs as root, so no sudo is needed.
+		s, err := inst.agent.Exec("", command)
+		if err != nil {
+			return nil, fmt.Errorf("failed to run command with agent: %v", err)
+		}
+		merger.Add("agent", s.Output)
+		return &runningCommand{kill: s.Kill, wait: s.Wait}, nil
+	}
+	sshRpipe, sshWpipe, err := osutil.LongPipe()
+	if err != nil {
+		return nil, err
+	}
+	if inst.env.OS == "linux" {
+		if inst.sshUser != "root" {
+			command = fmt.Sprintf("sudo bash -c '%v'", command)
+		}
+	}
+	args := append(inst.sshArgs("-p"), inst.sshUser+"@"+inst.ip, command)
+	ssh := osutil.Command("ssh", args...)
+	ssh.Stdout = sshWpipe
+	ssh.Stderr = sshWpipe
+	if err := ssh.Start(); err != nil {
+		sshRpipe.Close()
+		sshWpipe.Close()
+		return nil, fmt.Errorf("failed to connect to instance: %v", err)
+	}
+	sshWpipe.Close()
+	merger.Add("ssh", sshRpipe)
+	return &runningCommand{kill: func() { ssh.Process.Kill() }, wait: ssh.Wait}, nil
+}
+
 func (inst *instance) Diagnose() bool {
 	return false
 }
 
-func (pool *Pool) waitInstanceBoot(name, ip, sshKey, sshUser, gceKey string) error {
+func (pool *Pool) waitInstanceBoot(name, ip, sshKey, sshUser, gceKey, workdir string) error {
 	pwd := "pwd"
 	if pool.env.OS == "windows" {
 		pwd = "dir"
@@ -363,7 +659,8 @@ func (pool *Pool) waitInstanceBoot(name, ip, sshKey, sshUser, gceKey string) err
 		if !vmimpl.SleepInterruptible(5 * time.Second) {
 			return fmt.Errorf("shutdown in progress")
 		}
-		args := append(sshArgs(pool.env.Debug, sshKey, "-p", 22), sshUser+"@"+ip, pwd)
+		args := append(sshArgs(pool.env.Debug, sshKey, "-p", 22), pool.env.SSHOptions.Args(workdir, true)...)
+		args = append(args, sshUser+"@"+ip, pwd)
 		if err := runCmd(pool.env.Debug, "ssh", args...); err == nil {
 			return nil
 		}
@@ -420,12 +717,13 @@ func (pool *Pool) getSerialPortOutput(name, gceKey string) ([]byte, error) {
 	return output, nil
 }
 
-func uploadImageToGCS(localImage, gcsImage string) error {
+func uploadImageToGCS(localImage, gcsImage, userProject string) error {
 	GCS, err := gcs.NewClient()
 	if err != nil {
 		return fmt.Errorf("failed to create GCS client: %v", err)
 	}
 	defer GCS.Close()
+	GCS.UserProject = userProject
 
 	localReader, err := os.Open(localImage)
 	if err != nil {
@@ -489,6 +787,17 @@ func runCmd(debug bool, bin string, args ...string) error {
 	return err
 }
 
+func (inst *instance) Address() string {
+	return vmimpl.SSHCommand(inst.sshKey, inst.sshUser+"@"+inst.ip, 22)
+}
+
+// sshArgs returns arguments for ssh/scp connections to the instance itself
+// (as opposed to connections to the serial console server).
+func (inst *instance) sshArgs(portArg string) []string {
+	args := sshArgs(inst.debug, inst.sshKey, portArg, 22)
+	return append(args, inst.env.SSHOptions.Args(inst.workdir, true)...)
+}
+
 func sshArgs(debug bool, sshKey, portArg string, port int) []string {
 	args := []string{
 		portArg, fmt.Sprint(port),
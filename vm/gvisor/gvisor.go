@@ -1,6 +1,8 @@
 // Copyright 2018 syzkaller project authors. All rights reserved.
 // Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
 
+// +build !windows
+
 // Package gvisor provides support for gVisor, user-space kernel, testing.
 // See https://github.com/google/gvisor
 package gvisor
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"net"
 	"os"
 	"path/filepath"
 	"strconv"
@@ -24,7 +25,7 @@ func init() {
 }
 
 type Config struct {
-	Targets      []string `json:"targets"`       // target machines: (hostname|ip)(:port)?
+	Targets      []string `json:"targets"`       // target machines: (hostname|ip|[ipv6])(:port)?
 	TargetDir    string   `json:"target_dir"`    // directory to copy/run on target
 	TargetReboot bool     `json:"target_reboot"` // reboot target on repair
 }
@@ -37,10 +38,13 @@ type Pool struct {
 type instance struct {
 	cfg        *Config
 	target     string
+	scpTarget  string
 	targetPort int
 	closed     chan bool
 	debug      bool
 	sshkey     string
+	sshOptions vmimpl.SSHOptions
+	workdir    string
 	port       int
 }
 
@@ -79,10 +83,13 @@ func (pool *Pool) Create(workdir string, index int) (vmimpl.Instance, error) {
 	inst := &instance{
 		cfg:        pool.cfg,
 		target:     pool.env.SSHUser + "@" + target,
+		scpTarget:  pool.env.SSHUser + "@" + vmimpl.SCPHost(target),
 		targetPort: targetPort,
 		closed:     make(chan bool),
 		debug:      pool.env.Debug,
 		sshkey:     pool.env.SSHKey,
+		sshOptions: pool.env.SSHOptions,
+		workdir:    workdir,
 	}
 	closeInst := inst
 	defer func() {
@@ -235,7 +242,7 @@ func (inst *instance) Copy(hostSrc string) (string, error) {
 	baseName := filepath.Base(hostSrc)
 	vmDst := filepath.Join(inst.cfg.TargetDir, baseName)
 	inst.ssh("pkill -9 '" + baseName + "'; rm -f '" + vmDst + "'")
-	args := append(inst.sshArgs("-P"), hostSrc, inst.target+":"+vmDst)
+	args := append(inst.sshArgs("-P"), hostSrc, inst.scpTarget+":"+vmDst)
 	cmd := osutil.Command("scp", args...)
 	if inst.debug {
 		log.Logf(0, "running command: scp %#v", args)
@@ -312,6 +319,10 @@ func (inst *instance) Diagnose() bool {
 	return false
 }
 
+func (inst *instance) Address() string {
+	return vmimpl.SSHCommand(inst.sshkey, inst.target, inst.targetPort)
+}
+
 func (inst *instance) sshArgs(portArg string) []string {
 	args := []string{
 		portArg, fmt.Sprint(inst.targetPort),
@@ -326,6 +337,7 @@ func (inst *instance) sshArgs(portArg string) []string {
 	if inst.sshkey != "" {
 		args = append(args, "-i", inst.sshkey)
 	}
+	args = append(args, inst.sshOptions.Args(inst.workdir, true)...)
 	if inst.debug {
 		args = append(args, "-v")
 	}
@@ -335,13 +347,16 @@ func (inst *instance) sshArgs(portArg string) []string {
 func splitTargetPort(addr string) (string, int, error) {
 	target := addr
 	port := 22
-	if colonPos := strings.Index(addr, ":"); colonPos != -1 {
-		p, err := strconv.ParseUint(addr[colonPos+1:], 10, 16)
+	if host, portStr, err := net.SplitHostPort(addr); err == nil {
+		p, err := strconv.ParseUint(portStr, 10, 16)
 		if err != nil {
 			return "", 0, err
 		}
-		target = addr[:colonPos]
+		target = host
 		port = int(p)
+	} else if strings.HasPrefix(addr, "[") && strings.HasSuffix(addr, "]") {
+		// Bracketed IPv6 address without port, ssh wants it without brackets.
+		target = addr[1 : len(addr)-1]
 	}
 	if target == "" {
 		return "", 0, fmt.Errorf("target is empty")
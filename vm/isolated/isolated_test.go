@@ -0,0 +1,48 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package isolated
+
+import (
+	"testing"
+)
+
+func TestSplitTargetPort(t *testing.T) {
+	tests := []struct {
+		addr   string
+		target string
+		port   int
+		err    bool
+	}{
+		{"host", "host", 22, false},
+		{"host:2222", "host", 2222, false},
+		{"10.0.0.1", "10.0.0.1", 22, false},
+		{"10.0.0.1:23", "10.0.0.1", 23, false},
+		{"::1", "::1", 22, false},
+		{"fe80::1", "fe80::1", 22, false},
+		{"[::1]", "::1", 22, false},
+		{"[::1]:2222", "::1", 2222, false},
+		{"[fe80::1]:22", "fe80::1", 22, false},
+		{"host:foo", "", 0, true},
+		{"host:100000", "", 0, true},
+		{"", "", 0, true},
+		{":22", "", 0, true},
+		{"[]", "", 0, true},
+	}
+	for _, test := range tests {
+		target, port, err := splitTargetPort(test.addr)
+		if test.err {
+			if err == nil {
+				t.Errorf("%q: no error, got %q %v", test.addr, target, port)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%q: %v", test.addr, err)
+			continue
+		}
+		if target != test.target || port != test.port {
+			t.Errorf("%q: got %q %v, want %q %v", test.addr, target, port, test.target, test.port)
+		}
+	}
+}
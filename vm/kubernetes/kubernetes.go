@@ -0,0 +1,379 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+// Package kubernetes allows to use qemu VMs running inside of Kubernetes pods.
+// It is assumed that syz-manager also runs in the cluster (see tools/kubernetes),
+// so that it can reach pods by their IPs and VMs can reach manager_addr.
+//
+// Each VM is a pod running pod_image. The image entrypoint must boot the VM
+// (see tools/kubernetes/qemu-pod for the reference implementation):
+//   - VM size is passed in SYZ_CPU and SYZ_MEM (MB) environment variables
+//   - VM ssh port must be forwarded to pod port 10022
+//   - VM console output must be written to the container stdout
+//
+// Hardware virtualization is requested via kvm_resource extended resource
+// (e.g. provided by kubevirt KVM device plugin).
+//
+// The pool acts as a small controller for the pods: it labels pods with the manager name,
+// creates pods for VM indexes on demand, deletes pods of closed VMs and garbage collects
+// pods with indexes beyond count (e.g. after count was reduced).
+package kubernetes
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/syzkaller/pkg/config"
+	"github.com/google/syzkaller/pkg/log"
+	"github.com/google/syzkaller/pkg/osutil"
+	"github.com/google/syzkaller/vm/vmimpl"
+)
+
+func init() {
+	vmimpl.Register("kubernetes", ctor)
+}
+
+type Config struct {
+	Count       int    `json:"count"`        // number of VMs to use
+	Kubectl     string `json:"kubectl"`      // kubectl binary ("kubectl" by default)
+	Namespace   string `json:"namespace"`    // namespace for VM pods ("default" by default)
+	PodImage    string `json:"pod_image"`    // container image that boots the VM
+	KVMResource string `json:"kvm_resource"` // extended resource for /dev/kvm ("devices.kubevirt.io/kvm" by default)
+	CPU         int    `json:"cpu"`          // number of VM CPUs
+	Mem         int    `json:"mem"`          // amount of VM memory in MBs
+	// Address of syz-manager reachable from VMs (e.g. name of the manager service).
+	ManagerAddr string `json:"manager_addr"`
+}
+
+type Pool struct {
+	env *vmimpl.Env
+	cfg *Config
+}
+
+type instance struct {
+	pool    *Pool
+	name    string
+	ip      string
+	workdir string
+	closed  chan bool
+}
+
+const (
+	podSSHPort = 10022
+	podLabel   = "syzkaller-manager"
+	vmDir      = "/"
+)
+
+func ctor(env *vmimpl.Env) (vmimpl.Pool, error) {
+	if env.Name == "" {
+		return nil, fmt.Errorf("config param name is empty (required for kubernetes)")
+	}
+	cfg := &Config{
+		Count:       1,
+		Kubectl:     "kubectl",
+		Namespace:   "default",
+		KVMResource: "devices.kubevirt.io/kvm",
+		CPU:         2,
+		Mem:         2048,
+	}
+	if err := config.LoadData(env.Config, cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse kubernetes vm config: %v", err)
+	}
+	if cfg.Count < 1 || cfg.Count > 1000 {
+		return nil, fmt.Errorf("invalid config param count: %v, want [1, 1000]", cfg.Count)
+	}
+	if env.Debug {
+		cfg.Count = 1
+	}
+	if cfg.PodImage == "" {
+		return nil, fmt.Errorf("config param pod_image is empty")
+	}
+	if cfg.ManagerAddr == "" {
+		return nil, fmt.Errorf("config param manager_addr is empty")
+	}
+	if cfg.CPU <= 0 || cfg.CPU > 1024 {
+		return nil, fmt.Errorf("bad kubernetes cpu: %v, want [1-1024]", cfg.CPU)
+	}
+	if cfg.Mem < 128 || cfg.Mem > 1048576 {
+		return nil, fmt.Errorf("bad kubernetes mem: %v, want [128-1048576]", cfg.Mem)
+	}
+	pool := &Pool{
+		env: env,
+		cfg: cfg,
+	}
+	if err := pool.collectGarbage(); err != nil {
+		return nil, err
+	}
+	return pool, nil
+}
+
+func (pool *Pool) Count() int {
+	return pool.cfg.Count
+}
+
+func (pool *Pool) Create(workdir string, index int) (vmimpl.Instance, error) {
+	name := pool.podName(index)
+	// Pod with the same name may be left from a previous run.
+	if err := pool.deletePod(name, true); err != nil {
+		return nil, err
+	}
+	log.Logf(1, "kubernetes: creating pod %v", name)
+	manifest, err := pool.podManifest(name, index)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := pool.kubectl(manifest, "apply", "-f", "-"); err != nil {
+		return nil, err
+	}
+	inst := &instance{
+		pool:    pool,
+		name:    name,
+		workdir: workdir,
+		closed:  make(chan bool),
+	}
+	ok := false
+	defer func() {
+		if !ok {
+			inst.Close()
+		}
+	}()
+	if err := inst.waitRunning(10 * time.Minute); err != nil {
+		return nil, err
+	}
+	log.Logf(1, "kubernetes: pod %v is running at %v, waiting for ssh", name, inst.ip)
+	if err := inst.waitForSSH(10 * time.Minute); err != nil {
+		out, _ := pool.kubectl(nil, "logs", "--tail=1000", name)
+		return nil, vmimpl.BootError{Title: err.Error(), Output: out}
+	}
+	ok = true
+	return inst, nil
+}
+
+// collectGarbage deletes pods of this manager with indexes beyond count.
+func (pool *Pool) collectGarbage() error {
+	out, err := pool.kubectl(nil, "get", "pods", "-l", podLabel+"="+pool.labelValue(),
+		"-o", "jsonpath={.items[*].metadata.name}")
+	if err != nil {
+		return err
+	}
+	prefix := pool.podName(0)
+	prefix = prefix[:len(prefix)-1]
+	for _, name := range strings.Fields(string(out)) {
+		index, err := strconv.Atoi(strings.TrimPrefix(name, prefix))
+		if err == nil && index < pool.cfg.Count {
+			continue
+		}
+		log.Logf(0, "kubernetes: deleting stale pod %v", name)
+		if err := pool.deletePod(name, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (pool *Pool) podManifest(name string, index int) ([]byte, error) {
+	resources := map[string]interface{}{
+		"cpu":    strconv.Itoa(pool.cfg.CPU),
+		"memory": fmt.Sprintf("%vMi", pool.cfg.Mem+256), // some slack for qemu itself
+	}
+	if pool.cfg.KVMResource != "" {
+		resources[pool.cfg.KVMResource] = "1"
+	}
+	pod := map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Pod",
+		"metadata": map[string]interface{}{
+			"name": name,
+			"labels": map[string]string{
+				podLabel:            pool.labelValue(),
+				podLabel + "-index": strconv.Itoa(index),
+			},
+		},
+		"spec": map[string]interface{}{
+			"restartPolicy": "Never",
+			"containers": []interface{}{
+				map[string]interface{}{
+					"name":  "vm",
+					"image": pool.cfg.PodImage,
+					"env": []interface{}{
+						map[string]string{"name": "SYZ_CPU", "value": strconv.Itoa(pool.cfg.CPU)},
+						map[string]string{"name": "SYZ_MEM", "value": strconv.Itoa(pool.cfg.Mem)},
+					},
+					"ports": []interface{}{
+						map[string]int{"containerPort": podSSHPort},
+					},
+					"resources": map[string]interface{}{
+						"requests": resources,
+						"limits":   resources,
+					},
+				},
+			},
+		},
+	}
+	return json.Marshal(pod)
+}
+
+var badNameChars = regexp.MustCompile("[^a-z0-9-]+")
+
+// labelValue returns manager name suitable for label values and pod names.
+func (pool *Pool) labelValue() string {
+	val := badNameChars.ReplaceAllString(strings.ToLower(pool.env.Name), "-")
+	if len(val) > 50 {
+		val = val[:50]
+	}
+	return strings.Trim(val, "-")
+}
+
+func (pool *Pool) podName(index int) string {
+	return fmt.Sprintf("syz-%v-%v", pool.labelValue(), index)
+}
+
+func (pool *Pool) deletePod(name string, wait bool) error {
+	_, err := pool.kubectl(nil, "delete", "pod", name, "--ignore-not-found",
+		"--wait="+strconv.FormatBool(wait), "--grace-period=0")
+	return err
+}
+
+func (pool *Pool) kubectl(input []byte, args ...string) ([]byte, error) {
+	args = append([]string{"--namespace=" + pool.cfg.Namespace}, args...)
+	if pool.env.Debug {
+		log.Logf(0, "running command: %v %#v", pool.cfg.Kubectl, args)
+	}
+	cmd := osutil.Command(pool.cfg.Kubectl, args...)
+	if input != nil {
+		cmd.Stdin = bytes.NewReader(input)
+	}
+	return osutil.Run(5*time.Minute, cmd)
+}
+
+func (inst *instance) waitRunning(timeout time.Duration) error {
+	for start := time.Now(); ; {
+		out, err := inst.pool.kubectl(nil, "get", "pod", inst.name,
+			"-o", "jsonpath={.status.phase} {.status.podIP}")
+		if err == nil {
+			fields := strings.Fields(string(out))
+			if len(fields) == 2 && fields[0] == "Running" {
+				inst.ip = fields[1]
+				return nil
+			}
+			if len(fields) != 0 && (fields[0] == "Failed" || fields[0] == "Succeeded") {
+				out, _ := inst.pool.kubectl(nil, "logs", "--tail=1000", inst.name)
+				return vmimpl.BootError{Title: fmt.Sprintf("pod %v terminated", inst.name), Output: out}
+			}
+		}
+		if time.Since(start) > timeout {
+			return fmt.Errorf("pod %v is not running after %v (%v): %s", inst.name, timeout, err, out)
+		}
+		if !vmimpl.SleepInterruptible(5 * time.Second) {
+			return fmt.Errorf("shutdown in progress")
+		}
+	}
+}
+
+func (inst *instance) waitForSSH(timeout time.Duration) error {
+	var err error
+	for start := time.Now(); time.Since(start) < timeout; {
+		if !vmimpl.SleepInterruptible(5 * time.Second) {
+			return fmt.Errorf("shutdown in progress")
+		}
+		args := append(inst.sshArgs("-p"), inst.sshTarget(), "pwd")
+		if _, err = osutil.RunCmd(time.Minute, "", "ssh", args...); err == nil {
+			return nil
+		}
+	}
+	return fmt.Errorf("can't ssh into the instance: %v", err)
+}
+
+func (inst *instance) Close() {
+	close(inst.closed)
+	if err := inst.pool.deletePod(inst.name, false); err != nil {
+		log.Logf(0, "kubernetes: failed to delete pod %v: %v", inst.name, err)
+	}
+}
+
+func (inst *instance) Forward(port int) (string, error) {
+	return fmt.Sprintf("%v:%v", inst.pool.cfg.ManagerAddr, port), nil
+}
+
+func (inst *instance) Copy(hostSrc string) (string, error) {
+	vmDst := filepath.Join(vmDir, filepath.Base(hostSrc))
+	args := append(inst.sshArgs("-P"), hostSrc, inst.pool.env.SSHUser+"@"+vmimpl.SCPHost(inst.ip)+":"+vmDst)
+	if _, err := osutil.RunCmd(3*time.Minute, "", "scp", args...); err != nil {
+		return "", err
+	}
+	return vmDst, nil
+}
+
+func (inst *instance) Run(timeout time.Duration, stop <-chan bool, command string) (
+	<-chan []byte, <-chan error, error) {
+	// VM console is the pod output.
+	conArgs := []string{"--namespace=" + inst.pool.cfg.Namespace, "logs", "-f", "--tail=0", inst.name}
+	con, err := vmimpl.OpenCommandConsole(inst.pool.cfg.Kubectl, conArgs...)
+	if err != nil {
+		return nil, nil, err
+	}
+	rpipe, wpipe, err := osutil.LongPipe()
+	if err != nil {
+		con.Close()
+		return nil, nil, err
+	}
+	args := append(inst.sshArgs("-p"), inst.sshTarget(), "cd "+vmDir+" && exec "+command)
+	if inst.pool.env.Debug {
+		log.Logf(0, "running command: ssh %#v", args)
+	}
+	cmd := osutil.Command("ssh", args...)
+	cmd.Stdout = wpipe
+	cmd.Stderr = wpipe
+	if err := cmd.Start(); err != nil {
+		con.Close()
+		rpipe.Close()
+		wpipe.Close()
+		return nil, nil, err
+	}
+	wpipe.Close()
+	var tee io.Writer
+	if inst.pool.env.Debug {
+		tee = os.Stdout
+	}
+	merger := vmimpl.NewOutputMerger(tee)
+	merger.Add("console", con)
+	merger.Add("ssh", rpipe)
+	return vmimpl.Multiplex(cmd, merger, con, timeout, stop, inst.closed, inst.pool.env.Debug)
+}
+
+func (inst *instance) Diagnose() bool {
+	return false
+}
+
+func (inst *instance) sshTarget() string {
+	return inst.pool.env.SSHUser + "@" + inst.ip
+}
+
+func (inst *instance) sshArgs(portArg string) []string {
+	args := []string{
+		portArg, strconv.Itoa(podSSHPort),
+		"-o", "ConnectionAttempts=10",
+		"-o", "ConnectTimeout=10",
+		"-o", "BatchMode=yes",
+		"-o", "UserKnownHostsFile=/dev/null",
+		"-o", "IdentitiesOnly=yes",
+		"-o", "StrictHostKeyChecking=no",
+		"-o", "LogLevel=error",
+	}
+	if inst.pool.env.SSHKey != "" {
+		args = append(args, "-i", inst.pool.env.SSHKey)
+	}
+	args = append(args, inst.pool.env.SSHOptions.Args(inst.workdir, false)...)
+	if inst.pool.env.Debug {
+		args = append(args, "-v")
+	}
+	return args
+}
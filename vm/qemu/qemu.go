@@ -9,11 +9,14 @@ import (
 	"net"
 	"os"
 	"os/exec"
+	"path"
 	"path/filepath"
+	"runtime"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/google/syzkaller/pkg/agent"
 	"github.com/google/syzkaller/pkg/config"
 	"github.com/google/syzkaller/pkg/log"
 	"github.com/google/syzkaller/pkg/osutil"
@@ -35,11 +38,25 @@ type Config struct {
 	Kernel      string `json:"kernel"`       // kernel for injected boot (e.g. arch/x86/boot/bzImage)
 	Cmdline     string `json:"cmdline"`      // kernel command line (can only be specified with kernel)
 	Initrd      string `json:"initrd"`       // linux initial ramdisk. (optional)
+	Dtb         string `json:"dtb"`          // device tree blob (optional)
+	Bios        string `json:"bios"`         // firmware to boot with, e.g. UEFI firmware for arm64 (optional)
 	ImageDevice string `json:"image_device"` // qemu image device (hda by default)
 	CPU         int    `json:"cpu"`          // number of VM CPUs
 	Mem         int    `json:"mem"`          // amount of VM memory in MBs
+	// Post-mortem of crashed VMs: gdb is attached to the qemu gdbstub of the crashed VM
+	// (which stops the guest), executes the script (e.g. bt, lx-dmesg, struct dumps)
+	// and detaches. The output is saved along with the crash.
+	GDBScript string `json:"gdb_script"` // gdb script for post-mortem (optional)
+	GDB       string `json:"gdb"`        // gdb binary name ("gdb" by default, e.g. gdb-multiarch)
+	// Run commands and copy files with the guest agent (syz-agent) over virtio-serial instead of ssh.
+	// This saves ssh round-trips and keeps working when fuzzing breaks guest networking.
+	// ssh is still used once after boot to start the agent. Requires CONFIG_VIRTIO_CONSOLE.
+	Agent bool `json:"agent"`
 }
 
+// gdbTimeout bounds time spent in post-mortem of a single crash.
+const gdbTimeout = 5 * time.Minute
+
 type Pool struct {
 	env        *vmimpl.Env
 	cfg        *Config
@@ -47,19 +64,26 @@ type Pool struct {
 }
 
 type instance struct {
-	cfg        *Config
-	archConfig *archConfig
-	image      string
-	debug      bool
-	workdir    string
-	sshkey     string
-	sshuser    string
-	port       int
-	rpipe      io.ReadCloser
-	wpipe      io.WriteCloser
-	qemu       *exec.Cmd
-	waiterC    chan error
-	merger     *vmimpl.OutputMerger
+	cfg         *Config
+	archConfig  *archConfig
+	image       string
+	debug       bool
+	panicOnWarn bool
+	sshOptions  vmimpl.SSHOptions
+	workdir     string
+	sshkey      string
+	sshuser     string
+	cmdline     string // additional kernel command line for this boot
+	kernelObj   string
+	agentBin    string
+	agent       *agent.Client // guest agent connection if Config.Agent is set
+	port        int
+	gdbPort     int
+	rpipe       io.ReadCloser
+	wpipe       io.WriteCloser
+	qemu        *exec.Cmd
+	waiterC     chan error
+	merger      *vmimpl.OutputMerger
 }
 
 type archConfig struct {
@@ -136,7 +160,6 @@ var linuxCmdline = []string{
 	"earlyprintk=serial",
 	"oops=panic",
 	"nmi_watchdog=panic",
-	"panic_on_warn=1",
 	"panic=86400",
 	"ftrace_dump_on_oops=orig_cpu",
 	"rodata=n",
@@ -145,13 +168,26 @@ var linuxCmdline = []string{
 	"biosdevname=0",
 }
 
+// hostQemuArgs replaces KVM acceleration in default qemu args
+// with the hardware accelerator available on the host OS.
+func hostQemuArgs(args string) string {
+	switch runtime.GOOS {
+	case "darwin":
+		return strings.Replace(args, "-enable-kvm", "-accel hvf", -1)
+	case "windows":
+		return strings.Replace(args, "-enable-kvm", "-accel whpx", -1)
+	}
+	return args
+}
+
 func ctor(env *vmimpl.Env) (vmimpl.Pool, error) {
 	archConfig := archConfigs[env.OS+"/"+env.Arch]
 	cfg := &Config{
 		Count:       1,
 		ImageDevice: "hda",
+		GDB:         "gdb",
 		Qemu:        archConfig.Qemu,
-		QemuArgs:    archConfig.QemuArgs,
+		QemuArgs:    hostQemuArgs(archConfig.QemuArgs),
 	}
 	if err := config.LoadData(env.Config, cfg); err != nil {
 		return nil, fmt.Errorf("failed to parse qemu vm config: %v", err)
@@ -169,6 +205,9 @@ func ctor(env *vmimpl.Env) (vmimpl.Pool, error) {
 		if env.OS != "linux" {
 			return nil, fmt.Errorf("9p image is supported for linux only")
 		}
+		if runtime.GOOS != "linux" {
+			return nil, fmt.Errorf("9p image requires linux host")
+		}
 		if cfg.Kernel == "" {
 			return nil, fmt.Errorf("9p image requires kernel")
 		}
@@ -185,6 +224,20 @@ func ctor(env *vmimpl.Env) (vmimpl.Pool, error) {
 	}
 	cfg.Kernel = osutil.Abs(cfg.Kernel)
 	cfg.Initrd = osutil.Abs(cfg.Initrd)
+	cfg.Dtb = osutil.Abs(cfg.Dtb)
+	cfg.Bios = osutil.Abs(cfg.Bios)
+	if cfg.Agent && !osutil.IsExist(env.AgentBin) {
+		return nil, fmt.Errorf("agent is enabled, but %v does not exist", env.AgentBin)
+	}
+	if cfg.GDBScript != "" {
+		cfg.GDBScript = osutil.Abs(cfg.GDBScript)
+		if !osutil.IsExist(cfg.GDBScript) {
+			return nil, fmt.Errorf("gdb script %v does not exist", cfg.GDBScript)
+		}
+		if _, err := exec.LookPath(cfg.GDB); err != nil {
+			return nil, err
+		}
+	}
 	pool := &Pool{
 		cfg:        cfg,
 		env:        env,
@@ -198,6 +251,13 @@ func (pool *Pool) Count() int {
 }
 
 func (pool *Pool) Create(workdir string, index int) (vmimpl.Instance, error) {
+	return pool.CreateWithCmdline(workdir, index, "")
+}
+
+func (pool *Pool) CreateWithCmdline(workdir string, index int, cmdline string) (vmimpl.Instance, error) {
+	if cmdline != "" && pool.cfg.Kernel == "" {
+		return nil, fmt.Errorf("kernel command line can only be specified with kernel")
+	}
 	sshkey := pool.env.SSHKey
 	sshuser := pool.env.SSHUser
 	if pool.env.Image == "9p" {
@@ -214,7 +274,7 @@ func (pool *Pool) Create(workdir string, index int) (vmimpl.Instance, error) {
 	}
 
 	for i := 0; ; i++ {
-		inst, err := pool.ctor(workdir, sshkey, sshuser, index)
+		inst, err := pool.ctor(workdir, sshkey, sshuser, cmdline, index)
 		if err == nil {
 			return inst, nil
 		}
@@ -226,15 +286,20 @@ func (pool *Pool) Create(workdir string, index int) (vmimpl.Instance, error) {
 	}
 }
 
-func (pool *Pool) ctor(workdir, sshkey, sshuser string, index int) (vmimpl.Instance, error) {
+func (pool *Pool) ctor(workdir, sshkey, sshuser, cmdline string, index int) (vmimpl.Instance, error) {
 	inst := &instance{
-		cfg:        pool.cfg,
-		archConfig: pool.archConfig,
-		image:      pool.env.Image,
-		debug:      pool.env.Debug,
-		workdir:    workdir,
-		sshkey:     sshkey,
-		sshuser:    sshuser,
+		cfg:         pool.cfg,
+		archConfig:  pool.archConfig,
+		image:       pool.env.Image,
+		debug:       pool.env.Debug,
+		panicOnWarn: pool.env.PanicOnWarn && pool.env.OS == "linux",
+		sshOptions:  pool.env.SSHOptions,
+		workdir:     workdir,
+		sshkey:      sshkey,
+		sshuser:     sshuser,
+		cmdline:     cmdline,
+		kernelObj:   pool.env.KernelObj,
+		agentBin:    pool.env.AgentBin,
 	}
 	closeInst := inst
 	defer func() {
@@ -258,6 +323,9 @@ func (pool *Pool) ctor(workdir, sshkey, sshuser string, index int) (vmimpl.Insta
 }
 
 func (inst *instance) Close() {
+	if inst.agent != nil {
+		inst.agent.Close()
+	}
 	if inst.qemu != nil {
 		inst.qemu.Process.Kill()
 		err := <-inst.waiterC
@@ -286,6 +354,17 @@ func (inst *instance) Boot() error {
 		"-no-reboot",
 	}
 	args = append(args, strings.Split(inst.cfg.QemuArgs, " ")...)
+	if inst.cfg.Agent {
+		args = append(args,
+			"-device", "virtio-serial",
+			"-chardev", fmt.Sprintf("socket,id=syzagent,path=%v,server,nowait", inst.agentSocket()),
+			"-device", "virtserialport,chardev=syzagent,name="+agentPort,
+		)
+	}
+	if inst.cfg.GDBScript != "" {
+		inst.gdbPort = vmimpl.UnusedTCPPort()
+		args = append(args, "-gdb", fmt.Sprintf("tcp:127.0.0.1:%v", inst.gdbPort))
+	}
 	if inst.image == "9p" {
 		args = append(args,
 			"-fsdev", "local,id=fsdev0,path=/,security_model=none,readonly",
@@ -302,8 +381,21 @@ func (inst *instance) Boot() error {
 			"-initrd", inst.cfg.Initrd,
 		)
 	}
+	if inst.cfg.Dtb != "" {
+		args = append(args,
+			"-dtb", inst.cfg.Dtb,
+		)
+	}
+	if inst.cfg.Bios != "" {
+		args = append(args,
+			"-bios", inst.cfg.Bios,
+		)
+	}
 	if inst.cfg.Kernel != "" {
 		cmdline := append([]string{}, inst.archConfig.CmdLine...)
+		if inst.panicOnWarn {
+			cmdline = append(cmdline, "panic_on_warn=1")
+		}
 		if inst.image == "9p" {
 			cmdline = append(cmdline,
 				"root=/dev/root",
@@ -315,6 +407,9 @@ func (inst *instance) Boot() error {
 			cmdline = append(cmdline, "root=/dev/sda")
 		}
 		cmdline = append(cmdline, inst.cfg.Cmdline)
+		if inst.cmdline != "" {
+			cmdline = append(cmdline, inst.cmdline)
+		}
 		args = append(args,
 			"-kernel", inst.cfg.Kernel,
 			"-append", strings.Join(cmdline, " "),
@@ -395,6 +490,38 @@ func (inst *instance) Boot() error {
 		}
 	}
 	bootOutputStop <- true
+	if inst.cfg.Agent {
+		if err := inst.startAgent(); err != nil {
+			return fmt.Errorf("failed to start agent: %v", err)
+		}
+	}
+	return nil
+}
+
+// agentPort is the name of the virtio-serial port of the guest agent.
+const agentPort = "syz-agent"
+
+func (inst *instance) agentSocket() string {
+	return filepath.Join(inst.workdir, "agent.sock")
+}
+
+// startAgent copies syz-agent into the VM, starts it over ssh and connects to it,
+// after that Copy and Run use the agent instead of ssh.
+func (inst *instance) startAgent() error {
+	agentBin, err := inst.Copy(inst.agentBin)
+	if err != nil {
+		return err
+	}
+	args := append(inst.sshArgs("-p"), inst.sshuser+"@localhost",
+		fmt.Sprintf("nohup %v -port=%v >/dev/null 2>&1 </dev/null &", agentBin, agentPort))
+	if _, err := osutil.Run(time.Minute, osutil.Command("ssh", args...)); err != nil {
+		return err
+	}
+	conn, err := net.Dial("unix", inst.agentSocket())
+	if err != nil {
+		return err
+	}
+	inst.agent = agent.NewClient(conn, "")
 	return nil
 }
 
@@ -410,7 +537,13 @@ func (inst *instance) targetDir() string {
 }
 
 func (inst *instance) Copy(hostSrc string) (string, error) {
-	vmDst := filepath.Join(inst.targetDir(), filepath.Base(hostSrc))
+	vmDst := path.Join(inst.targetDir(), filepath.Base(hostSrc))
+	if inst.agent != nil {
+		if err := vmimpl.AgentCopy(inst.agent, hostSrc, vmDst); err != nil {
+			return "", err
+		}
+		return vmDst, nil
+	}
 	args := append(inst.sshArgs("-P"), hostSrc, inst.sshuser+"@localhost:"+vmDst)
 	cmd := osutil.Command("scp", args...)
 	if inst.debug {
@@ -439,6 +572,10 @@ func (inst *instance) Copy(hostSrc string) (string, error) {
 
 func (inst *instance) Run(timeout time.Duration, stop <-chan bool, command string) (
 	<-chan []byte, <-chan error, error) {
+	if inst.agent != nil {
+		errc, err := vmimpl.AgentRun(inst.agent, inst.merger, timeout, stop, inst.targetDir(), command)
+		return inst.merger.Output, errc, err
+	}
 	rpipe, wpipe, err := osutil.LongPipe()
 	if err != nil {
 		return nil, nil, err
@@ -491,14 +628,35 @@ func (inst *instance) Diagnose() bool {
 	return false
 }
 
+func (inst *instance) PostMortem() ([]byte, error) {
+	if inst.cfg.GDBScript == "" {
+		return nil, nil
+	}
+	args := []string{"-batch", "-nx", "-ex", "set pagination off"}
+	if vmlinux := filepath.Join(inst.kernelObj, "vmlinux"); inst.kernelObj != "" && osutil.IsExist(vmlinux) {
+		// Allow auto-loading of the kernel gdb scripts (lx-dmesg and friends) from the build dir.
+		args = append(args, "-iex", "add-auto-load-safe-path "+inst.kernelObj, vmlinux)
+	}
+	args = append(args,
+		"-ex", fmt.Sprintf("target remote 127.0.0.1:%v", inst.gdbPort),
+		"-x", inst.cfg.GDBScript,
+		"-ex", "detach",
+	)
+	return osutil.Run(gdbTimeout, osutil.Command(inst.cfg.GDB, args...))
+}
+
+func (inst *instance) Address() string {
+	return vmimpl.SSHCommand(inst.sshkey, inst.sshuser+"@localhost", inst.port)
+}
+
 func (inst *instance) sshArgs(portArg string) []string {
 	args := []string{
 		portArg, strconv.Itoa(inst.port),
-		"-F", "/dev/null",
+		"-F", os.DevNull,
 		"-o", "ConnectionAttempts=10",
 		"-o", "ConnectTimeout=10",
 		"-o", "BatchMode=yes",
-		"-o", "UserKnownHostsFile=/dev/null",
+		"-o", "UserKnownHostsFile=" + os.DevNull,
 		"-o", "IdentitiesOnly=yes",
 		"-o", "StrictHostKeyChecking=no",
 		"-o", "LogLevel=error",
@@ -506,6 +664,7 @@ func (inst *instance) sshArgs(portArg string) []string {
 	if inst.sshkey != "" {
 		args = append(args, "-i", inst.sshkey)
 	}
+	args = append(args, inst.sshOptions.Args(inst.workdir, false)...)
 	if inst.debug {
 		args = append(args, "-v")
 	}
@@ -14,6 +14,7 @@ import (
 	"os"
 	"time"
 
+	"github.com/google/syzkaller/pkg/log"
 	"github.com/google/syzkaller/pkg/osutil"
 	"github.com/google/syzkaller/pkg/report"
 	"github.com/google/syzkaller/syz-manager/mgrconfig"
@@ -24,25 +25,29 @@ import (
 	_ "github.com/google/syzkaller/vm/gce"
 	_ "github.com/google/syzkaller/vm/gvisor"
 	_ "github.com/google/syzkaller/vm/isolated"
+	_ "github.com/google/syzkaller/vm/kubernetes"
 	_ "github.com/google/syzkaller/vm/kvm"
 	_ "github.com/google/syzkaller/vm/odroid"
 	_ "github.com/google/syzkaller/vm/qemu"
 )
 
 type Pool struct {
-	impl    vmimpl.Pool
-	workdir string
+	impl      vmimpl.Pool
+	workdir   string
+	provision []mgrconfig.ProvisionStep
 }
 
 type Instance struct {
-	impl    vmimpl.Instance
-	workdir string
-	index   int
+	impl      vmimpl.Instance
+	workdir   string
+	index     int
+	preempted bool
 }
 
 var (
-	Shutdown   = vmimpl.Shutdown
-	ErrTimeout = vmimpl.ErrTimeout
+	Shutdown     = vmimpl.Shutdown
+	ErrTimeout   = vmimpl.ErrTimeout
+	ErrPreempted = vmimpl.ErrPreempted
 )
 
 type BootErrorer interface {
@@ -60,14 +65,25 @@ func Create(cfg *mgrconfig.Config, debug bool) (*Pool, error) {
 		SSHUser: cfg.SSHUser,
 		Debug:   debug,
 		Config:  cfg.VM,
+
+		ExecutorBin: cfg.SyzExecutorBin,
+		KernelObj:   cfg.KernelObj,
+		AgentBin:    cfg.SyzAgentBin,
+
+		SSHOptions: vmimpl.SSHOptions{
+			Multiplex: cfg.SSHMultiplex,
+			JumpHost:  cfg.SSHJumpHost,
+		},
+		PanicOnWarn: cfg.PanicOnWarn(),
 	}
 	impl, err := vmimpl.Create(cfg.Type, env)
 	if err != nil {
 		return nil, err
 	}
 	return &Pool{
-		impl:    impl,
-		workdir: env.Workdir,
+		impl:      impl,
+		workdir:   env.Workdir,
+		provision: cfg.Provision,
 	}, nil
 }
 
@@ -76,6 +92,26 @@ func (pool *Pool) Count() int {
 }
 
 func (pool *Pool) Create(index int) (*Instance, error) {
+	return pool.create(index, "")
+}
+
+// SupportsCmdline says if the VM type controls kernel command line,
+// i.e. if CreateWithCmdline can be used.
+func (pool *Pool) SupportsCmdline() bool {
+	_, ok := pool.impl.(vmimpl.CmdlinePool)
+	return ok
+}
+
+// CreateWithCmdline is the same as Create, but additionally appends cmdline
+// to the kernel command line for this boot.
+func (pool *Pool) CreateWithCmdline(index int, cmdline string) (*Instance, error) {
+	if !pool.SupportsCmdline() {
+		return nil, fmt.Errorf("VM type does not control kernel command line")
+	}
+	return pool.create(index, cmdline)
+}
+
+func (pool *Pool) create(index int, cmdline string) (*Instance, error) {
 	if index < 0 || index >= pool.Count() {
 		return nil, fmt.Errorf("invalid VM index %v (count %v)", index, pool.Count())
 	}
@@ -83,16 +119,96 @@ func (pool *Pool) Create(index int) (*Instance, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to create instance temp dir: %v", err)
 	}
-	impl, err := pool.impl.Create(workdir, index)
+	var impl vmimpl.Instance
+	if cmdline != "" {
+		impl, err = pool.impl.(vmimpl.CmdlinePool).CreateWithCmdline(workdir, index, cmdline)
+	} else {
+		impl, err = pool.impl.Create(workdir, index)
+	}
 	if err != nil {
 		os.RemoveAll(workdir)
 		return nil, err
 	}
-	return &Instance{
+	inst := &Instance{
 		impl:    impl,
 		workdir: workdir,
 		index:   index,
-	}, nil
+	}
+	if err := inst.provision(pool.provision); err != nil {
+		inst.Close()
+		return nil, err
+	}
+	return inst, nil
+}
+
+// ProvisionError is returned by Pool.Create if a guest provisioning step fails.
+type ProvisionError struct {
+	Step   string
+	Output []byte
+	Err    error
+}
+
+func (err *ProvisionError) Error() string {
+	return fmt.Sprintf("provisioning step %q failed: %v\n%s", err.Step, err.Err, err.Output)
+}
+
+const provisionTimeout = 5 * time.Minute
+
+func (inst *Instance) provision(steps []mgrconfig.ProvisionStep) error {
+	for _, step := range steps {
+		command := step.Command
+		if step.Script != "" {
+			vmScript, err := inst.Copy(step.Script)
+			if err != nil {
+				return &ProvisionError{Step: step.Script, Err: fmt.Errorf("failed to copy script: %v", err)}
+			}
+			command = "sh " + vmScript
+		}
+		output, err := inst.runCommand(provisionTimeout, command)
+		if err == nil {
+			continue
+		}
+		if step.Optional {
+			log.Logf(0, "vm-%v: optional provisioning step %q failed: %v\n%s",
+				inst.index, command, err, output)
+			continue
+		}
+		return &ProvisionError{Step: command, Output: output, Err: err}
+	}
+	return nil
+}
+
+// runCommand runs a command in the instance and waits for its completion.
+func (inst *Instance) runCommand(timeout time.Duration, command string) ([]byte, error) {
+	outc, errc, err := inst.Run(timeout, nil, command)
+	if err != nil {
+		return nil, err
+	}
+	var output []byte
+	for {
+		select {
+		case out, ok := <-outc:
+			if !ok {
+				outc = nil
+				continue
+			}
+			output = append(output, out...)
+		case err := <-errc:
+			// Grab whatever output is already buffered.
+			for outc != nil {
+				select {
+				case out, ok := <-outc:
+					if !ok {
+						outc = nil
+					}
+					output = append(output, out...)
+				default:
+					outc = nil
+				}
+			}
+			return output, err
+		}
+	}
 }
 
 func (inst *Instance) Copy(hostSrc string) (string, error) {
@@ -108,6 +224,12 @@ func (inst *Instance) Run(timeout time.Duration, stop <-chan bool, command strin
 	return inst.impl.Run(timeout, stop, command)
 }
 
+// Preempted returns true if MonitorExecution returned nil because the VM was preempted
+// rather than because the program finished or timed out.
+func (inst *Instance) Preempted() bool {
+	return inst.preempted
+}
+
 func (inst *Instance) Diagnose() bool {
 	return inst.impl.Diagnose()
 }
@@ -117,11 +239,38 @@ func (inst *Instance) Close() {
 	os.RemoveAll(inst.workdir)
 }
 
+// PostMortem collects post-mortem debugging info from the crashed VM
+// after MonitorExecution has returned a report. Returns nil if the VM type does not support it.
+func (inst *Instance) PostMortem() []byte {
+	pm, ok := inst.impl.(vmimpl.PostMortemInstance)
+	if !ok {
+		return nil
+	}
+	output, err := pm.PostMortem()
+	if err != nil {
+		log.Logf(0, "post-mortem failed: %v", err)
+		if verr, ok := err.(*osutil.VerboseError); ok {
+			output = append([]byte(verr.Title+"\n"), verr.Output...)
+		}
+	}
+	return output
+}
+
+// Address returns a command that connects to the VM for manual debugging.
+// Returns "" if the VM type does not support it.
+func (inst *Instance) Address() string {
+	addr, ok := inst.impl.(vmimpl.AddressInstance)
+	if !ok {
+		return ""
+	}
+	return addr.Address()
+}
+
 // MonitorExecution monitors execution of a program running inside of a VM.
 // It detects kernel oopses in output, lost connections, hangs, etc.
 // outc/errc is what vm.Instance.Run returns, reporter parses kernel output for oopses.
 // If canExit is false and the program exits, it is treated as an error.
-// Returns a non-symbolized crash report, or nil if no error happens.
+// Returns a non-symbolized crash report, or nil if no error happens (see also Preempted).
 func (inst *Instance) MonitorExecution(outc <-chan []byte, errc <-chan error,
 	reporter report.Reporter, canExit bool) (
 	rep *report.Report) {
@@ -150,6 +299,7 @@ func (inst *Instance) MonitorExecution(outc <-chan []byte, errc <-chan error,
 		// Give it some time to finish writing the error message.
 		waitForOutput()
 		if bytes.Contains(output, []byte("SYZ-FUZZER: PREEMPTED")) {
+			inst.preempted = true
 			return nil
 		}
 		if !reporter.ContainsCrash(output[matchPos:]) {
@@ -196,6 +346,9 @@ func (inst *Instance) MonitorExecution(outc <-chan []byte, errc <-chan error,
 				return extractError("")
 			case ErrTimeout:
 				return nil
+			case ErrPreempted:
+				inst.preempted = true
+				return nil
 			default:
 				// Note: connection lost can race with a kernel oops message.
 				// In such case we want to return the kernel oops.
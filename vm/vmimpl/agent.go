@@ -0,0 +1,64 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package vmimpl
+
+import (
+	"io/ioutil"
+	"time"
+
+	"github.com/google/syzkaller/pkg/agent"
+)
+
+const agentMergerName = "agent"
+
+// AgentRun runs command in dir in the VM with the guest agent (see pkg/agent).
+// It has the same semantics as Instance.Run for VM types that run commands over ssh:
+// the output is added to merger, errc receives ErrTimeout on timeout or stop,
+// nil if the command exits successfully and the error otherwise
+// (including failures of other merger inputs, e.g. console of a crashed VM).
+func AgentRun(client *agent.Client, merger *OutputMerger, timeout time.Duration, stop <-chan bool,
+	dir, command string) (<-chan error, error) {
+	s, err := client.Exec(dir, command)
+	if err != nil {
+		return nil, err
+	}
+	merger.Add(agentMergerName, s.Output)
+	done := make(chan error, 1)
+	go func() {
+		done <- s.Wait()
+	}()
+	errc := make(chan error, 1)
+	go func() {
+		timer := time.After(timeout)
+		for {
+			select {
+			case <-timer:
+				errc <- ErrTimeout
+			case <-stop:
+				errc <- ErrTimeout
+			case err := <-done:
+				errc <- err
+				return
+			case err := <-merger.Err:
+				if merr, ok := err.(MergerError); ok && merr.Name == agentMergerName {
+					// Output of the command is closed, the exit status follows.
+					continue
+				}
+				errc <- err
+			}
+			s.Kill()
+			return
+		}
+	}()
+	return errc, nil
+}
+
+// AgentCopy copies hostSrc file to vmDst in the VM with the guest agent.
+func AgentCopy(client *agent.Client, hostSrc, vmDst string) error {
+	data, err := ioutil.ReadFile(hostSrc)
+	if err != nil {
+		return err
+	}
+	return client.WriteFile(vmDst, data)
+}
@@ -0,0 +1,13 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package vmimpl
+
+import (
+	"fmt"
+	"io"
+)
+
+func OpenConsole(con string) (rc io.ReadCloser, err error) {
+	return nil, fmt.Errorf("serial consoles are not supported on windows")
+}
@@ -0,0 +1,70 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package vmimpl
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+
+	"github.com/google/syzkaller/pkg/hash"
+)
+
+// SSHOptions contains ssh connection parameters common for all VM types.
+type SSHOptions struct {
+	// Multiplex says if ssh sessions to the same VM should share
+	// a single network connection (ssh ControlMaster).
+	Multiplex bool
+	// JumpHost is an optional intermediate host ([user@]host[:port])
+	// used to reach VMs that are not directly accessible (ssh ProxyJump).
+	JumpHost string
+}
+
+// Args returns ssh/scp arguments that implement the options.
+// Control sockets for connection multiplexing are specific to dir (the VM instance workdir).
+// Jump host is used only if remote is set, for VMs running
+// on the local machine it does not make sense.
+// Windows OpenSSH does not support multiplexing, so it's silently disabled there.
+func (opts SSHOptions) Args(dir string, remote bool) []string {
+	var args []string
+	if opts.Multiplex && dir != "" && runtime.GOOS != "windows" {
+		args = append(args,
+			"-o", "ControlMaster=auto",
+			"-o", "ControlPath="+controlPath(dir),
+			"-o", "ControlPersist=60",
+		)
+	}
+	if opts.JumpHost != "" && remote {
+		args = append(args, "-o", "ProxyJump="+opts.JumpHost)
+	}
+	return args
+}
+
+// controlPath returns path of the ssh control socket for VM instance dir.
+// Unix socket paths are limited to ~108 bytes and workdirs can be long, so the socket
+// is created in the temp dir and named after hash of dir (so that different VM instances
+// with the same address don't share connections) and %C (hash of the connection parameters).
+func controlPath(dir string) string {
+	return filepath.Join(os.TempDir(), "syz-"+hash.String([]byte(dir))[:8]+"-%C")
+}
+
+// SCPHost returns host in the form suitable for scp destinations (host:path),
+// IPv6 addresses need to be enclosed in square brackets.
+func SCPHost(host string) string {
+	if strings.Contains(host, ":") && !strings.HasPrefix(host, "[") {
+		return "[" + host + "]"
+	}
+	return host
+}
+
+// SSHCommand returns ssh command line that connects to target ([user@]host) for manual debugging.
+func SSHCommand(sshKey, target string, port int) string {
+	cmd := fmt.Sprintf("ssh -p %v", port)
+	if sshKey != "" {
+		cmd += " -i " + sshKey
+	}
+	return cmd + " -o StrictHostKeyChecking=no -o UserKnownHostsFile=/dev/null " + target
+}
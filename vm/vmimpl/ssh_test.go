@@ -0,0 +1,54 @@
+// Copyright 2018 syzkaller project authors. All rights reserved.
+// Use of this source code is governed by Apache 2 LICENSE that can be found in the LICENSE file.
+
+package vmimpl
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSCPHost(t *testing.T) {
+	for host, want := range map[string]string{
+		"host":        "host",
+		"10.0.0.1":    "10.0.0.1",
+		"::1":         "[::1]",
+		"fe80::1%eth": "[fe80::1%eth]",
+		"[::1]":       "[::1]",
+	} {
+		if got := SCPHost(host); got != want {
+			t.Errorf("%q: got %q, want %q", host, got, want)
+		}
+	}
+}
+
+func TestControlPath(t *testing.T) {
+	dir1 := "/" + strings.Repeat("long-syz-ci-workdir/", 10) + "instance-0"
+	dir2 := "/" + strings.Repeat("long-syz-ci-workdir/", 10) + "instance-1"
+	path1, path2 := controlPath(dir1), controlPath(dir2)
+	if path1 == path2 {
+		t.Fatalf("instances share control path %v", path1)
+	}
+	// The socket name must not depend on length of the workdir (%C expands to 40 chars).
+	if name := filepath.Base(path1); len(name) > 16 {
+		t.Fatalf("control socket name %v is too long", name)
+	}
+}
+
+func TestSSHCommand(t *testing.T) {
+	for _, test := range []struct {
+		key, target string
+		port        int
+		want        string
+	}{
+		{"", "root@localhost", 10022,
+			"ssh -p 10022 -o StrictHostKeyChecking=no -o UserKnownHostsFile=/dev/null root@localhost"},
+		{"/key", "root@::1", 22,
+			"ssh -p 22 -i /key -o StrictHostKeyChecking=no -o UserKnownHostsFile=/dev/null root@::1"},
+	} {
+		if got := SSHCommand(test.key, test.target, test.port); got != test.want {
+			t.Errorf("got %q, want %q", got, test.want)
+		}
+	}
+}
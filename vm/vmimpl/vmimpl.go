@@ -39,7 +39,8 @@ type Instance interface {
 
 	// Run runs cmd inside of the VM (think of ssh cmd).
 	// outc receives combined cmd and kernel console output.
-	// errc receives either command Wait return error or vmimpl.ErrTimeout.
+	// errc receives either command Wait return error, vmimpl.ErrTimeout
+	// or vmimpl.ErrPreempted if the VM was stopped by the cloud provider.
 	// Command is terminated after timeout. Send on the stop chan can be used to terminate it earlier.
 	Run(timeout time.Duration, stop <-chan bool, command string) (outc <-chan []byte, errc <-chan error, err error)
 
@@ -52,6 +53,27 @@ type Instance interface {
 	Close()
 }
 
+// CmdlinePool is implemented by pools of VM types that control kernel command line
+// (e.g. qemu with an external kernel). CreateWithCmdline is the same as Create,
+// but additionally appends cmdline to the kernel command line for this boot.
+type CmdlinePool interface {
+	CreateWithCmdline(workdir string, index int, cmdline string) (Instance, error)
+}
+
+// PostMortemInstance is implemented by instances of VM types that can collect post-mortem
+// debugging info from a crashed VM that is still alive (e.g. qemu with gdb_script).
+// PostMortem returns the collected output and releases the VM.
+type PostMortemInstance interface {
+	PostMortem() ([]byte, error)
+}
+
+// AddressInstance is implemented by instances of VM types that can be accessed manually
+// (e.g. VMs held for manual debugging by syz-manager).
+// Address returns a command that connects to the VM (e.g. ssh command line).
+type AddressInstance interface {
+	Address() string
+}
+
 // Env contains global constant parameters for a pool of VMs.
 type Env struct {
 	// Unique name
@@ -65,6 +87,19 @@ type Env struct {
 	SSHUser string
 	Debug   bool
 	Config  []byte // json-serialized VM-type-specific config
+	// ExecutorBin is the syz-executor binary, used by VM types that test images before use.
+	ExecutorBin string
+	// SSHOptions are applied to all ssh connections to VMs.
+	SSHOptions SSHOptions
+	// PanicOnWarn says if the kernel should panic on WARNINGs,
+	// used by VM types that control kernel command line.
+	PanicOnWarn bool
+	// AgentBin is the syz-agent binary (see pkg/agent), used by VM types that can run commands
+	// with the guest agent instead of ssh. The binary may not exist.
+	AgentBin string
+	// KernelObj is the directory with kernel object files (vmlinux),
+	// used by VM types for post-mortem debugging (optional).
+	KernelObj string
 }
 
 // BootError is returned by Pool.Create when VM does not boot.
@@ -99,6 +134,9 @@ var (
 	// Close to interrupt all pending operations in all VMs.
 	Shutdown   = make(chan struct{})
 	ErrTimeout = errors.New("timeout")
+	// ErrPreempted is returned by Run if the VM was preempted (e.g. a preemptible GCE instance),
+	// this is not a kernel bug and the VM needs to be re-created.
+	ErrPreempted = errors.New("preempted")
 
 	ctors = make(map[string]ctorFunc)
 )